@@ -1,6 +1,7 @@
 package app
 
 import (
+	v0_7_0 "github.com/KYVENetwork/chain/app/upgrades/v0.7.0"
 	v0_7_0_beta1 "github.com/KYVENetwork/chain/app/upgrades/v0.7.0_beta1"
 	"io"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -153,6 +155,10 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		poolmoduleclient.UnpausePoolHandler,
 		poolmoduleclient.SchedulePoolUpgradeHandler,
 		poolmoduleclient.CancelPoolUpgradeHandler,
+		poolmoduleclient.ResetPoolHandler,
+		poolmoduleclient.CreateRuntimeHandler,
+		poolmoduleclient.UpdateRuntimeHandler,
+		poolmoduleclient.DeprecateRuntimeHandler,
 	)
 
 	return govProposalHandlers
@@ -206,6 +212,9 @@ type App struct {
 
 	// mm is the module manager
 	mm *module.Manager
+
+	// configurator is used to register and run the module migrations
+	configurator module.Configurator
 }
 
 func New(
@@ -390,6 +399,8 @@ func NewApp(
 		app.DelegationKeeper,
 	)
 
+	poolmodulekeeper.SetBundlesKeeper(&app.PoolKeeper, app.BundlesKeeper)
+
 	app.QueryKeeper = *querymodulekeeper.NewKeeper(
 		appCodec,
 		keys[querymoduletypes.StoreKey],
@@ -576,7 +587,8 @@ func NewApp(
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.mm.RegisterServices(module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	app.mm.RegisterServices(app.configurator)
 
 	// initialize stores
 	app.MountKVStores(keys)
@@ -740,6 +752,35 @@ func GetMaccPerms() map[string][]string {
 }
 
 func (app *App) setupUpgradeHandlers() {
-	//app.UpgradeKeeper.SetUpgradeHandler(v0_7_0.UpgradeName, v0_7_0.CreateUpgradeHandler(&app.RegistryKeeper))
+	app.UpgradeKeeper.SetUpgradeHandler(v0_7_0.UpgradeName, v0_7_0.CreateUpgradeHandler(
+		app.mm,
+		app.configurator,
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		&app.RegistryKeeper,
+		&app.PoolKeeper,
+		&app.StakersKeeper,
+		&app.DelegationKeeper,
+		&app.BundlesKeeper,
+	))
 	app.UpgradeKeeper.SetUpgradeHandler(v0_7_0_beta1.UpgradeName, v0_7_0_beta1.CreateUpgradeHandler(&app.RegistryKeeper))
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+
+	// The protocol modules replace x/registry with v0.7.0 and need new stores.
+	if upgradeInfo.Name == v0_7_0.UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{
+				poolmoduletypes.StoreKey,
+				stakersmoduletypes.StoreKey,
+				delegationmoduletypes.StoreKey,
+				bundlesmoduletypes.StoreKey,
+				querymoduletypes.StoreKey,
+			},
+		}))
+	}
 }
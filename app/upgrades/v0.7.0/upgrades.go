@@ -1,17 +1,501 @@
 package v0_7_0
 
 import (
+	"sort"
+
+	"github.com/KYVENetwork/chain/util"
+	bundleskeeper "github.com/KYVENetwork/chain/x/bundles/keeper"
+	bundlestypes "github.com/KYVENetwork/chain/x/bundles/types"
+	"github.com/KYVENetwork/chain/x/delegation"
+	delegationkeeper "github.com/KYVENetwork/chain/x/delegation/keeper"
+	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
+	poolkeeper "github.com/KYVENetwork/chain/x/pool/keeper"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
 	registrykeeper "github.com/KYVENetwork/chain/x/registry/keeper"
+	registrytypes "github.com/KYVENetwork/chain/x/registry/types"
+	stakerskeeper "github.com/KYVENetwork/chain/x/stakers/keeper"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
-func CreateUpgradeHandler(registryKeeper *registrykeeper.Keeper) upgradetypes.UpgradeHandler {
+// CreateUpgradeHandler initialises the new protocol modules and moves the whole
+// state of the legacy x/registry module into x/pool, x/stakers, x/delegation
+// and x/bundles. Afterwards the registry store and module account are empty.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	accountKeeper authkeeper.AccountKeeper,
+	bankKeeper bankkeeper.Keeper,
+	distrKeeper distrkeeper.Keeper,
+	registryKeeper *registrykeeper.Keeper,
+	poolKeeper *poolkeeper.Keeper,
+	stakersKeeper *stakerskeeper.Keeper,
+	delegationKeeper *delegationkeeper.Keeper,
+	bundlesKeeper *bundleskeeper.Keeper,
+) upgradetypes.UpgradeHandler {
 	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		// Run the genesis of the new modules first, so that the migrated
+		// state is not overwritten by their default genesis.
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return nil, err
+		}
+
+		m := migrator{
+			accountKeeper:    accountKeeper,
+			bankKeeper:       bankKeeper,
+			distrKeeper:      distrKeeper,
+			registryKeeper:   registryKeeper,
+			poolKeeper:       poolKeeper,
+			stakersKeeper:    stakersKeeper,
+			delegationKeeper: delegationKeeper,
+			bundlesKeeper:    bundlesKeeper,
+		}
 
-		_ = registryKeeper
+		if err := m.migrate(ctx); err != nil {
+			return nil, err
+		}
 
 		return vm, nil
 	}
 }
+
+type migrator struct {
+	accountKeeper    authkeeper.AccountKeeper
+	bankKeeper       bankkeeper.Keeper
+	distrKeeper      distrkeeper.Keeper
+	registryKeeper   *registrykeeper.Keeper
+	poolKeeper       *poolkeeper.Keeper
+	stakersKeeper    *stakerskeeper.Keeper
+	delegationKeeper *delegationkeeper.Keeper
+	bundlesKeeper    *bundleskeeper.Keeper
+}
+
+// migrate moves the registry state module by module. The order matters:
+// stakers have to exist before anything can be delegated to them and the
+// registry F1 state has to be settled before the delegations are moved.
+func (m migrator) migrate(ctx sdk.Context) error {
+	if err := m.migratePools(ctx); err != nil {
+		return err
+	}
+
+	m.migrateBundles(ctx)
+
+	if err := m.migrateStakers(ctx); err != nil {
+		return err
+	}
+
+	if err := m.migrateDelegations(ctx); err != nil {
+		return err
+	}
+
+	m.migrateQueues(ctx)
+
+	// Everything left in the registry module is rounding dust of the
+	// registry F1 distribution, which belongs to the treasury.
+	remaining := m.bankKeeper.GetAllBalances(ctx, m.accountKeeper.GetModuleAddress(registrytypes.ModuleName))
+	if !remaining.IsZero() {
+		if err := util.TransferCoinsFromModuleToTreasury(m.accountKeeper, m.distrKeeper, ctx, registrytypes.ModuleName, remaining); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migratePools moves all pools together with their funders into x/pool and
+// transfers the funds to the pool module.
+func (m migrator) migratePools(ctx sdk.Context) error {
+	for _, registryPool := range m.registryKeeper.GetAllPool(ctx) {
+		pool := pooltypes.Pool{
+			Id:             registryPool.Id,
+			Name:           registryPool.Name,
+			Runtime:        registryPool.Runtime,
+			Logo:           registryPool.Logo,
+			Config:         registryPool.Config,
+			StartKey:       registryPool.StartKey,
+			CurrentKey:     registryPool.CurrentKey,
+			CurrentValue:   registryPool.CurrentValue,
+			CurrentHeight:  registryPool.CurrentHeight,
+			TotalBundles:   registryPool.TotalBundles,
+			UploadInterval: registryPool.UploadInterval,
+			OperatingCost:  registryPool.OperatingCost,
+			MinStake:       registryPool.MinStake,
+			MaxBundleSize:  registryPool.MaxBundleSize,
+			Paused:         registryPool.Paused,
+			TotalFunds:     sdk.NewCoins(),
+			Protocol:       &pooltypes.Protocol{},
+			UpgradePlan:    &pooltypes.UpgradePlan{},
+		}
+
+		if registryPool.Protocol != nil {
+			pool.Protocol = &pooltypes.Protocol{
+				Version:     registryPool.Protocol.Version,
+				Binaries:    registryPool.Protocol.Binaries,
+				LastUpgrade: registryPool.Protocol.LastUpgrade,
+			}
+		}
+
+		if registryPool.UpgradePlan != nil {
+			pool.UpgradePlan = &pooltypes.UpgradePlan{
+				Version:     registryPool.UpgradePlan.Version,
+				Binaries:    registryPool.UpgradePlan.Binaries,
+				ScheduledAt: registryPool.UpgradePlan.ScheduledAt,
+				Duration:    registryPool.UpgradePlan.Duration,
+			}
+		}
+
+		for _, address := range registryPool.Funders {
+			registryFunder, found := m.registryKeeper.GetFunder(ctx, address, registryPool.Id)
+			if !found || registryFunder.Amount == 0 {
+				continue
+			}
+
+			amount := util.KyveCoins(registryFunder.Amount)
+			pool.InsertFunder(pooltypes.Funder{
+				Address: address,
+				Amount:  amount,
+			})
+
+			m.registryKeeper.RemoveFunder(ctx, address, registryPool.Id)
+		}
+
+		if err := util.TransferCoinsFromModuleToModule(m.bankKeeper, ctx, registrytypes.ModuleName, pooltypes.ModuleName, pool.TotalFunds); err != nil {
+			return err
+		}
+
+		m.poolKeeper.SetPool(ctx, pool)
+
+		if registryPool.BundleProposal != nil {
+			m.bundlesKeeper.SetBundleProposal(ctx, bundlestypes.BundleProposal{
+				PoolId:        registryPool.Id,
+				StorageId:     registryPool.BundleProposal.StorageId,
+				Uploader:      registryPool.BundleProposal.Uploader,
+				NextUploader:  registryPool.BundleProposal.NextUploader,
+				ByteSize:      registryPool.BundleProposal.ByteSize,
+				ToHeight:      registryPool.BundleProposal.ToHeight,
+				ToKey:         registryPool.BundleProposal.ToKey,
+				ToValue:       registryPool.BundleProposal.ToValue,
+				BundleHash:    registryPool.BundleProposal.BundleHash,
+				CreatedAt:     registryPool.BundleProposal.CreatedAt,
+				VotersValid:   registryPool.BundleProposal.VotersValid,
+				VotersInvalid: registryPool.BundleProposal.VotersInvalid,
+				VotersAbstain: registryPool.BundleProposal.VotersAbstain,
+			})
+		}
+
+		m.registryKeeper.RemovePool(ctx, registryPool.Id)
+	}
+
+	// Funders which are not referenced by their pool do not hold any funds
+	// in the new modules, therefore their tokens are paid back.
+	for _, registryFunder := range m.registryKeeper.GetAllFunder(ctx) {
+		if err := util.TransferFromModuleToAddress(m.bankKeeper, ctx, registrytypes.ModuleName, registryFunder.Account, registryFunder.Amount); err != nil {
+			return err
+		}
+		m.registryKeeper.RemoveFunder(ctx, registryFunder.Account, registryFunder.PoolId)
+	}
+
+	m.poolKeeper.SetPoolCount(ctx, m.registryKeeper.GetPoolCount(ctx))
+	m.registryKeeper.SetPoolCount(ctx, 0)
+
+	return nil
+}
+
+// migrateBundles moves all finalized bundles into x/bundles.
+func (m migrator) migrateBundles(ctx sdk.Context) {
+	for _, proposal := range m.registryKeeper.GetAllProposal(ctx) {
+		m.bundlesKeeper.SetFinalizedBundle(ctx, bundlestypes.FinalizedBundle{
+			PoolId:      proposal.PoolId,
+			Id:          proposal.Id,
+			StorageId:   proposal.StorageId,
+			Uploader:    proposal.Uploader,
+			FromHeight:  proposal.FromHeight,
+			ToHeight:    proposal.ToHeight,
+			Key:         proposal.Key,
+			Value:       proposal.Value,
+			BundleHash:  proposal.BundleHash,
+			FinalizedAt: proposal.FinalizedAt,
+		})
+
+		m.registryKeeper.RemoveProposal(ctx, proposal)
+	}
+}
+
+// migrateStakers merges the per-pool registry stakers into one staker per
+// address. The staker takes the commission and metadata of the pool it has
+// the most stake in and joins every pool it was active in with its own
+// address as valaddress. The stake of all pools becomes one self-delegation.
+func (m migrator) migrateStakers(ctx sdk.Context) error {
+	registryStakers := m.registryKeeper.GetAllStaker(ctx)
+
+	selfDelegations := make(map[string]uint64)
+	mainStakers := make(map[string]registrytypes.Staker)
+	addresses := make([]string, 0)
+
+	for _, registryStaker := range registryStakers {
+		mainStaker, found := mainStakers[registryStaker.Account]
+		if !found {
+			addresses = append(addresses, registryStaker.Account)
+		}
+		if !found || registryStaker.Amount > mainStaker.Amount {
+			mainStakers[registryStaker.Account] = registryStaker
+		}
+		selfDelegations[registryStaker.Account] += registryStaker.Amount
+	}
+
+	for _, address := range addresses {
+		mainStaker := mainStakers[address]
+
+		m.stakersKeeper.AppendStaker(ctx, stakerstypes.Staker{
+			Address:    address,
+			Commission: mainStaker.Commission,
+			Moniker:    mainStaker.Moniker,
+			Website:    mainStaker.Website,
+			Logo:       mainStaker.Logo,
+		})
+
+		if err := m.delegate(ctx, address, address, selfDelegations[address]); err != nil {
+			return err
+		}
+	}
+
+	for _, registryStaker := range registryStakers {
+		if registryStaker.Status != registrytypes.STAKER_STATUS_INACTIVE {
+			m.stakersKeeper.AddValaccountToPool(ctx, registryStaker.PoolId, registryStaker.Account, registryStaker.Account)
+
+			valaccount, _ := m.stakersKeeper.GetValaccount(ctx, registryStaker.PoolId, registryStaker.Account)
+			valaccount.Points = registryStaker.Points
+			m.stakersKeeper.SetValaccount(ctx, valaccount)
+		}
+
+		m.registryKeeper.RemoveStaker(ctx, registryStaker.Account, registryStaker.PoolId)
+	}
+
+	return nil
+}
+
+// migrateDelegations pays out all outstanding rewards of the registry F1
+// distribution and afterwards delegates the merged delegations of every
+// delegator to the new staker. The per-pool F1 state can not be merged,
+// so the new F1 state starts fresh with the first period.
+func (m migrator) migrateDelegations(ctx sdk.Context) error {
+	registryDelegators := m.registryKeeper.GetAllDelegator(ctx)
+
+	type delegationKey struct {
+		staker    string
+		delegator string
+	}
+
+	delegations := make(map[delegationKey]uint64)
+	keys := make([]delegationKey, 0)
+
+	for _, registryDelegator := range registryDelegators {
+		if err := util.TransferFromModuleToAddress(m.bankKeeper, ctx, registrytypes.ModuleName, registryDelegator.Delegator, m.getOutstandingRewards(ctx, registryDelegator)); err != nil {
+			return err
+		}
+
+		key := delegationKey{staker: registryDelegator.Staker, delegator: registryDelegator.Delegator}
+		if _, found := delegations[key]; !found {
+			keys = append(keys, key)
+		}
+		delegations[key] += registryDelegator.DelegationAmount
+	}
+
+	for _, key := range keys {
+		if !m.stakersKeeper.DoesStakerExist(ctx, key.staker) {
+			// The staker left all pools, the delegation is paid back.
+			if err := util.TransferFromModuleToAddress(m.bankKeeper, ctx, registrytypes.ModuleName, key.delegator, delegations[key]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := m.delegate(ctx, key.staker, key.delegator, delegations[key]); err != nil {
+			return err
+		}
+	}
+
+	for _, registryDelegator := range registryDelegators {
+		m.registryKeeper.RemoveDelegator(ctx, registryDelegator.Id, registryDelegator.Staker, registryDelegator.Delegator)
+	}
+
+	for _, entry := range m.registryKeeper.GetAllDelegationEntries(ctx) {
+		m.registryKeeper.RemoveDelegationEntries(ctx, entry.Id, entry.Staker, entry.KIndex)
+	}
+
+	for _, poolData := range m.registryKeeper.GetAllDelegationPoolData(ctx) {
+		m.registryKeeper.RemoveDelegationPoolData(ctx, poolData.Id, poolData.Staker)
+	}
+
+	for _, cooldown := range m.registryKeeper.GetAllRedelegationCooldownEntries(ctx) {
+		m.delegationKeeper.SetRedelegationCooldown(ctx, delegationtypes.RedelegationCooldown{
+			Address:      cooldown.Address,
+			CreationDate: cooldown.CreationDate,
+		})
+		m.registryKeeper.RemoveRedelegationCooldown(ctx, cooldown.Address, cooldown.CreationDate)
+	}
+
+	return nil
+}
+
+// getOutstandingRewards calculates the rewards of a registry delegator with
+// the registry F1 state: $n * (e_{latest} + T / N - e_{k})$.
+func (m migrator) getOutstandingRewards(ctx sdk.Context, registryDelegator registrytypes.Delegator) uint64 {
+	poolData, found := m.registryKeeper.GetDelegationPoolData(ctx, registryDelegator.Id, registryDelegator.Staker)
+	if !found {
+		return 0
+	}
+
+	startEntry, found := m.registryKeeper.GetDelegationEntries(ctx, registryDelegator.Id, registryDelegator.Staker, registryDelegator.KIndex)
+	if !found {
+		return 0
+	}
+
+	latestEntry, found := m.registryKeeper.GetDelegationEntries(ctx, registryDelegator.Id, registryDelegator.Staker, poolData.LatestIndexK)
+	if !found {
+		return 0
+	}
+
+	value := sdk.MustNewDecFromStr(latestEntry.Balance).Sub(sdk.MustNewDecFromStr(startEntry.Balance))
+	if poolData.TotalDelegation > 0 {
+		value = value.Add(sdk.NewDec(int64(poolData.CurrentRewards)).QuoInt64(int64(poolData.TotalDelegation)))
+	}
+
+	reward := value.MulInt64(int64(registryDelegator.DelegationAmount))
+	if !reward.IsPositive() {
+		return 0
+	}
+
+	return reward.TruncateInt().Uint64()
+}
+
+// delegate moves the amount from the registry module to the delegator and
+// delegates it with a regular delegation, so that the F1 state is built by
+// the delegation module itself.
+func (m migrator) delegate(ctx sdk.Context, staker string, delegator string, amount uint64) error {
+	if amount == 0 {
+		return nil
+	}
+
+	if err := util.TransferFromModuleToAddress(m.bankKeeper, ctx, registrytypes.ModuleName, delegator, amount); err != nil {
+		return err
+	}
+
+	_, err := delegation.NewHandler(*m.delegationKeeper)(ctx, &delegationtypes.MsgDelegate{
+		Creator: delegator,
+		Staker:  staker,
+		Amount:  amount,
+	})
+
+	return err
+}
+
+// migrateQueues moves the unbonding and commission change queues. Unbonding
+// stakers and unbonding delegators share the undelegation queue of
+// x/delegation, therefore both registry queues are merged by the time they
+// are due. Entries of stakers which no longer exist are dropped.
+func (m migrator) migrateQueues(ctx sdk.Context) {
+	undelegations := make([]delegationtypes.UndelegationQueueEntry, 0)
+
+	// The undelegation queue releases an entry after the unbonding delegation
+	// time, so the creation time of an unbonding staker is shifted to keep
+	// its release after the unbonding staking time.
+	unbondingStakingTime := m.stakersKeeper.UnbondingStakingTime(ctx)
+	unbondingDelegationTime := m.delegationKeeper.UnbondingDelegationTime(ctx)
+
+	for _, entry := range m.registryKeeper.GetAllUnbondingStakingQueueEntries(ctx) {
+		m.registryKeeper.RemoveUnbondingStakingQueueEntry(ctx, &entry)
+
+		if !m.stakersKeeper.DoesStakerExist(ctx, entry.Staker) {
+			continue
+		}
+
+		var creationTime uint64
+		if entry.CreationTime+unbondingStakingTime > unbondingDelegationTime {
+			creationTime = entry.CreationTime + unbondingStakingTime - unbondingDelegationTime
+		}
+
+		undelegations = append(undelegations, delegationtypes.UndelegationQueueEntry{
+			Staker:       entry.Staker,
+			Delegator:    entry.Staker,
+			Amount:       entry.Amount,
+			CreationTime: creationTime,
+		})
+	}
+
+	for _, entry := range m.registryKeeper.GetAllUnbondingDelegationQueueEntries(ctx) {
+		m.registryKeeper.RemoveUnbondingDelegationQueueEntry(ctx, &entry)
+
+		if !m.stakersKeeper.DoesStakerExist(ctx, entry.Staker) {
+			continue
+		}
+
+		undelegations = append(undelegations, delegationtypes.UndelegationQueueEntry{
+			Staker:       entry.Staker,
+			Delegator:    entry.Delegator,
+			Amount:       entry.Amount,
+			CreationTime: entry.CreationTime,
+		})
+	}
+
+	for _, unbondingStaker := range m.registryKeeper.GetAllUnbondingStakers(ctx) {
+		m.registryKeeper.RemoveUnbondingStaker(ctx, &unbondingStaker)
+	}
+
+	sort.SliceStable(undelegations, func(i, j int) bool {
+		return undelegations[i].CreationTime < undelegations[j].CreationTime
+	})
+
+	undelegationQueueState := m.delegationKeeper.GetQueueState(ctx)
+	for _, entry := range undelegations {
+		undelegationQueueState.HighIndex += 1
+		entry.Index = undelegationQueueState.HighIndex
+		m.delegationKeeper.SetUndelegationQueueEntry(ctx, entry)
+	}
+	m.delegationKeeper.SetQueueState(ctx, undelegationQueueState)
+
+	// The new commission is per staker, so only the latest change request
+	// of every staker is kept.
+	commissionChanges := make(map[string]registrytypes.CommissionChangeQueueEntry)
+	stakers := make([]string, 0)
+
+	for _, entry := range m.registryKeeper.GetAllCommissionChangeQueueEntries(ctx) {
+		if _, found := commissionChanges[entry.Staker]; !found {
+			stakers = append(stakers, entry.Staker)
+		}
+		if entry.Index >= commissionChanges[entry.Staker].Index {
+			commissionChanges[entry.Staker] = entry
+		}
+		m.registryKeeper.RemoveCommissionChangeQueueEntry(ctx, &entry)
+	}
+
+	sort.SliceStable(stakers, func(i, j int) bool {
+		return commissionChanges[stakers[i]].Index < commissionChanges[stakers[j]].Index
+	})
+
+	commissionQueueState := m.stakersKeeper.GetQueueState(ctx, stakerstypes.QUEUE_IDENTIFIER_COMMISSION)
+	for _, staker := range stakers {
+		if !m.stakersKeeper.DoesStakerExist(ctx, staker) {
+			continue
+		}
+
+		commissionQueueState.HighIndex += 1
+		m.stakersKeeper.SetCommissionChangeEntry(ctx, stakerstypes.CommissionChangeEntry{
+			Index:        commissionQueueState.HighIndex,
+			Staker:       staker,
+			Commission:   commissionChanges[staker].Commission,
+			CreationDate: commissionChanges[staker].CreationDate,
+		})
+	}
+	m.stakersKeeper.SetQueueState(ctx, stakerstypes.QUEUE_IDENTIFIER_COMMISSION, commissionQueueState)
+
+	m.registryKeeper.SetUnbondingStakingQueueState(ctx, registrytypes.UnbondingStakingQueueState{})
+	m.registryKeeper.SetUnbondingDelegationQueueState(ctx, registrytypes.UnbondingDelegationQueueState{})
+	m.registryKeeper.SetCommissionChangeQueueState(ctx, registrytypes.CommissionChangeQueueState{})
+}
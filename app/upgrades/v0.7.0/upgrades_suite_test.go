@@ -0,0 +1,13 @@
+package v0_7_0_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestUpgrades(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "v0.7.0 Upgrade Suite")
+}
@@ -0,0 +1,375 @@
+package v0_7_0_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v0_7_0 "github.com/KYVENetwork/chain/app/upgrades/v0.7.0"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	registrytypes "github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+/*
+
+TEST CASES - v0.7.0 upgrade
+
+* Migrate pools, funders and finalized bundles
+* Migrate stakers into one staker with a self-delegation
+* Migrate delegations and pay out registry rewards
+* Migrate the unbonding and commission change queues
+* Release migrated unbonding stakers after the unbonding staking time
+* Drop queue entries of stakers which no longer exist
+* Schedule a pool upgrade and update a pool after the migration
+
+*/
+
+var _ = Describe("v0.7.0 upgrade", Ordered, func() {
+	s := i.NewCleanChain()
+
+	balances := make(map[string]uint64)
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		for _, address := range []string{i.ALICE, i.BOB, i.STAKER_0, i.STAKER_1, i.DUMMY[0], i.DUMMY[1]} {
+			balances[address] = s.GetBalanceFromAddress(address)
+		}
+
+		registry := s.App().RegistryKeeper
+		now := uint64(s.Ctx().BlockTime().Unix())
+
+		// pools with funders and a finalized bundle
+		registry.SetPool(s.Ctx(), registrytypes.Pool{
+			Id:             0,
+			Name:           "Moontest",
+			Runtime:        "@kyve/evm",
+			UploadInterval: 60,
+			OperatingCost:  10_000,
+			MaxBundleSize:  100,
+			TotalBundles:   1,
+			CurrentHeight:  100,
+			Funders:        []string{i.ALICE, i.BOB},
+			TotalFunds:     150 * i.KYVE,
+			Protocol: &registrytypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: now,
+			},
+			BundleProposal: &registrytypes.BundleProposal{
+				Uploader:    i.STAKER_0,
+				StorageId:   "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+				ToHeight:    200,
+				VotersValid: []string{i.STAKER_0},
+			},
+		})
+		registry.SetPool(s.Ctx(), registrytypes.Pool{
+			Id:             1,
+			Name:           "Moontest2",
+			UploadInterval: 60,
+			MaxBundleSize:  100,
+			Funders:        []string{i.ALICE},
+			TotalFunds:     30 * i.KYVE,
+		})
+		registry.SetPoolCount(s.Ctx(), 2)
+
+		registry.SetFunder(s.Ctx(), registrytypes.Funder{Account: i.ALICE, PoolId: 0, Amount: 100 * i.KYVE})
+		registry.SetFunder(s.Ctx(), registrytypes.Funder{Account: i.BOB, PoolId: 0, Amount: 50 * i.KYVE})
+		registry.SetFunder(s.Ctx(), registrytypes.Funder{Account: i.ALICE, PoolId: 1, Amount: 30 * i.KYVE})
+
+		registry.SetProposal(s.Ctx(), registrytypes.Proposal{
+			StorageId:   "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			PoolId:      0,
+			Id:          0,
+			Uploader:    i.STAKER_0,
+			FromHeight:  0,
+			ToHeight:    100,
+			FinalizedAt: 10,
+		})
+
+		// STAKER_0 is staking in both pools, STAKER_1 only in the second one
+		registry.SetStaker(s.Ctx(), registrytypes.Staker{Account: i.STAKER_0, PoolId: 0, Amount: 100 * i.KYVE, Commission: "0.1", Moniker: "Staker 0", Status: registrytypes.STAKER_STATUS_ACTIVE, Points: 1})
+		registry.SetStaker(s.Ctx(), registrytypes.Staker{Account: i.STAKER_0, PoolId: 1, Amount: 50 * i.KYVE, Commission: "0.2", Moniker: "Other", Status: registrytypes.STAKER_STATUS_ACTIVE})
+		registry.SetStaker(s.Ctx(), registrytypes.Staker{Account: i.STAKER_1, PoolId: 1, Amount: 300 * i.KYVE, UnbondingAmount: 100 * i.KYVE, Commission: "0.5", Status: registrytypes.STAKER_STATUS_ACTIVE, Points: 2})
+		registry.SetStaker(s.Ctx(), registrytypes.Staker{Account: i.STAKER_1, PoolId: 0, Amount: 0, Commission: "0.5", Status: registrytypes.STAKER_STATUS_INACTIVE})
+
+		// DUMMY_0 delegates to STAKER_0 in both pools with outstanding rewards
+		registry.SetDelegator(s.Ctx(), registrytypes.Delegator{Id: 0, Staker: i.STAKER_0, Delegator: i.DUMMY[0], DelegationAmount: 10 * i.KYVE, KIndex: 1})
+		registry.SetDelegationPoolData(s.Ctx(), registrytypes.DelegationPoolData{Id: 0, Staker: i.STAKER_0, CurrentRewards: 5 * i.KYVE, TotalDelegation: 10 * i.KYVE, LatestIndexK: 1, DelegatorCount: 1})
+		registry.SetDelegationEntries(s.Ctx(), registrytypes.DelegationEntries{Id: 0, Staker: i.STAKER_0, KIndex: 1, Balance: "0"})
+
+		registry.SetDelegator(s.Ctx(), registrytypes.Delegator{Id: 1, Staker: i.STAKER_0, Delegator: i.DUMMY[0], DelegationAmount: 20 * i.KYVE, KIndex: 0})
+		registry.SetDelegationPoolData(s.Ctx(), registrytypes.DelegationPoolData{Id: 1, Staker: i.STAKER_0, TotalDelegation: 20 * i.KYVE, LatestIndexK: 1, DelegatorCount: 1})
+		registry.SetDelegationEntries(s.Ctx(), registrytypes.DelegationEntries{Id: 1, Staker: i.STAKER_0, KIndex: 0, Balance: "0"})
+		registry.SetDelegationEntries(s.Ctx(), registrytypes.DelegationEntries{Id: 1, Staker: i.STAKER_0, KIndex: 1, Balance: "0.1"})
+
+		// DUMMY_1 delegates to STAKER_1 without rewards
+		registry.SetDelegator(s.Ctx(), registrytypes.Delegator{Id: 1, Staker: i.STAKER_1, Delegator: i.DUMMY[1], DelegationAmount: 40 * i.KYVE, KIndex: 1})
+		registry.SetDelegationPoolData(s.Ctx(), registrytypes.DelegationPoolData{Id: 1, Staker: i.STAKER_1, TotalDelegation: 40 * i.KYVE, LatestIndexK: 1, DelegatorCount: 1})
+		registry.SetDelegationEntries(s.Ctx(), registrytypes.DelegationEntries{Id: 1, Staker: i.STAKER_1, KIndex: 1, Balance: "0"})
+
+		registry.SetRedelegationCooldown(s.Ctx(), registrytypes.RedelegationCooldown{Address: i.DUMMY[0], CreationDate: now - 5})
+
+		// queues, BOB is not a staker anymore
+		registry.SetUnbondingStakingQueueEntry(s.Ctx(), registrytypes.UnbondingStakingQueueEntry{Index: 1, Staker: i.STAKER_1, PoolId: 1, Amount: 100 * i.KYVE, CreationTime: now - 10})
+		registry.SetUnbondingStakingQueueEntry(s.Ctx(), registrytypes.UnbondingStakingQueueEntry{Index: 2, Staker: i.BOB, PoolId: 1, Amount: 50 * i.KYVE, CreationTime: now - 5})
+		registry.SetUnbondingStakingQueueState(s.Ctx(), registrytypes.UnbondingStakingQueueState{LowIndex: 0, HighIndex: 2})
+		registry.SetUnbondingStaker(s.Ctx(), registrytypes.UnbondingStaker{Staker: i.STAKER_1, PoolId: 1, UnbondingAmount: 100 * i.KYVE})
+
+		registry.SetUnbondingDelegationQueueEntry(s.Ctx(), registrytypes.UnbondingDelegationQueueEntry{Index: 1, Staker: i.STAKER_1, Delegator: i.DUMMY[1], PoolId: 1, Amount: 10 * i.KYVE, CreationTime: now - 20})
+		registry.SetUnbondingDelegationQueueEntry(s.Ctx(), registrytypes.UnbondingDelegationQueueEntry{Index: 2, Staker: i.BOB, Delegator: i.DUMMY[0], PoolId: 1, Amount: 5 * i.KYVE, CreationTime: now - 15})
+		registry.SetUnbondingDelegationQueueState(s.Ctx(), registrytypes.UnbondingDelegationQueueState{LowIndex: 0, HighIndex: 2})
+
+		registry.SetCommissionChangeQueueEntry(s.Ctx(), registrytypes.CommissionChangeQueueEntry{Index: 1, Staker: i.STAKER_0, PoolId: 1, Commission: "0.4", CreationDate: int64(now - 30)})
+		registry.SetCommissionChangeQueueEntry(s.Ctx(), registrytypes.CommissionChangeQueueEntry{Index: 2, Staker: i.STAKER_0, PoolId: 0, Commission: "0.3", CreationDate: int64(now - 10)})
+		registry.SetCommissionChangeQueueState(s.Ctx(), registrytypes.CommissionChangeQueueState{LowIndex: 0, HighIndex: 2})
+
+		// stakers unbond a day longer than delegators
+		stakersParams := s.App().StakersKeeper.GetParams(s.Ctx())
+		stakersParams.UnbondingStakingTime = s.App().DelegationKeeper.UnbondingDelegationTime(s.Ctx()) + 60*60*24
+		s.App().StakersKeeper.SetParams(s.Ctx(), stakersParams)
+
+		// funds 180 + stake 450 + delegation 70 + rewards 7 $KYVE and some rounding dust
+		registryBalance := sdk.NewCoins(sdk.NewInt64Coin(i.KYVE_DENOM, int64(707*i.KYVE+3)))
+		Expect(s.App().BankKeeper.MintCoins(s.Ctx(), registrytypes.ModuleName, registryBalance)).To(BeNil())
+
+		// ACT
+		s.App().UpgradeKeeper.ApplyUpgrade(s.Ctx(), upgradetypes.Plan{
+			Name:   v0_7_0.UpgradeName,
+			Height: s.Ctx().BlockHeight(),
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Migrate pools, funders and finalized bundles", func() {
+		// ASSERT
+		Expect(s.App().PoolKeeper.GetPoolCount(s.Ctx())).To(Equal(uint64(2)))
+
+		pool, found := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+		Expect(pool.Name).To(Equal("Moontest"))
+		Expect(pool.Runtime).To(Equal("@kyve/evm"))
+		Expect(pool.CurrentHeight).To(Equal(uint64(100)))
+		Expect(pool.Protocol.Version).To(Equal("0.0.0"))
+		Expect(pool.TotalFunds).To(Equal(i.KYVECoins(150 * i.KYVE)))
+		Expect(pool.Funders).To(HaveLen(2))
+
+		funder, found := pool.GetFunder(i.BOB)
+		Expect(found).To(BeTrue())
+		Expect(funder.Amount).To(Equal(i.KYVECoins(50 * i.KYVE)))
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(pool.TotalFunds).To(Equal(i.KYVECoins(30 * i.KYVE)))
+
+		Expect(s.GetBalanceFromModule("pool")).To(Equal(180 * i.KYVE))
+
+		bundleProposal, found := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+		Expect(bundleProposal.Uploader).To(Equal(i.STAKER_0))
+		Expect(bundleProposal.VotersValid).To(Equal([]string{i.STAKER_0}))
+
+		finalizedBundle, found := s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 0)
+		Expect(found).To(BeTrue())
+		Expect(finalizedBundle.StorageId).To(Equal("y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI"))
+		Expect(finalizedBundle.ToHeight).To(Equal(uint64(100)))
+
+		Expect(s.App().RegistryKeeper.GetAllPool(s.Ctx())).To(BeEmpty())
+		Expect(s.App().RegistryKeeper.GetAllFunder(s.Ctx())).To(BeEmpty())
+		Expect(s.App().RegistryKeeper.GetAllProposal(s.Ctx())).To(BeEmpty())
+
+		// registry module is empty and the rounding dust went to the treasury
+		Expect(s.GetBalanceFromModule(registrytypes.ModuleName)).To(BeZero())
+	})
+
+	It("Migrate stakers into one staker with a self-delegation", func() {
+		// ASSERT
+		staker, found := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(found).To(BeTrue())
+		Expect(staker.Commission).To(Equal("0.1"))
+		Expect(staker.Moniker).To(Equal("Staker 0"))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(150 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_1, i.STAKER_1)).To(Equal(300 * i.KYVE))
+
+		Expect(s.App().StakersKeeper.GetValaccountsFromStaker(s.Ctx(), i.STAKER_0)).To(HaveLen(2))
+
+		// the inactive staker does not join the pool
+		Expect(s.App().StakersKeeper.GetValaccountsFromStaker(s.Ctx(), i.STAKER_1)).To(HaveLen(1))
+
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 1, i.STAKER_1)
+		Expect(found).To(BeTrue())
+		Expect(valaccount.Valaddress).To(Equal(i.STAKER_1))
+		Expect(valaccount.Points).To(Equal(uint64(2)))
+
+		// stakers did not receive any tokens
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(balances[i.STAKER_0]))
+		Expect(s.GetBalanceFromAddress(i.STAKER_1)).To(Equal(balances[i.STAKER_1]))
+
+		Expect(s.App().RegistryKeeper.GetAllStaker(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Migrate delegations and pay out registry rewards", func() {
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.DUMMY[0])).To(Equal(30 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_1, i.DUMMY[1])).To(Equal(40 * i.KYVE))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_0)).To(Equal(180 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_1)).To(Equal(340 * i.KYVE))
+
+		// 5 $KYVE from the first pool and 2 $KYVE from the second pool
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(balances[i.DUMMY[0]] + 7*i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.DUMMY[1])).To(Equal(balances[i.DUMMY[1]]))
+
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.DUMMY[0]).IsZero()).To(BeTrue())
+		Expect(s.GetBalanceFromModule("delegation")).To(Equal(520 * i.KYVE))
+
+		Expect(s.App().DelegationKeeper.GetRedelegationCooldownEntries(s.Ctx(), i.DUMMY[0])).To(HaveLen(1))
+
+		Expect(s.App().RegistryKeeper.GetAllDelegator(s.Ctx())).To(BeEmpty())
+		Expect(s.App().RegistryKeeper.GetAllDelegationEntries(s.Ctx())).To(BeEmpty())
+		Expect(s.App().RegistryKeeper.GetAllDelegationPoolData(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Migrate the unbonding and commission change queues", func() {
+		// ASSERT
+		undelegations := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx())
+		Expect(undelegations).To(HaveLen(2))
+
+		// the queues are merged by creation time
+		Expect(undelegations[0].Index).To(Equal(uint64(1)))
+		Expect(undelegations[0].Delegator).To(Equal(i.DUMMY[1]))
+		Expect(undelegations[0].Amount).To(Equal(10 * i.KYVE))
+		Expect(undelegations[1].Index).To(Equal(uint64(2)))
+		Expect(undelegations[1].Delegator).To(Equal(i.STAKER_1))
+		Expect(undelegations[1].Amount).To(Equal(100 * i.KYVE))
+
+		// only the latest commission change of a staker is kept
+		commissionChanges := s.App().StakersKeeper.GetAllCommissionChangeEntries(s.Ctx())
+		Expect(commissionChanges).To(HaveLen(1))
+		Expect(commissionChanges[0].Staker).To(Equal(i.STAKER_0))
+		Expect(commissionChanges[0].Commission).To(Equal("0.3"))
+
+		Expect(s.App().RegistryKeeper.GetAllUnbondingStakingQueueEntries(s.Ctx())).To(BeEmpty())
+		Expect(s.App().RegistryKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx())).To(BeEmpty())
+		Expect(s.App().RegistryKeeper.GetAllCommissionChangeQueueEntries(s.Ctx())).To(BeEmpty())
+
+		// ACT
+		// pause the pools so that no upload timeouts are slashed in the meantime
+		for _, pool := range s.App().PoolKeeper.GetAllPools(s.Ctx()) {
+			pool.Paused = true
+			s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+		}
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.UnbondingStakingTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx())).To(BeEmpty())
+
+		Expect(s.GetBalanceFromAddress(i.DUMMY[1])).To(Equal(balances[i.DUMMY[1]] + 10*i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.STAKER_1)).To(Equal(balances[i.STAKER_1] + 100*i.KYVE))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_1)).To(Equal(230 * i.KYVE))
+	})
+
+	It("Release migrated unbonding stakers after the unbonding staking time", func() {
+		// ARRANGE
+		// pause the pools so that no upload timeouts are slashed in the meantime
+		for _, pool := range s.App().PoolKeeper.GetAllPools(s.Ctx()) {
+			pool.Paused = true
+			s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+		}
+
+		// the staker entry was created 10 seconds ago
+		unbondingStakingTime := s.App().StakersKeeper.UnbondingStakingTime(s.Ctx())
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx())[1].CreationTime).To(Equal(uint64(s.Ctx().BlockTime().Unix()) - 10 + 60*60*24))
+
+		// ACT
+		s.CommitAfterSeconds(s.App().DelegationKeeper.UnbondingDelegationTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		// only the delegator is released after the unbonding delegation time
+		undelegations := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx())
+		Expect(undelegations).To(HaveLen(1))
+		Expect(undelegations[0].Delegator).To(Equal(i.STAKER_1))
+
+		Expect(s.GetBalanceFromAddress(i.DUMMY[1])).To(Equal(balances[i.DUMMY[1]] + 10*i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.STAKER_1)).To(Equal(balances[i.STAKER_1]))
+
+		// ACT
+		s.CommitAfterSeconds(unbondingStakingTime - s.App().DelegationKeeper.UnbondingDelegationTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx())).To(BeEmpty())
+		Expect(s.GetBalanceFromAddress(i.STAKER_1)).To(Equal(balances[i.STAKER_1] + 100*i.KYVE))
+	})
+
+	It("Drop queue entries of stakers which no longer exist", func() {
+		// ASSERT
+		Expect(s.App().StakersKeeper.DoesStakerExist(s.Ctx(), i.BOB)).To(BeFalse())
+
+		for _, entry := range s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx()) {
+			Expect(entry.Staker).NotTo(Equal(i.BOB))
+		}
+
+		Expect(s.App().DelegationKeeper.GetQueueState(s.Ctx()).HighIndex).To(Equal(uint64(2)))
+		Expect(s.App().RegistryKeeper.GetAllUnbondingStakingQueueEntries(s.Ctx())).To(BeEmpty())
+		Expect(s.App().RegistryKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Schedule a pool upgrade and update a pool after the migration", func() {
+		// ARRANGE
+		// the runtime of the migrated pools is not registered yet
+		Expect(s.App().PoolKeeper.DoesRuntimeExist(s.Ctx(), "@kyve/evm")).To(BeFalse())
+
+		binaries := "{\"linux-x64\":\"https://example.com/kyve-evm-linux-x64\"}"
+
+		// ACT
+		err := s.App().PoolKeeper.UpgradePool(s.Ctx(), &pooltypes.SchedulePoolUpgradeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Runtime:     "@kyve/evm",
+			Version:     "1.0.0",
+			ScheduledAt: uint64(s.Ctx().BlockTime().Unix()) + 60,
+			Duration:    60,
+			Binaries:    binaries,
+		})
+		Expect(err).To(BeNil())
+
+		config := "{\"rpc\": \"https://rpc.example.com\"}"
+		err = s.App().PoolKeeper.UpdatePool(s.Ctx(), &pooltypes.UpdatePoolProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Id:          0,
+			UpdateMask:  []string{"config"},
+			Update:      pooltypes.PoolUpdate{Config: config},
+		})
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.UpgradePlan.Version).To(Equal("1.0.0"))
+		Expect(pool.UpgradePlan.Binaries).To(Equal(binaries))
+		Expect(pool.Config).To(Equal(config))
+
+		// the other pool does not use the runtime
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(pool.UpgradePlan.Version).To(BeEmpty())
+
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Protocol.Version).To(Equal("1.0.0"))
+		Expect(pool.Protocol.Binaries).To(Equal(binaries))
+	})
+})
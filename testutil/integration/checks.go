@@ -2,11 +2,16 @@ package integration
 
 import (
 	"github.com/KYVENetwork/chain/x/bundles"
+	bundleskeeper "github.com/KYVENetwork/chain/x/bundles/keeper"
+	bundlestypes "github.com/KYVENetwork/chain/x/bundles/types"
 	"github.com/KYVENetwork/chain/x/delegation"
+	delegationkeeper "github.com/KYVENetwork/chain/x/delegation/keeper"
 	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
 	"github.com/KYVENetwork/chain/x/pool"
+	poolkeeper "github.com/KYVENetwork/chain/x/pool/keeper"
 	querytypes "github.com/KYVENetwork/chain/x/query/types"
 	"github.com/KYVENetwork/chain/x/stakers"
+	stakerskeeper "github.com/KYVENetwork/chain/x/stakers/keeper"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	. "github.com/onsi/gomega"
 
@@ -35,6 +40,27 @@ func (suite *KeeperTestSuite) PerformValidityChecks() {
 	suite.VerifyDelegationQueries()
 	suite.VerifyDelegationModuleIntegrity()
 	suite.VerifyDelegationGenesisImportExport()
+
+	// verify module invariants
+	suite.VerifyInvariants()
+}
+
+// =================
+// invariant checks
+// =================
+
+func (suite *KeeperTestSuite) VerifyInvariants() {
+	invariants := []sdk.Invariant{
+		poolkeeper.AllInvariants(suite.App().PoolKeeper),
+		stakerskeeper.AllInvariants(suite.App().StakersKeeper),
+		delegationkeeper.AllInvariants(suite.App().DelegationKeeper),
+		bundleskeeper.AllInvariants(suite.App().BundlesKeeper),
+	}
+
+	for _, invariant := range invariants {
+		msg, broken := invariant(suite.Ctx())
+		Expect(broken).To(BeFalse(), msg)
+	}
 }
 
 // ==================
@@ -42,31 +68,30 @@ func (suite *KeeperTestSuite) PerformValidityChecks() {
 // ==================
 
 func (suite *KeeperTestSuite) VerifyPoolModuleAssetsIntegrity() {
-	expectedBalance := uint64(0)
-	actualBalance := uint64(0)
+	expectedBalance := sdk.NewCoins()
 
 	for _, pool := range suite.App().PoolKeeper.GetAllPools(suite.Ctx()) {
 		for _, funder := range pool.Funders {
-			expectedBalance += funder.Amount
+			expectedBalance = expectedBalance.Add(funder.Amount...)
 		}
 	}
 
 	moduleAcc := suite.App().AccountKeeper.GetModuleAccount(suite.Ctx(), pooltypes.ModuleName).GetAddress()
-	actualBalance = suite.App().BankKeeper.GetBalance(suite.Ctx(), moduleAcc, "tkyve").Amount.Uint64()
+	actualBalance := suite.App().BankKeeper.GetAllBalances(suite.Ctx(), moduleAcc)
 
-	Expect(actualBalance).To(Equal(expectedBalance))
+	Expect(actualBalance.String()).To(Equal(expectedBalance.String()))
 }
 
 func (suite *KeeperTestSuite) VerifyPoolTotalFunds() {
 	for _, pool := range suite.App().PoolKeeper.GetAllPools(suite.Ctx()) {
-		expectedBalance := uint64(0)
+		expectedBalance := sdk.NewCoins()
 		actualBalance := pool.TotalFunds
 
 		for _, funder := range pool.Funders {
-			expectedBalance += funder.Amount
+			expectedBalance = expectedBalance.Add(funder.Amount...)
 		}
 
-		Expect(actualBalance).To(Equal(expectedBalance))
+		Expect(actualBalance.String()).To(Equal(expectedBalance.String()))
 	}
 }
 
@@ -261,7 +286,60 @@ func (suite *KeeperTestSuite) VerifyBundlesQueries() {
 			Expect(finalizedBundleQueryErr).To(BeNil())
 			Expect(finalizedBundleQuery.FinalizedBundle).To(Equal(finalizedBundlesState[i]))
 		}
+
+		// native bundles queries
+		bundleProposalState, bundleProposalFound := suite.App().BundlesKeeper.GetBundleProposal(suite.Ctx(), pool.Id)
+		bundleProposalQuery, bundleProposalQueryErr := suite.App().BundlesKeeper.BundleProposal(sdk.WrapSDKContext(suite.Ctx()), &bundlestypes.QueryBundleProposalRequest{
+			PoolId: pool.Id,
+		})
+
+		if bundleProposalFound {
+			Expect(bundleProposalQueryErr).To(BeNil())
+			Expect(bundleProposalQuery.BundleProposal).To(Equal(bundleProposalState))
+		} else {
+			Expect(bundleProposalQueryErr).NotTo(BeNil())
+		}
+
+		nativeFinalizedBundlesQuery, nativeFinalizedBundlesQueryErr := suite.App().BundlesKeeper.FinalizedBundles(sdk.WrapSDKContext(suite.Ctx()), &bundlestypes.QueryFinalizedBundlesRequest{
+			PoolId: pool.Id,
+		})
+
+		Expect(nativeFinalizedBundlesQueryErr).To(BeNil())
+		Expect(nativeFinalizedBundlesQuery.FinalizedBundles).To(HaveLen(len(finalizedBundlesState)))
+
+		for i := range finalizedBundlesState {
+			Expect(nativeFinalizedBundlesQuery.FinalizedBundles[i]).To(Equal(finalizedBundlesState[i]))
+
+			finalizedBundleQuery, finalizedBundleQueryErr := suite.App().BundlesKeeper.FinalizedBundle(sdk.WrapSDKContext(suite.Ctx()), &bundlestypes.QueryFinalizedBundleRequest{
+				PoolId: pool.Id,
+				Id:     finalizedBundlesState[i].Id,
+			})
+
+			Expect(finalizedBundleQueryErr).To(BeNil())
+			Expect(finalizedBundleQuery.FinalizedBundle).To(Equal(finalizedBundlesState[i]))
+
+			byStorageIdQuery, byStorageIdQueryErr := suite.App().BundlesKeeper.FinalizedBundleByStorageId(sdk.WrapSDKContext(suite.Ctx()), &bundlestypes.QueryFinalizedBundleByStorageIdRequest{
+				StorageId: finalizedBundlesState[i].StorageId,
+			})
+
+			Expect(byStorageIdQueryErr).To(BeNil())
+			Expect(byStorageIdQuery.FinalizedBundle).To(Equal(finalizedBundlesState[i]))
+
+			byHeightQuery, byHeightQueryErr := suite.App().BundlesKeeper.FinalizedBundleByHeight(sdk.WrapSDKContext(suite.Ctx()), &bundlestypes.QueryFinalizedBundleByHeightRequest{
+				PoolId: pool.Id,
+				Height: finalizedBundlesState[i].FromHeight,
+			})
+
+			Expect(byHeightQueryErr).To(BeNil())
+			Expect(byHeightQuery.FinalizedBundle).To(Equal(finalizedBundlesState[i]))
+		}
 	}
+
+	bundleProposalsState := suite.App().BundlesKeeper.GetAllBundleProposals(suite.Ctx())
+	bundleProposalsQuery, bundleProposalsQueryErr := suite.App().BundlesKeeper.BundleProposals(sdk.WrapSDKContext(suite.Ctx()), &bundlestypes.QueryBundleProposalsRequest{})
+
+	Expect(bundleProposalsQueryErr).To(BeNil())
+	Expect(bundleProposalsQuery.BundleProposals).To(Equal(bundleProposalsState))
 }
 
 func (suite *KeeperTestSuite) VerifyBundlesGenesisImportExport() {
@@ -335,21 +413,26 @@ func (suite *KeeperTestSuite) VerifyDelegationQueries() {
 }
 
 func (suite *KeeperTestSuite) VerifyDelegationModuleIntegrity() {
-	expectedBalance := uint64(0)
+	expectedBalance := sdk.NewCoins()
 
 	for _, delegator := range suite.App().DelegationKeeper.GetAllDelegators(suite.Ctx()) {
-		expectedBalance += suite.App().DelegationKeeper.GetDelegationAmountOfDelegator(suite.Ctx(), delegator.Staker, delegator.Delegator)
-		expectedBalance += suite.App().DelegationKeeper.GetOutstandingRewards(suite.Ctx(), delegator.Staker, delegator.Delegator)
+		expectedBalance = expectedBalance.Add(sdk.NewInt64Coin(KYVE_DENOM, int64(suite.App().DelegationKeeper.GetDelegationAmountOfDelegator(suite.Ctx(), delegator.Staker, delegator.Delegator))))
+		expectedBalance = expectedBalance.Add(suite.App().DelegationKeeper.GetOutstandingRewards(suite.Ctx(), delegator.Staker, delegator.Delegator)...)
 	}
 
+	moduleAcc := suite.App().AccountKeeper.GetModuleAccount(suite.Ctx(), delegationtypes.ModuleName).GetAddress()
+	actualBalance := suite.App().BankKeeper.GetAllBalances(suite.Ctx(), moduleAcc)
+
 	// Due to rounding errors the delegation module will get a very few nKYVE over the time.
 	// As long as it is guaranteed that it's always the user who gets paid out less in case of
 	// rounding, everything is fine.
-	difference := suite.GetBalanceFromModule(delegationtypes.ModuleName) - expectedBalance
-	Expect(difference >= 0).To(BeTrue())
+	difference, isNegative := actualBalance.SafeSub(expectedBalance)
+	Expect(isNegative).To(BeFalse())
 
 	// 10 should be enough for testing
-	Expect(difference <= 10).To(BeTrue())
+	for _, coin := range difference {
+		Expect(coin.Amount.Uint64() <= 10).To(BeTrue())
+	}
 }
 
 func (suite *KeeperTestSuite) VerifyDelegationGenesisImportExport() {
@@ -382,6 +465,8 @@ func (suite *KeeperTestSuite) verifyFullStaker(fullStaker querytypes.FullStaker,
 	Expect(fullStaker.Metadata.Website).To(Equal(staker.Website))
 	Expect(fullStaker.Metadata.Commission).To(Equal(staker.Commission))
 	Expect(fullStaker.Metadata.Moniker).To(Equal(staker.Moniker))
+	Expect(fullStaker.Metadata.MaxCommission).To(Equal(staker.MaxCommission))
+	Expect(fullStaker.Metadata.MaxCommissionChangeRate).To(Equal(staker.MaxCommissionChangeRate))
 
 	pendingCommissionChange, found := suite.App().StakersKeeper.GetCommissionChangeEntryByIndex2(suite.Ctx(), stakerAddress)
 	if found {
@@ -391,6 +476,13 @@ func (suite *KeeperTestSuite) verifyFullStaker(fullStaker querytypes.FullStaker,
 		Expect(fullStaker.Metadata.PendingCommissionChange).To(BeNil())
 	}
 
+	pendingRetirement, found := suite.App().StakersKeeper.GetRetireStakerEntryByIndex2(suite.Ctx(), stakerAddress)
+	if found {
+		Expect(fullStaker.Metadata.PendingRetirement.CreationDate).To(Equal(pendingRetirement.CreationDate))
+	} else {
+		Expect(fullStaker.Metadata.PendingRetirement).To(BeNil())
+	}
+
 	delegationData, _ := suite.App().DelegationKeeper.GetDelegationData(suite.Ctx(), stakerAddress)
 	Expect(fullStaker.DelegatorCount).To(Equal(delegationData.DelegatorCount))
 
@@ -10,14 +10,23 @@ func (suite *KeeperTestSuite) GetBalanceFromAddress(address string) uint64 {
 		return 0
 	}
 
-	balance := suite.App().BankKeeper.GetBalance(suite.Ctx(), accAddress, "tkyve")
+	balance := suite.App().BankKeeper.GetBalance(suite.Ctx(), accAddress, KYVE_DENOM)
 
 	return uint64(balance.Amount.Int64())
 }
 
 func (suite *KeeperTestSuite) GetBalanceFromModule(moduleName string) uint64 {
 	moduleAcc := suite.App().AccountKeeper.GetModuleAccount(suite.Ctx(), moduleName).GetAddress()
-	return suite.App().BankKeeper.GetBalance(suite.Ctx(), moduleAcc, "tkyve").Amount.Uint64()
+	return suite.App().BankKeeper.GetBalance(suite.Ctx(), moduleAcc, KYVE_DENOM).Amount.Uint64()
+}
+
+func (suite *KeeperTestSuite) GetCoinsFromAddress(address string) sdk.Coins {
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return sdk.NewCoins()
+	}
+
+	return suite.App().BankKeeper.GetAllBalances(suite.Ctx(), accAddress)
 }
 
 func (suite *KeeperTestSuite) GetNextUploader() (nextStaker string, nextValaddress string) {
@@ -53,6 +53,11 @@ const KYVE = uint64(1_000_000_000)
 const TKYVE = uint64(1)
 const KYVE_DENOM = "tkyve"
 
+// KYVECoins returns the given amount of tkyve as coins
+func KYVECoins(amount uint64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(KYVE_DENOM, int64(amount)))
+}
+
 func NewCleanChain() KeeperTestSuite {
 	s := KeeperTestSuite{}
 	s.SetupTest()
@@ -102,7 +107,10 @@ func (suite *KeeperTestSuite) initDummyAccounts() {
 }
 
 func (suite *KeeperTestSuite) Mint(address string, amount uint64) error {
-	coins := sdk.NewCoins(sdk.NewInt64Coin(KYVE_DENOM, int64(amount)))
+	return suite.MintCoins(address, sdk.NewCoins(sdk.NewInt64Coin(KYVE_DENOM, int64(amount))))
+}
+
+func (suite *KeeperTestSuite) MintCoins(address string, coins sdk.Coins) error {
 	err := suite.app.BankKeeper.MintCoins(suite.ctx, "pool", coins)
 	if err != nil {
 		return err
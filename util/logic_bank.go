@@ -4,6 +4,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// KyveDenom is the denom used for staking, delegation and slashing.
+// Pools can additionally be funded with every denom of the pool params.
+const KyveDenom = "tkyve"
+
 type BankKeeper interface {
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
@@ -19,6 +23,11 @@ type AccountKeeper interface {
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
 
+// KyveCoins returns the given amount of tkyve as coins.
+func KyveCoins(amount uint64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(KyveDenom, sdk.NewIntFromUint64(amount)))
+}
+
 // TransferFromAddressToAddress sends tokens from the given address to a specified address.
 func TransferFromAddressToAddress(bankKeeper BankKeeper, ctx sdk.Context, fromAddress string, toAddress string, amount uint64) error {
 	sender, errSenderAddress := sdk.AccAddressFromBech32(fromAddress)
@@ -31,30 +40,37 @@ func TransferFromAddressToAddress(bankKeeper BankKeeper, ctx sdk.Context, fromAd
 		return errRecipientAddress
 	}
 
-	coins := sdk.NewCoins(sdk.NewInt64Coin("tkyve", int64(amount)))
-	err := bankKeeper.SendCoins(ctx, sender, recipient, coins)
+	err := bankKeeper.SendCoins(ctx, sender, recipient, KyveCoins(amount))
 	return err
 }
 
 // TransferToAddress sends tokens from the given module to a specified address.
 func TransferFromModuleToAddress(bankKeeper BankKeeper, ctx sdk.Context, module string, address string, amount uint64) error {
+	return TransferCoinsFromModuleToAddress(bankKeeper, ctx, module, address, KyveCoins(amount))
+}
+
+// TransferCoinsFromModuleToAddress sends coins from the given module to a specified address.
+func TransferCoinsFromModuleToAddress(bankKeeper BankKeeper, ctx sdk.Context, module string, address string, coins sdk.Coins) error {
 	recipient, errAddress := sdk.AccAddressFromBech32(address)
 	if errAddress != nil {
 		return errAddress
 	}
 
-	coins := sdk.NewCoins(sdk.NewInt64Coin("tkyve", int64(amount)))
 	err := bankKeeper.SendCoinsFromModuleToAccount(ctx, module, recipient, coins)
 	return err
 }
 
 // TransferToRegistry sends tokens from a specified address to the given module.
 func TransferFromAddressToModule(bankKeeper BankKeeper, ctx sdk.Context, address string, module string, amount uint64) error {
+	return TransferCoinsFromAddressToModule(bankKeeper, ctx, address, module, KyveCoins(amount))
+}
+
+// TransferCoinsFromAddressToModule sends coins from a specified address to the given module.
+func TransferCoinsFromAddressToModule(bankKeeper BankKeeper, ctx sdk.Context, address string, module string, coins sdk.Coins) error {
 	sender, errAddress := sdk.AccAddressFromBech32(address)
 	if errAddress != nil {
 		return errAddress
 	}
-	coins := sdk.NewCoins(sdk.NewInt64Coin("tkyve", int64(amount)))
 
 	err := bankKeeper.SendCoinsFromAccountToModule(ctx, sender, module, coins)
 	return err
@@ -62,7 +78,11 @@ func TransferFromAddressToModule(bankKeeper BankKeeper, ctx sdk.Context, address
 
 // TransferInterModule ...
 func TransferFromModuleToModule(bankKeeper BankKeeper, ctx sdk.Context, fromModule string, toModule string, amount uint64) error {
-	coins := sdk.NewCoins(sdk.NewInt64Coin("tkyve", int64(amount)))
+	return TransferCoinsFromModuleToModule(bankKeeper, ctx, fromModule, toModule, KyveCoins(amount))
+}
+
+// TransferCoinsFromModuleToModule sends coins from one module to another.
+func TransferCoinsFromModuleToModule(bankKeeper BankKeeper, ctx sdk.Context, fromModule string, toModule string, coins sdk.Coins) error {
 	err := bankKeeper.SendCoinsFromModuleToModule(ctx, fromModule, toModule, coins)
 	return err
 }
@@ -73,9 +93,8 @@ func TransferFromAddressToTreasury(distrKeeper DistrKeeper, ctx sdk.Context, add
 	if errAddress != nil {
 		return errAddress
 	}
-	coins := sdk.NewCoins(sdk.NewInt64Coin("tkyve", int64(amount)))
 
-	if err := distrKeeper.FundCommunityPool(ctx, coins, sender); err != nil {
+	if err := distrKeeper.FundCommunityPool(ctx, KyveCoins(amount), sender); err != nil {
 		return err
 	}
 
@@ -84,8 +103,12 @@ func TransferFromAddressToTreasury(distrKeeper DistrKeeper, ctx sdk.Context, add
 
 // transferToTreasury sends tokens from this module to the treasury (community spend pool).
 func TransferFromModuleToTreasury(accountKeeper AccountKeeper, distrKeeper DistrKeeper, ctx sdk.Context, module string, amount uint64) error {
+	return TransferCoinsFromModuleToTreasury(accountKeeper, distrKeeper, ctx, module, KyveCoins(amount))
+}
+
+// TransferCoinsFromModuleToTreasury sends coins from this module to the treasury (community spend pool).
+func TransferCoinsFromModuleToTreasury(accountKeeper AccountKeeper, distrKeeper DistrKeeper, ctx sdk.Context, module string, coins sdk.Coins) error {
 	sender := accountKeeper.GetModuleAddress(module)
-	coins := sdk.NewCoins(sdk.NewInt64Coin("tkyve", int64(amount)))
 
 	if err := distrKeeper.FundCommunityPool(ctx, coins, sender); err != nil {
 		return err
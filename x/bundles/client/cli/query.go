@@ -25,6 +25,14 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+
+	cmd.AddCommand(CmdShowBundleProposal())
+	cmd.AddCommand(CmdListBundleProposals())
+
+	cmd.AddCommand(CmdListFinalizedBundles())
+	cmd.AddCommand(CmdShowFinalizedBundle())
+	cmd.AddCommand(CmdShowFinalizedBundleByStorageId())
+	cmd.AddCommand(CmdShowFinalizedBundleByHeight())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
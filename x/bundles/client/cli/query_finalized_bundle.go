@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdListFinalizedBundles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalized-bundles [pool_id]",
+		Short: "list all finalized bundles of pool given by pool_id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			poolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFinalizedBundlesRequest{
+				PoolId:     poolId,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FinalizedBundles(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowFinalizedBundle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalized-bundle [pool_id] [bundle_id]",
+		Short: "show the finalized bundle given by pool_id and bundle_id",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			poolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			bundleId, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFinalizedBundleRequest{
+				PoolId: poolId,
+				Id:     bundleId,
+			}
+
+			res, err := queryClient.FinalizedBundle(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowFinalizedBundleByStorageId() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalized-bundle-by-storage-id [storage_id]",
+		Short: "show the finalized bundle given by storage_id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFinalizedBundleByStorageIdRequest{
+				StorageId: args[0],
+			}
+
+			res, err := queryClient.FinalizedBundleByStorageId(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowFinalizedBundleByHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalized-bundle-by-height [pool_id] [height]",
+		Short: "show the finalized bundle of pool_id which contains the given height",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			poolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			height, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFinalizedBundleByHeightRequest{
+				PoolId: poolId,
+				Height: height,
+			}
+
+			res, err := queryClient.FinalizedBundleByHeight(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
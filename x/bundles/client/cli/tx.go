@@ -33,6 +33,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdClaimUploaderRole())
 	cmd.AddCommand(CmdSubmitBundleProposal())
 	cmd.AddCommand(CmdVoteBundleProposal())
+	cmd.AddCommand(CmdCommitBundleVote())
+	cmd.AddCommand(CmdRevealBundleVote())
 
 	return cmd
 }
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// CmdCommitBundleVote commits to a vote. Only the hash of staker, vote, salt
+// and storage id is broadcast, the salt has to be kept for the reveal.
+func CmdCommitBundleVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-bundle-vote [staker] [pool_id] [storage_id] [vote] [salt]",
+		Short: "Broadcast message commit-bundle-vote",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argStaker := args[0]
+
+			argPoolId, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argStorageId := args[2]
+
+			argVote, err := cast.ToInt32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			argSalt := args[4]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCommitBundleVote(
+				clientCtx.GetFromAddress().String(),
+				argStaker,
+				argPoolId,
+				argStorageId,
+				types.GetVoteCommitHash(argStaker, types.VoteType(argVote), argSalt, argStorageId),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRevealBundleVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-bundle-vote [staker] [pool_id] [storage_id] [vote] [salt]",
+		Short: "Broadcast message reveal-bundle-vote",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argStaker := args[0]
+
+			argPoolId, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argStorageId := args[2]
+
+			argVote, err := cast.ToInt32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			argSalt := args[4]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealBundleVote(
+				clientCtx.GetFromAddress().String(),
+				argStaker,
+				argPoolId,
+				argStorageId,
+				types.VoteType(argVote),
+				argSalt,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
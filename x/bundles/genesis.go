@@ -20,6 +20,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetFinalizedBundle(ctx, entry)
 	}
 
+	for _, entry := range genState.ConcludedBundleProposalList {
+		k.SetConcludedBundleProposal(ctx, entry)
+	}
+
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -31,5 +35,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.FinalizedBundleList = k.GetAllFinalizedBundles(ctx)
 
+	genesis.ConcludedBundleProposalList = k.GetAllConcludedBundleProposals(ctx)
+
 	return genesis
 }
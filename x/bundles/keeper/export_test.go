@@ -0,0 +1,16 @@
+package keeper
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// ChooseNextUploaderFromSelectedStakers exposes the uploader selection to the keeper tests.
+func (k Keeper) ChooseNextUploaderFromSelectedStakers(ctx sdk.Context, poolId uint64, addresses []string) string {
+	return k.chooseNextUploaderFromSelectedStakers(ctx, poolId, addresses)
+}
+
+// HandleUploadTimeoutOfAllPools runs the upload timeout for every pool,
+// regardless of its upload deadline.
+func (k Keeper) HandleUploadTimeoutOfAllPools(ctx sdk.Context) {
+	for _, pool := range k.poolKeeper.GetAllPools(ctx) {
+		k.handleUploadTimeoutOfPool(ctx, pool)
+	}
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// These functions are meant to be called from external modules
+// For now this is the pool module which needs to interact
+// with the bundles module.
+
+// ResetToFinalizedBundle rolls back the bundles state of the given pool to the
+// finalized bundle with id `bundleId`. All finalized bundles after it are removed
+// from the store and the memory indexes and the current bundle proposal is reset.
+// It returns the height, key and value of the target bundle so that the pool
+// can restore its state. If the target bundle does not exist nothing is changed.
+func (k Keeper) ResetToFinalizedBundle(ctx sdk.Context, poolId uint64, bundleId uint64) (toHeight uint64, toKey string, toValue string, found bool) {
+	targetBundle, found := k.GetFinalizedBundle(ctx, poolId, bundleId)
+	if !found {
+		return 0, "", "", false
+	}
+
+	// Collect all finalized bundles after the target bundle first,
+	// as the store must not be modified during iteration
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), util.GetByteKey(types.FinalizedBundlePrefix, poolId))
+	iterator := store.Iterator(util.GetByteKey(bundleId+1), nil)
+
+	var bundles []types.FinalizedBundle
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FinalizedBundle
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		bundles = append(bundles, val)
+	}
+	iterator.Close()
+
+	for _, bundle := range bundles {
+		k.RemoveFinalizedBundle(ctx, bundle)
+	}
+
+	// Drop the current bundle proposal, the designated uploader stays the same
+	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
+	k.SetBundleProposal(ctx, types.BundleProposal{
+		PoolId:       poolId,
+		NextUploader: bundleProposal.NextUploader,
+		CreatedAt:    uint64(ctx.BlockTime().Unix()),
+	})
+
+	return targetBundle.ToHeight, targetBundle.Key, targetBundle.Value, true
+}
@@ -18,6 +18,12 @@ func (k Keeper) SetBundleProposal(ctx sdk.Context, bundleProposal types.BundlePr
 	store.Set(types.BundleProposalKey(
 		bundleProposal.PoolId,
 	), b)
+
+	// The upload timeout end blocker has to look at the pool
+	// once the upload interval of the new proposal is over.
+	if pool, found := k.poolKeeper.GetPool(ctx, bundleProposal.PoolId); found {
+		k.poolKeeper.LowerUploadDeadline(ctx, pool.Id, bundleProposal.CreatedAt+pool.UploadInterval)
+	}
 }
 
 // GetBundleProposal returns a staker from its index
@@ -50,6 +56,32 @@ func (k Keeper) GetAllBundleProposals(ctx sdk.Context) (list []types.BundlePropo
 	return
 }
 
+// GetPaginatedBundleProposalQuery returns the current bundle proposals of all pools with pagination
+func (k Keeper) GetPaginatedBundleProposalQuery(ctx sdk.Context, pagination *query.PageRequest) ([]types.BundleProposal, *query.PageResponse, error) {
+	var data []types.BundleProposal
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BundleKeyPrefix)
+
+	pageRes, err := query.FilteredPaginate(store, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var bundleProposal types.BundleProposal
+			if err := k.cdc.Unmarshal(value, &bundleProposal); err != nil {
+				return false, err
+			}
+
+			data = append(data, bundleProposal)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return data, pageRes, nil
+}
+
 // =====================
 // = Finalized Bundles =
 // =====================
@@ -78,6 +110,21 @@ func (k Keeper) SetFinalizedBundleIndexes(ctx sdk.Context, finalizedBundle types
 		util.GetByteKey(finalizedBundle.Id))
 }
 
+// RemoveFinalizedBundle removes a finalized bundle and its memory indexes from the store
+func (k Keeper) RemoveFinalizedBundle(ctx sdk.Context, finalizedBundle types.FinalizedBundle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FinalizedBundlePrefix)
+	store.Delete(types.FinalizedBundleKey(
+		finalizedBundle.PoolId,
+		finalizedBundle.Id,
+	))
+
+	indexByStorageId := prefix.NewStore(ctx.KVStore(k.memKey), types.FinalizedBundleByStorageIdPrefix)
+	indexByStorageId.Delete(types.FinalizedBundleByStorageIdKey(finalizedBundle.StorageId))
+
+	indexByStorageHeight := prefix.NewStore(ctx.KVStore(k.memKey), types.FinalizedBundleByHeightPrefix)
+	indexByStorageHeight.Delete(types.FinalizedBundleByHeightKey(finalizedBundle.PoolId, finalizedBundle.FromHeight))
+}
+
 func (k Keeper) GetAllFinalizedBundles(ctx sdk.Context) (list []types.FinalizedBundle) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FinalizedBundlePrefix)
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
@@ -173,3 +220,94 @@ func (k Keeper) GetFinalizedBundleByHeight(ctx sdk.Context, poolId, height uint6
 	}
 	return
 }
+
+// ================================
+// = Concluded Bundle Proposals =
+// ================================
+
+// AppendConcludedBundleProposal adds a concluded bundle proposal to the history of its pool
+// and assigns the next free id to it. If the history exceeds `MaxConcludedBundleProposals`
+// the oldest entry of the pool gets removed.
+func (k Keeper) AppendConcludedBundleProposal(ctx sdk.Context, concludedBundleProposal types.ConcludedBundleProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), util.GetByteKey(types.ConcludedBundleProposalPrefix, concludedBundleProposal.PoolId))
+
+	// The next id is the id of the latest entry plus one
+	concludedBundleProposal.Id = 0
+	iterator := store.ReverseIterator(nil, nil)
+	if iterator.Valid() {
+		concludedBundleProposal.Id = binary.BigEndian.Uint64(iterator.Key()[0:8]) + 1
+	}
+	iterator.Close()
+
+	k.SetConcludedBundleProposal(ctx, concludedBundleProposal)
+
+	// Remove the oldest entry if the history is full
+	if concludedBundleProposal.Id >= types.MaxConcludedBundleProposals {
+		store.Delete(util.GetByteKey(concludedBundleProposal.Id - types.MaxConcludedBundleProposals))
+	}
+}
+
+// SetConcludedBundleProposal set a specific concluded bundle proposal in the store from its index
+func (k Keeper) SetConcludedBundleProposal(ctx sdk.Context, concludedBundleProposal types.ConcludedBundleProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConcludedBundleProposalPrefix)
+	b := k.cdc.MustMarshal(&concludedBundleProposal)
+	store.Set(types.ConcludedBundleProposalKey(
+		concludedBundleProposal.PoolId,
+		concludedBundleProposal.Id,
+	), b)
+}
+
+// GetConcludedBundleProposal returns a concluded bundle proposal from its index
+func (k Keeper) GetConcludedBundleProposal(ctx sdk.Context, poolId, id uint64) (val types.ConcludedBundleProposal, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConcludedBundleProposalPrefix)
+
+	b := store.Get(types.ConcludedBundleProposalKey(poolId, id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllConcludedBundleProposals returns all concluded bundle proposals of all pools
+func (k Keeper) GetAllConcludedBundleProposals(ctx sdk.Context) (list []types.ConcludedBundleProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConcludedBundleProposalPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ConcludedBundleProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPaginatedConcludedBundleProposalQuery returns the concluded bundle proposals of a pool
+// ordered by their id
+func (k Keeper) GetPaginatedConcludedBundleProposalQuery(ctx sdk.Context, pagination *query.PageRequest, poolId uint64) ([]types.ConcludedBundleProposal, *query.PageResponse, error) {
+	var data []types.ConcludedBundleProposal
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), util.GetByteKey(types.ConcludedBundleProposalPrefix, poolId))
+
+	pageRes, err := query.FilteredPaginate(store, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var concludedBundleProposal types.ConcludedBundleProposal
+			if err := k.cdc.Unmarshal(value, &concludedBundleProposal); err != nil {
+				return false, err
+			}
+
+			data = append(data, concludedBundleProposal)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return data, pageRes, nil
+}
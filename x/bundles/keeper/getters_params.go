@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"github.com/KYVENetwork/chain/x/bundles/types"
+	poolmoduletypes "github.com/KYVENetwork/chain/x/pool/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -13,6 +14,8 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.StorageCost(ctx),
 		k.NetworkFee(ctx),
 		k.MaxPoints(ctx),
+		k.RevealInterval(ctx),
+		k.VoterRewardShare(ctx),
 	)
 }
 
@@ -27,6 +30,16 @@ func (k Keeper) UploadTimeout(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// PoolUploadTimeout returns the upload timeout of the given pool.
+// Pools without an override fall back to the UploadTimeout param.
+func (k Keeper) PoolUploadTimeout(ctx sdk.Context, pool poolmoduletypes.Pool) uint64 {
+	if pool.UploadTimeout > 0 {
+		return pool.UploadTimeout
+	}
+
+	return k.UploadTimeout(ctx)
+}
+
 // StorageCost returns the StorageCost param
 func (k Keeper) StorageCost(ctx sdk.Context) (res uint64) {
 	k.paramstore.Get(ctx, types.KeyStorageCost, &res)
@@ -45,6 +58,28 @@ func (k Keeper) MaxPoints(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// PoolMaxPoints returns the max points of the given pool.
+// Pools without an override fall back to the MaxPoints param.
+func (k Keeper) PoolMaxPoints(ctx sdk.Context, pool poolmoduletypes.Pool) uint64 {
+	if pool.MaxPoints > 0 {
+		return pool.MaxPoints
+	}
+
+	return k.MaxPoints(ctx)
+}
+
+// RevealInterval returns the RevealInterval param
+func (k Keeper) RevealInterval(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyRevealInterval, &res)
+	return
+}
+
+// VoterRewardShare returns the VoterRewardShare param
+func (k Keeper) VoterRewardShare(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyVoterRewardShare, &res)
+	return
+}
+
 // ParamStore ...
 func (k Keeper) ParamStore() (paramStore paramtypes.Subspace) {
 	return k.paramstore
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BundleProposal(c context.Context, req *types.QueryBundleProposalRequest) (*types.QueryBundleProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bundleProposal, found := k.GetBundleProposal(ctx, req.PoolId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryBundleProposalResponse{BundleProposal: bundleProposal}, nil
+}
+
+func (k Keeper) BundleProposals(c context.Context, req *types.QueryBundleProposalsRequest) (*types.QueryBundleProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bundleProposals, pageRes, err := k.GetPaginatedBundleProposalQuery(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBundleProposalsResponse{BundleProposals: bundleProposals, Pagination: pageRes}, nil
+}
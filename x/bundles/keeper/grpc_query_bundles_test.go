@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+/*
+
+TEST CASES - bundles grpc queries
+
+* Query the current bundle proposal and all bundle proposals with pagination
+* Query finalized bundles by id, storage id and height
+* Return errors for unknown bundles
+
+*/
+
+var _ = Describe("bundles grpc queries", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create two clean pools for every test case
+		for range []int{0, 1} {
+			s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+				Name:           "Moontest",
+				MaxBundleSize:  100,
+				StartKey:       "0",
+				UploadInterval: 60,
+				OperatingCost:  10_000,
+				Protocol: &pooltypes.Protocol{
+					Version:     "0.0.0",
+					Binaries:    "{}",
+					LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+				},
+				UpgradePlan: &pooltypes.UpgradePlan{},
+			})
+		}
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			ByteSize:   100,
+			FromHeight: 0,
+			ToHeight:   100,
+			FromKey:    "0",
+			ToKey:      "99",
+			ToValue:    "test_value",
+			BundleHash: "test_hash",
+		})
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			ByteSize:   100,
+			FromHeight: 100,
+			ToHeight:   200,
+			FromKey:    "99",
+			ToKey:      "199",
+			ToValue:    "test_value2",
+			BundleHash: "test_hash2",
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Query the current bundle proposal and all bundle proposals with pagination", func() {
+		// ACT
+		bundleProposal, err := s.App().BundlesKeeper.BundleProposal(sdk.WrapSDKContext(s.Ctx()), &bundletypes.QueryBundleProposalRequest{
+			PoolId: 0,
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(bundleProposal.BundleProposal.StorageId).To(Equal("P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg"))
+		Expect(bundleProposal.BundleProposal.ToHeight).To(Equal(uint64(200)))
+
+		// ACT
+		bundleProposals, err := s.App().BundlesKeeper.BundleProposals(sdk.WrapSDKContext(s.Ctx()), &bundletypes.QueryBundleProposalsRequest{
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(bundleProposals.BundleProposals).To(HaveLen(1))
+		Expect(bundleProposals.BundleProposals[0].PoolId).To(Equal(uint64(0)))
+		Expect(bundleProposals.Pagination.Total).To(Equal(uint64(len(s.App().BundlesKeeper.GetAllBundleProposals(s.Ctx())))))
+	})
+
+	It("Query finalized bundles by id, storage id and height", func() {
+		// ACT
+		finalizedBundles, err := s.App().BundlesKeeper.FinalizedBundles(sdk.WrapSDKContext(s.Ctx()), &bundletypes.QueryFinalizedBundlesRequest{
+			PoolId: 0,
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(finalizedBundles.FinalizedBundles).To(HaveLen(1))
+
+		finalizedBundle := finalizedBundles.FinalizedBundles[0]
+		Expect(finalizedBundle.StorageId).To(Equal("y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI"))
+
+		// ACT
+		byId, err := s.App().BundlesKeeper.FinalizedBundle(sdk.WrapSDKContext(s.Ctx()), &bundletypes.QueryFinalizedBundleRequest{
+			PoolId: 0,
+			Id:     0,
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(byId.FinalizedBundle).To(Equal(finalizedBundle))
+
+		// ACT
+		byStorageId, err := s.App().BundlesKeeper.FinalizedBundleByStorageId(sdk.WrapSDKContext(s.Ctx()), &bundletypes.QueryFinalizedBundleByStorageIdRequest{
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(byStorageId.FinalizedBundle).To(Equal(finalizedBundle))
+
+		// ACT
+		byHeight, err := s.App().BundlesKeeper.FinalizedBundleByHeight(sdk.WrapSDKContext(s.Ctx()), &bundletypes.QueryFinalizedBundleByHeightRequest{
+			PoolId: 0,
+			Height: 99,
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(byHeight.FinalizedBundle).To(Equal(finalizedBundle))
+	})
+
+	It("Return errors for unknown bundles", func() {
+		// ACT & ASSERT
+		_, err := s.App().BundlesKeeper.FinalizedBundle(sdk.WrapSDKContext(s.Ctx()), &bundletypes.QueryFinalizedBundleRequest{
+			PoolId: 0,
+			Id:     1,
+		})
+		Expect(err).NotTo(BeNil())
+
+		_, err = s.App().BundlesKeeper.FinalizedBundleByStorageId(sdk.WrapSDKContext(s.Ctx()), &bundletypes.QueryFinalizedBundleByStorageIdRequest{
+			StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+		})
+		Expect(err).NotTo(BeNil())
+
+		_, err = s.App().BundlesKeeper.FinalizedBundleByHeight(sdk.WrapSDKContext(s.Ctx()), &bundletypes.QueryFinalizedBundleByHeightRequest{
+			PoolId: 0,
+			Height: 100,
+		})
+		Expect(err).NotTo(BeNil())
+
+		_, err = s.App().BundlesKeeper.BundleProposal(sdk.WrapSDKContext(s.Ctx()), nil)
+		Expect(err).NotTo(BeNil())
+	})
+})
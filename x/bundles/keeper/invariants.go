@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all bundles module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "bundle-proposal-references", BundleProposalReferencesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the bundles module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return BundleProposalReferencesInvariant(k)(ctx)
+	}
+}
+
+// BundleProposalReferencesInvariant checks that every bundle proposal
+// belongs to an existing pool.
+func BundleProposalReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, bundleProposal := range k.GetAllBundleProposals(ctx) {
+			if err := k.poolKeeper.AssertPoolExists(ctx, bundleProposal.PoolId); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tbundle proposal references non-existent pool %d\n", bundleProposal.PoolId)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "bundle-proposal-references", msg), broken
+	}
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/bundles/keeper"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - invariants
+
+* Invariants hold for a pool with a bundle proposal
+* Bundle proposal references invariant breaks if the pool does not exist
+
+*/
+
+var _ = Describe("invariants", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:           "Moontest",
+			MaxBundleSize:  100,
+			StartKey:       "0",
+			UploadInterval: 60,
+			OperatingCost:  10_000,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Invariants hold for a pool with a bundle proposal", func() {
+		// ACT
+		_, broken := keeper.AllInvariants(s.App().BundlesKeeper)(s.Ctx())
+
+		// ASSERT
+		Expect(broken).To(BeFalse())
+	})
+
+	It("Bundle proposal references invariant breaks if the pool does not exist", func() {
+		// ARRANGE
+		// break the state in a cached context, so the validity checks still pass
+		ctx, _ := s.Ctx().CacheContext()
+
+		s.App().BundlesKeeper.SetBundleProposal(ctx, bundletypes.BundleProposal{
+			PoolId:       1,
+			NextUploader: i.STAKER_0,
+		})
+
+		// ACT
+		msg, broken := keeper.BundleProposalReferencesInvariant(s.App().BundlesKeeper)(ctx)
+
+		// ASSERT
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("non-existent pool 1"))
+	})
+})
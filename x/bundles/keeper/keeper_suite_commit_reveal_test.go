@@ -0,0 +1,314 @@
+package keeper_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - commit-reveal voting
+
+* Reject plain votes on commit-reveal pools
+* Only count committed votes after they got revealed
+* Reject invalid commits and reveals
+* Reject commits and reveals copied from another staker
+* Add points to stakers who did not reveal their committed vote
+
+*/
+
+var _ = Describe("commit-reveal voting", Ordered, func() {
+	s := i.NewCleanChain()
+
+	storageId := "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI"
+
+	salt0 := strings.Repeat("00", bundletypes.VoteSaltSize)
+	salt1 := strings.Repeat("11", bundletypes.VoteSaltSize)
+	salt2 := strings.Repeat("22", bundletypes.VoteSaltSize)
+
+	commit := func(staker, valaddress string, vote bundletypes.VoteType, salt string) *bundletypes.MsgCommitBundleVote {
+		return bundletypes.NewMsgCommitBundleVote(valaddress, staker, 0, storageId, bundletypes.GetVoteCommitHash(staker, vote, salt, storageId))
+	}
+
+	reveal := func(staker, valaddress string, vote bundletypes.VoteType, salt string) *bundletypes.MsgRevealBundleVote {
+		return bundletypes.NewMsgRevealBundleVote(valaddress, staker, 0, storageId, vote, salt)
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool with commit-reveal voting for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:           "Moontest",
+			MaxBundleSize:  100,
+			StartKey:       "0",
+			UploadInterval: 60,
+			OperatingCost:  10_000,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan:        &pooltypes.UpgradePlan{},
+			CommitRevealVoting: true,
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		for _, staker := range [][2]string{
+			{i.STAKER_0, i.VALADDRESS_0},
+			{i.STAKER_1, i.VALADDRESS_1},
+			{i.STAKER_2, i.VALADDRESS_2},
+		} {
+			s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+				Creator: staker[0],
+				Amount:  100 * i.KYVE,
+			})
+
+			s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+				Creator:    staker[0],
+				PoolId:     0,
+				Valaddress: staker[1],
+			})
+		}
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  storageId,
+			ByteSize:   100,
+			FromHeight: 0,
+			ToHeight:   100,
+			FromKey:    "0",
+			ToKey:      "99",
+			ToValue:    "test_value",
+			BundleHash: "test_hash",
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Reject plain votes on commit-reveal pools", func() {
+		// ACT
+		s.RunTxBundlesError(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: storageId,
+			Vote:      bundletypes.VOTE_TYPE_YES,
+		})
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VotersValid).To(Equal([]string{i.STAKER_0}))
+		Expect(bundleProposal.VoteCommits).To(BeEmpty())
+	})
+
+	It("Only count committed votes after they got revealed", func() {
+		// ACT
+		s.RunTxBundlesSuccess(commit(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt1))
+		s.RunTxBundlesSuccess(commit(i.STAKER_2, i.VALADDRESS_2, bundletypes.VOTE_TYPE_NO, salt2))
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VoteCommits).To(HaveLen(2))
+		Expect(bundleProposal.VotersValid).To(Equal([]string{i.STAKER_0}))
+		Expect(bundleProposal.VotersInvalid).To(BeEmpty())
+
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+		Expect(voteDistribution.Valid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Invalid).To(BeZero())
+		Expect(voteDistribution.Status).To(Equal(bundletypes.BUNDLE_STATUS_NO_QUORUM))
+
+		// ACT
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(reveal(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt1))
+		s.RunTxBundlesSuccess(reveal(i.STAKER_2, i.VALADDRESS_2, bundletypes.VOTE_TYPE_NO, salt2))
+
+		// ASSERT
+		bundleProposal, _ = s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VoteCommits).To(BeEmpty())
+		Expect(bundleProposal.VotersValid).To(Equal([]string{i.STAKER_0, i.STAKER_1}))
+		Expect(bundleProposal.VotersInvalid).To(Equal([]string{i.STAKER_2}))
+
+		voteDistribution = s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+		Expect(voteDistribution.Valid).To(Equal(200 * i.KYVE))
+		Expect(voteDistribution.Invalid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundletypes.BUNDLE_STATUS_VALID))
+
+		// next proposal can only be submitted after the reveal interval
+		nextStaker, nextValaddress := s.GetNextUploader()
+		nextProposal := &bundletypes.MsgSubmitBundleProposal{
+			Creator:    nextValaddress,
+			Staker:     nextStaker,
+			PoolId:     0,
+			StorageId:  "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			ByteSize:   100,
+			FromHeight: 100,
+			ToHeight:   200,
+			FromKey:    "99",
+			ToKey:      "199",
+			ToValue:    "test_value2",
+			BundleHash: "test_hash2",
+		}
+
+		s.RunTxBundlesError(nextProposal)
+
+		s.CommitAfterSeconds(s.App().BundlesKeeper.RevealInterval(s.Ctx()))
+
+		s.RunTxBundlesSuccess(nextProposal)
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.TotalBundles).To(Equal(uint64(1)))
+
+		_, finalizedBundleFound := s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 0)
+		Expect(finalizedBundleFound).To(BeTrue())
+	})
+
+	It("Reject invalid commits and reveals", func() {
+		// ACT & ASSERT
+		// uploader already voted valid with the proposal
+		s.RunTxBundlesError(commit(i.STAKER_0, i.VALADDRESS_0, bundletypes.VOTE_TYPE_YES, salt0))
+
+		// commit hash has to be a sha256 hash
+		Expect(bundletypes.NewMsgCommitBundleVote(i.VALADDRESS_1, i.STAKER_1, 0, storageId, "test_hash").ValidateBasic()).NotTo(BeNil())
+		Expect(commit(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt1).ValidateBasic()).To(BeNil())
+
+		// reveals need a valid staker, storage id and salt
+		Expect(reveal(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt1).ValidateBasic()).To(BeNil())
+		Expect(reveal("invalid_staker", i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt1).ValidateBasic()).NotTo(BeNil())
+		Expect(bundletypes.NewMsgRevealBundleVote(i.VALADDRESS_1, i.STAKER_1, 0, "", bundletypes.VOTE_TYPE_YES, salt1).ValidateBasic()).NotTo(BeNil())
+		Expect(reveal(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, "salt_1").ValidateBasic()).NotTo(BeNil())
+		Expect(reveal(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt1[2:]).ValidateBasic()).NotTo(BeNil())
+
+		s.RunTxBundlesSuccess(commit(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt1))
+
+		// committing twice is not allowed
+		s.RunTxBundlesError(commit(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_NO, salt1))
+
+		// revealing during the commit phase is not allowed
+		s.RunTxBundlesError(reveal(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt1))
+
+		s.CommitAfterSeconds(60)
+
+		// committing during the reveal phase is not allowed
+		s.RunTxBundlesError(commit(i.STAKER_2, i.VALADDRESS_2, bundletypes.VOTE_TYPE_YES, salt2))
+
+		// revealing without a commit is not allowed
+		s.RunTxBundlesError(reveal(i.STAKER_2, i.VALADDRESS_2, bundletypes.VOTE_TYPE_YES, salt2))
+
+		// revealed vote has to match the commit
+		s.RunTxBundlesError(reveal(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_NO, salt1))
+		s.RunTxBundlesError(reveal(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt2))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VoteCommits).To(HaveLen(1))
+		Expect(bundleProposal.VotersValid).To(Equal([]string{i.STAKER_0}))
+		Expect(bundleProposal.VotersInvalid).To(BeEmpty())
+
+		s.RunTxBundlesSuccess(reveal(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt1))
+
+		// revealing twice is not allowed
+		s.RunTxBundlesError(reveal(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt1))
+
+		bundleProposal, _ = s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VoteCommits).To(BeEmpty())
+		Expect(bundleProposal.VotersValid).To(Equal([]string{i.STAKER_0, i.STAKER_1}))
+	})
+
+	It("Reject commits and reveals copied from another staker", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(commit(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt1))
+
+		// staker 2 copies the commit of staker 1
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		copiedHash := bundleProposal.VoteCommits[0].CommitHash
+
+		s.RunTxBundlesSuccess(bundletypes.NewMsgCommitBundleVote(i.VALADDRESS_2, i.STAKER_2, 0, storageId, copiedHash))
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		// staker 1 reveals first, staker 2 replays the reveal
+		s.RunTxBundlesSuccess(reveal(i.STAKER_1, i.VALADDRESS_1, bundletypes.VOTE_TYPE_YES, salt1))
+		s.RunTxBundlesError(reveal(i.STAKER_2, i.VALADDRESS_2, bundletypes.VOTE_TYPE_YES, salt1))
+
+		// ASSERT
+		bundleProposal, _ = s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VotersValid).To(Equal([]string{i.STAKER_0, i.STAKER_1}))
+		Expect(bundleProposal.VoteCommits).To(HaveLen(1))
+		Expect(bundleProposal.VoteCommits[0].Staker).To(Equal(i.STAKER_2))
+	})
+
+	It("Add points to stakers who did not reveal their committed vote", func() {
+		// ARRANGE
+		nextStaker, nextValaddress := s.GetNextUploader()
+
+		revealer, revealerValaddress := i.STAKER_1, i.VALADDRESS_1
+		nonRevealer, nonRevealerValaddress := i.STAKER_2, i.VALADDRESS_2
+
+		if nextStaker == i.STAKER_2 {
+			revealer, revealerValaddress = i.STAKER_2, i.VALADDRESS_2
+			nonRevealer, nonRevealerValaddress = i.STAKER_1, i.VALADDRESS_1
+		}
+
+		s.RunTxBundlesSuccess(commit(revealer, revealerValaddress, bundletypes.VOTE_TYPE_YES, salt0))
+		s.RunTxBundlesSuccess(commit(nonRevealer, nonRevealerValaddress, bundletypes.VOTE_TYPE_YES, salt0))
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(reveal(revealer, revealerValaddress, bundletypes.VOTE_TYPE_YES, salt0))
+
+		s.CommitAfterSeconds(s.App().BundlesKeeper.RevealInterval(s.Ctx()))
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    nextValaddress,
+			Staker:     nextStaker,
+			PoolId:     0,
+			StorageId:  "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			ByteSize:   100,
+			FromHeight: 100,
+			ToHeight:   200,
+			FromKey:    "99",
+			ToKey:      "199",
+			ToValue:    "test_value2",
+			BundleHash: "test_hash2",
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.TotalBundles).To(Equal(uint64(1)))
+
+		valaccountRevealer, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, revealer)
+		Expect(valaccountRevealer.Points).To(BeZero())
+
+		valaccountNonRevealer, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, nonRevealer)
+		Expect(valaccountNonRevealer.Points).To(Equal(uint64(1)))
+	})
+})
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+/*
+
+TEST CASES - concluded bundle proposals
+
+* Save a valid bundle proposal in the history
+* Remove the oldest bundle proposal if the history is full
+* Query the history of concluded bundle proposals paginated
+
+*/
+
+var _ = Describe("concluded bundle proposals", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:           "Moontest",
+			MaxBundleSize:  100,
+			StartKey:       "0",
+			UploadInterval: 60,
+			OperatingCost:  10_000,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Save a valid bundle proposal in the history", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			ByteSize:   100,
+			FromHeight: 0,
+			ToHeight:   100,
+			FromKey:    "0",
+			ToKey:      "99",
+			ToValue:    "test_value",
+			BundleHash: "test_hash",
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			ByteSize:   100,
+			FromHeight: 100,
+			ToHeight:   200,
+			FromKey:    "99",
+			ToKey:      "199",
+			ToValue:    "test_value2",
+			BundleHash: "test_hash2",
+		})
+
+		// ASSERT
+		concludedBundleProposal, found := s.App().BundlesKeeper.GetConcludedBundleProposal(s.Ctx(), 0, 0)
+		Expect(found).To(BeTrue())
+
+		Expect(concludedBundleProposal.PoolId).To(Equal(uint64(0)))
+		Expect(concludedBundleProposal.Id).To(Equal(uint64(0)))
+		Expect(concludedBundleProposal.StorageId).To(Equal("y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI"))
+		Expect(concludedBundleProposal.Uploader).To(Equal(i.STAKER_0))
+		Expect(concludedBundleProposal.FromHeight).To(Equal(uint64(0)))
+		Expect(concludedBundleProposal.ToHeight).To(Equal(uint64(100)))
+		Expect(concludedBundleProposal.ToKey).To(Equal("99"))
+		Expect(concludedBundleProposal.BundleHash).To(Equal("test_hash"))
+		Expect(concludedBundleProposal.VotersValid).To(ConsistOf(i.STAKER_0))
+		Expect(concludedBundleProposal.VoteDistribution.Valid).To(Equal(100 * i.KYVE))
+		Expect(concludedBundleProposal.Status).To(Equal(bundletypes.BUNDLE_STATUS_VALID))
+		Expect(concludedBundleProposal.Slashes).To(BeEmpty())
+		Expect(concludedBundleProposal.ConcludedAt).To(Equal(uint64(s.Ctx().BlockTime().Unix())))
+
+		_, found = s.App().BundlesKeeper.GetConcludedBundleProposal(s.Ctx(), 0, 1)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Remove the oldest bundle proposal if the history is full", func() {
+		// ACT
+		for id := 0; id < bundletypes.MaxConcludedBundleProposals+2; id++ {
+			s.App().BundlesKeeper.AppendConcludedBundleProposal(s.Ctx(), bundletypes.ConcludedBundleProposal{
+				PoolId:    0,
+				StorageId: "storage_id",
+				Status:    bundletypes.BUNDLE_STATUS_NO_QUORUM,
+			})
+		}
+
+		// ASSERT
+		history := s.App().BundlesKeeper.GetAllConcludedBundleProposals(s.Ctx())
+		Expect(history).To(HaveLen(bundletypes.MaxConcludedBundleProposals))
+
+		Expect(history[0].Id).To(Equal(uint64(2)))
+		Expect(history[len(history)-1].Id).To(Equal(uint64(bundletypes.MaxConcludedBundleProposals + 1)))
+
+		_, found := s.App().BundlesKeeper.GetConcludedBundleProposal(s.Ctx(), 0, 1)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Query the history of concluded bundle proposals paginated", func() {
+		// ARRANGE
+		for id := 0; id < 5; id++ {
+			s.App().BundlesKeeper.AppendConcludedBundleProposal(s.Ctx(), bundletypes.ConcludedBundleProposal{
+				PoolId:    0,
+				StorageId: "storage_id",
+				Status:    bundletypes.BUNDLE_STATUS_INVALID,
+			})
+		}
+
+		// ACT
+		res, err := s.App().QueryKeeper.ConcludedBundleProposals(sdk.WrapSDKContext(s.Ctx()), &querytypes.QueryConcludedBundleProposalsRequest{
+			PoolId:     0,
+			Pagination: &query.PageRequest{Offset: 2, Limit: 2, CountTotal: true},
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(res.ConcludedBundleProposals).To(HaveLen(2))
+		Expect(res.ConcludedBundleProposals[0].Id).To(Equal(uint64(2)))
+		Expect(res.ConcludedBundleProposals[1].Id).To(Equal(uint64(3)))
+		Expect(res.Pagination.Total).To(Equal(uint64(5)))
+
+		single, err := s.App().QueryKeeper.ConcludedBundleProposal(sdk.WrapSDKContext(s.Ctx()), &querytypes.QueryConcludedBundleProposalRequest{
+			PoolId: 0,
+			Id:     4,
+		})
+		Expect(err).To(BeNil())
+		Expect(single.ConcludedBundleProposal.Id).To(Equal(uint64(4)))
+	})
+})
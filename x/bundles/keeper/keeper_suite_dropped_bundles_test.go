@@ -52,7 +52,7 @@ var _ = Describe("dropped bundles", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
@@ -152,6 +152,18 @@ var _ = Describe("dropped bundles", Ordered, func() {
 		Expect(bundleProposal.VotersInvalid).To(BeEmpty())
 		Expect(bundleProposal.VotersAbstain).To(BeEmpty())
 
+		// check if dropped bundle proposal got saved in history
+		concludedBundleProposal, concludedBundleProposalFound := s.App().BundlesKeeper.GetConcludedBundleProposal(s.Ctx(), 0, 0)
+		Expect(concludedBundleProposalFound).To(BeTrue())
+
+		Expect(concludedBundleProposal.StorageId).To(Equal("y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI"))
+		Expect(concludedBundleProposal.Uploader).To(Equal(i.STAKER_0))
+		Expect(concludedBundleProposal.VotersValid).To(ConsistOf(i.STAKER_0))
+		Expect(concludedBundleProposal.VotersInvalid).To(BeEmpty())
+		Expect(concludedBundleProposal.Status).To(Equal(bundletypes.BUNDLE_STATUS_NO_QUORUM))
+		Expect(concludedBundleProposal.VoteDistribution.Total).To(Equal(300 * i.KYVE))
+		Expect(concludedBundleProposal.Slashes).To(BeEmpty())
+
 		// check uploader status
 		valaccountUploader, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
 		Expect(valaccountUploader.Points).To(BeZero())
@@ -162,7 +174,7 @@ var _ = Describe("dropped bundles", Ordered, func() {
 		balanceUploader := s.GetBalanceFromAddress(valaccountUploader.Staker)
 
 		Expect(balanceUploader).To(Equal(initialBalanceStaker0))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
 
 		// check voter status
 		valaccountVoter, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
@@ -175,27 +187,27 @@ var _ = Describe("dropped bundles", Ordered, func() {
 		Expect(balanceVoter).To(Equal(initialBalanceStaker1))
 
 		Expect(balanceVoter).To(Equal(initialBalanceStaker1))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_1, i.STAKER_1)).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_1, i.STAKER_1).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
 
 		// check pool funds
 		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 		funder, _ := pool.GetFunder(i.ALICE)
 
 		Expect(pool.Funders).To(HaveLen(1))
-		Expect(funder.Amount).To(Equal(100 * i.KYVE))
+		Expect(funder.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100 * i.KYVE))
 	})
 
 	//PIt("Produce dropped bundle because pool has not enough funds", func() {
 	//	// ARRANGE
 	//	s.RunTxPoolSuccess(&pooltypes.MsgDefundPool{
 	//		Creator: i.ALICE,
-	//		Amount:  100 * i.KYVE,
+	//		Amount:  i.KYVECoins(100 * i.KYVE),
 	//	})
 	//
 	//	// fund amount which definetely not cover bundle reward
 	//	s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 	//		Creator: i.ALICE,
-	//		Amount:  1,
+	//		Amount:  i.KYVECoins(1),
 	//	})
 	//
 	//	initialBalanceAlice := s.GetBalanceFromAddress(i.ALICE)
@@ -66,7 +66,7 @@ var _ = Describe("funding bundles", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
@@ -116,12 +116,12 @@ var _ = Describe("funding bundles", Ordered, func() {
 		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 
 		// assert total pool funds
-		Expect(pool.TotalFunds).To(Equal(100*i.KYVE - totalReward))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100*i.KYVE - totalReward))
 		Expect(pool.Funders).To(HaveLen(1))
 
 		// assert individual funds
 		funder, _ := pool.GetFunder(i.ALICE)
-		Expect(funder.Amount).To(Equal(100*i.KYVE - totalReward))
+		Expect(funder.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100*i.KYVE - totalReward))
 
 		// assert individual balances
 		balanceAlice := s.GetBalanceFromAddress(i.ALICE)
@@ -134,13 +134,13 @@ var _ = Describe("funding bundles", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.BOB,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
@@ -190,17 +190,17 @@ var _ = Describe("funding bundles", Ordered, func() {
 		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 
 		// assert total pool funds
-		Expect(pool.TotalFunds).To(Equal(200*i.KYVE - totalReward))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(200*i.KYVE - totalReward))
 		Expect(pool.Funders).To(HaveLen(2))
 
 		// assert individual funds
 		fundersCharge := uint64(sdk.NewDec(int64(totalReward)).Quo(sdk.NewDec(2)).TruncateInt64())
 
 		funderAlice, _ := pool.GetFunder(i.ALICE)
-		Expect(funderAlice.Amount).To(Equal(100*i.KYVE - fundersCharge))
+		Expect(funderAlice.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100*i.KYVE - fundersCharge))
 
 		funderBob, _ := pool.GetFunder(i.BOB)
-		Expect(funderBob.Amount).To(Equal(100*i.KYVE - fundersCharge))
+		Expect(funderBob.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100*i.KYVE - fundersCharge))
 
 		// assert individual balances
 		balanceAlice := s.GetBalanceFromAddress(i.ALICE)
@@ -215,13 +215,13 @@ var _ = Describe("funding bundles", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  150 * i.KYVE,
+			Amount:  i.KYVECoins(150 * i.KYVE),
 		})
 
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.BOB,
 			Id:      0,
-			Amount:  50 * i.KYVE,
+			Amount:  i.KYVECoins(50 * i.KYVE),
 		})
 
 		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
@@ -271,17 +271,17 @@ var _ = Describe("funding bundles", Ordered, func() {
 		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 
 		// assert total pool funds
-		Expect(pool.TotalFunds).To(Equal(200*i.KYVE - totalReward))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(200*i.KYVE - totalReward))
 		Expect(pool.Funders).To(HaveLen(2))
 
 		// assert individual funds
 		fundersCharge := uint64(sdk.NewDec(int64(totalReward)).Quo(sdk.NewDec(2)).TruncateInt64())
 
 		funderAlice, _ := pool.GetFunder(i.ALICE)
-		Expect(funderAlice.Amount).To(Equal(150*i.KYVE - fundersCharge))
+		Expect(funderAlice.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(150*i.KYVE - fundersCharge))
 
 		funderBob, _ := pool.GetFunder(i.BOB)
-		Expect(funderBob.Amount).To(Equal(50*i.KYVE - fundersCharge))
+		Expect(funderBob.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(50*i.KYVE - fundersCharge))
 
 		// assert individual balances
 		balanceAlice := s.GetBalanceFromAddress(i.ALICE)
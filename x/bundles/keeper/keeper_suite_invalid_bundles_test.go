@@ -18,6 +18,7 @@ TEST CASES - invalid bundles
 
 * Produce an invalid bundle with multiple validators and no foreign delegations
 * Produce an invalid bundle with multiple validators and foreign delegations
+* Slash of an invalid bundle is not recorded for the last finalized bundle
 * TODO: Produce an invalid bundle with multiple validators although some voted valid
 
 */
@@ -53,7 +54,7 @@ var _ = Describe("invalid bundles", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
@@ -173,6 +174,24 @@ var _ = Describe("invalid bundles", Ordered, func() {
 		Expect(bundleProposal.VotersInvalid).To(BeEmpty())
 		Expect(bundleProposal.VotersAbstain).To(BeEmpty())
 
+		// check if invalid bundle proposal got saved in history
+		concludedBundleProposal, concludedBundleProposalFound := s.App().BundlesKeeper.GetConcludedBundleProposal(s.Ctx(), 0, 0)
+		Expect(concludedBundleProposalFound).To(BeTrue())
+
+		Expect(concludedBundleProposal.StorageId).To(Equal("y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI"))
+		Expect(concludedBundleProposal.Uploader).To(Equal(i.STAKER_0))
+		Expect(concludedBundleProposal.ToHeight).To(Equal(uint64(100)))
+		Expect(concludedBundleProposal.VotersValid).To(ConsistOf(i.STAKER_0))
+		Expect(concludedBundleProposal.VotersInvalid).To(ConsistOf(i.STAKER_1))
+		Expect(concludedBundleProposal.Status).To(Equal(bundletypes.BUNDLE_STATUS_INVALID))
+		Expect(concludedBundleProposal.VoteDistribution.Valid).To(Equal(100 * i.KYVE))
+		Expect(concludedBundleProposal.VoteDistribution.Invalid).To(Equal(200 * i.KYVE))
+		Expect(concludedBundleProposal.Slashes).To(ConsistOf(bundletypes.ProposalSlash{
+			Staker:    i.STAKER_0,
+			SlashType: stakertypes.SLASH_TYPE_UPLOAD,
+			Amount:    uint64(sdk.NewDec(int64(100 * i.KYVE)).Mul(sdk.MustNewDecFromStr(s.App().StakersKeeper.UploadSlash(s.Ctx()))).TruncateInt64()),
+		}))
+
 		// check uploader status
 		valaccountUploader, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
 		Expect(valaccountUploader.Points).To(BeZero())
@@ -185,7 +204,7 @@ var _ = Describe("invalid bundles", Ordered, func() {
 		Expect(uploaderFound).To(BeTrue())
 
 		Expect(balanceUploader).To(Equal(initialBalanceStaker0))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
 
 		// calculate uploader slashes
 		fraction, _ := sdk.NewDecFromStr(s.App().StakersKeeper.UploadSlash(s.Ctx()))
@@ -204,14 +223,14 @@ var _ = Describe("invalid bundles", Ordered, func() {
 		balanceVoter := s.GetBalanceFromAddress(valaccountVoter.Staker)
 
 		Expect(balanceVoter).To(Equal(initialBalanceStaker1))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_1, i.STAKER_1)).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_1, i.STAKER_1).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
 
 		// check pool funds
 		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 		funder, _ := pool.GetFunder(i.ALICE)
 
 		Expect(pool.Funders).To(HaveLen(1))
-		Expect(funder.Amount).To(Equal(100 * i.KYVE))
+		Expect(funder.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100 * i.KYVE))
 	})
 
 	It("Produce an invalid bundle with multiple validators and foreign delegations", func() {
@@ -327,7 +346,7 @@ var _ = Describe("invalid bundles", Ordered, func() {
 		// assert payout transfer
 		Expect(balanceUploader).To(Equal(initialBalanceStaker0))
 		// assert uploader self delegation rewards
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
 
 		// calculate uploader slashes
 		fraction, _ := sdk.NewDecFromStr(s.App().StakersKeeper.UploadSlash(s.Ctx()))
@@ -348,14 +367,96 @@ var _ = Describe("invalid bundles", Ordered, func() {
 
 		balanceVoter := s.GetBalanceFromAddress(valaccountVoter.Staker)
 		Expect(balanceVoter).To(Equal(initialBalanceStaker1))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_1, i.STAKER_1)).To(BeZero())
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_1, i.BOB)).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_1, i.STAKER_1).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_1, i.BOB).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
 
 		// check pool funds
 		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 		funder, _ := pool.GetFunder(i.ALICE)
 
 		Expect(pool.Funders).To(HaveLen(1))
-		Expect(funder.Amount).To(Equal(100 * i.KYVE))
+		Expect(funder.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100 * i.KYVE))
+	})
+
+	It("Slash of an invalid bundle is not recorded for the last finalized bundle", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			ByteSize:   100,
+			FromHeight: 0,
+			ToHeight:   100,
+			FromKey:    "0",
+			ToKey:      "99",
+			ToValue:    "test_value",
+			BundleHash: "test_hash",
+		})
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			ByteSize:   100,
+			FromHeight: 100,
+			ToHeight:   200,
+			FromKey:    "99",
+			ToKey:      "199",
+			ToValue:    "test_value2",
+			BundleHash: "test_hash2",
+		})
+
+		// stake a bit more than first node so >50% is reached
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  200 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			Vote:      bundletypes.VOTE_TYPE_NO,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  "18SRvVuCrB8vy_OCLBaNbXONMVGeflays3ZCk4nJgbg",
+			ByteSize:   100,
+			FromHeight: 200,
+			ToHeight:   300,
+			FromKey:    "199",
+			ToKey:      "299",
+			ToValue:    "test_value3",
+			BundleHash: "test_hash3",
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.TotalBundles).To(Equal(uint64(1)))
+
+		records := s.App().DelegationKeeper.GetAllSlashRecords(s.Ctx())
+		Expect(records).To(HaveLen(1))
+
+		Expect(records[0].Staker).To(Equal(i.STAKER_0))
+		Expect(records[0].SlashType).To(Equal(stakertypes.SLASH_TYPE_UPLOAD))
+		Expect(records[0].BundleId).To(BeZero())
+		Expect(records[0].HasBundle).To(BeFalse())
 	})
 })
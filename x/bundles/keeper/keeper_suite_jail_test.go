@@ -0,0 +1,334 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - keeper_suite_jail_test.go
+
+* Next uploader gets jailed on upload timeout and keeps its slot in the pool
+* Jailed staker can not claim the uploader role
+* Jailed staker can not vote on a bundle proposal
+* Jailed staker is not part of the vote distribution
+* Votes of a staker which got jailed after voting are not counted
+* Jailed staker does not receive points for not voting
+* Next uploader gets removed after being jailed max jailings times
+
+*/
+
+var _ = Describe("Jailing", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:           "Moontest",
+			MaxBundleSize:  100,
+			StartKey:       "0",
+			MinStake:       100 * i.KYVE,
+			UploadInterval: 60,
+			OperatingCost:  10_000,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Next uploader gets jailed on upload timeout and keeps its slot in the pool", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(s.App().BundlesKeeper.UploadTimeout(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeTrue())
+		Expect(valaccount.IsJailed()).To(BeTrue())
+		Expect(valaccount.JailCount).To(Equal(uint64(1)))
+		Expect(valaccount.Points).To(BeZero())
+
+		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)
+		Expect(poolStakers).To(ConsistOf(i.STAKER_0, i.STAKER_1))
+
+		// the only staker which is not jailed has to be the next uploader
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).To(Equal(i.STAKER_1))
+	})
+
+	It("Jailed staker can not claim the uploader role", func() {
+		// ARRANGE
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		// ACT
+		s.RunTxBundlesError(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).To(BeEmpty())
+	})
+
+	It("Jailed staker can not vote on a bundle proposal", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_1,
+			Staker:  i.STAKER_1,
+			PoolId:  0,
+		})
+
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_1,
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			ByteSize:   100,
+			FromHeight: 0,
+			ToHeight:   100,
+			FromKey:    "0",
+			ToKey:      "99",
+			ToValue:    "test_value",
+			BundleHash: "test_hash",
+		})
+
+		// ACT
+		s.RunTxBundlesError(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_0,
+			Staker:    i.STAKER_0,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_YES,
+		})
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VotersValid).To(ConsistOf(i.STAKER_1))
+		Expect(bundleProposal.VotersInvalid).To(BeEmpty())
+		Expect(bundleProposal.VotersAbstain).To(BeEmpty())
+	})
+
+	It("Jailed staker is not part of the vote distribution", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_1,
+			Staker:  i.STAKER_1,
+			PoolId:  0,
+		})
+
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_1,
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			ByteSize:   100,
+			FromHeight: 0,
+			ToHeight:   100,
+			FromKey:    "0",
+			ToKey:      "99",
+			ToValue:    "test_value",
+			BundleHash: "test_hash",
+		})
+
+		// ACT
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+
+		// ASSERT
+		Expect(voteDistribution.Valid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Total).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundletypes.BUNDLE_STATUS_VALID))
+	})
+
+	It("Votes of a staker which got jailed after voting are not counted", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_1,
+			Staker:  i.STAKER_1,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_1,
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			ByteSize:   100,
+			FromHeight: 0,
+			ToHeight:   100,
+			FromKey:    "0",
+			ToKey:      "99",
+			ToValue:    "test_value",
+			BundleHash: "test_hash",
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_0,
+			Staker:    i.STAKER_0,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_NO,
+		})
+
+		// ACT
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_1)
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.VotersValid).To(ConsistOf(i.STAKER_1))
+
+		Expect(voteDistribution.Valid).To(BeZero())
+		Expect(voteDistribution.Invalid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Total).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundletypes.BUNDLE_STATUS_INVALID))
+	})
+
+	It("Jailed staker does not receive points for not voting", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_1,
+			Staker:  i.STAKER_1,
+			PoolId:  0,
+		})
+
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_1,
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			ByteSize:   100,
+			FromHeight: 0,
+			ToHeight:   100,
+			FromKey:    "0",
+			ToKey:      "99",
+			ToValue:    "test_value",
+			BundleHash: "test_hash",
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_1,
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			StorageId:  "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			ByteSize:   100,
+			FromHeight: 100,
+			ToHeight:   200,
+			FromKey:    "99",
+			ToKey:      "199",
+			ToValue:    "test_value2",
+			BundleHash: "test_hash2",
+		})
+
+		// ASSERT
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeTrue())
+		Expect(valaccount.Points).To(BeZero())
+
+		finalizedBundle, found := s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 0)
+		Expect(found).To(BeTrue())
+		Expect(finalizedBundle.Uploader).To(Equal(i.STAKER_1))
+	})
+
+	It("Next uploader gets removed after being jailed max jailings times", func() {
+		// ARRANGE
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaxJailings = 1
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		// staker already got jailed once before
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		valaccount.JailCount = 1
+		s.App().StakersKeeper.SetValaccount(s.Ctx(), valaccount)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(s.App().BundlesKeeper.UploadTimeout(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeFalse())
+
+		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)
+		Expect(poolStakers).To(ConsistOf(i.STAKER_1))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).To(Equal(i.STAKER_1))
+	})
+})
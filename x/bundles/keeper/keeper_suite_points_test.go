@@ -44,7 +44,7 @@ var _ = Describe("points", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - pool quorums
+
+* Finalize a bundle with a lower valid quorum
+* Require a supermajority with a higher valid quorum
+* Report the pool quorums in the current vote status
+
+*/
+
+var _ = Describe("pool quorums", Ordered, func() {
+	s := i.NewCleanChain()
+
+	storageId := "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI"
+
+	// setupPool creates a pool with the given quorums, three equally
+	// staked validators and a first bundle proposal by STAKER_0
+	setupPool := func(validQuorum string, invalidQuorum string) {
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:           "Moontest",
+			MaxBundleSize:  100,
+			StartKey:       "0",
+			UploadInterval: 60,
+			OperatingCost:  10_000,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan:   &pooltypes.UpgradePlan{},
+			ValidQuorum:   validQuorum,
+			InvalidQuorum: invalidQuorum,
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		for _, staker := range [][2]string{
+			{i.STAKER_0, i.VALADDRESS_0},
+			{i.STAKER_1, i.VALADDRESS_1},
+			{i.STAKER_2, i.VALADDRESS_2},
+		} {
+			s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+				Creator: staker[0],
+				Amount:  100 * i.KYVE,
+			})
+
+			s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+				Creator:    staker[0],
+				PoolId:     0,
+				Valaddress: staker[1],
+			})
+		}
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  storageId,
+			ByteSize:   100,
+			FromHeight: 0,
+			ToHeight:   100,
+			FromKey:    "0",
+			ToKey:      "99",
+			ToValue:    "test_value",
+			BundleHash: "test_hash",
+		})
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Finalize a bundle with a lower valid quorum", func() {
+		// ARRANGE
+		setupPool("0.3", "0.7")
+
+		// ASSERT
+		// the uploader alone holds a third of the delegation
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+		Expect(voteDistribution.Status).To(Equal(bundletypes.BUNDLE_STATUS_VALID))
+
+		// ACT
+		s.CommitAfterSeconds(60)
+
+		nextStaker, nextValaddress := s.GetNextUploader()
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    nextValaddress,
+			Staker:     nextStaker,
+			PoolId:     0,
+			StorageId:  "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			ByteSize:   100,
+			FromHeight: 100,
+			ToHeight:   200,
+			FromKey:    "99",
+			ToKey:      "199",
+			ToValue:    "test_value2",
+			BundleHash: "test_hash2",
+		})
+
+		// ASSERT
+		_, finalizedBundleFound := s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 0)
+		Expect(finalizedBundleFound).To(BeTrue())
+	})
+
+	It("Require a supermajority with a higher valid quorum", func() {
+		// ARRANGE
+		setupPool("0.7", "0.3")
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: storageId,
+			Vote:      bundletypes.VOTE_TYPE_YES,
+		})
+
+		// ASSERT
+		// two thirds of the delegation are not enough
+		voteDistribution := s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+		Expect(voteDistribution.Valid).To(Equal(200 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundletypes.BUNDLE_STATUS_NO_QUORUM))
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_2,
+			Staker:    i.STAKER_2,
+			PoolId:    0,
+			StorageId: storageId,
+			Vote:      bundletypes.VOTE_TYPE_NO,
+		})
+
+		// ASSERT
+		// a third of the delegation voting invalid is enough to drop the bundle
+		voteDistribution = s.App().BundlesKeeper.GetVoteDistribution(s.Ctx(), 0)
+		Expect(voteDistribution.Invalid).To(Equal(100 * i.KYVE))
+		Expect(voteDistribution.Status).To(Equal(bundletypes.BUNDLE_STATUS_INVALID))
+	})
+
+	It("Report the pool quorums in the current vote status", func() {
+		// ARRANGE
+		setupPool("0.7", "")
+
+		// ACT
+		voteStatus, err := s.App().QueryKeeper.CurrentVoteStatus(sdk.WrapSDKContext(s.Ctx()), &querytypes.QueryCurrentVoteStatusRequest{
+			PoolId: 0,
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(voteStatus.Valid).To(Equal(100 * i.KYVE))
+		Expect(voteStatus.Total).To(Equal(300 * i.KYVE))
+		Expect(voteStatus.Status).To(Equal(bundletypes.BUNDLE_STATUS_NO_QUORUM))
+		Expect(voteStatus.ValidQuorum).To(Equal(sdk.MustNewDecFromStr("0.7").String()))
+		Expect(voteStatus.InvalidQuorum).To(Equal(sdk.MustNewDecFromStr(pooltypes.DefaultInvalidQuorum).String()))
+	})
+})
@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - reset pool
+
+* Reset pool to an earlier finalized bundle
+* Reset pool to the latest finalized bundle
+* Reset pool to a bundle which is not finalized yet
+* Continue producing bundles after a pool reset
+
+*/
+
+var _ = Describe("reset pool", Ordered, func() {
+	s := i.NewCleanChain()
+
+	storageIds := []string{
+		"y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+		"P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+		"18SRvVuCrB8vy_OCLBaNbXONMVGeflal6wGjnDTcNm0",
+		"dL9_MGyg8WomoeOcCCeKL2m8OOVL3ZbgxABjzhUAZPQ",
+	}
+
+	submitBundleProposal := func(index uint64) {
+		fromKey := "0"
+		if index > 0 {
+			fromKey = fmt.Sprintf("%v99", index)
+		}
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  storageIds[index],
+			ByteSize:   100,
+			FromHeight: index * 100,
+			ToHeight:   (index + 1) * 100,
+			FromKey:    fromKey,
+			ToKey:      fmt.Sprintf("%v99", index+1),
+			ToValue:    "test_value",
+			BundleHash: "test_hash",
+		})
+
+		s.CommitAfterSeconds(60)
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:           "Moontest",
+			MaxBundleSize:  100,
+			StartKey:       "0",
+			UploadInterval: 60,
+			OperatingCost:  10_000,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// produce three finalized bundles and one open bundle proposal
+		for index := uint64(0); index < 4; index++ {
+			submitBundleProposal(index)
+		}
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Reset pool to an earlier finalized bundle", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.TotalBundles).To(Equal(uint64(3)))
+
+		// ACT
+		err := s.App().PoolKeeper.ResetPool(s.Ctx(), &pooltypes.ResetPoolProposal{
+			Title:       "title",
+			Description: "description",
+			Id:          0,
+			BundleId:    0,
+		})
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.CurrentHeight).To(Equal(uint64(100)))
+		Expect(pool.CurrentKey).To(Equal("199"))
+		Expect(pool.CurrentValue).To(Equal("test_value"))
+		Expect(pool.TotalBundles).To(Equal(uint64(1)))
+
+		_, found := s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 0)
+		Expect(found).To(BeTrue())
+
+		_, found = s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 1)
+		Expect(found).To(BeFalse())
+
+		_, found = s.App().BundlesKeeper.GetFinalizedBundle(s.Ctx(), 0, 2)
+		Expect(found).To(BeFalse())
+
+		Expect(s.App().BundlesKeeper.GetFinalizedBundlesByPool(s.Ctx(), 0)).To(HaveLen(1))
+
+		// check memory indexes
+		_, found = s.App().BundlesKeeper.GetFinalizedBundleByStorageId(s.Ctx(), storageIds[0])
+		Expect(found).To(BeTrue())
+
+		_, found = s.App().BundlesKeeper.GetFinalizedBundleByStorageId(s.Ctx(), storageIds[1])
+		Expect(found).To(BeFalse())
+
+		_, found = s.App().BundlesKeeper.GetFinalizedBundleByStorageId(s.Ctx(), storageIds[2])
+		Expect(found).To(BeFalse())
+
+		_, found = s.App().BundlesKeeper.GetFinalizedBundleByHeight(s.Ctx(), 0, 50)
+		Expect(found).To(BeTrue())
+
+		_, found = s.App().BundlesKeeper.GetFinalizedBundleByHeight(s.Ctx(), 0, 150)
+		Expect(found).To(BeFalse())
+
+		// check bundle proposal got reset
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+
+		Expect(bundleProposal.StorageId).To(BeEmpty())
+		Expect(bundleProposal.Uploader).To(BeEmpty())
+		Expect(bundleProposal.NextUploader).To(Equal(i.STAKER_0))
+		Expect(bundleProposal.VotersValid).To(BeEmpty())
+	})
+
+	It("Reset pool to the latest finalized bundle", func() {
+		// ACT
+		err := s.App().PoolKeeper.ResetPool(s.Ctx(), &pooltypes.ResetPoolProposal{
+			Title:       "title",
+			Description: "description",
+			Id:          0,
+			BundleId:    2,
+		})
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.CurrentHeight).To(Equal(uint64(300)))
+		Expect(pool.CurrentKey).To(Equal("399"))
+		Expect(pool.TotalBundles).To(Equal(uint64(3)))
+
+		Expect(s.App().BundlesKeeper.GetFinalizedBundlesByPool(s.Ctx(), 0)).To(HaveLen(3))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.StorageId).To(BeEmpty())
+	})
+
+	It("Reset pool to a bundle which is not finalized yet", func() {
+		// ACT
+		err := s.App().PoolKeeper.ResetPool(s.Ctx(), &pooltypes.ResetPoolProposal{
+			Title:       "title",
+			Description: "description",
+			Id:          0,
+			BundleId:    3,
+		})
+		Expect(err).NotTo(BeNil())
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.CurrentHeight).To(Equal(uint64(300)))
+		Expect(pool.TotalBundles).To(Equal(uint64(3)))
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.StorageId).To(Equal(storageIds[3]))
+	})
+
+	It("Continue producing bundles after a pool reset", func() {
+		// ARRANGE
+		err := s.App().PoolKeeper.ResetPool(s.Ctx(), &pooltypes.ResetPoolProposal{
+			Title:       "title",
+			Description: "description",
+			Id:          0,
+			BundleId:    0,
+		})
+		Expect(err).To(BeNil())
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		submitBundleProposal(1)
+		submitBundleProposal(2)
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.CurrentHeight).To(Equal(uint64(200)))
+		Expect(pool.CurrentKey).To(Equal("299"))
+		Expect(pool.TotalBundles).To(Equal(uint64(2)))
+
+		finalizedBundle, found := s.App().BundlesKeeper.GetFinalizedBundleByStorageId(s.Ctx(), storageIds[1])
+		Expect(found).To(BeTrue())
+		Expect(finalizedBundle.Id).To(Equal(uint64(1)))
+		Expect(finalizedBundle.FromHeight).To(Equal(uint64(100)))
+	})
+})
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - uploader selection
+
+* Select the same uploader for the same header hash, pool and bundle
+* Select uploaders proportional to their delegation
+* Select uploaders independently across pools
+* Do not touch the global random source
+
+*/
+
+var _ = Describe("uploader selection", Ordered, func() {
+	s := i.NewCleanChain()
+
+	stakers := []string{i.STAKER_0, i.STAKER_1, i.STAKER_2}
+
+	// headerCtx returns the current context with a unique header hash for every draw
+	headerCtx := func(draw uint64) sdk.Context {
+		b := make([]byte, 8)
+		binary.BigEndian.PutUint64(b, draw)
+		hash := sha256.Sum256(b)
+		return s.Ctx().WithHeaderHash(hash[:])
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create two clean pools for every test case
+		for range []int{0, 1} {
+			s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+				Name:           "Moontest",
+				MaxBundleSize:  100,
+				StartKey:       "0",
+				UploadInterval: 60,
+				OperatingCost:  10_000,
+				Protocol: &pooltypes.Protocol{
+					Version:     "0.0.0",
+					Binaries:    "{}",
+					LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+				},
+				UpgradePlan: &pooltypes.UpgradePlan{},
+			})
+		}
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  300 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_2,
+			Amount:  600 * i.KYVE,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Select the same uploader for the same header hash, pool and bundle", func() {
+		for draw := uint64(0); draw < 100; draw++ {
+			first := s.App().BundlesKeeper.ChooseNextUploaderFromSelectedStakers(headerCtx(draw), 0, stakers)
+			second := s.App().BundlesKeeper.ChooseNextUploaderFromSelectedStakers(headerCtx(draw), 0, stakers)
+
+			Expect(first).NotTo(BeEmpty())
+			Expect(first).To(Equal(second))
+		}
+	})
+
+	It("Select uploaders proportional to their delegation", func() {
+		// ACT
+		draws := uint64(10000)
+		selections := make(map[string]uint64)
+
+		for draw := uint64(0); draw < draws; draw++ {
+			selections[s.App().BundlesKeeper.ChooseNextUploaderFromSelectedStakers(headerCtx(draw), 0, stakers)]++
+		}
+
+		// ASSERT
+		Expect(selections).To(HaveLen(3))
+
+		Expect(float64(selections[i.STAKER_0]) / float64(draws)).To(BeNumerically("~", 0.1, 0.02))
+		Expect(float64(selections[i.STAKER_1]) / float64(draws)).To(BeNumerically("~", 0.3, 0.02))
+		Expect(float64(selections[i.STAKER_2]) / float64(draws)).To(BeNumerically("~", 0.6, 0.02))
+	})
+
+	It("Select uploaders independently across pools", func() {
+		// ACT
+		draws := uint64(1000)
+		differences := 0
+
+		for draw := uint64(0); draw < draws; draw++ {
+			uploaderPool0 := s.App().BundlesKeeper.ChooseNextUploaderFromSelectedStakers(headerCtx(draw), 0, stakers)
+			uploaderPool1 := s.App().BundlesKeeper.ChooseNextUploaderFromSelectedStakers(headerCtx(draw), 1, stakers)
+
+			if uploaderPool0 != uploaderPool1 {
+				differences++
+			}
+		}
+
+		// ASSERT
+		// with independent draws both pools select the same uploader with
+		// a probability of 0.1^2 + 0.3^2 + 0.6^2 = 0.46
+		Expect(float64(differences) / float64(draws)).To(BeNumerically("~", 0.54, 0.05))
+	})
+
+	It("Do not touch the global random source", func() {
+		// ARRANGE
+		rand.Seed(42)
+		expected := rand.Int63()
+
+		// ACT
+		rand.Seed(42)
+		s.App().BundlesKeeper.ChooseNextUploaderFromSelectedStakers(headerCtx(0), 0, stakers)
+
+		// ASSERT
+		Expect(rand.Int63()).To(Equal(expected))
+	})
+})
@@ -56,7 +56,7 @@ var _ = Describe("valid bundles", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
@@ -144,6 +144,7 @@ var _ = Describe("valid bundles", Ordered, func() {
 		Expect(finalizedBundle.Key).To(Equal("99"))
 		Expect(finalizedBundle.Value).To(Equal("test_value"))
 		Expect(finalizedBundle.BundleHash).To(Equal("test_hash"))
+		Expect(finalizedBundle.ByteSize).To(Equal(uint64(100)))
 		Expect(finalizedBundle.FinalizedAt).NotTo(BeZero())
 
 		// check if next bundle proposal got registered
@@ -188,14 +189,14 @@ var _ = Describe("valid bundles", Ordered, func() {
 		// assert payout transfer
 		Expect(balanceUploader).To(Equal(initialBalanceStaker0 + uploaderPayoutReward))
 		// assert uploader self delegation rewards
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(uploaderDelegationReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uploaderDelegationReward))
 
 		// check pool funds
 		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 		funder, _ := pool.GetFunder(i.ALICE)
 
 		Expect(pool.Funders).To(HaveLen(1))
-		Expect(funder.Amount).To(Equal(100*i.KYVE - totalReward))
+		Expect(funder.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100*i.KYVE - totalReward))
 	})
 
 	It("Produce a valid bundle with one validator and foreign delegations", func() {
@@ -307,16 +308,16 @@ var _ = Describe("valid bundles", Ordered, func() {
 		// assert payout transfer
 		Expect(balanceUploader).To(Equal(initialBalanceStaker0 + uploaderPayoutReward))
 		// assert uploader self delegation rewards
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(uploaderDelegationReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uploaderDelegationReward))
 		// assert delegator delegation rewards
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.ALICE)).To(Equal(delegatorDelegationReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.ALICE).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(delegatorDelegationReward))
 
 		// check pool funds
 		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 		funder, _ := pool.GetFunder(i.ALICE)
 
 		Expect(pool.Funders).To(HaveLen(1))
-		Expect(funder.Amount).To(Equal(100*i.KYVE - totalReward))
+		Expect(funder.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100*i.KYVE - totalReward))
 	})
 
 	It("Produce a valid bundle with multiple validators and no foreign delegations", func() {
@@ -450,14 +451,14 @@ var _ = Describe("valid bundles", Ordered, func() {
 		// assert payout transfer
 		Expect(balanceUploader).To(Equal(initialBalanceStaker0 + uploaderPayoutReward))
 		// assert uploader self delegation rewards
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(uploaderDelegationReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uploaderDelegationReward))
 
 		// check pool funds
 		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 		funder, _ := pool.GetFunder(i.ALICE)
 
 		Expect(pool.Funders).To(HaveLen(1))
-		Expect(funder.Amount).To(Equal(100*i.KYVE - totalReward))
+		Expect(funder.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100*i.KYVE - totalReward))
 	})
 
 	It("Produce a valid bundle with one validator and foreign delegations", func() {
@@ -607,23 +608,23 @@ var _ = Describe("valid bundles", Ordered, func() {
 		// assert payout transfer
 		Expect(balanceUploader).To(Equal(initialBalanceStaker0 + uploaderPayoutReward))
 		// assert uploader self delegation rewards
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(uploaderDelegationReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uploaderDelegationReward))
 		// assert delegator delegation rewards
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.ALICE)).To(Equal(delegatorDelegationReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.ALICE).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(delegatorDelegationReward))
 
 		// check voter rewards
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_1, i.BOB)).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_1, i.BOB).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
 
 		// assert payout transfer
 		Expect(balanceUploader).To(Equal(initialBalanceStaker0 + uploaderPayoutReward))
 		// assert uploader self delegation rewards
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(uploaderDelegationReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uploaderDelegationReward))
 
 		// check pool funds
 		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 		funder, _ := pool.GetFunder(i.ALICE)
 
 		Expect(pool.Funders).To(HaveLen(1))
-		Expect(funder.Amount).To(Equal(100*i.KYVE - totalReward))
+		Expect(funder.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100*i.KYVE - totalReward))
 	})
 })
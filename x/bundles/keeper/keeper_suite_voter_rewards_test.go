@@ -0,0 +1,248 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - voter rewards
+
+* Split the voter reward share between valid voters by delegation
+* Do not reward voters who voted abstain
+
+*/
+
+var _ = Describe("voter rewards", Ordered, func() {
+	s := i.NewCleanChain()
+
+	initialBalanceStaker0 := s.GetBalanceFromAddress(i.STAKER_0)
+	initialBalanceStaker1 := s.GetBalanceFromAddress(i.STAKER_1)
+	initialBalanceStaker2 := s.GetBalanceFromAddress(i.STAKER_2)
+
+	// payout calculates the commission and the delegation reward of a staker
+	payout := func(staker string, reward uint64) (uint64, uint64) {
+		stakerObj, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), staker)
+		commission, _ := sdk.NewDecFromStr(stakerObj.Commission)
+
+		rewardStaker := uint64(sdk.NewDec(int64(reward)).Mul(commission).RoundInt64())
+		return rewardStaker, reward - rewardStaker
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		params := s.App().BundlesKeeper.GetParams(s.Ctx())
+		params.VoterRewardShare = "0.5"
+		s.App().BundlesKeeper.SetParams(s.Ctx(), params)
+
+		// create clean pool for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:           "Moontest",
+			MaxBundleSize:  100,
+			StartKey:       "0",
+			UploadInterval: 60,
+			OperatingCost:  10_000,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		for _, staker := range []struct {
+			address    string
+			valaddress string
+			amount     uint64
+		}{
+			{i.STAKER_0, i.VALADDRESS_0, 100 * i.KYVE},
+			{i.STAKER_1, i.VALADDRESS_1, 100 * i.KYVE},
+			{i.STAKER_2, i.VALADDRESS_2, 300 * i.KYVE},
+		} {
+			s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+				Creator: staker.address,
+				Amount:  staker.amount,
+			})
+
+			s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+				Creator:    staker.address,
+				PoolId:     0,
+				Valaddress: staker.valaddress,
+			})
+		}
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			ByteSize:   100,
+			FromHeight: 0,
+			ToHeight:   100,
+			FromKey:    "0",
+			ToKey:      "99",
+			ToValue:    "test_value",
+			BundleHash: "test_hash",
+		})
+
+		initialBalanceStaker0 = s.GetBalanceFromAddress(i.STAKER_0)
+		initialBalanceStaker1 = s.GetBalanceFromAddress(i.STAKER_1)
+		initialBalanceStaker2 = s.GetBalanceFromAddress(i.STAKER_2)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Split the voter reward share between valid voters by delegation", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_YES,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_2,
+			Staker:    i.STAKER_2,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_YES,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		nextStaker, nextValaddress := s.GetNextUploader()
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    nextValaddress,
+			Staker:     nextStaker,
+			PoolId:     0,
+			StorageId:  "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			ByteSize:   100,
+			FromHeight: 100,
+			ToHeight:   200,
+			FromKey:    "99",
+			ToKey:      "199",
+			ToValue:    "test_value2",
+			BundleHash: "test_hash2",
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.TotalBundles).To(Equal(uint64(1)))
+
+		totalReward := 100*s.App().BundlesKeeper.StorageCost(s.Ctx()) + pool.OperatingCost
+		networkFee, _ := sdk.NewDecFromStr(s.App().BundlesKeeper.NetworkFee(s.Ctx()))
+
+		treasuryReward := uint64(sdk.NewDec(int64(totalReward)).Mul(networkFee).RoundInt64())
+		totalNodeReward := totalReward - treasuryReward
+
+		// voters receive half of the node reward weighted by their delegation of 100 and 300 KYVE
+		totalVoterReward := totalNodeReward / 2
+		voter1Reward := totalVoterReward / 4
+		voter2Reward := totalVoterReward * 3 / 4
+
+		voter1PayoutReward, voter1DelegationReward := payout(i.STAKER_1, voter1Reward)
+		voter2PayoutReward, voter2DelegationReward := payout(i.STAKER_2, voter2Reward)
+		uploaderPayoutReward, uploaderDelegationReward := payout(i.STAKER_0, totalNodeReward-voter1Reward-voter2Reward)
+
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(initialBalanceStaker0 + uploaderPayoutReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uploaderDelegationReward))
+
+		Expect(s.GetBalanceFromAddress(i.STAKER_1)).To(Equal(initialBalanceStaker1 + voter1PayoutReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_1, i.STAKER_1).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(voter1DelegationReward))
+
+		Expect(s.GetBalanceFromAddress(i.STAKER_2)).To(Equal(initialBalanceStaker2 + voter2PayoutReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_2, i.STAKER_2).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(voter2DelegationReward))
+
+		// funders only pay the total reward
+		funder, _ := pool.GetFunder(i.ALICE)
+		Expect(funder.Amount.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100*i.KYVE - totalReward))
+	})
+
+	It("Do not reward voters who voted abstain", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_1,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_ABSTAIN,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.VALADDRESS_2,
+			Staker:    i.STAKER_2,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_YES,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		nextStaker, nextValaddress := s.GetNextUploader()
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    nextValaddress,
+			Staker:     nextStaker,
+			PoolId:     0,
+			StorageId:  "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			ByteSize:   100,
+			FromHeight: 100,
+			ToHeight:   200,
+			FromKey:    "99",
+			ToKey:      "199",
+			ToValue:    "test_value2",
+			BundleHash: "test_hash2",
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.TotalBundles).To(Equal(uint64(1)))
+
+		totalReward := 100*s.App().BundlesKeeper.StorageCost(s.Ctx()) + pool.OperatingCost
+		networkFee, _ := sdk.NewDecFromStr(s.App().BundlesKeeper.NetworkFee(s.Ctx()))
+
+		treasuryReward := uint64(sdk.NewDec(int64(totalReward)).Mul(networkFee).RoundInt64())
+		totalNodeReward := totalReward - treasuryReward
+
+		// the only valid voter receives the entire voter share
+		voter2PayoutReward, voter2DelegationReward := payout(i.STAKER_2, totalNodeReward/2)
+		uploaderPayoutReward, uploaderDelegationReward := payout(i.STAKER_0, totalNodeReward-totalNodeReward/2)
+
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(initialBalanceStaker0 + uploaderPayoutReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.STAKER_0).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uploaderDelegationReward))
+
+		Expect(s.GetBalanceFromAddress(i.STAKER_1)).To(Equal(initialBalanceStaker1))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_1, i.STAKER_1).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
+
+		Expect(s.GetBalanceFromAddress(i.STAKER_2)).To(Equal(initialBalanceStaker2 + voter2PayoutReward))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_2, i.STAKER_2).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(voter2DelegationReward))
+	})
+})
@@ -1,14 +1,14 @@
 package keeper
 
 import (
+	"crypto/sha256"
 	"github.com/KYVENetwork/chain/util"
 	"github.com/KYVENetwork/chain/x/bundles/types"
 	poolmoduletypes "github.com/KYVENetwork/chain/x/pool/types"
 	stakermoduletypes "github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"math"
-	"math/rand"
+	"math/big"
 	"sort"
 )
 
@@ -55,8 +55,14 @@ func (k Keeper) AssertCanVote(ctx sdk.Context, poolId uint64, staker string, vot
 		return err
 	}
 
+	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
 	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
 
+	// Votes on commit-reveal pools have to be committed and revealed
+	if pool.CommitRevealVoting {
+		return types.ErrCommitRevealEnabled
+	}
+
 	// Check if dropped bundle
 	if bundleProposal.StorageId == "" {
 		return types.ErrBundleDropped
@@ -101,9 +107,10 @@ func (k Keeper) AssertCanPropose(ctx sdk.Context, poolId uint64, staker string,
 		return sdkErrors.Wrapf(types.ErrNotDesignatedUploader, "expected %v received %v", bundleProposal.NextUploader, staker)
 	}
 
-	// Check if upload interval has been surpassed
-	if uint64(ctx.BlockTime().Unix()) < (bundleProposal.CreatedAt + pool.UploadInterval) {
-		return sdkErrors.Wrapf(types.ErrUploadInterval, "expected %v < %v", ctx.BlockTime().Unix(), bundleProposal.CreatedAt+pool.UploadInterval)
+	// Check if upload interval (and the reveal interval of commit-reveal pools) has been surpassed
+	votingEnd := bundleProposal.CreatedAt + pool.UploadInterval + k.getRevealInterval(ctx, pool, bundleProposal)
+	if uint64(ctx.BlockTime().Unix()) < votingEnd {
+		return sdkErrors.Wrapf(types.ErrUploadInterval, "expected %v < %v", ctx.BlockTime().Unix(), votingEnd)
 	}
 
 	// Check if from_height matches
@@ -207,9 +214,10 @@ func (k Keeper) registerBundleProposalFromUploader(ctx sdk.Context, pool poolmod
 // handleNonVoters checks if stakers in a pool voted on the current bundle proposal
 // if a staker did not vote at all on a bundle proposal he received points
 // if a staker receives a certain number of points he receives a timeout slash and gets
-// kicked out of a pool
-func (k Keeper) handleNonVoters(ctx sdk.Context, poolId uint64) {
+// kicked out of a pool. It returns all timeout slashes which were applied.
+func (k Keeper) handleNonVoters(ctx sdk.Context, poolId uint64) (slashes []types.ProposalSlash) {
 	voters := map[string]bool{}
+	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
 	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
 
 	for _, address := range bundleProposal.VotersValid {
@@ -225,26 +233,50 @@ func (k Keeper) handleNonVoters(ctx sdk.Context, poolId uint64) {
 	}
 
 	for _, staker := range k.stakerKeeper.GetAllStakerAddressesOfPool(ctx, poolId) {
+		// jailed stakers are not allowed to vote
+		if k.stakerKeeper.IsValaccountJailed(ctx, poolId, staker) {
+			continue
+		}
+
 		if !voters[staker] {
 			points := k.stakerKeeper.AddPoint(ctx, poolId, staker)
 
-			if points >= k.MaxPoints(ctx) {
-				k.delegationKeeper.SlashDelegators(ctx, staker, stakermoduletypes.SLASH_TYPE_TIMEOUT)
+			if points >= k.PoolMaxPoints(ctx, pool) {
+				amount := k.delegationKeeper.SlashDelegators(ctx, poolId, staker, stakermoduletypes.SLASH_TYPE_TIMEOUT, 0, false)
 				k.stakerKeeper.ResetPoints(ctx, poolId, staker)
-				k.stakerKeeper.RemoveValaccountFromPool(ctx, poolId, staker)
+				k.stakerKeeper.JailValaccount(ctx, poolId, staker)
+
+				slashes = append(slashes, types.ProposalSlash{
+					Staker:    staker,
+					SlashType: stakermoduletypes.SLASH_TYPE_TIMEOUT,
+					Amount:    amount,
+				})
 			}
 		}
 	}
-}
 
-// calculatePayouts deducts the network fee from the rewards and splits the remaining amount
-// between the staker and its delegators. If there are no delegators, the entire amount is
-// awarded to the staker.
-func (k Keeper) calculatePayouts(ctx sdk.Context, poolId uint64) (bundleReward types.BundleReward) {
+	return
+}
 
+// calculateBundleCost returns the cost of the current bundle proposal in units of
+// the pool costs. This is the amount the funders of the pool get charged.
+func (k Keeper) calculateBundleCost(ctx sdk.Context, poolId uint64) uint64 {
 	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
 	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
 
+	// formula for calculating the rewards
+	return pool.OperatingCost + (bundleProposal.ByteSize * k.StorageCost(ctx))
+}
+
+// calculatePayouts deducts the network fee from the charged coins and splits the remaining
+// amount between the uploader, the stakers who voted valid and their delegators. If there
+// are no delegators, the entire amount is awarded to the staker.
+func (k Keeper) calculatePayouts(ctx sdk.Context, poolId uint64, totalPayout sdk.Coins) (bundleReward types.BundleReward) {
+
+	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
+
+	bundleReward.Total = totalPayout
+
 	// Should not happen, if so move everything to the treasury
 	if !k.stakerKeeper.DoesStakerExist(ctx, bundleProposal.Uploader) {
 		bundleReward.Treasury = bundleReward.Total
@@ -252,34 +284,103 @@ func (k Keeper) calculatePayouts(ctx sdk.Context, poolId uint64) (bundleReward t
 		return
 	}
 
-	// formula for calculating the rewards
-	bundleReward.Total = pool.OperatingCost + (bundleProposal.ByteSize * k.StorageCost(ctx))
-
 	networkFee, err := sdk.NewDecFromStr(k.NetworkFee(ctx))
 	if err != nil {
 		util.LogFatalLogicError("Network Fee unparasable", err.Error(), k.NetworkFee(ctx))
 	}
 	// Add fee to treasury
-	bundleReward.Treasury = uint64(sdk.NewDec(int64(bundleReward.Total)).Mul(networkFee).RoundInt64())
+	bundleReward.Treasury = mulCoinsRound(bundleReward.Total, networkFee)
+
+	// Remaining rewards to be split between stakers and their delegators
+	totalNodeReward := bundleReward.Total.Sub(bundleReward.Treasury)
 
-	// Remaining rewards to be split between staker and its delegators
-	totalNodeReward := bundleReward.Total - bundleReward.Treasury
+	// Deduct the share of the stakers who voted valid
+	bundleReward.Voters = k.calculateVoterPayouts(ctx, bundleProposal, totalNodeReward)
+	for _, voterReward := range bundleReward.Voters {
+		totalNodeReward = totalNodeReward.Sub(voterReward.RewardStaker.Add(voterReward.RewardDelegation...))
+	}
 
 	// Payout delegators
 	if k.delegationKeeper.GetDelegationAmount(ctx, bundleProposal.Uploader) > 0 {
 		commission := k.stakerKeeper.GetCommission(ctx, bundleProposal.Uploader)
 
-		bundleReward.Uploader = uint64(sdk.NewDec(int64(totalNodeReward)).Mul(commission).RoundInt64())
-		bundleReward.Delegation = totalNodeReward - bundleReward.Uploader
+		bundleReward.Uploader = mulCoinsRound(totalNodeReward, commission)
+		bundleReward.Delegation = totalNodeReward.Sub(bundleReward.Uploader)
 	} else {
 		bundleReward.Uploader = totalNodeReward
-		bundleReward.Delegation = 0
+		bundleReward.Delegation = sdk.NewCoins()
 	}
 
 	return
 }
 
-func (k Keeper) finalizeCurrentBundleProposal(ctx sdk.Context, pool poolmoduletypes.Pool, bundleProposal types.BundleProposal, voteDistribution types.VoteDistribution, bundleReward types.BundleReward) error {
+// calculateVoterPayouts splits the voter reward share of the given amount between all
+// stakers who voted valid, except the uploader, weighted by their delegation. Rounding
+// remainders are not distributed and stay with the uploader.
+func (k Keeper) calculateVoterPayouts(ctx sdk.Context, bundleProposal types.BundleProposal, amount sdk.Coins) (voterRewards []types.VoterReward) {
+	voterRewardShare, err := sdk.NewDecFromStr(k.VoterRewardShare(ctx))
+	if err != nil {
+		util.LogFatalLogicError("Voter Reward Share unparasable", err.Error(), k.VoterRewardShare(ctx))
+	}
+
+	totalVoterReward, _ := sdk.NewDecCoinsFromCoins(amount...).MulDecTruncate(voterRewardShare).TruncateDecimal()
+	if totalVoterReward.IsZero() {
+		return
+	}
+
+	voters := make([]string, 0)
+	weights := make(map[string]uint64)
+	totalWeight := uint64(0)
+
+	for _, voter := range bundleProposal.VotersValid {
+		if voter == bundleProposal.Uploader {
+			continue
+		}
+
+		weight := k.delegationKeeper.GetDelegationAmount(ctx, voter)
+		if weight == 0 {
+			continue
+		}
+
+		voters = append(voters, voter)
+		weights[voter] = weight
+		totalWeight += weight
+	}
+
+	if totalWeight == 0 {
+		return
+	}
+
+	for _, voter := range voters {
+		reward := sdk.NewCoins()
+		for _, coin := range totalVoterReward {
+			share := coin.Amount.Mul(sdk.NewIntFromUint64(weights[voter])).Quo(sdk.NewIntFromUint64(totalWeight))
+			reward = reward.Add(sdk.NewCoin(coin.Denom, share))
+		}
+
+		commission := k.stakerKeeper.GetCommission(ctx, voter)
+		rewardStaker := mulCoinsRound(reward, commission)
+
+		voterRewards = append(voterRewards, types.VoterReward{
+			Staker:           voter,
+			RewardStaker:     rewardStaker,
+			RewardDelegation: reward.Sub(rewardStaker),
+		})
+	}
+
+	return
+}
+
+// mulCoinsRound multiplies every coin with the given decimal and rounds the result.
+func mulCoinsRound(coins sdk.Coins, dec sdk.Dec) sdk.Coins {
+	result := sdk.NewCoins()
+	for _, coin := range coins {
+		result = result.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(dec).RoundInt()))
+	}
+	return result
+}
+
+func (k Keeper) finalizeCurrentBundleProposal(ctx sdk.Context, pool poolmoduletypes.Pool, bundleProposal types.BundleProposal, voteDistribution types.VoteDistribution, bundleReward types.BundleReward, slashes []types.ProposalSlash) error {
 	// save finalized bundle
 	finalizedBundle := types.FinalizedBundle{
 		StorageId:   bundleProposal.StorageId,
@@ -292,9 +393,11 @@ func (k Keeper) finalizeCurrentBundleProposal(ctx sdk.Context, pool poolmodulety
 		Key:         bundleProposal.ToKey,
 		Value:       bundleProposal.ToValue,
 		BundleHash:  bundleProposal.BundleHash,
+		ByteSize:    bundleProposal.ByteSize,
 	}
 
 	k.SetFinalizedBundle(ctx, finalizedBundle)
+	k.saveConcludedBundleProposal(ctx, pool, bundleProposal, voteDistribution, slashes)
 
 	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventBundleFinalized{
 		PoolId:           finalizedBundle.PoolId,
@@ -308,6 +411,7 @@ func (k Keeper) finalizeCurrentBundleProposal(ctx sdk.Context, pool poolmodulety
 		RewardUploader:   bundleReward.Uploader,
 		RewardDelegation: bundleReward.Delegation,
 		RewardTotal:      bundleReward.Total,
+		RewardVoters:     bundleReward.Voters,
 	}); errEmit != nil {
 		return errEmit
 	}
@@ -323,6 +427,7 @@ func (k Keeper) dropCurrentBundleProposal(
 	bundleProposal types.BundleProposal,
 	voteDistribution types.VoteDistribution,
 	nextUploader string,
+	slashes []types.ProposalSlash,
 ) error {
 	err := ctx.EventManager().EmitTypedEvent(&types.EventBundleFinalized{
 		PoolId:  pool.Id,
@@ -334,6 +439,9 @@ func (k Keeper) dropCurrentBundleProposal(
 		Status:  voteDistribution.Status,
 	})
 
+	// keep a record of the dropped bundle
+	k.saveConcludedBundleProposal(ctx, pool, bundleProposal, voteDistribution, slashes)
+
 	// drop bundle
 	bundleProposal = types.BundleProposal{
 		PoolId:       pool.Id,
@@ -346,14 +454,50 @@ func (k Keeper) dropCurrentBundleProposal(
 	return err
 }
 
+// saveConcludedBundleProposal adds the given bundle proposal together with its
+// vote outcome and the applied slashes to the proposal history of the pool.
+func (k Keeper) saveConcludedBundleProposal(
+	ctx sdk.Context, pool poolmoduletypes.Pool,
+	bundleProposal types.BundleProposal,
+	voteDistribution types.VoteDistribution,
+	slashes []types.ProposalSlash,
+) {
+	k.AppendConcludedBundleProposal(ctx, types.ConcludedBundleProposal{
+		PoolId:           pool.Id,
+		StorageId:        bundleProposal.StorageId,
+		Uploader:         bundleProposal.Uploader,
+		FromHeight:       pool.CurrentHeight,
+		ToHeight:         bundleProposal.ToHeight,
+		ToKey:            bundleProposal.ToKey,
+		BundleHash:       bundleProposal.BundleHash,
+		VotersValid:      bundleProposal.VotersValid,
+		VotersInvalid:    bundleProposal.VotersInvalid,
+		VotersAbstain:    bundleProposal.VotersAbstain,
+		VoteDistribution: voteDistribution,
+		Status:           voteDistribution.Status,
+		Slashes:          slashes,
+		CreatedAt:        bundleProposal.CreatedAt,
+		ConcludedAt:      uint64(ctx.BlockTime().Unix()),
+	})
+}
+
 // RandomChoiceCandidate ...
 type RandomChoiceCandidate struct {
 	Account string
 	Amount  uint64
 }
 
+// getUploaderSeed returns the seed for selecting the next uploader of a pool. It is derived
+// from the block header hash, the pool id and the id of the current bundle, so every pool
+// draws independently and the outcome is unknown before the block is proposed.
+func (k Keeper) getUploaderSeed(ctx sdk.Context, poolId uint64) []byte {
+	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
+	return util.GetByteKey([]byte(ctx.HeaderHash()), poolId, pool.TotalBundles)
+}
+
 // getWeightedRandomChoice is an internal function that returns a random selection out of a list of candidates.
-func (k Keeper) getWeightedRandomChoice(candidates []RandomChoiceCandidate, seed uint64) string {
+// The selection is fully determined by the given seed and does not depend on any global state.
+func (k Keeper) getWeightedRandomChoice(candidates []RandomChoiceCandidate, seed []byte) string {
 	type WeightedRandomChoice struct {
 		Elements    []string
 		Weights     []uint64
@@ -373,8 +517,16 @@ func (k Keeper) getWeightedRandomChoice(candidates []RandomChoiceCandidate, seed
 		wrc.TotalWeight += candidate.Amount
 	}
 
-	rand.Seed(int64(seed))
-	value := uint64(math.Floor(rand.Float64() * float64(wrc.TotalWeight)))
+	if wrc.TotalWeight == 0 {
+		return ""
+	}
+
+	// Reduce the 256 bit hash of the seed to a value in [0, TotalWeight).
+	hash := sha256.Sum256(seed)
+	value := new(big.Int).Mod(
+		new(big.Int).SetBytes(hash[:]),
+		new(big.Int).SetUint64(wrc.TotalWeight),
+	).Uint64()
 
 	for key, weight := range wrc.Weights {
 		if weight > value {
@@ -395,6 +547,11 @@ func (k Keeper) chooseNextUploaderFromSelectedStakers(ctx sdk.Context, poolId ui
 	}
 
 	for _, s := range addresses {
+		// jailed stakers are excluded from the uploader selection
+		if k.stakerKeeper.IsValaccountJailed(ctx, poolId, s) {
+			continue
+		}
+
 		delegation := k.delegationKeeper.GetDelegationAmount(ctx, s)
 
 		_candidates = append(_candidates, RandomChoiceCandidate{
@@ -403,7 +560,7 @@ func (k Keeper) chooseNextUploaderFromSelectedStakers(ctx sdk.Context, poolId ui
 		})
 	}
 
-	return k.getWeightedRandomChoice(_candidates, uint64(ctx.BlockHeight()))
+	return k.getWeightedRandomChoice(_candidates, k.getUploaderSeed(ctx, poolId))
 }
 
 func (k Keeper) chooseNextUploaderFromAllStakers(ctx sdk.Context, poolId uint64) (nextUploader string) {
@@ -418,29 +575,57 @@ func (k Keeper) GetVoteDistribution(ctx sdk.Context, poolId uint64) (voteDistrib
 		return
 	}
 
+	// Stakers can get jailed after they voted (e.g. the uploader who votes
+	// valid with its proposal), their votes are not counted anymore.
+
 	// get $KYVE voted for valid
 	for _, voter := range bundleProposal.VotersValid {
+		if k.stakerKeeper.IsValaccountJailed(ctx, poolId, voter) {
+			continue
+		}
+
 		delegation := k.delegationKeeper.GetDelegationAmount(ctx, voter)
 		voteDistribution.Valid += delegation
 	}
 
 	// get $KYVE voted for invalid
 	for _, voter := range bundleProposal.VotersInvalid {
+		if k.stakerKeeper.IsValaccountJailed(ctx, poolId, voter) {
+			continue
+		}
+
 		delegation := k.delegationKeeper.GetDelegationAmount(ctx, voter)
 		voteDistribution.Invalid += delegation
 	}
 
 	// get $KYVE voted for abstain
 	for _, voter := range bundleProposal.VotersAbstain {
+		if k.stakerKeeper.IsValaccountJailed(ctx, poolId, voter) {
+			continue
+		}
+
 		delegation := k.delegationKeeper.GetDelegationAmount(ctx, voter)
 		voteDistribution.Abstain += delegation
 	}
 
-	voteDistribution.Total = k.delegationKeeper.GetDelegationOfPool(ctx, poolId)
+	// jailed stakers can not vote, so they are not part of the total
+	for _, staker := range k.stakerKeeper.GetAllStakerAddressesOfPool(ctx, poolId) {
+		if !k.stakerKeeper.IsValaccountJailed(ctx, poolId, staker) {
+			voteDistribution.Total += k.delegationKeeper.GetDelegationAmount(ctx, staker)
+		}
+	}
+
+	// evaluate the votes against the quorums of the pool
+	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
+	validQuorum, invalidQuorum := pool.GetQuorums()
+
+	total := sdk.NewDecFromInt(sdk.NewIntFromUint64(voteDistribution.Total))
+	valid := sdk.NewDecFromInt(sdk.NewIntFromUint64(voteDistribution.Valid))
+	invalid := sdk.NewDecFromInt(sdk.NewIntFromUint64(voteDistribution.Invalid))
 
-	if voteDistribution.Valid*2 > voteDistribution.Total {
+	if valid.GT(total.Mul(validQuorum)) {
 		voteDistribution.Status = types.BUNDLE_STATUS_VALID
-	} else if voteDistribution.Invalid*2 >= voteDistribution.Total {
+	} else if invalid.GTE(total.Mul(invalidQuorum)) {
 		voteDistribution.Status = types.BUNDLE_STATUS_INVALID
 	} else {
 		voteDistribution.Status = types.BUNDLE_STATUS_NO_QUORUM
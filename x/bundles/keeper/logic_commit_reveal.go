@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	poolmoduletypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// getRevealInterval returns the time a bundle proposal stays open after the
+// upload interval so that committed votes can be revealed. This only applies
+// to pools with commit-reveal voting and to proposals which contain data.
+func (k Keeper) getRevealInterval(ctx sdk.Context, pool poolmoduletypes.Pool, bundleProposal types.BundleProposal) uint64 {
+	if pool.CommitRevealVoting && bundleProposal.StorageId != "" {
+		return k.RevealInterval(ctx)
+	}
+
+	return 0
+}
+
+// getVoteCommitIndex returns the index of the vote commit of the given staker
+// or -1 if the staker has not committed a vote on the bundle proposal.
+func getVoteCommitIndex(bundleProposal types.BundleProposal, staker string) int {
+	for i, commit := range bundleProposal.VoteCommits {
+		if commit.Staker == staker {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// assertCommitRevealProposal checks the common requirements for committing and
+// revealing a vote on the current bundle proposal of a commit-reveal pool.
+func (k Keeper) assertCommitRevealProposal(ctx sdk.Context, poolId uint64, staker string, voter string, storageId string) error {
+	// Check basic pool configs
+	if err := k.AssertPoolCanRun(ctx, poolId); err != nil {
+		return err
+	}
+
+	// Check if sender is a staker in pool
+	if err := k.stakerKeeper.AssertValaccountAuthorized(ctx, poolId, staker, voter); err != nil {
+		return err
+	}
+
+	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
+	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
+
+	if !pool.CommitRevealVoting {
+		return types.ErrCommitRevealDisabled
+	}
+
+	// Check if dropped bundle
+	if bundleProposal.StorageId == "" {
+		return types.ErrBundleDropped
+	}
+
+	// Check if tx matches current bundleProposal
+	if storageId != bundleProposal.StorageId {
+		return types.ErrInvalidStorageId
+	}
+
+	return nil
+}
+
+// AssertCanCommitVote checks whether the given staker can commit a vote
+// on the current bundle proposal of a commit-reveal pool.
+func (k Keeper) AssertCanCommitVote(ctx sdk.Context, poolId uint64, staker string, voter string, storageId string) error {
+	if err := k.assertCommitRevealProposal(ctx, poolId, staker, voter, storageId); err != nil {
+		return err
+	}
+
+	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
+	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
+
+	// Votes can only be committed until the upload interval is over
+	if uint64(ctx.BlockTime().Unix()) >= bundleProposal.CreatedAt+pool.UploadInterval {
+		return sdkErrors.Wrapf(types.ErrCommitPhaseOver, "expected %v < %v", ctx.BlockTime().Unix(), bundleProposal.CreatedAt+pool.UploadInterval)
+	}
+
+	// Check if the sender has already voted on the bundle.
+	if util.ContainsString(bundleProposal.VotersValid, staker) {
+		return types.ErrAlreadyVotedValid
+	}
+
+	if util.ContainsString(bundleProposal.VotersInvalid, staker) {
+		return types.ErrAlreadyVotedInvalid
+	}
+
+	if util.ContainsString(bundleProposal.VotersAbstain, staker) {
+		return types.ErrAlreadyVotedAbstain
+	}
+
+	if getVoteCommitIndex(bundleProposal, staker) >= 0 {
+		return types.ErrAlreadyCommitted
+	}
+
+	return nil
+}
+
+// AssertCanRevealVote checks whether the given staker can reveal the vote
+// committed to on the current bundle proposal of a commit-reveal pool.
+func (k Keeper) AssertCanRevealVote(ctx sdk.Context, poolId uint64, staker string, voter string, storageId string) error {
+	if err := k.assertCommitRevealProposal(ctx, poolId, staker, voter, storageId); err != nil {
+		return err
+	}
+
+	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
+	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
+
+	// Votes can only be revealed after the upload interval and before the reveal interval is over
+	revealStart := bundleProposal.CreatedAt + pool.UploadInterval
+	revealEnd := revealStart + k.getRevealInterval(ctx, pool, bundleProposal)
+
+	if now := uint64(ctx.BlockTime().Unix()); now < revealStart || now >= revealEnd {
+		return sdkErrors.Wrapf(types.ErrNotInRevealPhase, "expected %v <= %v < %v", revealStart, now, revealEnd)
+	}
+
+	if getVoteCommitIndex(bundleProposal, staker) < 0 {
+		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrVoteCommitNotFound.Error(), staker)
+	}
+
+	return nil
+}
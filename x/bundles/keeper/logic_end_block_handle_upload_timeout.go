@@ -2,77 +2,133 @@ package keeper
 
 import (
 	"context"
+	"sort"
+
 	"github.com/KYVENetwork/chain/x/bundles/types"
+	poolmoduletypes "github.com/KYVENetwork/chain/x/pool/types"
 	stakersmoduletypes "github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // HandleUploadTimeout is an end block hook that triggers an upload timeout for every pool (if applicable).
+// Only pools whose upload deadline has expired are looked at, all other pools
+// would remain unchanged anyway.
 func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Iterate over all pool Ids.
-	for _, pool := range k.poolKeeper.GetAllPools(ctx) {
-		err := k.AssertPoolCanRun(ctx, pool.Id)
-		bundleProposal, _ := k.GetBundleProposal(ctx, pool.Id)
-
-		// Remove next uploader if pool is not active
-		if err != nil {
-			bundleProposal.NextUploader = ""
-			k.SetBundleProposal(ctx, bundleProposal)
+	// Pools are handled in the order of their ids. Handling a pool can expire
+	// the deadline of other pools (e.g. by slashing a staker), pools which
+	// were not expired yet are handled in the next end block.
+	poolIds := k.poolKeeper.GetExpiredUploadDeadlines(ctx, uint64(ctx.BlockTime().Unix()))
+	sort.Slice(poolIds, func(i, j int) bool {
+		return poolIds[i] < poolIds[j]
+	})
+
+	for _, poolId := range poolIds {
+		pool, poolFound := k.poolKeeper.GetPool(ctx, poolId)
+		if !poolFound {
+			k.poolKeeper.RemoveUploadDeadline(ctx, poolId)
 			continue
 		}
 
-		// Skip if we haven't reached the upload interval.
-		if uint64(ctx.BlockTime().Unix()) < (bundleProposal.CreatedAt + pool.UploadInterval) {
-			continue
-		}
+		k.handleUploadTimeoutOfPool(ctx, pool)
+		k.updateUploadDeadline(ctx, poolId)
+	}
+}
 
-		// Check if bundle needs to be dropped
-		if bundleProposal.StorageId != "" {
-			// check if the quorum was actually reached
-			voteDistribution := k.GetVoteDistribution(ctx, pool.Id)
+// updateUploadDeadline sets the time at which the pool has to be handled
+// by the upload timeout end blocker again.
+func (k Keeper) updateUploadDeadline(ctx sdk.Context, poolId uint64) {
+	// Inactive pools are not handled until something changes.
+	if err := k.AssertPoolCanRun(ctx, poolId); err != nil {
+		k.poolKeeper.RemoveUploadDeadline(ctx, poolId)
+		return
+	}
 
-			if voteDistribution.Status == types.BUNDLE_STATUS_NO_QUORUM {
-				// handle stakers who did not vote at all
-				k.handleNonVoters(ctx, pool.Id)
+	pool, _ := k.poolKeeper.GetPool(ctx, poolId)
+	bundleProposal, _ := k.GetBundleProposal(ctx, poolId)
 
-				// Get next uploader
-				voters := append(bundleProposal.VotersValid, bundleProposal.VotersInvalid...)
-				nextUploader := ""
+	now := uint64(ctx.BlockTime().Unix())
+	deadline := bundleProposal.CreatedAt + pool.UploadInterval
 
-				if len(voters) > 0 {
-					nextUploader = k.chooseNextUploaderFromSelectedStakers(ctx, pool.Id, voters)
-				} else {
-					nextUploader = k.chooseNextUploaderFromAllStakers(ctx, pool.Id)
-				}
+	// Once the upload interval is over the vote distribution and the upload
+	// timeout have to be checked in every block.
+	if deadline < now {
+		deadline = now
+	}
 
-				// If consensus wasn't reached, we drop the bundle and emit an event.
-				k.dropCurrentBundleProposal(ctx, pool, bundleProposal, voteDistribution, nextUploader)
-				continue
-			}
-		}
+	// The pool stops running once its upgrade starts.
+	if pool.UpgradePlan.ScheduledAt > 0 && pool.UpgradePlan.ScheduledAt < deadline {
+		deadline = pool.UpgradePlan.ScheduledAt
+	}
 
-		// Skip if we haven't reached the upload timeout.
-		if uint64(ctx.BlockTime().Unix()) < (bundleProposal.CreatedAt + pool.UploadInterval + k.UploadTimeout(ctx)) {
-			continue
+	k.poolKeeper.SetUploadDeadline(ctx, poolId, deadline)
+}
+
+// handleUploadTimeoutOfPool triggers an upload timeout for the given pool (if applicable).
+func (k Keeper) handleUploadTimeoutOfPool(ctx sdk.Context, pool poolmoduletypes.Pool) {
+	err := k.AssertPoolCanRun(ctx, pool.Id)
+	bundleProposal, found := k.GetBundleProposal(ctx, pool.Id)
+
+	// Remove next uploader if pool is not active
+	if err != nil {
+		if !found || bundleProposal.NextUploader != "" {
+			bundleProposal.NextUploader = ""
+			k.SetBundleProposal(ctx, bundleProposal)
 		}
+		return
+	}
 
-		// We now know that the pool is active and the upload timeout has been reached.
+	// Skip if we haven't reached the upload interval (and the reveal interval of commit-reveal pools).
+	votingEnd := bundleProposal.CreatedAt + pool.UploadInterval + k.getRevealInterval(ctx, pool, bundleProposal)
+	if uint64(ctx.BlockTime().Unix()) < votingEnd {
+		return
+	}
+
+	// Check if bundle needs to be dropped
+	if bundleProposal.StorageId != "" {
+		// check if the quorum was actually reached
+		voteDistribution := k.GetVoteDistribution(ctx, pool.Id)
 
-		// Now we slash and remove the current next_uploader
-		// (if he is still participating in the pool) and select a new one.
-		_, foundNextUploader := k.stakerKeeper.GetValaccount(ctx, pool.Id, bundleProposal.NextUploader)
+		if voteDistribution.Status == types.BUNDLE_STATUS_NO_QUORUM {
+			// handle stakers who did not vote at all
+			slashes := k.handleNonVoters(ctx, pool.Id)
 
-		if foundNextUploader {
-			k.delegationKeeper.SlashDelegators(ctx, bundleProposal.NextUploader, stakersmoduletypes.SLASH_TYPE_TIMEOUT)
-			k.stakerKeeper.RemoveValaccountFromPool(ctx, pool.Id, bundleProposal.NextUploader)
+			// Get next uploader
+			voters := append(bundleProposal.VotersValid, bundleProposal.VotersInvalid...)
+			nextUploader := ""
+
+			if len(voters) > 0 {
+				nextUploader = k.chooseNextUploaderFromSelectedStakers(ctx, pool.Id, voters)
+			} else {
+				nextUploader = k.chooseNextUploaderFromAllStakers(ctx, pool.Id)
+			}
+
+			// If consensus wasn't reached, we drop the bundle and emit an event.
+			k.dropCurrentBundleProposal(ctx, pool, bundleProposal, voteDistribution, nextUploader, slashes)
+			return
 		}
+	}
+
+	// Skip if we haven't reached the upload timeout.
+	if uint64(ctx.BlockTime().Unix()) < (votingEnd + k.PoolUploadTimeout(ctx, pool)) {
+		return
+	}
 
-		// Update bundle proposal
-		bundleProposal.NextUploader = k.chooseNextUploaderFromAllStakers(ctx, pool.Id)
-		bundleProposal.CreatedAt = uint64(ctx.BlockTime().Unix())
+	// We now know that the pool is active and the upload timeout has been reached.
 
-		k.SetBundleProposal(ctx, bundleProposal)
+	// Now we slash and jail the current next_uploader
+	// (if he is still participating in the pool) and select a new one.
+	_, foundNextUploader := k.stakerKeeper.GetValaccount(ctx, pool.Id, bundleProposal.NextUploader)
+
+	if foundNextUploader {
+		k.delegationKeeper.SlashDelegators(ctx, pool.Id, bundleProposal.NextUploader, stakersmoduletypes.SLASH_TYPE_TIMEOUT, 0, false)
+		k.stakerKeeper.JailValaccount(ctx, pool.Id, bundleProposal.NextUploader)
 	}
+
+	// Update bundle proposal
+	bundleProposal.NextUploader = k.chooseNextUploaderFromAllStakers(ctx, pool.Id)
+	bundleProposal.CreatedAt = uint64(ctx.BlockTime().Unix())
+
+	k.SetBundleProposal(ctx, bundleProposal)
 }
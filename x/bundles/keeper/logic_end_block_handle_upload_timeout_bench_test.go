@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/gomega"
+)
+
+// BenchmarkHandleUploadTimeout compares the upload timeout end blocker which
+// uses the upload deadline index with handling every pool in every block.
+// All pools are active and inside their upload interval.
+func BenchmarkHandleUploadTimeout(b *testing.B) {
+	RegisterTestingT(b)
+
+	s := i.NewCleanChain()
+
+	s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+		Creator: i.STAKER_0,
+		Amount:  100 * i.KYVE,
+	})
+
+	for id := uint64(0); id < 500; id++ {
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:           "Moontest",
+			MaxBundleSize:  100,
+			StartKey:       "0",
+			MinStake:       100 * i.KYVE,
+			UploadInterval: 60,
+			OperatingCost:  10_000,
+			Funders: []*pooltypes.Funder{
+				{Address: i.ALICE, Amount: i.KYVECoins(100 * i.KYVE)},
+			},
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		s.App().StakersKeeper.AddValaccountToPool(s.Ctx(), id, i.STAKER_0, i.VALADDRESS_0)
+	}
+
+	// Choose the next uploader of every pool
+	s.App().BundlesKeeper.HandleUploadTimeout(sdk.WrapSDKContext(s.Ctx()))
+
+	b.Run("all pools", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			ctx, _ := s.Ctx().CacheContext()
+			s.App().BundlesKeeper.HandleUploadTimeoutOfAllPools(ctx)
+		}
+	})
+
+	b.Run("deadline index", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			ctx, _ := s.Ctx().CacheContext()
+			s.App().BundlesKeeper.HandleUploadTimeout(sdk.WrapSDKContext(ctx))
+		}
+	})
+}
@@ -29,7 +29,11 @@ TEST CASES - logic_end_block_handle_upload_timeout.go
 * A bundle proposal with no quorum does not reach the upload interval
 * A bundle proposal with no quorum does reach the upload interval
 * Staker who just left the pool is next uploader of bundle proposal and upload timeout passes
-* TODO: test with multiple pools
+* Upload timeout of the pool overrides the module param
+* Max points of the pool override the module param
+* Upload deadline of a pool is the end of its upload interval
+* Pool which can not run has no upload deadline until it changes
+* Multiple pools are handled like without the upload deadline index
 
 */
 
@@ -40,6 +44,11 @@ var _ = Describe("logic_end_block_handle_upload_timeout.go", Ordered, func() {
 		// init new clean chain
 		s = i.NewCleanChain()
 
+		// remove stakers right away instead of jailing them
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaxJailings = 0
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
 		// create clean pool for every test case
 		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
 			Name:           "Moontest",
@@ -59,7 +68,7 @@ var _ = Describe("logic_end_block_handle_upload_timeout.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
@@ -171,7 +180,7 @@ var _ = Describe("logic_end_block_handle_upload_timeout.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgDefundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		// ACT
@@ -612,4 +621,249 @@ var _ = Describe("logic_end_block_handle_upload_timeout.go", Ordered, func() {
 
 		Expect(expectedBalance).To(Equal(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.STAKER_0)))
 	})
+
+	It("Upload timeout of the pool overrides the module param", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.UploadTimeout = 30
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(29)
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).To(Equal(i.STAKER_0))
+
+		// ACT
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		bundleProposal, _ = s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).To(BeEmpty())
+
+		// check if next uploader got removed from pool
+		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)
+		Expect(poolStakers).To(BeEmpty())
+
+		Expect(s.App().DelegationKeeper.GetDelegationOfPool(s.Ctx(), 0)).To(BeZero())
+	})
+
+	It("Max points of the pool override the module param", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.MaxPoints = 1
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:    i.VALADDRESS_0,
+			Staker:     i.STAKER_0,
+			PoolId:     0,
+			StorageId:  "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			ByteSize:   100,
+			FromHeight: 0,
+			ToHeight:   100,
+			FromKey:    "0",
+			ToKey:      "99",
+			ToValue:    "test_value",
+			BundleHash: "test_hash",
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.StorageId).To(BeEmpty())
+		Expect(bundleProposal.NextUploader).To(Equal(i.STAKER_0))
+
+		// the non voter reached the max points of the pool after one missed vote
+		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)
+		Expect(poolStakers).To(ConsistOf(i.STAKER_0))
+
+		// check if non voter got slashed
+		slashAmountRatio, _ := sdk.NewDecFromStr(s.App().StakersKeeper.TimeoutSlash(s.Ctx()))
+		expectedBalance := 100*i.KYVE - uint64(sdk.NewDec(int64(100*i.KYVE)).Mul(slashAmountRatio).RoundInt64())
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_1, i.STAKER_1)).To(Equal(expectedBalance))
+	})
+
+	It("Upload deadline of a pool is the end of its upload interval", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+
+		deadline, found := s.App().PoolKeeper.GetUploadDeadline(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+		Expect(deadline).To(Equal(bundleProposal.CreatedAt + 60))
+
+		Expect(s.App().PoolKeeper.GetExpiredUploadDeadlines(s.Ctx(), uint64(s.Ctx().BlockTime().Unix()))).To(BeEmpty())
+	})
+
+	It("Pool which can not run has no upload deadline until it changes", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.Paused = true
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		_, found := s.App().PoolKeeper.GetUploadDeadline(s.Ctx(), 0)
+		Expect(found).To(BeFalse())
+
+		// ACT
+		pool.Paused = false
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		// ASSERT
+		deadline, found := s.App().PoolKeeper.GetUploadDeadline(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+		Expect(deadline).To(BeZero())
+
+		s.CommitAfterSeconds(1)
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.NextUploader).To(BeEmpty())
+
+		deadline, found = s.App().PoolKeeper.GetUploadDeadline(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+		Expect(deadline).To(Equal(bundleProposal.CreatedAt + 60))
+	})
+
+	It("Multiple pools are handled like without the upload deadline index", func() {
+		// ARRANGE
+		for id := uint64(1); id <= 2; id++ {
+			s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+				Name:           "Moontest",
+				MaxBundleSize:  100,
+				StartKey:       "0",
+				MinStake:       100 * i.KYVE,
+				UploadInterval: 3600,
+				OperatingCost:  10_000,
+				Protocol: &pooltypes.Protocol{
+					Version:     "0.0.0",
+					Binaries:    "{}",
+					LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+				},
+				UpgradePlan: &pooltypes.UpgradePlan{},
+			})
+
+			s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+				Creator: i.ALICE,
+				Id:      id,
+				Amount:  i.KYVECoins(100 * i.KYVE),
+			})
+		}
+
+		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		// STAKER_0 is the only staker of pool 1, a timeout slash in pool 0
+		// lets pool 1 fall below its min stake during its upload interval.
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     1,
+			Valaddress: i.VALADDRESS_2,
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     2,
+			Valaddress: i.VALADDRESS_1,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgClaimUploaderRole{
+			Creator: i.VALADDRESS_0,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		expectSameResultAsAllPools := func() {
+			indexCtx, _ := s.Ctx().CacheContext()
+			allPoolsCtx, _ := s.Ctx().CacheContext()
+
+			// Pools whose deadline expires while other pools are handled
+			// are only looked at by the following end block.
+			s.App().BundlesKeeper.HandleUploadTimeout(sdk.WrapSDKContext(indexCtx))
+			s.App().BundlesKeeper.HandleUploadTimeout(sdk.WrapSDKContext(indexCtx))
+			s.App().BundlesKeeper.HandleUploadTimeoutOfAllPools(allPoolsCtx)
+
+			Expect(s.App().BundlesKeeper.GetAllBundleProposals(indexCtx)).To(Equal(s.App().BundlesKeeper.GetAllBundleProposals(allPoolsCtx)))
+
+			for id := uint64(0); id <= 2; id++ {
+				Expect(s.App().StakersKeeper.GetAllValaccountsOfPool(indexCtx, id)).To(Equal(s.App().StakersKeeper.GetAllValaccountsOfPool(allPoolsCtx, id)))
+			}
+
+			for _, staker := range []string{i.STAKER_0, i.STAKER_1} {
+				Expect(s.App().DelegationKeeper.GetDelegationAmount(indexCtx, staker)).To(Equal(s.App().DelegationKeeper.GetDelegationAmount(allPoolsCtx, staker)))
+			}
+		}
+
+		// ACT
+		for _, seconds := range []uint64{1, 59, s.App().BundlesKeeper.UploadTimeout(s.Ctx()), 1, 1, 60} {
+			expectSameResultAsAllPools()
+			s.CommitAfterSeconds(seconds)
+		}
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 2)
+		pool.Paused = true
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		expectSameResultAsAllPools()
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		expectSameResultAsAllPools()
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 1)
+		Expect(bundleProposal.NextUploader).To(BeEmpty())
+
+		bundleProposal, _ = s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 2)
+		Expect(bundleProposal.NextUploader).To(BeEmpty())
+	})
 })
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommitBundleVote handles the logic of an SDK message that allows protocol nodes to commit
+// to a hidden vote on a bundle proposal of a pool with commit-reveal voting.
+func (k msgServer) CommitBundleVote(
+	goCtx context.Context, msg *types.MsgCommitBundleVote,
+) (*types.MsgCommitBundleVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.AssertCanCommitVote(ctx, msg.PoolId, msg.Staker, msg.Creator, msg.StorageId); err != nil {
+		return nil, err
+	}
+
+	bundleProposal, _ := k.GetBundleProposal(ctx, msg.PoolId)
+
+	// The vote only counts once it is revealed, until then the
+	// staker is treated like any other non-voter.
+	bundleProposal.VoteCommits = append(bundleProposal.VoteCommits, types.VoteCommit{
+		Staker:     msg.Staker,
+		CommitHash: msg.CommitHash,
+	})
+
+	k.SetBundleProposal(ctx, bundleProposal)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBundleVoteCommitted{
+		PoolId:     msg.PoolId,
+		Staker:     msg.Staker,
+		StorageId:  msg.StorageId,
+		CommitHash: msg.CommitHash,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCommitBundleVoteResponse{}, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"github.com/KYVENetwork/chain/x/bundles/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RevealBundleVote handles the logic of an SDK message that allows protocol nodes to reveal
+// a previously committed vote on a bundle proposal of a pool with commit-reveal voting.
+func (k msgServer) RevealBundleVote(
+	goCtx context.Context, msg *types.MsgRevealBundleVote,
+) (*types.MsgRevealBundleVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.AssertCanRevealVote(ctx, msg.PoolId, msg.Staker, msg.Creator, msg.StorageId); err != nil {
+		return nil, err
+	}
+
+	bundleProposal, _ := k.GetBundleProposal(ctx, msg.PoolId)
+	commitIndex := getVoteCommitIndex(bundleProposal, msg.Staker)
+
+	// Check if the revealed vote matches the commit
+	if bundleProposal.VoteCommits[commitIndex].CommitHash != types.GetVoteCommitHash(msg.Staker, msg.Vote, msg.Salt, msg.StorageId) {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrInvalidVoteReveal.Error())
+	}
+
+	// Count the revealed vote
+	if msg.Vote == types.VOTE_TYPE_YES {
+		bundleProposal.VotersValid = append(bundleProposal.VotersValid, msg.Staker)
+	} else if msg.Vote == types.VOTE_TYPE_NO {
+		bundleProposal.VotersInvalid = append(bundleProposal.VotersInvalid, msg.Staker)
+	} else if msg.Vote == types.VOTE_TYPE_ABSTAIN {
+		bundleProposal.VotersAbstain = append(bundleProposal.VotersAbstain, msg.Staker)
+	} else {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrInvalidVote.Error(), msg.Vote)
+	}
+
+	bundleProposal.VoteCommits = append(bundleProposal.VoteCommits[:commitIndex], bundleProposal.VoteCommits[commitIndex+1:]...)
+
+	k.SetBundleProposal(ctx, bundleProposal)
+
+	// reset points as user has now proven to be active
+	k.stakerKeeper.ResetPoints(ctx, msg.PoolId, msg.Staker)
+
+	// Emit a vote event.
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBundleVote{
+		PoolId:    msg.PoolId,
+		Staker:    msg.Staker,
+		StorageId: msg.StorageId,
+		Vote:      msg.Vote,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealBundleVoteResponse{}, nil
+}
@@ -44,7 +44,7 @@ func (k msgServer) SubmitBundleProposal(
 	// Previous round contains a bundle which needs to be validated now.
 
 	// increase points of stakers who did not vote at all + slash + remove if necessary
-	k.handleNonVoters(ctx, msg.PoolId)
+	slashes := k.handleNonVoters(ctx, msg.PoolId)
 
 	// Get next uploader from stakers voted
 	voters := append(bundleProposal.VotersValid, bundleProposal.VotersInvalid...)
@@ -61,10 +61,11 @@ func (k msgServer) SubmitBundleProposal(
 
 	// handle valid proposal
 	if voteDistribution.Status == types.BUNDLE_STATUS_VALID {
-		// Calculate the total reward for the bundle, and individual payouts.
-		bundleReward := k.calculatePayouts(ctx, msg.PoolId)
+		// Calculate the total cost of the bundle and charge it from the funders.
+		bundleCost := k.calculateBundleCost(ctx, msg.PoolId)
 
-		if err := k.poolKeeper.ChargeFundersOfPool(ctx, msg.PoolId, bundleReward.Total); err != nil {
+		payout, err := k.poolKeeper.ChargeFundersOfPool(ctx, msg.PoolId, bundleCost)
+		if err != nil {
 			// drop bundle because pool ran out of funds
 			bundleProposal.CreatedAt = uint64(ctx.BlockTime().Unix())
 			k.SetBundleProposal(ctx, bundleProposal)
@@ -79,6 +80,9 @@ func (k msgServer) SubmitBundleProposal(
 			return &types.MsgSubmitBundleProposalResponse{}, nil
 		}
 
+		// Split the charged coins into the individual payouts.
+		bundleReward := k.calculatePayouts(ctx, msg.PoolId, payout)
+
 		pool, _ := k.poolKeeper.GetPool(ctx, msg.PoolId)
 		bundleProposal, _ := k.GetBundleProposal(ctx, msg.PoolId)
 
@@ -87,25 +91,40 @@ func (k msgServer) SubmitBundleProposal(
 		delegationPayoutSuccessful := k.delegationKeeper.PayoutRewards(ctx, bundleProposal.Uploader, bundleReward.Delegation, pooltypes.ModuleName)
 		// If staker has no delegators add all delegation rewards to the staker rewards
 		if !delegationPayoutSuccessful {
-			uploaderPayout += bundleReward.Delegation
+			uploaderPayout = uploaderPayout.Add(bundleReward.Delegation...)
 		}
 
 		// send commission to uploader
-		if err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, pooltypes.ModuleName, bundleProposal.Uploader, uploaderPayout); err != nil {
+		if err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, pooltypes.ModuleName, bundleProposal.Uploader, uploaderPayout); err != nil {
 			return nil, err
 		}
 
+		// send rewards to stakers who voted valid
+		for _, voterReward := range bundleReward.Voters {
+			voterPayout := voterReward.RewardStaker
+
+			// If staker has no delegators add all delegation rewards to the staker rewards
+			if !k.delegationKeeper.PayoutRewards(ctx, voterReward.Staker, voterReward.RewardDelegation, pooltypes.ModuleName) {
+				voterPayout = voterPayout.Add(voterReward.RewardDelegation...)
+			}
+
+			if err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, pooltypes.ModuleName, voterReward.Staker, voterPayout); err != nil {
+				return nil, err
+			}
+		}
+
 		// send network fee to treasury
-		if err := util.TransferFromModuleToTreasury(k.accountKeeper, k.distrkeeper, ctx, pooltypes.ModuleName, bundleReward.Treasury); err != nil {
+		if err := util.TransferCoinsFromModuleToTreasury(k.accountKeeper, k.distrkeeper, ctx, pooltypes.ModuleName, bundleReward.Treasury); err != nil {
 			return nil, err
 		}
 
-		// slash stakers who voted incorrectly
+		// slash stakers who voted incorrectly, the slash is recorded for the finalized bundle
 		for _, voter := range bundleProposal.VotersInvalid {
-			k.delegationKeeper.SlashDelegators(ctx, voter, stakertypes.SLASH_TYPE_VOTE)
+			amount := k.delegationKeeper.SlashDelegators(ctx, msg.PoolId, voter, stakertypes.SLASH_TYPE_VOTE, pool.TotalBundles, true)
+			slashes = append(slashes, types.ProposalSlash{Staker: voter, SlashType: stakertypes.SLASH_TYPE_VOTE, Amount: amount})
 		}
 
-		if err := k.finalizeCurrentBundleProposal(ctx, pool, bundleProposal, voteDistribution, bundleReward); err != nil {
+		if err := k.finalizeCurrentBundleProposal(ctx, pool, bundleProposal, voteDistribution, bundleReward, slashes); err != nil {
 			return nil, err
 		}
 
@@ -119,15 +138,17 @@ func (k msgServer) SubmitBundleProposal(
 		// slash stakers who voted incorrectly - uploader receives upload slash
 		for _, voter := range bundleProposal.VotersValid {
 			if voter == bundleProposal.Uploader {
-				k.delegationKeeper.SlashDelegators(ctx, voter, stakertypes.SLASH_TYPE_UPLOAD)
+				amount := k.delegationKeeper.SlashDelegators(ctx, msg.PoolId, voter, stakertypes.SLASH_TYPE_UPLOAD, 0, false)
+				slashes = append(slashes, types.ProposalSlash{Staker: voter, SlashType: stakertypes.SLASH_TYPE_UPLOAD, Amount: amount})
 			} else {
-				k.delegationKeeper.SlashDelegators(ctx, voter, stakertypes.SLASH_TYPE_VOTE)
+				amount := k.delegationKeeper.SlashDelegators(ctx, msg.PoolId, voter, stakertypes.SLASH_TYPE_VOTE, 0, false)
+				slashes = append(slashes, types.ProposalSlash{Staker: voter, SlashType: stakertypes.SLASH_TYPE_VOTE, Amount: amount})
 			}
 		}
 
 		// Drop current bundle. Can't register the provided bundle because the previous bundles
 		// needs to be resubmitted first.
-		if err := k.dropCurrentBundleProposal(ctx, pool, bundleProposal, voteDistribution, bundleProposal.NextUploader); err != nil {
+		if err := k.dropCurrentBundleProposal(ctx, pool, bundleProposal, voteDistribution, bundleProposal.NextUploader, slashes); err != nil {
 			return nil, err
 		}
 
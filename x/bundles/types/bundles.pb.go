@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/KYVENetwork/chain/x/stakers/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -91,6 +94,9 @@ type BundleProposal struct {
 	VotersInvalid []string `protobuf:"bytes,12,rep,name=voters_invalid,json=votersInvalid,proto3" json:"voters_invalid,omitempty"`
 	// voters_abstain ...
 	VotersAbstain []string `protobuf:"bytes,13,rep,name=voters_abstain,json=votersAbstain,proto3" json:"voters_abstain,omitempty"`
+	// vote_commits are the not yet revealed votes of
+	// pools with commit-reveal voting enabled
+	VoteCommits []VoteCommit `protobuf:"bytes,14,rep,name=vote_commits,json=voteCommits,proto3" json:"vote_commits"`
 }
 
 func (m *BundleProposal) Reset()         { *m = BundleProposal{} }
@@ -217,6 +223,68 @@ func (m *BundleProposal) GetVotersAbstain() []string {
 	return nil
 }
 
+func (m *BundleProposal) GetVoteCommits() []VoteCommit {
+	if m != nil {
+		return m.VoteCommits
+	}
+	return nil
+}
+
+// VoteCommit is the hashed vote a staker commits to before revealing it
+type VoteCommit struct {
+	// staker ...
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// commit_hash is the hex encoded sha256 hash of staker, vote, salt and storage_id
+	CommitHash string `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+}
+
+func (m *VoteCommit) Reset()         { *m = VoteCommit{} }
+func (m *VoteCommit) String() string { return proto.CompactTextString(m) }
+func (*VoteCommit) ProtoMessage()    {}
+func (*VoteCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_889cf76d77a4de2b, []int{1}
+}
+func (m *VoteCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteCommit.Merge(m, src)
+}
+func (m *VoteCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteCommit proto.InternalMessageInfo
+
+func (m *VoteCommit) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *VoteCommit) GetCommitHash() string {
+	if m != nil {
+		return m.CommitHash
+	}
+	return ""
+}
+
 // Proposal ...
 type FinalizedBundle struct {
 	// pool_id ...
@@ -239,13 +307,16 @@ type FinalizedBundle struct {
 	BundleHash string `protobuf:"bytes,9,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundle_hash,omitempty"`
 	// finalized_at ...
 	FinalizedAt uint64 `protobuf:"varint,10,opt,name=finalized_at,json=finalizedAt,proto3" json:"finalized_at,omitempty"`
+	// byte_size is the size of the bundle in bytes,
+	// it is zero for bundles finalized before it was recorded
+	ByteSize uint64 `protobuf:"varint,11,opt,name=byte_size,json=byteSize,proto3" json:"byte_size,omitempty"`
 }
 
 func (m *FinalizedBundle) Reset()         { *m = FinalizedBundle{} }
 func (m *FinalizedBundle) String() string { return proto.CompactTextString(m) }
 func (*FinalizedBundle) ProtoMessage()    {}
 func (*FinalizedBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_889cf76d77a4de2b, []int{1}
+	return fileDescriptor_889cf76d77a4de2b, []int{2}
 }
 func (m *FinalizedBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,212 +415,574 @@ func (m *FinalizedBundle) GetFinalizedAt() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("kyve.bundles.v1beta1.BundleStatus", BundleStatus_name, BundleStatus_value)
-	proto.RegisterType((*BundleProposal)(nil), "kyve.bundles.v1beta1.BundleProposal")
-	proto.RegisterType((*FinalizedBundle)(nil), "kyve.bundles.v1beta1.FinalizedBundle")
+func (m *FinalizedBundle) GetByteSize() uint64 {
+	if m != nil {
+		return m.ByteSize
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("kyve/bundles/v1beta1/bundles.proto", fileDescriptor_889cf76d77a4de2b)
+// VoteDistribution ...
+type VoteDistribution struct {
+	// valid ...
+	Valid uint64 `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// invalid ...
+	Invalid uint64 `protobuf:"varint,2,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	// abstain ...
+	Abstain uint64 `protobuf:"varint,3,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	// total ...
+	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// status ...
+	Status BundleStatus `protobuf:"varint,5,opt,name=status,proto3,enum=kyve.bundles.v1beta1.BundleStatus" json:"status,omitempty"`
 }
 
-var fileDescriptor_889cf76d77a4de2b = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xdf, 0x4e, 0xdb, 0x3c,
-	0x18, 0xc6, 0x9b, 0xb6, 0x94, 0xf6, 0x6d, 0xe1, 0xab, 0xfc, 0xc1, 0x08, 0x45, 0x84, 0x3f, 0xd3,
-	0x24, 0x34, 0x4d, 0x44, 0x68, 0x57, 0x50, 0xd6, 0x56, 0x44, 0xb0, 0xc0, 0x1a, 0x52, 0x69, 0x3b,
-	0x89, 0x1c, 0x62, 0x1a, 0x8b, 0x10, 0x57, 0x89, 0xdb, 0x51, 0xae, 0x60, 0x87, 0xbb, 0x83, 0x4d,
-	0x9a, 0xb4, 0x6b, 0xd9, 0x21, 0x87, 0x3b, 0x9c, 0xe0, 0x46, 0x26, 0xdb, 0x69, 0xa1, 0x4c, 0x63,
-	0x67, 0x79, 0x7f, 0xcf, 0x4f, 0xb1, 0xfc, 0x3e, 0x32, 0x6c, 0x5f, 0x8c, 0x47, 0xc4, 0xf4, 0x87,
-	0x71, 0x10, 0x91, 0xd4, 0x1c, 0xed, 0xf9, 0x84, 0xe3, 0xbd, 0xc9, 0xbc, 0x3b, 0x48, 0x18, 0x67,
-	0x68, 0x49, 0x38, 0xbb, 0x13, 0x96, 0x39, 0x8d, 0xa5, 0x3e, 0xeb, 0x33, 0x29, 0x98, 0xe2, 0x4b,
-	0xb9, 0xdb, 0xdf, 0x0b, 0xb0, 0xb8, 0x2f, 0xcd, 0x93, 0x84, 0x0d, 0x58, 0x8a, 0x23, 0xb4, 0x02,
-	0xf3, 0x03, 0xc6, 0x22, 0x8f, 0x06, 0xba, 0xb6, 0xa9, 0xed, 0x14, 0xbb, 0x25, 0x31, 0x5a, 0x01,
-	0x5a, 0x07, 0x48, 0x39, 0x4b, 0x70, 0x9f, 0x88, 0x2c, 0xbf, 0xa9, 0xed, 0x54, 0xba, 0x95, 0x8c,
-	0x58, 0x01, 0x6a, 0x40, 0x79, 0x38, 0x88, 0x18, 0x0e, 0x48, 0xa2, 0x17, 0x64, 0x38, 0x9d, 0xd1,
-	0x73, 0x58, 0x88, 0xc9, 0x15, 0xf7, 0xa6, 0x42, 0x51, 0x0a, 0x35, 0x01, 0xdd, 0x89, 0xb4, 0x06,
-	0x15, 0x7f, 0xcc, 0x89, 0x97, 0xd2, 0x6b, 0xa2, 0xcf, 0xc9, 0xa3, 0xcb, 0x02, 0x38, 0xf4, 0x9a,
-	0x88, 0x90, 0x33, 0x2f, 0x24, 0xb4, 0x1f, 0x72, 0xbd, 0xa4, 0x42, 0xce, 0x0e, 0xe4, 0x8c, 0x96,
-	0xa1, 0xc4, 0x99, 0x77, 0x41, 0xc6, 0xfa, 0xbc, 0xfc, 0xef, 0x1c, 0x67, 0x87, 0x64, 0x8c, 0x56,
-	0xa1, 0xcc, 0x99, 0x37, 0xc2, 0xd1, 0x90, 0xe8, 0x65, 0x19, 0xcc, 0x73, 0xd6, 0x13, 0x23, 0xda,
-	0x80, 0xaa, 0x5a, 0x90, 0x17, 0xe2, 0x34, 0xd4, 0x2b, 0x32, 0x05, 0x85, 0x0e, 0x70, 0x1a, 0x8a,
-	0xcb, 0x9e, 0x25, 0x04, 0x73, 0x12, 0x78, 0x98, 0xeb, 0x20, 0x0f, 0xac, 0x64, 0xa4, 0xc9, 0xd1,
-	0x16, 0xd4, 0x46, 0x8c, 0x93, 0x24, 0x15, 0xbf, 0xa7, 0x81, 0x5e, 0xdd, 0x2c, 0xec, 0x54, 0xba,
-	0x55, 0xc5, 0x7a, 0x02, 0xa1, 0x17, 0xb0, 0x98, 0x29, 0x34, 0x56, 0x52, 0x4d, 0x4a, 0x0b, 0x8a,
-	0x5a, 0x0a, 0x3e, 0xd0, 0xb0, 0x9f, 0x72, 0x4c, 0x63, 0x7d, 0xe1, 0xa1, 0xd6, 0x54, 0x70, 0xfb,
-	0x4b, 0x1e, 0xfe, 0xeb, 0xd0, 0x18, 0x47, 0xf4, 0x9a, 0x04, 0xaa, 0xb1, 0xbf, 0x37, 0xb5, 0x08,
-	0xf9, 0xac, 0xa1, 0x62, 0x37, 0x4f, 0x1f, 0x37, 0x57, 0x78, 0xaa, 0xb9, 0xe2, 0xa3, 0xe6, 0x36,
-	0xa0, 0x7a, 0x9e, 0xb0, 0xcb, 0xc9, 0xe6, 0x55, 0x2d, 0x20, 0x50, 0xb6, 0xfb, 0x27, 0x8b, 0xa9,
-	0x43, 0xe1, 0xbe, 0x15, 0xf1, 0x89, 0x96, 0x60, 0xee, 0x61, 0x21, 0x6a, 0xf8, 0x77, 0x1d, 0x5b,
-	0x50, 0x3b, 0x9f, 0xdc, 0xfe, 0xbe, 0x90, 0xea, 0x94, 0x35, 0xf9, 0xcb, 0xaf, 0x1a, 0xd4, 0xd4,
-	0x62, 0x1c, 0x8e, 0xf9, 0x30, 0x45, 0xeb, 0xb0, 0xba, 0xef, 0xda, 0xad, 0xa3, 0xb6, 0xe7, 0x9c,
-	0x36, 0x4f, 0x5d, 0xc7, 0x73, 0x6d, 0xe7, 0xa4, 0xfd, 0xc6, 0xea, 0x58, 0xed, 0x56, 0x3d, 0x87,
-	0x56, 0xe0, 0xff, 0xd9, 0xb8, 0xd7, 0x3c, 0xb2, 0x5a, 0x75, 0x0d, 0xad, 0xc2, 0xf2, 0x6c, 0x60,
-	0xd9, 0x2a, 0xca, 0xa3, 0x06, 0x3c, 0x9b, 0x8d, 0xec, 0x63, 0xaf, 0xe3, 0xda, 0x2d, 0xa7, 0x5e,
-	0x40, 0x6b, 0xb0, 0xf2, 0x47, 0xf6, 0xce, 0x3d, 0xee, 0xba, 0x6f, 0xeb, 0xc5, 0x46, 0xf1, 0xd3,
-	0x37, 0x23, 0xb7, 0xdf, 0xf9, 0x71, 0x6b, 0x68, 0x37, 0xb7, 0x86, 0xf6, 0xeb, 0xd6, 0xd0, 0x3e,
-	0xdf, 0x19, 0xb9, 0x9b, 0x3b, 0x23, 0xf7, 0xf3, 0xce, 0xc8, 0x7d, 0x78, 0xd5, 0xa7, 0x3c, 0x1c,
-	0xfa, 0xbb, 0x67, 0xec, 0xd2, 0x3c, 0x7c, 0xdf, 0x6b, 0xdb, 0x84, 0x7f, 0x64, 0xc9, 0x85, 0x79,
-	0x16, 0x62, 0x1a, 0x9b, 0x57, 0xd3, 0x17, 0xcf, 0xc7, 0x03, 0x92, 0xfa, 0x25, 0xf9, 0x78, 0x5f,
-	0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xf6, 0x51, 0x7a, 0x0e, 0x04, 0x00, 0x00,
+func (m *VoteDistribution) Reset()         { *m = VoteDistribution{} }
+func (m *VoteDistribution) String() string { return proto.CompactTextString(m) }
+func (*VoteDistribution) ProtoMessage()    {}
+func (*VoteDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_889cf76d77a4de2b, []int{3}
 }
-
-func (m *BundleProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VoteDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BundleProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VoteDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteDistribution.Merge(m, src)
+}
+func (m *VoteDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteDistribution.DiscardUnknown(m)
 }
 
-func (m *BundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VotersAbstain) > 0 {
-		for iNdEx := len(m.VotersAbstain) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.VotersAbstain[iNdEx])
-			copy(dAtA[i:], m.VotersAbstain[iNdEx])
-			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersAbstain[iNdEx])))
-			i--
-			dAtA[i] = 0x6a
-		}
-	}
-	if len(m.VotersInvalid) > 0 {
-		for iNdEx := len(m.VotersInvalid) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.VotersInvalid[iNdEx])
-			copy(dAtA[i:], m.VotersInvalid[iNdEx])
-			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersInvalid[iNdEx])))
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	if len(m.VotersValid) > 0 {
-		for iNdEx := len(m.VotersValid) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.VotersValid[iNdEx])
-			copy(dAtA[i:], m.VotersValid[iNdEx])
-			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersValid[iNdEx])))
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintBundles(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x50
+var xxx_messageInfo_VoteDistribution proto.InternalMessageInfo
+
+func (m *VoteDistribution) GetValid() uint64 {
+	if m != nil {
+		return m.Valid
 	}
-	if len(m.BundleHash) > 0 {
-		i -= len(m.BundleHash)
-		copy(dAtA[i:], m.BundleHash)
-		i = encodeVarintBundles(dAtA, i, uint64(len(m.BundleHash)))
-		i--
-		dAtA[i] = 0x4a
+	return 0
+}
+
+func (m *VoteDistribution) GetInvalid() uint64 {
+	if m != nil {
+		return m.Invalid
 	}
-	if len(m.ToValue) > 0 {
-		i -= len(m.ToValue)
-		copy(dAtA[i:], m.ToValue)
-		i = encodeVarintBundles(dAtA, i, uint64(len(m.ToValue)))
-		i--
-		dAtA[i] = 0x42
+	return 0
+}
+
+func (m *VoteDistribution) GetAbstain() uint64 {
+	if m != nil {
+		return m.Abstain
 	}
-	if len(m.ToKey) > 0 {
-		i -= len(m.ToKey)
-		copy(dAtA[i:], m.ToKey)
-		i = encodeVarintBundles(dAtA, i, uint64(len(m.ToKey)))
-		i--
-		dAtA[i] = 0x3a
+	return 0
+}
+
+func (m *VoteDistribution) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
 	}
-	if m.ToHeight != 0 {
-		i = encodeVarintBundles(dAtA, i, uint64(m.ToHeight))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *VoteDistribution) GetStatus() BundleStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.ByteSize != 0 {
-		i = encodeVarintBundles(dAtA, i, uint64(m.ByteSize))
-		i--
-		dAtA[i] = 0x28
+	return BUNDLE_STATUS_UNSPECIFIED
+}
+
+// ProposalSlash is a slash which was applied to a staker
+// when a bundle proposal got concluded.
+type ProposalSlash struct {
+	// staker ...
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// slash_type ...
+	SlashType types.SlashType `protobuf:"varint,2,opt,name=slash_type,json=slashType,proto3,enum=kyve.stakers.v1beta1.SlashType" json:"slash_type,omitempty"`
+	// amount is the total amount in $nKYVE which got slashed
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *ProposalSlash) Reset()         { *m = ProposalSlash{} }
+func (m *ProposalSlash) String() string { return proto.CompactTextString(m) }
+func (*ProposalSlash) ProtoMessage()    {}
+func (*ProposalSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_889cf76d77a4de2b, []int{4}
+}
+func (m *ProposalSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.NextUploader) > 0 {
-		i -= len(m.NextUploader)
-		copy(dAtA[i:], m.NextUploader)
-		i = encodeVarintBundles(dAtA, i, uint64(len(m.NextUploader)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *ProposalSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalSlash.Merge(m, src)
+}
+func (m *ProposalSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalSlash proto.InternalMessageInfo
+
+func (m *ProposalSlash) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	if len(m.Uploader) > 0 {
-		i -= len(m.Uploader)
-		copy(dAtA[i:], m.Uploader)
-		i = encodeVarintBundles(dAtA, i, uint64(len(m.Uploader)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *ProposalSlash) GetSlashType() types.SlashType {
+	if m != nil {
+		return m.SlashType
 	}
-	if len(m.StorageId) > 0 {
-		i -= len(m.StorageId)
-		copy(dAtA[i:], m.StorageId)
-		i = encodeVarintBundles(dAtA, i, uint64(len(m.StorageId)))
-		i--
-		dAtA[i] = 0x12
+	return types.SLASH_TYPE_UNSPECIFIED
+}
+
+func (m *ProposalSlash) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintBundles(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+// VoterReward is the share of a bundle reward which was paid out
+// to a staker who voted valid on a finalized bundle.
+type VoterReward struct {
+	// staker ...
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// reward_staker is the commission the staker received
+	RewardStaker github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=reward_staker,json=rewardStaker,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_staker"`
+	// reward_delegation is the amount paid out to the delegators of the staker
+	RewardDelegation github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=reward_delegation,json=rewardDelegation,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_delegation"`
+}
+
+func (m *VoterReward) Reset()         { *m = VoterReward{} }
+func (m *VoterReward) String() string { return proto.CompactTextString(m) }
+func (*VoterReward) ProtoMessage()    {}
+func (*VoterReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_889cf76d77a4de2b, []int{5}
+}
+func (m *VoterReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoterReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoterReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VoterReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoterReward.Merge(m, src)
+}
+func (m *VoterReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoterReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoterReward.DiscardUnknown(m)
 }
 
-func (m *FinalizedBundle) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VoterReward proto.InternalMessageInfo
+
+func (m *VoterReward) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *FinalizedBundle) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VoterReward) GetRewardStaker() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardStaker
+	}
+	return nil
 }
 
-func (m *FinalizedBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FinalizedAt != 0 {
-		i = encodeVarintBundles(dAtA, i, uint64(m.FinalizedAt))
-		i--
-		dAtA[i] = 0x50
+func (m *VoterReward) GetRewardDelegation() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardDelegation
 	}
-	if len(m.BundleHash) > 0 {
-		i -= len(m.BundleHash)
-		copy(dAtA[i:], m.BundleHash)
+	return nil
+}
+
+// ConcludedBundleProposal is a bundle proposal whose voting round
+// has been concluded, regardless of whether it got finalized or dropped.
+type ConcludedBundleProposal struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// id is an incrementing index per pool
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// storage_id ...
+	StorageId string `protobuf:"bytes,3,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
+	// uploader ...
+	Uploader string `protobuf:"bytes,4,opt,name=uploader,proto3" json:"uploader,omitempty"`
+	// from_height ...
+	FromHeight uint64 `protobuf:"varint,5,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height ...
+	ToHeight uint64 `protobuf:"varint,6,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// to_key ...
+	ToKey string `protobuf:"bytes,7,opt,name=to_key,json=toKey,proto3" json:"to_key,omitempty"`
+	// bundle_hash ...
+	BundleHash string `protobuf:"bytes,8,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundle_hash,omitempty"`
+	// voters_valid ...
+	VotersValid []string `protobuf:"bytes,9,rep,name=voters_valid,json=votersValid,proto3" json:"voters_valid,omitempty"`
+	// voters_invalid ...
+	VotersInvalid []string `protobuf:"bytes,10,rep,name=voters_invalid,json=votersInvalid,proto3" json:"voters_invalid,omitempty"`
+	// voters_abstain ...
+	VotersAbstain []string `protobuf:"bytes,11,rep,name=voters_abstain,json=votersAbstain,proto3" json:"voters_abstain,omitempty"`
+	// vote_distribution ...
+	VoteDistribution VoteDistribution `protobuf:"bytes,12,opt,name=vote_distribution,json=voteDistribution,proto3" json:"vote_distribution"`
+	// status is the final status of the bundle proposal
+	Status BundleStatus `protobuf:"varint,13,opt,name=status,proto3,enum=kyve.bundles.v1beta1.BundleStatus" json:"status,omitempty"`
+	// slashes contains all slashes applied when the proposal got concluded
+	Slashes []ProposalSlash `protobuf:"bytes,14,rep,name=slashes,proto3" json:"slashes"`
+	// created_at ...
+	CreatedAt uint64 `protobuf:"varint,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// concluded_at is the unix time the proposal got concluded
+	ConcludedAt uint64 `protobuf:"varint,16,opt,name=concluded_at,json=concludedAt,proto3" json:"concluded_at,omitempty"`
+}
+
+func (m *ConcludedBundleProposal) Reset()         { *m = ConcludedBundleProposal{} }
+func (m *ConcludedBundleProposal) String() string { return proto.CompactTextString(m) }
+func (*ConcludedBundleProposal) ProtoMessage()    {}
+func (*ConcludedBundleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_889cf76d77a4de2b, []int{6}
+}
+func (m *ConcludedBundleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConcludedBundleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConcludedBundleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConcludedBundleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConcludedBundleProposal.Merge(m, src)
+}
+func (m *ConcludedBundleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConcludedBundleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConcludedBundleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConcludedBundleProposal proto.InternalMessageInfo
+
+func (m *ConcludedBundleProposal) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *ConcludedBundleProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ConcludedBundleProposal) GetStorageId() string {
+	if m != nil {
+		return m.StorageId
+	}
+	return ""
+}
+
+func (m *ConcludedBundleProposal) GetUploader() string {
+	if m != nil {
+		return m.Uploader
+	}
+	return ""
+}
+
+func (m *ConcludedBundleProposal) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *ConcludedBundleProposal) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *ConcludedBundleProposal) GetToKey() string {
+	if m != nil {
+		return m.ToKey
+	}
+	return ""
+}
+
+func (m *ConcludedBundleProposal) GetBundleHash() string {
+	if m != nil {
+		return m.BundleHash
+	}
+	return ""
+}
+
+func (m *ConcludedBundleProposal) GetVotersValid() []string {
+	if m != nil {
+		return m.VotersValid
+	}
+	return nil
+}
+
+func (m *ConcludedBundleProposal) GetVotersInvalid() []string {
+	if m != nil {
+		return m.VotersInvalid
+	}
+	return nil
+}
+
+func (m *ConcludedBundleProposal) GetVotersAbstain() []string {
+	if m != nil {
+		return m.VotersAbstain
+	}
+	return nil
+}
+
+func (m *ConcludedBundleProposal) GetVoteDistribution() VoteDistribution {
+	if m != nil {
+		return m.VoteDistribution
+	}
+	return VoteDistribution{}
+}
+
+func (m *ConcludedBundleProposal) GetStatus() BundleStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BUNDLE_STATUS_UNSPECIFIED
+}
+
+func (m *ConcludedBundleProposal) GetSlashes() []ProposalSlash {
+	if m != nil {
+		return m.Slashes
+	}
+	return nil
+}
+
+func (m *ConcludedBundleProposal) GetCreatedAt() uint64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ConcludedBundleProposal) GetConcludedAt() uint64 {
+	if m != nil {
+		return m.ConcludedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("kyve.bundles.v1beta1.BundleStatus", BundleStatus_name, BundleStatus_value)
+	proto.RegisterType((*BundleProposal)(nil), "kyve.bundles.v1beta1.BundleProposal")
+	proto.RegisterType((*VoteCommit)(nil), "kyve.bundles.v1beta1.VoteCommit")
+	proto.RegisterType((*FinalizedBundle)(nil), "kyve.bundles.v1beta1.FinalizedBundle")
+	proto.RegisterType((*VoteDistribution)(nil), "kyve.bundles.v1beta1.VoteDistribution")
+	proto.RegisterType((*ProposalSlash)(nil), "kyve.bundles.v1beta1.ProposalSlash")
+	proto.RegisterType((*VoterReward)(nil), "kyve.bundles.v1beta1.VoterReward")
+	proto.RegisterType((*ConcludedBundleProposal)(nil), "kyve.bundles.v1beta1.ConcludedBundleProposal")
+}
+
+func init() {
+	proto.RegisterFile("kyve/bundles/v1beta1/bundles.proto", fileDescriptor_889cf76d77a4de2b)
+}
+
+var fileDescriptor_889cf76d77a4de2b = []byte{
+	// 1019 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x8e, 0x1a, 0x47,
+	0x10, 0x66, 0x80, 0xe5, 0xa7, 0x06, 0x30, 0xee, 0xac, 0xbd, 0xb3, 0x58, 0x06, 0x8c, 0x95, 0x08,
+	0x45, 0x09, 0xc4, 0x9b, 0x5b, 0x0e, 0x91, 0xf8, 0x5b, 0x19, 0xd9, 0xc1, 0xce, 0xb0, 0x20, 0x39,
+	0x97, 0xd1, 0xc0, 0xb4, 0x61, 0xc4, 0x30, 0x8d, 0xa6, 0x1b, 0xbc, 0xec, 0x25, 0x52, 0x4e, 0x39,
+	0x26, 0x6f, 0x10, 0x29, 0xb7, 0x1c, 0xf2, 0x04, 0x79, 0x00, 0x1f, 0x7d, 0xcc, 0x29, 0x89, 0x76,
+	0x9f, 0x20, 0x6f, 0x10, 0x75, 0xf7, 0x0c, 0x0b, 0x04, 0xec, 0x8d, 0x94, 0x83, 0x4f, 0x4c, 0x7d,
+	0xf5, 0x75, 0x7f, 0x3d, 0xd5, 0x5f, 0xd5, 0x00, 0xa5, 0xc9, 0x72, 0x81, 0xab, 0x83, 0xb9, 0x6b,
+	0x39, 0x98, 0x56, 0x17, 0x8f, 0x06, 0x98, 0x99, 0x8f, 0x82, 0xb8, 0x32, 0xf3, 0x08, 0x23, 0xe8,
+	0x90, 0x73, 0x2a, 0x01, 0xe6, 0x73, 0x72, 0xf9, 0x21, 0xa1, 0x53, 0x42, 0xab, 0x03, 0x93, 0xe2,
+	0xd5, 0xc2, 0x21, 0xb1, 0x5d, 0xb9, 0x2a, 0x77, 0x38, 0x22, 0x23, 0x22, 0x1e, 0xab, 0xfc, 0xc9,
+	0x47, 0xa5, 0x1e, 0x65, 0xe6, 0x04, 0x7b, 0xd7, 0x7a, 0x7e, 0x2c, 0x39, 0xa5, 0xbf, 0x23, 0x90,
+	0xa9, 0x0b, 0xb5, 0xe7, 0x1e, 0x99, 0x11, 0x6a, 0x3a, 0xe8, 0x08, 0xe2, 0x33, 0x42, 0x1c, 0xc3,
+	0xb6, 0x34, 0xa5, 0xa8, 0x94, 0xa3, 0x7a, 0x8c, 0x87, 0x6d, 0x0b, 0xdd, 0x07, 0xa0, 0x8c, 0x78,
+	0xe6, 0x08, 0xf3, 0x5c, 0xb8, 0xa8, 0x94, 0x93, 0x7a, 0xd2, 0x47, 0xda, 0x16, 0xca, 0x41, 0x62,
+	0x3e, 0x73, 0x88, 0x69, 0x61, 0x4f, 0x8b, 0x88, 0xe4, 0x2a, 0x46, 0x0f, 0x21, 0xed, 0xe2, 0x73,
+	0x66, 0xac, 0x08, 0x51, 0x41, 0x48, 0x71, 0xb0, 0x17, 0x90, 0xee, 0x41, 0x72, 0xb0, 0x64, 0xd8,
+	0xa0, 0xf6, 0x05, 0xd6, 0x0e, 0x84, 0x74, 0x82, 0x03, 0x5d, 0xfb, 0x02, 0xf3, 0x24, 0x23, 0xc6,
+	0x18, 0xdb, 0xa3, 0x31, 0xd3, 0x62, 0x32, 0xc9, 0xc8, 0x63, 0x11, 0xa3, 0x3b, 0x10, 0x63, 0xc4,
+	0x98, 0xe0, 0xa5, 0x16, 0x17, 0xfb, 0x1e, 0x30, 0xf2, 0x04, 0x2f, 0xd1, 0x31, 0x24, 0x18, 0x31,
+	0x16, 0xa6, 0x33, 0xc7, 0x5a, 0x42, 0x24, 0xe2, 0x8c, 0xf4, 0x79, 0x88, 0x0a, 0xa0, 0xca, 0x22,
+	0x1b, 0x63, 0x93, 0x8e, 0xb5, 0xa4, 0xc8, 0x82, 0x84, 0x1e, 0x9b, 0x74, 0xcc, 0x5f, 0x76, 0xe8,
+	0x61, 0x93, 0x61, 0xcb, 0x30, 0x99, 0x06, 0x42, 0x30, 0xe9, 0x23, 0x35, 0x86, 0x1e, 0x40, 0x6a,
+	0x41, 0x18, 0xf6, 0x28, 0xdf, 0xde, 0xb6, 0x34, 0xb5, 0x18, 0x29, 0x27, 0x75, 0x55, 0x62, 0x7d,
+	0x0e, 0xa1, 0x0f, 0x21, 0xe3, 0x53, 0x6c, 0x57, 0x92, 0x52, 0x82, 0x94, 0x96, 0x68, 0xdb, 0x5d,
+	0x6c, 0xd1, 0xcc, 0x01, 0x65, 0xa6, 0xed, 0x6a, 0xe9, 0x75, 0x5a, 0x4d, 0x82, 0xa8, 0x2d, 0x05,
+	0x8d, 0x21, 0x99, 0x4e, 0x6d, 0x46, 0xb5, 0x4c, 0x31, 0x52, 0x56, 0x4f, 0x8a, 0x95, 0x5d, 0x7e,
+	0xa9, 0xf4, 0x09, 0xc3, 0x0d, 0x41, 0xac, 0x47, 0x5f, 0xff, 0x51, 0x08, 0xc9, 0x83, 0x49, 0x84,
+	0x96, 0x5a, 0x00, 0xd7, 0x04, 0x74, 0x17, 0x62, 0xd2, 0x12, 0xe2, 0xb6, 0x93, 0xba, 0x1f, 0xf1,
+	0x0a, 0x49, 0x2d, 0x59, 0x21, 0x79, 0xdd, 0x20, 0x21, 0x5e, 0xa1, 0xd2, 0x6f, 0x61, 0xb8, 0x75,
+	0x6a, 0xbb, 0xa6, 0x63, 0x5f, 0x60, 0x4b, 0x7a, 0x68, 0xbf, 0x77, 0x32, 0x10, 0xf6, 0x3d, 0x13,
+	0xd5, 0xc3, 0xf6, 0xb6, 0x97, 0x22, 0x6f, 0xf3, 0x52, 0x74, 0xcb, 0x4b, 0x05, 0x50, 0x5f, 0x7a,
+	0x64, 0x1a, 0x78, 0x41, 0x1a, 0x05, 0x38, 0xe4, 0xbb, 0xe1, 0xad, 0x56, 0xc9, 0x42, 0xe4, 0xda,
+	0x27, 0xfc, 0x11, 0x1d, 0xc2, 0xc1, 0xba, 0x45, 0x64, 0xf0, 0x6e, 0x83, 0x3c, 0x80, 0xd4, 0xcb,
+	0xe0, 0xed, 0xaf, 0x2d, 0xa2, 0xae, 0xb0, 0x1a, 0xdb, 0x34, 0xb4, 0xba, 0x69, 0xe8, 0xd2, 0xaf,
+	0x0a, 0x64, 0xf9, 0x35, 0x34, 0x6d, 0xca, 0x3c, 0x7b, 0x30, 0x67, 0x36, 0x71, 0xfd, 0xb3, 0xac,
+	0xaa, 0x27, 0x03, 0xa4, 0x41, 0x3c, 0xb0, 0x90, 0xac, 0x60, 0x10, 0xf2, 0x4c, 0xe0, 0x9a, 0x88,
+	0xcc, 0xf8, 0x21, 0xdf, 0x89, 0x11, 0x66, 0x3a, 0xa2, 0x7c, 0x51, 0x5d, 0x06, 0xe8, 0x0b, 0x71,
+	0xd9, 0x6c, 0x4e, 0x45, 0xd9, 0x32, 0x27, 0xa5, 0xdd, 0xfe, 0x91, 0xb7, 0xd9, 0x15, 0x4c, 0xdd,
+	0x5f, 0x51, 0xfa, 0x16, 0xd2, 0xc1, 0x8c, 0xe8, 0x3a, 0xbc, 0x02, 0xfb, 0x9c, 0xf3, 0x25, 0x00,
+	0xe5, 0x04, 0x83, 0x2d, 0x67, 0x58, 0x9c, 0x38, 0x73, 0x52, 0x90, 0x42, 0xc1, 0xf0, 0x09, 0x84,
+	0xc4, 0x46, 0x67, 0xcb, 0x19, 0xd6, 0x93, 0x34, 0x78, 0xe4, 0xfb, 0x9a, 0x53, 0x32, 0x77, 0x99,
+	0xff, 0x4e, 0x7e, 0x54, 0xfa, 0x31, 0x0c, 0x2a, 0xaf, 0x98, 0xa7, 0xe3, 0x57, 0xa6, 0x67, 0xed,
+	0xd5, 0x9f, 0x41, 0xda, 0x13, 0x0c, 0xc3, 0x4f, 0x87, 0x45, 0xaf, 0x1c, 0x57, 0xe4, 0x14, 0xad,
+	0xf0, 0x29, 0xba, 0x3a, 0x41, 0x83, 0xd8, 0x6e, 0xfd, 0x33, 0xde, 0x24, 0xbf, 0xfc, 0x59, 0x28,
+	0x8f, 0x6c, 0x36, 0x9e, 0x0f, 0x2a, 0x43, 0x32, 0xad, 0xfa, 0x23, 0x57, 0xfe, 0x7c, 0x4a, 0xad,
+	0x49, 0x95, 0xbf, 0x0e, 0x15, 0x0b, 0xa8, 0x9e, 0x92, 0x0a, 0x5d, 0xa9, 0x78, 0x0e, 0xb7, 0x7d,
+	0x45, 0x0b, 0x3b, 0x78, 0x64, 0xf2, 0xbb, 0xd4, 0x22, 0xff, 0xbf, 0x6a, 0x56, 0xaa, 0x34, 0x57,
+	0x22, 0xa5, 0xef, 0x0e, 0xe0, 0xa8, 0x41, 0xdc, 0xa1, 0x33, 0xb7, 0x82, 0x26, 0x7c, 0xf7, 0x20,
+	0x7f, 0x6f, 0x9a, 0x71, 0xcf, 0xdc, 0xde, 0xea, 0xbd, 0xc4, 0xae, 0xde, 0xdb, 0x98, 0xbe, 0xc9,
+	0x9b, 0x4c, 0x5f, 0xb8, 0xd9, 0xf4, 0x55, 0x77, 0x4d, 0xdf, 0x17, 0x70, 0x9b, 0x03, 0x86, 0xb5,
+	0xd6, 0xac, 0x5a, 0xaa, 0xa8, 0x94, 0xd5, 0x93, 0x8f, 0xf6, 0x8f, 0xe0, 0xf5, 0xd6, 0xf6, 0x07,
+	0x71, 0x76, 0xb1, 0x85, 0xaf, 0xb5, 0x64, 0xfa, 0xbf, 0xb6, 0x24, 0x6a, 0x40, 0x5c, 0xb4, 0x0d,
+	0x0e, 0xbe, 0x07, 0x0f, 0x77, 0x2f, 0xde, 0xe8, 0x5b, 0xff, 0x24, 0xc1, 0xca, 0xad, 0x2f, 0xdd,
+	0xad, 0x1d, 0x5f, 0xba, 0x61, 0x60, 0x30, 0x4e, 0xc8, 0xca, 0x39, 0xb7, 0xc2, 0x6a, 0xec, 0xe3,
+	0x9f, 0x14, 0x48, 0xad, 0x9f, 0x0f, 0xdd, 0x87, 0xe3, 0x7a, 0xaf, 0xd3, 0x7c, 0xda, 0x32, 0xba,
+	0x67, 0xb5, 0xb3, 0x5e, 0xd7, 0xe8, 0x75, 0xba, 0xcf, 0x5b, 0x8d, 0xf6, 0x69, 0xbb, 0xd5, 0xcc,
+	0x86, 0xd0, 0x11, 0x7c, 0xb0, 0x99, 0xee, 0xd7, 0x9e, 0xb6, 0x9b, 0x59, 0x05, 0x1d, 0xc3, 0x9d,
+	0xcd, 0x44, 0xbb, 0x23, 0x53, 0x61, 0x94, 0x83, 0xbb, 0x9b, 0xa9, 0xce, 0x33, 0xe3, 0xb4, 0xd7,
+	0x69, 0x76, 0xb3, 0x11, 0x74, 0x0f, 0x8e, 0xfe, 0x95, 0xfb, 0xba, 0xf7, 0x4c, 0xef, 0x7d, 0x95,
+	0x8d, 0xe6, 0xa2, 0xdf, 0xff, 0x9c, 0x0f, 0xd5, 0x4f, 0x5f, 0x5f, 0xe6, 0x95, 0x37, 0x97, 0x79,
+	0xe5, 0xaf, 0xcb, 0xbc, 0xf2, 0xc3, 0x55, 0x3e, 0xf4, 0xe6, 0x2a, 0x1f, 0xfa, 0xfd, 0x2a, 0x1f,
+	0xfa, 0xe6, 0x93, 0xb5, 0xee, 0x7b, 0xf2, 0xa2, 0xdf, 0xea, 0x60, 0xf6, 0x8a, 0x78, 0x93, 0xea,
+	0x70, 0x6c, 0xda, 0x6e, 0xf5, 0x7c, 0xf5, 0x7f, 0x4d, 0xf4, 0xe1, 0x20, 0x26, 0xfe, 0x36, 0x7d,
+	0xfe, 0xcf, 0x00, 0x02, 0x3a, 0x11, 0x36, 0xcc, 0x09, 0x00, 0x00,
+}
+
+func (m *BundleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteCommits) > 0 {
+		for iNdEx := len(m.VoteCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundles(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.VotersAbstain) > 0 {
+		for iNdEx := len(m.VotersAbstain) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VotersAbstain[iNdEx])
+			copy(dAtA[i:], m.VotersAbstain[iNdEx])
+			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersAbstain[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.VotersInvalid) > 0 {
+		for iNdEx := len(m.VotersInvalid) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VotersInvalid[iNdEx])
+			copy(dAtA[i:], m.VotersInvalid[iNdEx])
+			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersInvalid[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.VotersValid) > 0 {
+		for iNdEx := len(m.VotersValid) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VotersValid[iNdEx])
+			copy(dAtA[i:], m.VotersValid[iNdEx])
+			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersValid[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
 		i = encodeVarintBundles(dAtA, i, uint64(len(m.BundleHash)))
 		i--
 		dAtA[i] = 0x4a
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintBundles(dAtA, i, uint64(len(m.Value)))
+	if len(m.ToValue) > 0 {
+		i -= len(m.ToValue)
+		copy(dAtA[i:], m.ToValue)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.ToValue)))
 		i--
 		dAtA[i] = 0x42
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintBundles(dAtA, i, uint64(len(m.Key)))
+	if len(m.ToKey) > 0 {
+		i -= len(m.ToKey)
+		copy(dAtA[i:], m.ToKey)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.ToKey)))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -558,29 +991,31 @@ func (m *FinalizedBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.FromHeight != 0 {
-		i = encodeVarintBundles(dAtA, i, uint64(m.FromHeight))
+	if m.ByteSize != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.ByteSize))
 		i--
 		dAtA[i] = 0x28
 	}
+	if len(m.NextUploader) > 0 {
+		i -= len(m.NextUploader)
+		copy(dAtA[i:], m.NextUploader)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.NextUploader)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Uploader) > 0 {
 		i -= len(m.Uploader)
 		copy(dAtA[i:], m.Uploader)
 		i = encodeVarintBundles(dAtA, i, uint64(len(m.Uploader)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
 	if len(m.StorageId) > 0 {
 		i -= len(m.StorageId)
 		copy(dAtA[i:], m.StorageId)
 		i = encodeVarintBundles(dAtA, i, uint64(len(m.StorageId)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintBundles(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.PoolId != 0 {
 		i = encodeVarintBundles(dAtA, i, uint64(m.PoolId))
@@ -590,164 +1025,1423 @@ func (m *FinalizedBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBundles(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBundles(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VoteCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BundleProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *VoteCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovBundles(uint64(m.PoolId))
-	}
-	l = len(m.StorageId)
-	if l > 0 {
-		n += 1 + l + sovBundles(uint64(l))
+	if len(m.CommitHash) > 0 {
+		i -= len(m.CommitHash)
+		copy(dAtA[i:], m.CommitHash)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.CommitHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Uploader)
-	if l > 0 {
-		n += 1 + l + sovBundles(uint64(l))
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.NextUploader)
-	if l > 0 {
-		n += 1 + l + sovBundles(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizedBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizedBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizedBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.ByteSize != 0 {
-		n += 1 + sovBundles(uint64(m.ByteSize))
+		i = encodeVarintBundles(dAtA, i, uint64(m.ByteSize))
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.ToHeight != 0 {
-		n += 1 + sovBundles(uint64(m.ToHeight))
+	if m.FinalizedAt != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.FinalizedAt))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.ToKey)
-	if l > 0 {
-		n += 1 + l + sovBundles(uint64(l))
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.ToValue)
-	if l > 0 {
-		n += 1 + l + sovBundles(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.BundleHash)
-	if l > 0 {
-		n += 1 + l + sovBundles(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovBundles(uint64(m.CreatedAt))
+	if m.ToHeight != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.VotersValid) > 0 {
-		for _, s := range m.VotersValid {
-			l = len(s)
-			n += 1 + l + sovBundles(uint64(l))
-		}
+	if m.FromHeight != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.VotersInvalid) > 0 {
-		for _, s := range m.VotersInvalid {
-			l = len(s)
-			n += 1 + l + sovBundles(uint64(l))
-		}
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.VotersAbstain) > 0 {
-		for _, s := range m.VotersAbstain {
-			l = len(s)
-			n += 1 + l + sovBundles(uint64(l))
-		}
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Id != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FinalizedBundle) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VoteDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovBundles(uint64(m.PoolId))
+	if m.Status != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Id != 0 {
-		n += 1 + sovBundles(uint64(m.Id))
+	if m.Total != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.StorageId)
-	if l > 0 {
-		n += 1 + l + sovBundles(uint64(l))
+	if m.Abstain != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Abstain))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Uploader)
-	if l > 0 {
-		n += 1 + l + sovBundles(uint64(l))
+	if m.Invalid != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Invalid))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.FromHeight != 0 {
-		n += 1 + sovBundles(uint64(m.FromHeight))
+	if m.Valid != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Valid))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.ToHeight != 0 {
-		n += 1 + sovBundles(uint64(m.ToHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovBundles(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ProposalSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovBundles(uint64(l))
+	if m.SlashType != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.SlashType))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.BundleHash)
-	if l > 0 {
-		n += 1 + l + sovBundles(uint64(l))
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.FinalizedAt != 0 {
-		n += 1 + sovBundles(uint64(m.FinalizedAt))
+	return len(dAtA) - i, nil
+}
+
+func (m *VoterReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovBundles(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *VoterReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozBundles(x uint64) (n int) {
-	return sovBundles(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *VoterReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardDelegation) > 0 {
+		for iNdEx := len(m.RewardDelegation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardDelegation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundles(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RewardStaker) > 0 {
+		for iNdEx := len(m.RewardStaker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardStaker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundles(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *BundleProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBundles
+
+func (m *ConcludedBundleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConcludedBundleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConcludedBundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConcludedAt != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.ConcludedAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundles(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.VoteDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBundles(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.VotersAbstain) > 0 {
+		for iNdEx := len(m.VotersAbstain) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VotersAbstain[iNdEx])
+			copy(dAtA[i:], m.VotersAbstain[iNdEx])
+			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersAbstain[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VotersInvalid) > 0 {
+		for iNdEx := len(m.VotersInvalid) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VotersInvalid[iNdEx])
+			copy(dAtA[i:], m.VotersInvalid[iNdEx])
+			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersInvalid[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VotersValid) > 0 {
+		for iNdEx := len(m.VotersValid) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VotersValid[iNdEx])
+			copy(dAtA[i:], m.VotersValid[iNdEx])
+			i = encodeVarintBundles(dAtA, i, uint64(len(m.VotersValid[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ToKey) > 0 {
+		i -= len(m.ToKey)
+		copy(dAtA[i:], m.ToKey)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.ToKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBundles(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBundles(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BundleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovBundles(uint64(m.PoolId))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	l = len(m.NextUploader)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	if m.ByteSize != 0 {
+		n += 1 + sovBundles(uint64(m.ByteSize))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovBundles(uint64(m.ToHeight))
+	}
+	l = len(m.ToKey)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	l = len(m.ToValue)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovBundles(uint64(m.CreatedAt))
+	}
+	if len(m.VotersValid) > 0 {
+		for _, s := range m.VotersValid {
+			l = len(s)
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	if len(m.VotersInvalid) > 0 {
+		for _, s := range m.VotersInvalid {
+			l = len(s)
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	if len(m.VotersAbstain) > 0 {
+		for _, s := range m.VotersAbstain {
+			l = len(s)
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	if len(m.VoteCommits) > 0 {
+		for _, e := range m.VoteCommits {
+			l = e.Size()
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VoteCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	l = len(m.CommitHash)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalizedBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovBundles(uint64(m.PoolId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovBundles(uint64(m.Id))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovBundles(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovBundles(uint64(m.ToHeight))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	if m.FinalizedAt != 0 {
+		n += 1 + sovBundles(uint64(m.FinalizedAt))
+	}
+	if m.ByteSize != 0 {
+		n += 1 + sovBundles(uint64(m.ByteSize))
+	}
+	return n
+}
+
+func (m *VoteDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid != 0 {
+		n += 1 + sovBundles(uint64(m.Valid))
+	}
+	if m.Invalid != 0 {
+		n += 1 + sovBundles(uint64(m.Invalid))
+	}
+	if m.Abstain != 0 {
+		n += 1 + sovBundles(uint64(m.Abstain))
+	}
+	if m.Total != 0 {
+		n += 1 + sovBundles(uint64(m.Total))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBundles(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *ProposalSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	if m.SlashType != 0 {
+		n += 1 + sovBundles(uint64(m.SlashType))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovBundles(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *VoterReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	if len(m.RewardStaker) > 0 {
+		for _, e := range m.RewardStaker {
+			l = e.Size()
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	if len(m.RewardDelegation) > 0 {
+		for _, e := range m.RewardDelegation {
+			l = e.Size()
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConcludedBundleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovBundles(uint64(m.PoolId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovBundles(uint64(m.Id))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovBundles(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovBundles(uint64(m.ToHeight))
+	}
+	l = len(m.ToKey)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	if len(m.VotersValid) > 0 {
+		for _, s := range m.VotersValid {
+			l = len(s)
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	if len(m.VotersInvalid) > 0 {
+		for _, s := range m.VotersInvalid {
+			l = len(s)
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	if len(m.VotersAbstain) > 0 {
+		for _, s := range m.VotersAbstain {
+			l = len(s)
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	l = m.VoteDistribution.Size()
+	n += 1 + l + sovBundles(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovBundles(uint64(m.Status))
+	}
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovBundles(uint64(m.CreatedAt))
+	}
+	if m.ConcludedAt != 0 {
+		n += 2 + sovBundles(uint64(m.ConcludedAt))
+	}
+	return n
+}
+
+func sovBundles(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBundles(x uint64) (n int) {
+	return sovBundles(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BundleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextUploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
+			}
+			m.ByteSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ByteSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersValid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotersValid = append(m.VotersValid, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersInvalid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotersInvalid = append(m.VotersInvalid, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersAbstain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotersAbstain = append(m.VotersAbstain, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteCommits = append(m.VoteCommits, VoteCommit{})
+			if err := m.VoteCommits[len(m.VoteCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizedBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizedBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizedBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BundleProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
 			}
-			m.PoolId = 0
+			m.ToHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBundles
@@ -757,14 +2451,14 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.ToHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -792,11 +2486,11 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageId = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -824,11 +2518,11 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uploader = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextUploader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -856,9 +2550,28 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextUploader = string(dAtA[iNdEx:postIndex])
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedAt", wireType)
+			}
+			m.FinalizedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
 			}
@@ -877,11 +2590,61 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			m.ToHeight = 0
+			m.Valid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBundles
@@ -891,16 +2654,16 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ToHeight |= uint64(b&0x7F) << shift
+				m.Valid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalid", wireType)
 			}
-			var stringLen uint64
+			m.Invalid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBundles
@@ -910,29 +2673,54 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Invalid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBundles
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBundles
+			m.Abstain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Abstain |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.ToKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToValue", wireType)
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBundles
@@ -942,27 +2730,64 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= BundleStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBundles
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundles(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBundles
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -990,13 +2815,32 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundleHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashType", wireType)
+			}
+			m.SlashType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashType |= types.SlashType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.CreatedAt = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBundles
@@ -1006,14 +2850,64 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoterReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoterReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoterReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotersValid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1041,13 +2935,13 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VotersValid = append(m.VotersValid, string(dAtA[iNdEx:postIndex]))
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotersInvalid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardStaker", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBundles
@@ -1057,29 +2951,31 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBundles
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBundles
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VotersInvalid = append(m.VotersInvalid, string(dAtA[iNdEx:postIndex]))
+			m.RewardStaker = append(m.RewardStaker, types1.Coin{})
+			if err := m.RewardStaker[len(m.RewardStaker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotersAbstain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDelegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBundles
@@ -1089,23 +2985,25 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBundles
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBundles
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VotersAbstain = append(m.VotersAbstain, string(dAtA[iNdEx:postIndex]))
+			m.RewardDelegation = append(m.RewardDelegation, types1.Coin{})
+			if err := m.RewardDelegation[len(m.RewardDelegation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1128,7 +3026,7 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinalizedBundle) Unmarshal(dAtA []byte) error {
+func (m *ConcludedBundleProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1151,10 +3049,10 @@ func (m *FinalizedBundle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinalizedBundle: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConcludedBundleProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinalizedBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConcludedBundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1299,7 +3197,7 @@ func (m *FinalizedBundle) Unmarshal(dAtA []byte) error {
 			}
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1327,11 +3225,11 @@ func (m *FinalizedBundle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ToKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1359,11 +3257,11 @@ func (m *FinalizedBundle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersValid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1391,13 +3289,110 @@ func (m *FinalizedBundle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundleHash = string(dAtA[iNdEx:postIndex])
+			m.VotersValid = append(m.VotersValid, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersInvalid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotersInvalid = append(m.VotersInvalid, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersAbstain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotersAbstain = append(m.VotersAbstain, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.FinalizedAt = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBundles
@@ -1407,7 +3402,79 @@ func (m *FinalizedBundle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizedAt |= uint64(b&0x7F) << shift
+				m.Status |= BundleStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, ProposalSlash{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcludedAt", wireType)
+			}
+			m.ConcludedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcludedAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
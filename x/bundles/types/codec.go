@@ -13,6 +13,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubmitBundleProposal{}, "bundles/SubmitBundleProposal", nil)
 	cdc.RegisterConcrete(&MsgVoteBundleProposal{}, "bundles/VoteBundleProposal", nil)
 	cdc.RegisterConcrete(&MsgClaimUploaderRole{}, "bundles/ClaimUploaderRole", nil)
+	cdc.RegisterConcrete(&MsgCommitBundleVote{}, "bundles/CommitBundleVote", nil)
+	cdc.RegisterConcrete(&MsgRevealBundleVote{}, "bundles/RevealBundleVote", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -26,6 +28,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgClaimUploaderRole{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCommitBundleVote{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevealBundleVote{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
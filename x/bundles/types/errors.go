@@ -30,4 +30,11 @@ var (
 	ErrAlreadyVotedValid      = sdkerrors.Register(ModuleName, 1204, "already voted valid on bundle proposal")
 	ErrAlreadyVotedInvalid    = sdkerrors.Register(ModuleName, 1205, "already voted invalid on bundle proposal")
 	ErrAlreadyVotedAbstain    = sdkerrors.Register(ModuleName, 1206, "already voted abstain on bundle proposal")
+	ErrCommitRevealEnabled    = sdkerrors.Register(ModuleName, 1207, "pool requires commit-reveal voting")
+	ErrCommitRevealDisabled   = sdkerrors.Register(ModuleName, 1208, "pool does not use commit-reveal voting")
+	ErrCommitPhaseOver        = sdkerrors.Register(ModuleName, 1209, "commit phase of bundle proposal is over")
+	ErrNotInRevealPhase       = sdkerrors.Register(ModuleName, 1210, "bundle proposal is not in reveal phase")
+	ErrAlreadyCommitted       = sdkerrors.Register(ModuleName, 1211, "already committed a vote on bundle proposal")
+	ErrVoteCommitNotFound     = sdkerrors.Register(ModuleName, 1212, "no vote commit found for staker %v")
+	ErrInvalidVoteReveal      = sdkerrors.Register(ModuleName, 1213, "revealed vote does not match commit")
 )
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -95,6 +98,79 @@ func (m *EventBundleVote) GetVote() VoteType {
 	return VOTE_TYPE_UNSPECIFIED
 }
 
+// EventBundleVoteCommitted is an event emitted when a protocol node commits to a hidden vote.
+type EventBundleVoteCommitted struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker is the account staker of the protocol node.
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// storage_id is the unique ID of the bundle.
+	StorageId string `protobuf:"bytes,3,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
+	// commit_hash is the hash of the committed vote.
+	CommitHash string `protobuf:"bytes,4,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+}
+
+func (m *EventBundleVoteCommitted) Reset()         { *m = EventBundleVoteCommitted{} }
+func (m *EventBundleVoteCommitted) String() string { return proto.CompactTextString(m) }
+func (*EventBundleVoteCommitted) ProtoMessage()    {}
+func (*EventBundleVoteCommitted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02f505e55d81e92, []int{1}
+}
+func (m *EventBundleVoteCommitted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBundleVoteCommitted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBundleVoteCommitted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBundleVoteCommitted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBundleVoteCommitted.Merge(m, src)
+}
+func (m *EventBundleVoteCommitted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBundleVoteCommitted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBundleVoteCommitted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBundleVoteCommitted proto.InternalMessageInfo
+
+func (m *EventBundleVoteCommitted) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventBundleVoteCommitted) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *EventBundleVoteCommitted) GetStorageId() string {
+	if m != nil {
+		return m.StorageId
+	}
+	return ""
+}
+
+func (m *EventBundleVoteCommitted) GetCommitHash() string {
+	if m != nil {
+		return m.CommitHash
+	}
+	return ""
+}
+
 // EventBundleProposed ...
 type EventBundleProposed struct {
 	// pool_id ...
@@ -127,7 +203,7 @@ func (m *EventBundleProposed) Reset()         { *m = EventBundleProposed{} }
 func (m *EventBundleProposed) String() string { return proto.CompactTextString(m) }
 func (*EventBundleProposed) ProtoMessage()    {}
 func (*EventBundleProposed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a02f505e55d81e92, []int{1}
+	return fileDescriptor_a02f505e55d81e92, []int{2}
 }
 func (m *EventBundleProposed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,20 +333,22 @@ type EventBundleFinalized struct {
 	// status ...
 	Status BundleStatus `protobuf:"varint,7,opt,name=status,proto3,enum=kyve.bundles.v1beta1.BundleStatus" json:"status,omitempty"`
 	// rewardTreasury ...
-	RewardTreasury uint64 `protobuf:"varint,8,opt,name=reward_treasury,json=rewardTreasury,proto3" json:"reward_treasury,omitempty"`
+	RewardTreasury github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=reward_treasury,json=rewardTreasury,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_treasury"`
 	// rewardUploader ...
-	RewardUploader uint64 `protobuf:"varint,9,opt,name=reward_uploader,json=rewardUploader,proto3" json:"reward_uploader,omitempty"`
+	RewardUploader github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=reward_uploader,json=rewardUploader,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_uploader"`
 	// rewardDelegation ...
-	RewardDelegation uint64 `protobuf:"varint,10,opt,name=reward_delegation,json=rewardDelegation,proto3" json:"reward_delegation,omitempty"`
+	RewardDelegation github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=reward_delegation,json=rewardDelegation,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_delegation"`
 	// rewardTotal ...
-	RewardTotal uint64 `protobuf:"varint,11,opt,name=reward_total,json=rewardTotal,proto3" json:"reward_total,omitempty"`
+	RewardTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=reward_total,json=rewardTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_total"`
+	// reward_voters contains the payouts of all stakers who voted valid
+	RewardVoters []VoterReward `protobuf:"bytes,12,rep,name=reward_voters,json=rewardVoters,proto3" json:"reward_voters"`
 }
 
 func (m *EventBundleFinalized) Reset()         { *m = EventBundleFinalized{} }
 func (m *EventBundleFinalized) String() string { return proto.CompactTextString(m) }
 func (*EventBundleFinalized) ProtoMessage()    {}
 func (*EventBundleFinalized) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a02f505e55d81e92, []int{2}
+	return fileDescriptor_a02f505e55d81e92, []int{3}
 }
 func (m *EventBundleFinalized) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,32 +426,39 @@ func (m *EventBundleFinalized) GetStatus() BundleStatus {
 	return BUNDLE_STATUS_UNSPECIFIED
 }
 
-func (m *EventBundleFinalized) GetRewardTreasury() uint64 {
+func (m *EventBundleFinalized) GetRewardTreasury() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.RewardTreasury
 	}
-	return 0
+	return nil
 }
 
-func (m *EventBundleFinalized) GetRewardUploader() uint64 {
+func (m *EventBundleFinalized) GetRewardUploader() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.RewardUploader
 	}
-	return 0
+	return nil
 }
 
-func (m *EventBundleFinalized) GetRewardDelegation() uint64 {
+func (m *EventBundleFinalized) GetRewardDelegation() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.RewardDelegation
 	}
-	return 0
+	return nil
 }
 
-func (m *EventBundleFinalized) GetRewardTotal() uint64 {
+func (m *EventBundleFinalized) GetRewardTotal() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.RewardTotal
 	}
-	return 0
+	return nil
+}
+
+func (m *EventBundleFinalized) GetRewardVoters() []VoterReward {
+	if m != nil {
+		return m.RewardVoters
+	}
+	return nil
 }
 
 // EventSkippedUploaderRole is an event emitted when an uploader skips the upload
@@ -392,7 +477,7 @@ func (m *EventSkippedUploaderRole) Reset()         { *m = EventSkippedUploaderRo
 func (m *EventSkippedUploaderRole) String() string { return proto.CompactTextString(m) }
 func (*EventSkippedUploaderRole) ProtoMessage()    {}
 func (*EventSkippedUploaderRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a02f505e55d81e92, []int{3}
+	return fileDescriptor_a02f505e55d81e92, []int{4}
 }
 func (m *EventSkippedUploaderRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,6 +536,7 @@ func (m *EventSkippedUploaderRole) GetNewUploader() string {
 
 func init() {
 	proto.RegisterType((*EventBundleVote)(nil), "kyve.bundles.v1beta1.EventBundleVote")
+	proto.RegisterType((*EventBundleVoteCommitted)(nil), "kyve.bundles.v1beta1.EventBundleVoteCommitted")
 	proto.RegisterType((*EventBundleProposed)(nil), "kyve.bundles.v1beta1.EventBundleProposed")
 	proto.RegisterType((*EventBundleFinalized)(nil), "kyve.bundles.v1beta1.EventBundleFinalized")
 	proto.RegisterType((*EventSkippedUploaderRole)(nil), "kyve.bundles.v1beta1.EventSkippedUploaderRole")
@@ -459,47 +545,55 @@ func init() {
 func init() { proto.RegisterFile("kyve/bundles/v1beta1/events.proto", fileDescriptor_a02f505e55d81e92) }
 
 var fileDescriptor_a02f505e55d81e92 = []byte{
-	// 628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x5c,
-	0x10, 0xad, 0xf3, 0xb9, 0x49, 0x3c, 0xa9, 0xd2, 0xd6, 0x5f, 0x01, 0x53, 0x54, 0xd3, 0x66, 0x43,
-	0xa5, 0xa2, 0x44, 0x2d, 0x3b, 0x76, 0x54, 0xb4, 0x6a, 0x55, 0x09, 0x21, 0xb7, 0x54, 0x82, 0x8d,
-	0x75, 0x53, 0x0f, 0xf1, 0x55, 0x5c, 0x5f, 0xcb, 0x1e, 0x27, 0x4d, 0x9f, 0x02, 0x09, 0xf1, 0x4e,
-	0x2c, 0xbb, 0x64, 0xc1, 0x02, 0xb5, 0xcf, 0x81, 0x84, 0xee, 0x8f, 0x43, 0x84, 0x82, 0x80, 0xe5,
-	0x9c, 0x73, 0xc6, 0x73, 0xe6, 0x78, 0x74, 0x61, 0x6b, 0x38, 0x19, 0x61, 0xaf, 0x5f, 0xa6, 0x51,
-	0x82, 0x45, 0x6f, 0xb4, 0xdb, 0x47, 0x62, 0xbb, 0x3d, 0x1c, 0x61, 0x4a, 0x45, 0x37, 0xcb, 0x05,
-	0x09, 0x77, 0x4d, 0x4a, 0xba, 0x46, 0xd2, 0x35, 0x92, 0xf5, 0xce, 0xdc, 0xc6, 0x4a, 0xa5, 0x3a,
-	0xd7, 0x37, 0xe6, 0x6a, 0xe8, 0x4a, 0xd3, 0x9d, 0x4f, 0x16, 0x2c, 0x1f, 0xc8, 0x49, 0xfb, 0x4a,
-	0x71, 0x2e, 0x08, 0xdd, 0x07, 0xd0, 0xc8, 0x84, 0x48, 0x42, 0x1e, 0x79, 0xd6, 0xa6, 0xb5, 0x6d,
-	0x07, 0x75, 0x59, 0x1e, 0x47, 0xee, 0x7d, 0xa8, 0x17, 0xc4, 0x86, 0x98, 0x7b, 0xb5, 0x4d, 0x6b,
-	0xdb, 0x09, 0x4c, 0xe5, 0x6e, 0x00, 0x14, 0x24, 0x72, 0x36, 0x40, 0xd9, 0xf3, 0x9f, 0xe2, 0x1c,
-	0x83, 0x1c, 0x47, 0xee, 0x1e, 0xd8, 0x23, 0x41, 0xe8, 0xd9, 0x9b, 0xd6, 0x76, 0x7b, 0xcf, 0xef,
-	0xce, 0xdb, 0xa5, 0x2b, 0x27, 0x9f, 0x4d, 0x32, 0x0c, 0x94, 0xb6, 0xf3, 0xb5, 0x06, 0xff, 0xcf,
-	0xf8, 0x7a, 0x9d, 0x8b, 0x4c, 0x14, 0x18, 0xfd, 0xde, 0x5b, 0x1b, 0x6a, 0x3c, 0x52, 0xbe, 0xec,
-	0xa0, 0xc6, 0xa3, 0x3f, 0x79, 0x5a, 0x87, 0x66, 0x99, 0x25, 0x82, 0x45, 0x98, 0x2b, 0x5f, 0x4e,
-	0x30, 0xad, 0xdd, 0x47, 0xe0, 0xf4, 0x27, 0x84, 0x61, 0xc1, 0xaf, 0xd1, 0x5b, 0x54, 0x5f, 0x6c,
-	0x4a, 0xe0, 0x94, 0x5f, 0xa3, 0xfb, 0x18, 0x5a, 0xef, 0x73, 0x71, 0x19, 0xc6, 0xc8, 0x07, 0x31,
-	0x79, 0x75, 0x45, 0x83, 0x84, 0x8e, 0x14, 0x22, 0xbb, 0x49, 0x54, 0x74, 0x43, 0x77, 0x93, 0x30,
-	0xe4, 0x43, 0x68, 0xaa, 0xee, 0x21, 0x4e, 0xbc, 0xa6, 0x1a, 0xdb, 0x90, 0xf5, 0x09, 0x4e, 0xdc,
-	0x7b, 0x50, 0x27, 0xa1, 0x08, 0x47, 0x11, 0x8b, 0x24, 0x24, 0xbc, 0x06, 0x8b, 0x23, 0x96, 0x94,
-	0xe8, 0x81, 0x46, 0x55, 0x21, 0x5d, 0xe8, 0x00, 0xc3, 0x98, 0x15, 0xb1, 0xd7, 0x52, 0x1c, 0x68,
-	0xe8, 0x88, 0x15, 0xb1, 0x5c, 0xff, 0x22, 0x47, 0x46, 0x18, 0x85, 0x8c, 0xbc, 0x25, 0x65, 0xc3,
-	0x31, 0xc8, 0x0b, 0xea, 0x7c, 0xaf, 0xc1, 0xda, 0x4c, 0xbc, 0x87, 0x3c, 0x65, 0x09, 0xbf, 0xfe,
-	0x97, 0x7c, 0xb5, 0x2f, 0x13, 0xad, 0x1d, 0xe8, 0xc2, 0xf5, 0xa0, 0xc1, 0x53, 0x8d, 0xdb, 0x0a,
-	0xaf, 0x4a, 0xc9, 0xb0, 0x7e, 0x41, 0x8c, 0xa7, 0x26, 0xd2, 0xaa, 0x94, 0x5f, 0x22, 0x41, 0x2c,
-	0x31, 0x59, 0xea, 0xc2, 0x7d, 0xae, 0x6e, 0x8d, 0xca, 0x42, 0x65, 0xd8, 0xde, 0xeb, 0xcc, 0x3f,
-	0x1b, 0xed, 0xff, 0x54, 0x29, 0x03, 0xd3, 0xe1, 0x3e, 0x81, 0xe5, 0x1c, 0xc7, 0x2c, 0x8f, 0x42,
-	0xca, 0x91, 0x15, 0x65, 0xae, 0xc3, 0xb6, 0x83, 0xb6, 0x86, 0xcf, 0x0c, 0x3a, 0x23, 0x9c, 0x1e,
-	0x83, 0x33, 0x2b, 0x7c, 0x53, 0x9d, 0xc4, 0x0e, 0xac, 0x1a, 0x61, 0x84, 0x09, 0x0e, 0x18, 0x71,
-	0x91, 0xaa, 0x3f, 0x62, 0x07, 0x2b, 0x9a, 0x78, 0x39, 0xc5, 0xdd, 0x2d, 0x58, 0xaa, 0xc6, 0xab,
-	0xbd, 0x5a, 0x4a, 0xd7, 0x32, 0xb3, 0x25, 0xd4, 0xf9, 0x68, 0x81, 0xa7, 0xf2, 0x3f, 0x1d, 0xf2,
-	0x2c, 0xc3, 0xe9, 0xa0, 0x40, 0x24, 0xf8, 0xf7, 0xff, 0x60, 0x07, 0x56, 0xb3, 0x1c, 0x47, 0x5c,
-	0x94, 0xc5, 0xcf, 0x05, 0xf4, 0xa9, 0xaf, 0x54, 0xc4, 0x74, 0x85, 0x2d, 0x58, 0x4a, 0x71, 0x1c,
-	0xfe, 0x72, 0xf5, 0xad, 0x14, 0xc7, 0x95, 0x64, 0xff, 0xf0, 0xf3, 0xad, 0x6f, 0xdd, 0xdc, 0xfa,
-	0xd6, 0xb7, 0x5b, 0xdf, 0xfa, 0x70, 0xe7, 0x2f, 0xdc, 0xdc, 0xf9, 0x0b, 0x5f, 0xee, 0xfc, 0x85,
-	0x77, 0x4f, 0x07, 0x9c, 0xe2, 0xb2, 0xdf, 0xbd, 0x10, 0x97, 0xbd, 0x93, 0xb7, 0xe7, 0x07, 0xaf,
-	0x90, 0xc6, 0x22, 0x1f, 0xf6, 0x2e, 0x62, 0xc6, 0xd3, 0xde, 0xd5, 0xf4, 0x7d, 0xa1, 0x49, 0x86,
-	0x45, 0xbf, 0xae, 0xde, 0x96, 0x67, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xc9, 0x6f, 0x1f,
-	0xd9, 0x04, 0x00, 0x00,
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0xd3, 0xfc, 0x4e, 0x72, 0xd3, 0xd6, 0x37, 0xf7, 0x5e, 0xb7, 0x57, 0x75, 0xdb, 0xac,
+	0x22, 0xdd, 0x8b, 0x4d, 0xc3, 0x8e, 0x1d, 0x29, 0xad, 0x5a, 0x15, 0x21, 0xe4, 0x96, 0x4a, 0xb0,
+	0x89, 0x26, 0xf1, 0x21, 0x19, 0xc5, 0xf1, 0x58, 0x9e, 0x49, 0xd2, 0xf4, 0x15, 0xd8, 0x20, 0x21,
+	0x5e, 0x82, 0x27, 0xe9, 0xb2, 0x4b, 0x16, 0x08, 0x50, 0xfb, 0x20, 0xa0, 0xf9, 0xb1, 0x89, 0xaa,
+	0xf0, 0x27, 0x95, 0x55, 0x7c, 0xbe, 0x73, 0xce, 0x7c, 0x5f, 0xbe, 0x39, 0x3e, 0x46, 0xdb, 0xc3,
+	0xd9, 0x04, 0xdc, 0xee, 0x38, 0xf4, 0x03, 0x60, 0xee, 0x64, 0xa7, 0x0b, 0x1c, 0xef, 0xb8, 0x30,
+	0x81, 0x90, 0x33, 0x27, 0x8a, 0x29, 0xa7, 0x66, 0x5d, 0x94, 0x38, 0xba, 0xc4, 0xd1, 0x25, 0xeb,
+	0x76, 0x8f, 0xb2, 0x11, 0x65, 0x6e, 0x17, 0x33, 0x48, 0xfb, 0x7a, 0x94, 0x84, 0xaa, 0x6b, 0xbd,
+	0xde, 0xa7, 0x7d, 0x2a, 0x1f, 0x5d, 0xf1, 0xa4, 0xd1, 0xc6, 0x42, 0xba, 0xe4, 0x6c, 0x55, 0xb3,
+	0xb1, 0xb0, 0x86, 0x9f, 0xa9, 0x74, 0xe3, 0x8d, 0x81, 0x96, 0xf7, 0x84, 0xbe, 0xb6, 0xac, 0x38,
+	0xa5, 0x1c, 0xcc, 0x7f, 0x50, 0x31, 0xa2, 0x34, 0xe8, 0x10, 0xdf, 0x32, 0xb6, 0x8c, 0x66, 0xce,
+	0x2b, 0x88, 0xf0, 0xd0, 0x37, 0xff, 0x46, 0x05, 0xc6, 0xf1, 0x10, 0x62, 0x2b, 0xbb, 0x65, 0x34,
+	0xcb, 0x9e, 0x8e, 0xcc, 0x0d, 0x84, 0x18, 0xa7, 0x31, 0xee, 0x83, 0xe8, 0x59, 0x92, 0xb9, 0xb2,
+	0x46, 0x0e, 0x7d, 0xb3, 0x85, 0x72, 0x13, 0xca, 0xc1, 0xca, 0x6d, 0x19, 0xcd, 0x5a, 0xcb, 0x76,
+	0x16, 0x39, 0xe0, 0x08, 0xe6, 0x93, 0x59, 0x04, 0x9e, 0xac, 0x6d, 0xbc, 0x34, 0x90, 0x75, 0x43,
+	0xd7, 0x2e, 0x1d, 0x8d, 0x08, 0xe7, 0xe0, 0xdf, 0xba, 0xc0, 0x4d, 0x54, 0xe9, 0xc9, 0xc3, 0x3b,
+	0x03, 0xcc, 0x06, 0x52, 0x67, 0xd9, 0x43, 0x0a, 0x3a, 0xc0, 0x6c, 0xd0, 0x78, 0x9f, 0x45, 0x7f,
+	0xce, 0xa9, 0x79, 0x12, 0xd3, 0x88, 0xb2, 0xef, 0x09, 0xa9, 0xa1, 0x2c, 0xf1, 0xa5, 0x88, 0x9c,
+	0x97, 0x25, 0xfe, 0x8f, 0x04, 0xac, 0xa3, 0xd2, 0x38, 0x0a, 0x28, 0xf6, 0x21, 0xd6, 0xec, 0x69,
+	0x6c, 0xfe, 0x8b, 0xca, 0xdd, 0x19, 0x87, 0x0e, 0x23, 0xe7, 0x60, 0xe5, 0xe5, 0x89, 0x25, 0x01,
+	0x1c, 0x93, 0x73, 0x10, 0xca, 0x5f, 0xc4, 0x74, 0xd4, 0x19, 0x00, 0xe9, 0x0f, 0xb8, 0x55, 0x90,
+	0x69, 0x24, 0xa0, 0x03, 0x89, 0x88, 0x6e, 0x4e, 0x93, 0x74, 0x51, 0x75, 0x73, 0xaa, 0x93, 0x6b,
+	0xa8, 0x24, 0xbb, 0x87, 0x30, 0xb3, 0x4a, 0x92, 0xb6, 0x28, 0xe2, 0x23, 0x98, 0x99, 0x7f, 0xa1,
+	0x02, 0xa7, 0x32, 0x51, 0x96, 0x89, 0x3c, 0xa7, 0x02, 0xae, 0xa3, 0xfc, 0x04, 0x07, 0x63, 0xb0,
+	0x90, 0x42, 0x65, 0x20, 0x54, 0xa8, 0xeb, 0x54, 0xfe, 0x55, 0x94, 0x7f, 0x0a, 0x12, 0xfe, 0x89,
+	0xbf, 0xdf, 0x8b, 0x01, 0x73, 0xf0, 0x3b, 0x98, 0x5b, 0x55, 0x29, 0xa3, 0xac, 0x91, 0x07, 0xbc,
+	0xf1, 0x39, 0x8f, 0xea, 0x73, 0xf6, 0xee, 0x93, 0x10, 0x07, 0xe4, 0xfc, 0x57, 0xfc, 0x55, 0xba,
+	0xb4, 0xb5, 0x39, 0x4f, 0x05, 0xa6, 0x85, 0x8a, 0x24, 0x54, 0x78, 0x4e, 0xe2, 0x49, 0x28, 0x32,
+	0xb8, 0xcb, 0x38, 0x26, 0xa1, 0xb6, 0x34, 0x09, 0xc5, 0x49, 0x9c, 0x72, 0x1c, 0x68, 0x2f, 0x55,
+	0x60, 0xde, 0x97, 0x83, 0xc5, 0xc7, 0x4c, 0x7a, 0x58, 0x6b, 0x35, 0x16, 0x0f, 0xb1, 0xd2, 0x7f,
+	0x2c, 0x2b, 0x3d, 0xdd, 0x61, 0x72, 0xb4, 0x1c, 0xc3, 0x14, 0xc7, 0x7e, 0x87, 0xc7, 0x80, 0xd9,
+	0x38, 0x16, 0x66, 0x2f, 0x35, 0x2b, 0xad, 0x35, 0x47, 0xbd, 0xf5, 0x8e, 0x78, 0xeb, 0xd3, 0x33,
+	0x76, 0x29, 0x09, 0xdb, 0x77, 0x2f, 0x3e, 0x6c, 0x66, 0xde, 0x7e, 0xdc, 0x6c, 0xf6, 0x09, 0x1f,
+	0x8c, 0xbb, 0x4e, 0x8f, 0x8e, 0x5c, 0xbd, 0x22, 0xd4, 0xcf, 0x1d, 0xe6, 0x0f, 0x5d, 0x3e, 0x8b,
+	0x80, 0xc9, 0x06, 0xe6, 0xd5, 0x14, 0xc7, 0x89, 0xa6, 0x98, 0x63, 0x4d, 0x27, 0xab, 0xfc, 0xdb,
+	0x58, 0x9f, 0x26, 0xc3, 0x7a, 0x86, 0x56, 0x35, 0xab, 0x0f, 0x01, 0xf4, 0x31, 0x27, 0x34, 0xb4,
+	0xd0, 0xed, 0xf3, 0xae, 0x28, 0x96, 0x87, 0x29, 0x89, 0x19, 0xa2, 0x6a, 0xe2, 0xb2, 0xbc, 0xbe,
+	0xca, 0xed, 0x93, 0x56, 0xb4, 0xc5, 0x72, 0x22, 0x1e, 0xa1, 0x3f, 0x34, 0x9f, 0xd8, 0x57, 0x31,
+	0xb3, 0xaa, 0x92, 0x70, 0xfb, 0xdb, 0xdb, 0x2d, 0xf6, 0x64, 0x7d, 0x3b, 0x27, 0x88, 0x3d, 0xad,
+	0x56, 0x26, 0x58, 0xe3, 0x75, 0xb2, 0xee, 0x8e, 0x87, 0x24, 0x8a, 0x20, 0x35, 0xd4, 0xa3, 0x01,
+	0xfc, 0xfc, 0x5b, 0xf0, 0x1f, 0x5a, 0x8d, 0x62, 0x98, 0x10, 0x3a, 0x66, 0x5f, 0x6f, 0x5d, 0x2d,
+	0x9b, 0x95, 0x24, 0x91, 0x5e, 0xd5, 0x36, 0xaa, 0x86, 0x30, 0xed, 0xdc, 0xd8, 0x3b, 0x95, 0x10,
+	0xa6, 0x49, 0x49, 0x7b, 0xff, 0xe2, 0xca, 0x36, 0x2e, 0xaf, 0x6c, 0xe3, 0xd3, 0x95, 0x6d, 0xbc,
+	0xba, 0xb6, 0x33, 0x97, 0xd7, 0x76, 0xe6, 0xdd, 0xb5, 0x9d, 0x79, 0xfe, 0xff, 0x9c, 0x69, 0x47,
+	0xcf, 0x4e, 0xf7, 0x1e, 0x03, 0x9f, 0xd2, 0x78, 0xe8, 0xf6, 0x06, 0x98, 0x84, 0xee, 0x59, 0xfa,
+	0xbd, 0x91, 0xf6, 0x75, 0x0b, 0xf2, 0x5b, 0x73, 0xef, 0xcb, 0x00, 0xa7, 0x8b, 0x43, 0xe1, 0x1f,
+	0x07, 0x00, 0x00,
 }
 
 func (m *EventBundleVote) Marshal() (dAtA []byte, err error) {
@@ -549,6 +643,55 @@ func (m *EventBundleVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBundleVoteCommitted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBundleVoteCommitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBundleVoteCommitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommitHash) > 0 {
+		i -= len(m.CommitHash)
+		copy(dAtA[i:], m.CommitHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CommitHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBundleProposed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -664,25 +807,75 @@ func (m *EventBundleFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.RewardTotal != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.RewardTotal))
-		i--
-		dAtA[i] = 0x58
+	if len(m.RewardVoters) > 0 {
+		for iNdEx := len(m.RewardVoters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardVoters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	if m.RewardDelegation != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.RewardDelegation))
-		i--
-		dAtA[i] = 0x50
+	if len(m.RewardTotal) > 0 {
+		for iNdEx := len(m.RewardTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	if m.RewardUploader != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.RewardUploader))
-		i--
-		dAtA[i] = 0x48
+	if len(m.RewardDelegation) > 0 {
+		for iNdEx := len(m.RewardDelegation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardDelegation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.RewardTreasury != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.RewardTreasury))
-		i--
-		dAtA[i] = 0x40
+	if len(m.RewardUploader) > 0 {
+		for iNdEx := len(m.RewardUploader) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardUploader[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RewardTreasury) > 0 {
+		for iNdEx := len(m.RewardTreasury) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardTreasury[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
 	if m.Status != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
@@ -803,6 +996,30 @@ func (m *EventBundleVote) Size() (n int) {
 	return n
 }
 
+func (m *EventBundleVoteCommitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CommitHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventBundleProposed) Size() (n int) {
 	if m == nil {
 		return 0
@@ -881,17 +1098,35 @@ func (m *EventBundleFinalized) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovEvents(uint64(m.Status))
 	}
-	if m.RewardTreasury != 0 {
-		n += 1 + sovEvents(uint64(m.RewardTreasury))
+	if len(m.RewardTreasury) > 0 {
+		for _, e := range m.RewardTreasury {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
 	}
-	if m.RewardUploader != 0 {
-		n += 1 + sovEvents(uint64(m.RewardUploader))
+	if len(m.RewardUploader) > 0 {
+		for _, e := range m.RewardUploader {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
 	}
-	if m.RewardDelegation != 0 {
-		n += 1 + sovEvents(uint64(m.RewardDelegation))
+	if len(m.RewardDelegation) > 0 {
+		for _, e := range m.RewardDelegation {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
 	}
-	if m.RewardTotal != 0 {
-		n += 1 + sovEvents(uint64(m.RewardTotal))
+	if len(m.RewardTotal) > 0 {
+		for _, e := range m.RewardTotal {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RewardVoters) > 0 {
+		for _, e := range m.RewardVoters {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
 	}
 	return n
 }
@@ -1077,6 +1312,171 @@ func (m *EventBundleVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBundleVoteCommitted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBundleVoteCommitted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBundleVoteCommitted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventBundleProposed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1596,10 +1996,10 @@ func (m *EventBundleFinalized) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 8:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RewardTreasury", wireType)
 			}
-			m.RewardTreasury = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1609,16 +2009,31 @@ func (m *EventBundleFinalized) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardTreasury |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardTreasury = append(m.RewardTreasury, types.Coin{})
+			if err := m.RewardTreasury[len(m.RewardTreasury)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 9:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RewardUploader", wireType)
 			}
-			m.RewardUploader = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1628,16 +2043,31 @@ func (m *EventBundleFinalized) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardUploader |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardUploader = append(m.RewardUploader, types.Coin{})
+			if err := m.RewardUploader[len(m.RewardUploader)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 10:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RewardDelegation", wireType)
 			}
-			m.RewardDelegation = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1647,16 +2077,31 @@ func (m *EventBundleFinalized) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardDelegation |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDelegation = append(m.RewardDelegation, types.Coin{})
+			if err := m.RewardDelegation[len(m.RewardDelegation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 11:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RewardTotal", wireType)
 			}
-			m.RewardTotal = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1666,11 +2111,60 @@ func (m *EventBundleFinalized) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardTotal |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardTotal = append(m.RewardTotal, types.Coin{})
+			if err := m.RewardTotal[len(m.RewardTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVoters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardVoters = append(m.RewardVoters, VoterReward{})
+			if err := m.RewardVoters[len(m.RewardVoters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
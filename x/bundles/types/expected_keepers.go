@@ -31,7 +31,12 @@ type PoolKeeper interface {
 	IncrementBundleInformation(ctx sdk.Context, poolId uint64, currentHeight uint64, currentKey string, currentValue string)
 
 	GetAllPools(ctx sdk.Context) (list []pooltypes.Pool)
-	ChargeFundersOfPool(ctx sdk.Context, poolId uint64, amount uint64) error
+	ChargeFundersOfPool(ctx sdk.Context, poolId uint64, amount uint64) (payout sdk.Coins, err error)
+
+	SetUploadDeadline(ctx sdk.Context, poolId uint64, deadline uint64)
+	LowerUploadDeadline(ctx sdk.Context, poolId uint64, deadline uint64)
+	RemoveUploadDeadline(ctx sdk.Context, poolId uint64)
+	GetExpiredUploadDeadlines(ctx sdk.Context, time uint64) (poolIds []uint64)
 }
 
 type StakerKeeper interface {
@@ -45,6 +50,9 @@ type StakerKeeper interface {
 
 	DoesStakerExist(ctx sdk.Context, staker string) bool
 
+	JailValaccount(ctx sdk.Context, poolId uint64, stakerAddress string) (removed bool)
+	IsValaccountJailed(ctx sdk.Context, poolId uint64, stakerAddress string) bool
+
 	// TODO replace exported mutation from getters file
 	GetValaccount(ctx sdk.Context, poolId uint64, stakerAddress string) (val stakertypes.Valaccount, found bool)
 	RemoveValaccountFromPool(ctx sdk.Context, poolId uint64, stakerAddress string)
@@ -53,6 +61,6 @@ type StakerKeeper interface {
 type DelegationKeeper interface {
 	GetDelegationAmount(ctx sdk.Context, staker string) uint64
 	GetDelegationOfPool(ctx sdk.Context, poolId uint64) uint64
-	PayoutRewards(ctx sdk.Context, staker string, amount uint64, payerModuleName string) (success bool)
-	SlashDelegators(ctx sdk.Context, staker string, slashType stakertypes.SlashType)
+	PayoutRewards(ctx sdk.Context, staker string, amount sdk.Coins, payerModuleName string) (success bool)
+	SlashDelegators(ctx sdk.Context, poolId uint64, staker string, slashType stakertypes.SlashType, bundleId uint64, hasBundle bool) (slashedAmount uint64)
 }
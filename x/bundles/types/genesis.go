@@ -53,5 +53,22 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Concluded bundle proposals
+	concludedBundleProposals := make(map[string]struct{})
+	concludedBundleProposalsPerPool := make(map[uint64]int)
+
+	for _, elem := range gs.ConcludedBundleProposalList {
+		index := string(ConcludedBundleProposalKey(elem.PoolId, elem.Id))
+		if _, ok := concludedBundleProposals[index]; ok {
+			return fmt.Errorf("duplicated index for concluded bundle proposal %v", elem)
+		}
+		concludedBundleProposals[index] = struct{}{}
+
+		concludedBundleProposalsPerPool[elem.PoolId] += 1
+		if concludedBundleProposalsPerPool[elem.PoolId] > MaxConcludedBundleProposals {
+			return fmt.Errorf("too many concluded bundle proposals for pool %v", elem.PoolId)
+		}
+	}
+
 	return gs.Params.Validate()
 }
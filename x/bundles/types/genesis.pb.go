@@ -31,6 +31,8 @@ type GenesisState struct {
 	BundleProposalList []BundleProposal `protobuf:"bytes,2,rep,name=bundle_proposal_list,json=bundleProposalList,proto3" json:"bundle_proposal_list"`
 	// finalized_bundle_list ...
 	FinalizedBundleList []FinalizedBundle `protobuf:"bytes,3,rep,name=finalized_bundle_list,json=finalizedBundleList,proto3" json:"finalized_bundle_list"`
+	// concluded_bundle_proposal_list ...
+	ConcludedBundleProposalList []ConcludedBundleProposal `protobuf:"bytes,4,rep,name=concluded_bundle_proposal_list,json=concludedBundleProposalList,proto3" json:"concluded_bundle_proposal_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +89,13 @@ func (m *GenesisState) GetFinalizedBundleList() []FinalizedBundle {
 	return nil
 }
 
+func (m *GenesisState) GetConcludedBundleProposalList() []ConcludedBundleProposal {
+	if m != nil {
+		return m.ConcludedBundleProposalList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.bundles.v1beta1.GenesisState")
 }
@@ -96,26 +105,28 @@ func init() {
 }
 
 var fileDescriptor_21c07b409d3bb015 = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0xae, 0x2c, 0x4b,
-	0xd5, 0x4f, 0x2a, 0xcd, 0x4b, 0xc9, 0x49, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x01, 0xa9, 0xd1, 0x83, 0xaa, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0xb0, 0x9b, 0x07, 0xd3, 0x0b, 0x51, 0xa3, 0x88, 0x55, 0x4d,
-	0x41, 0x62, 0x51, 0x62, 0x2e, 0x54, 0x89, 0x52, 0x27, 0x13, 0x17, 0x8f, 0x3b, 0xc4, 0x11, 0xc1,
-	0x25, 0x89, 0x25, 0xa9, 0x42, 0x56, 0x5c, 0x6c, 0x10, 0x05, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc,
-	0x46, 0x32, 0x7a, 0xd8, 0x1c, 0xa5, 0x17, 0x00, 0x56, 0xe3, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43,
-	0x10, 0x54, 0x87, 0x50, 0x0c, 0x97, 0x08, 0x44, 0x5d, 0x7c, 0x41, 0x51, 0x7e, 0x41, 0x7e, 0x71,
-	0x62, 0x4e, 0x7c, 0x4e, 0x66, 0x71, 0x89, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x0a, 0x76,
-	0x93, 0x9c, 0xc0, 0xfc, 0x00, 0xa8, 0x06, 0xa8, 0x89, 0x42, 0x49, 0x28, 0xa2, 0x3e, 0x99, 0xc5,
-	0x25, 0x42, 0xf1, 0x5c, 0xa2, 0x69, 0x99, 0x79, 0x89, 0x39, 0x99, 0x55, 0xa9, 0x29, 0xf1, 0x50,
-	0x7b, 0xc0, 0xc6, 0x33, 0x83, 0x8d, 0x57, 0xc5, 0x6e, 0xbc, 0x1b, 0x4c, 0x0b, 0xc4, 0x1e, 0xa8,
-	0xf9, 0xc2, 0x69, 0xa8, 0xc2, 0x20, 0x0b, 0x9c, 0xdc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x27, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf,
-	0x3b, 0x32, 0xcc, 0xd5, 0x2f, 0xb5, 0xa4, 0x3c, 0xbf, 0x28, 0x5b, 0x3f, 0x39, 0x23, 0x31, 0x33,
-	0x4f, 0xbf, 0x02, 0x1e, 0xc4, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xa0, 0x35, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xed, 0x45, 0xe0, 0xf3, 0x01, 0x00, 0x00,
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x4f, 0xc2, 0x30,
+	0x18, 0x86, 0x37, 0x21, 0x1c, 0x8a, 0xa7, 0x89, 0x09, 0x41, 0x53, 0x91, 0x68, 0xc2, 0x41, 0xdb,
+	0x80, 0x37, 0x8f, 0x18, 0xf1, 0xa0, 0x31, 0x44, 0x13, 0x13, 0x8d, 0xc9, 0xd2, 0x8d, 0x32, 0x1a,
+	0xc6, 0xba, 0xac, 0x05, 0xc1, 0xab, 0x7f, 0xc0, 0x9f, 0xc5, 0x91, 0xa3, 0x27, 0x63, 0xe0, 0x8f,
+	0x18, 0xda, 0xa2, 0x59, 0xd2, 0xdb, 0xd6, 0x3e, 0xef, 0xfb, 0xf4, 0xcb, 0x07, 0x1a, 0xa3, 0xf9,
+	0x94, 0xe2, 0x60, 0x92, 0xf4, 0x63, 0x2a, 0xf0, 0xb4, 0x15, 0x50, 0x49, 0x5a, 0x38, 0xa2, 0x09,
+	0x15, 0x4c, 0xa0, 0x34, 0xe3, 0x92, 0x7b, 0x95, 0x0d, 0x83, 0x0c, 0x83, 0x0c, 0x53, 0xab, 0x44,
+	0x3c, 0xe2, 0x0a, 0xc0, 0x9b, 0x2f, 0xcd, 0xd6, 0xec, 0x7d, 0xdb, 0xac, 0x66, 0x8e, 0xad, 0x4c,
+	0x4a, 0x32, 0x32, 0x36, 0x48, 0xe3, 0xa3, 0x00, 0x76, 0x6f, 0xf4, 0x23, 0x1e, 0x25, 0x91, 0xd4,
+	0xbb, 0x04, 0x25, 0x0d, 0x54, 0xdd, 0xba, 0xdb, 0x2c, 0xb7, 0x0f, 0x91, 0xed, 0x51, 0xa8, 0xa7,
+	0x98, 0x4e, 0x71, 0xf1, 0x7d, 0xe4, 0x3c, 0x98, 0x84, 0xf7, 0x0a, 0x2a, 0x9a, 0xf3, 0xd3, 0x8c,
+	0xa7, 0x5c, 0x90, 0xd8, 0x8f, 0x99, 0x90, 0xd5, 0x9d, 0x7a, 0xa1, 0x59, 0x6e, 0x9f, 0xd8, 0x9b,
+	0x3a, 0xea, 0xbf, 0x67, 0x02, 0xa6, 0xd1, 0x0b, 0x72, 0xa7, 0x77, 0x4c, 0x48, 0xcf, 0x07, 0xfb,
+	0x03, 0x96, 0x90, 0x98, 0xbd, 0xd3, 0xbe, 0x6f, 0x3c, 0xaa, 0xbe, 0xa0, 0xea, 0x4f, 0xed, 0xf5,
+	0xdd, 0x6d, 0x44, 0x7b, 0x4c, 0xff, 0xde, 0x20, 0x7f, 0xac, 0x04, 0x33, 0x00, 0x43, 0x9e, 0x84,
+	0xf1, 0xa4, 0xff, 0x2f, 0xc8, 0x0f, 0x52, 0x54, 0xa6, 0x73, 0xbb, 0xe9, 0x6a, 0x9b, 0xb5, 0x4e,
+	0x74, 0x10, 0xda, 0xaf, 0x37, 0xe6, 0x4e, 0x77, 0xb1, 0x82, 0xee, 0x72, 0x05, 0xdd, 0x9f, 0x15,
+	0x74, 0x3f, 0xd7, 0xd0, 0x59, 0xae, 0xa1, 0xf3, 0xb5, 0x86, 0xce, 0xcb, 0x59, 0xc4, 0xe4, 0x70,
+	0x12, 0xa0, 0x90, 0x8f, 0xf1, 0xed, 0xf3, 0xd3, 0xf5, 0x3d, 0x95, 0x6f, 0x3c, 0x1b, 0xe1, 0x70,
+	0x48, 0x58, 0x82, 0x67, 0x7f, 0xcb, 0x95, 0xf3, 0x94, 0x8a, 0xa0, 0xa4, 0x96, 0x7a, 0xf1, 0x3b,
+	0x00, 0xc3, 0xbd, 0x83, 0x5a, 0x6d, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +149,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConcludedBundleProposalList) > 0 {
+		for iNdEx := len(m.ConcludedBundleProposalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConcludedBundleProposalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.FinalizedBundleList) > 0 {
 		for iNdEx := len(m.FinalizedBundleList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -210,6 +235,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ConcludedBundleProposalList) > 0 {
+		for _, e := range m.ConcludedBundleProposalList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -349,6 +380,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcludedBundleProposalList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcludedBundleProposalList = append(m.ConcludedBundleProposalList, ConcludedBundleProposal{})
+			if err := m.ConcludedBundleProposalList[len(m.ConcludedBundleProposalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,11 +22,19 @@ const (
 	MemStoreKey = "mem_bundles"
 )
 
+const (
+	// MaxConcludedBundleProposals is the maximum amount of concluded
+	// bundle proposals which are kept in the history of each pool
+	MaxConcludedBundleProposals = 100
+)
+
 var (
 	// BundleKeyPrefix ...
 	BundleKeyPrefix = []byte{1}
 	// FinalizedBundlePrefix ...
 	FinalizedBundlePrefix = []byte{2}
+	// ConcludedBundleProposalPrefix ...
+	ConcludedBundleProposalPrefix = []byte{3}
 
 	FinalizedBundleByStorageIdPrefix = []byte{10}
 	FinalizedBundleByHeightPrefix    = []byte{11}
@@ -61,3 +69,8 @@ func FinalizedBundlePoolIdAndIdToValue(bytes []byte) (poolId uint64, id uint64)
 func FinalizedBundleByHeightKey(poolId uint64, height uint64) []byte {
 	return util.GetByteKey(poolId, height)
 }
+
+// ConcludedBundleProposalKey ...
+func ConcludedBundleProposalKey(poolId uint64, id uint64) []byte {
+	return util.GetByteKey(poolId, id)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCommitBundleVote = "commit_bundle_vote"
+
+var _ sdk.Msg = &MsgCommitBundleVote{}
+
+func NewMsgCommitBundleVote(creator string, staker string, poolId uint64, storageId string, commitHash string) *MsgCommitBundleVote {
+	return &MsgCommitBundleVote{
+		Creator:    creator,
+		Staker:     staker,
+		PoolId:     poolId,
+		StorageId:  storageId,
+		CommitHash: commitHash,
+	}
+}
+
+func (msg *MsgCommitBundleVote) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCommitBundleVote) Type() string {
+	return TypeMsgCommitBundleVote
+}
+
+func (msg *MsgCommitBundleVote) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCommitBundleVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCommitBundleVote) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if hash, err := hex.DecodeString(msg.CommitHash); err != nil || len(hash) != 32 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid commit hash (%s)", msg.CommitHash)
+	}
+
+	return nil
+}
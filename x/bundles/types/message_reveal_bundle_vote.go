@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRevealBundleVote = "reveal_bundle_vote"
+
+var _ sdk.Msg = &MsgRevealBundleVote{}
+
+func NewMsgRevealBundleVote(creator string, staker string, poolId uint64, storageId string, vote VoteType, salt string) *MsgRevealBundleVote {
+	return &MsgRevealBundleVote{
+		Creator:   creator,
+		Staker:    staker,
+		PoolId:    poolId,
+		StorageId: storageId,
+		Vote:      vote,
+		Salt:      salt,
+	}
+}
+
+func (msg *MsgRevealBundleVote) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevealBundleVote) Type() string {
+	return TypeMsgRevealBundleVote
+}
+
+func (msg *MsgRevealBundleVote) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevealBundleVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevealBundleVote) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Staker); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid staker address (%s)", err)
+	}
+
+	if msg.StorageId == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "storage id must not be empty")
+	}
+
+	if salt, err := hex.DecodeString(msg.Salt); err != nil || len(salt) != VoteSaltSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "salt has to be %v hex encoded bytes (%s)", VoteSaltSize, msg.Salt)
+	}
+
+	return nil
+}
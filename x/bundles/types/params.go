@@ -28,6 +28,16 @@ var (
 	DefaultMaxPoints uint64 = 5
 )
 
+var (
+	KeyRevealInterval            = []byte("RevealInterval")
+	DefaultRevealInterval uint64 = 60
+)
+
+var (
+	KeyVoterRewardShare            = []byte("VoterRewardShare")
+	DefaultVoterRewardShare string = "0"
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -39,12 +49,16 @@ func NewParams(
 	storageCost uint64,
 	networkFee string,
 	maxPoints uint64,
+	revealInterval uint64,
+	voterRewardShare string,
 ) Params {
 	return Params{
-		UploadTimeout: uploadTimeout,
-		StorageCost:   storageCost,
-		NetworkFee:    networkFee,
-		MaxPoints:     maxPoints,
+		UploadTimeout:    uploadTimeout,
+		StorageCost:      storageCost,
+		NetworkFee:       networkFee,
+		MaxPoints:        maxPoints,
+		RevealInterval:   revealInterval,
+		VoterRewardShare: voterRewardShare,
 	}
 }
 
@@ -55,6 +69,8 @@ func DefaultParams() Params {
 		DefaultStorageCost,
 		DefaultNetworkFee,
 		DefaultMaxPoints,
+		DefaultRevealInterval,
+		DefaultVoterRewardShare,
 	)
 }
 
@@ -65,6 +81,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyStorageCost, &p.StorageCost, util.ValidateUint64),
 		paramtypes.NewParamSetPair(KeyNetworkFee, &p.NetworkFee, util.ValidatePercentage),
 		paramtypes.NewParamSetPair(KeyMaxPoints, &p.MaxPoints, util.ValidateUint64),
+		paramtypes.NewParamSetPair(KeyRevealInterval, &p.RevealInterval, util.ValidateUint64),
+		paramtypes.NewParamSetPair(KeyVoterRewardShare, &p.VoterRewardShare, util.ValidatePercentage),
 	}
 }
 
@@ -87,6 +105,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := util.ValidateUint64(p.RevealInterval); err != nil {
+		return err
+	}
+
+	if err := util.ValidatePercentage(p.VoterRewardShare); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -33,6 +33,10 @@ type Params struct {
 	NetworkFee string `protobuf:"bytes,3,opt,name=network_fee,json=networkFee,proto3" json:"network_fee,omitempty"`
 	// max_points ...
 	MaxPoints uint64 `protobuf:"varint,4,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	// reveal_interval ...
+	RevealInterval uint64 `protobuf:"varint,5,opt,name=reveal_interval,json=revealInterval,proto3" json:"reveal_interval,omitempty"`
+	// voter_reward_share ...
+	VoterRewardShare string `protobuf:"bytes,6,opt,name=voter_reward_share,json=voterRewardShare,proto3" json:"voter_reward_share,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -95,6 +99,20 @@ func (m *Params) GetMaxPoints() uint64 {
 	return 0
 }
 
+func (m *Params) GetRevealInterval() uint64 {
+	if m != nil {
+		return m.RevealInterval
+	}
+	return 0
+}
+
+func (m *Params) GetVoterRewardShare() string {
+	if m != nil {
+		return m.VoterRewardShare
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kyve.bundles.v1beta1.Params")
 }
@@ -102,25 +120,28 @@ func init() {
 func init() { proto.RegisterFile("kyve/bundles/v1beta1/params.proto", fileDescriptor_cfd3a74b72a01aaa) }
 
 var fileDescriptor_cfd3a74b72a01aaa = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0xd0, 0x31, 0x4b, 0xc3, 0x40,
-	0x18, 0xc6, 0xf1, 0x9c, 0x86, 0x42, 0xaf, 0xea, 0x10, 0x3a, 0x04, 0xc1, 0x6b, 0x2b, 0x08, 0x1d,
-	0x24, 0x47, 0x71, 0x73, 0x54, 0xec, 0x22, 0x48, 0x29, 0x22, 0xe8, 0x12, 0x2e, 0xe9, 0x6b, 0x1a,
-	0xda, 0xe4, 0x0d, 0xb9, 0x37, 0x35, 0xfd, 0x16, 0x8e, 0x1d, 0xfd, 0x38, 0x8e, 0x1d, 0x1d, 0x25,
-	0xf9, 0x22, 0xd2, 0x4b, 0x70, 0x3b, 0xfe, 0xfc, 0xee, 0x81, 0x3b, 0x3e, 0x5a, 0x6d, 0x37, 0x20,
-	0x83, 0x22, 0x5d, 0xac, 0x41, 0xcb, 0xcd, 0x24, 0x00, 0x52, 0x13, 0x99, 0xa9, 0x5c, 0x25, 0xda,
-	0xcb, 0x72, 0x24, 0x74, 0xfa, 0x07, 0xe2, 0xb5, 0xc4, 0x6b, 0xc9, 0x79, 0x3f, 0xc2, 0x08, 0x0d,
-	0x90, 0x87, 0x53, 0x63, 0x2f, 0x77, 0x8c, 0x77, 0x66, 0xe6, 0xb2, 0x73, 0xc5, 0xcf, 0x8a, 0x6c,
-	0x8d, 0x6a, 0xe1, 0x53, 0x9c, 0x00, 0x16, 0xe4, 0xb2, 0x21, 0x1b, 0xdb, 0xf3, 0xd3, 0xa6, 0x3e,
-	0x37, 0xd1, 0x19, 0xf1, 0x13, 0x4d, 0x98, 0xab, 0x08, 0xfc, 0x10, 0x35, 0xb9, 0x47, 0x06, 0xf5,
-	0xda, 0x76, 0x8f, 0x9a, 0x9c, 0x01, 0xef, 0xa5, 0x40, 0x1f, 0x98, 0xaf, 0xfc, 0x77, 0x00, 0xf7,
-	0x78, 0xc8, 0xc6, 0xdd, 0x39, 0x6f, 0xd3, 0x14, 0xc0, 0xb9, 0xe0, 0x3c, 0x51, 0xa5, 0x9f, 0x61,
-	0x9c, 0x92, 0x76, 0x6d, 0xb3, 0xd0, 0x4d, 0x54, 0x39, 0x33, 0xe1, 0xd6, 0xde, 0x7d, 0x0d, 0xac,
-	0xbb, 0xe9, 0x77, 0x25, 0xd8, 0xbe, 0x12, 0xec, 0xb7, 0x12, 0xec, 0xb3, 0x16, 0xd6, 0xbe, 0x16,
-	0xd6, 0x4f, 0x2d, 0xac, 0xb7, 0xeb, 0x28, 0xa6, 0x65, 0x11, 0x78, 0x21, 0x26, 0xf2, 0xf1, 0xf5,
-	0xe5, 0xe1, 0xa9, 0x59, 0x96, 0xe1, 0x52, 0xc5, 0xa9, 0x2c, 0xff, 0x7f, 0x87, 0xb6, 0x19, 0xe8,
-	0xa0, 0x63, 0x5e, 0x7a, 0xf3, 0x17, 0x00, 0x00, 0xff, 0xff, 0xfc, 0xd7, 0xd0, 0x0c, 0x3a, 0x01,
-	0x00, 0x00,
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x33, 0xf7, 0xf6, 0x16, 0x3a, 0xbd, 0xb7, 0x57, 0x86, 0x2e, 0x82, 0x60, 0xda, 0x0a,
+	0x62, 0x17, 0x25, 0x43, 0x71, 0xe7, 0x52, 0xb1, 0x20, 0x82, 0x94, 0x2a, 0x82, 0x6e, 0x86, 0x49,
+	0x7b, 0x4c, 0x43, 0x93, 0x4c, 0x98, 0x39, 0x49, 0xdb, 0xb7, 0x70, 0xe9, 0xd2, 0xc7, 0x71, 0xd9,
+	0xa5, 0x4b, 0x69, 0x1f, 0xc0, 0x57, 0x90, 0x4e, 0x82, 0xbb, 0xe1, 0xfb, 0xbf, 0x39, 0xe7, 0xf0,
+	0xd3, 0xde, 0x62, 0x5d, 0x00, 0x0f, 0xf2, 0x74, 0x16, 0x83, 0xe1, 0xc5, 0x30, 0x00, 0x94, 0x43,
+	0x9e, 0x49, 0x2d, 0x13, 0xe3, 0x67, 0x5a, 0xa1, 0x62, 0xed, 0xbd, 0xe2, 0x57, 0x8a, 0x5f, 0x29,
+	0x87, 0xed, 0x50, 0x85, 0xca, 0x0a, 0x7c, 0xff, 0x2a, 0xdd, 0xe3, 0x2f, 0x42, 0xeb, 0x63, 0xfb,
+	0x99, 0x9d, 0xd0, 0x56, 0x9e, 0xc5, 0x4a, 0xce, 0x04, 0x46, 0x09, 0xa8, 0x1c, 0x5d, 0xd2, 0x25,
+	0xfd, 0xda, 0xe4, 0x5f, 0x49, 0xef, 0x4b, 0xc8, 0x7a, 0xf4, 0xaf, 0x41, 0xa5, 0x65, 0x08, 0x62,
+	0xaa, 0x0c, 0xba, 0xbf, 0xac, 0xd4, 0xac, 0xd8, 0xa5, 0x32, 0xc8, 0x3a, 0xb4, 0x99, 0x02, 0x2e,
+	0x95, 0x5e, 0x88, 0x67, 0x00, 0xf7, 0x77, 0x97, 0xf4, 0x1b, 0x13, 0x5a, 0xa1, 0x11, 0x00, 0x3b,
+	0xa2, 0x34, 0x91, 0x2b, 0x91, 0xa9, 0x28, 0x45, 0xe3, 0xd6, 0xec, 0x84, 0x46, 0x22, 0x57, 0x63,
+	0x0b, 0xd8, 0x29, 0xfd, 0xaf, 0xa1, 0x00, 0x19, 0x8b, 0x28, 0x45, 0xd0, 0x85, 0x8c, 0xdd, 0x3f,
+	0xd6, 0x69, 0x95, 0xf8, 0xba, 0xa2, 0x6c, 0x40, 0x59, 0xa1, 0x10, 0xb4, 0xd0, 0xb0, 0x94, 0x7a,
+	0x26, 0xcc, 0x5c, 0x6a, 0x70, 0xeb, 0x76, 0xdf, 0x81, 0x4d, 0x26, 0x36, 0xb8, 0xdb, 0xf3, 0xf3,
+	0xda, 0xeb, 0x5b, 0xc7, 0xb9, 0x18, 0xbd, 0x6f, 0x3d, 0xb2, 0xd9, 0x7a, 0xe4, 0x73, 0xeb, 0x91,
+	0x97, 0x9d, 0xe7, 0x6c, 0x76, 0x9e, 0xf3, 0xb1, 0xf3, 0x9c, 0xa7, 0x41, 0x18, 0xe1, 0x3c, 0x0f,
+	0xfc, 0xa9, 0x4a, 0xf8, 0xcd, 0xe3, 0xc3, 0xd5, 0x6d, 0x79, 0x30, 0x9f, 0xce, 0x65, 0x94, 0xf2,
+	0xd5, 0x4f, 0xe9, 0xb8, 0xce, 0xc0, 0x04, 0x75, 0x5b, 0xe0, 0xd9, 0xf7, 0x00, 0xf8, 0xbd, 0x60,
+	0x92, 0x91, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -143,6 +164,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoterRewardShare) > 0 {
+		i -= len(m.VoterRewardShare)
+		copy(dAtA[i:], m.VoterRewardShare)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.VoterRewardShare)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RevealInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RevealInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.MaxPoints != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxPoints))
 		i--
@@ -198,6 +231,13 @@ func (m *Params) Size() (n int) {
 	if m.MaxPoints != 0 {
 		n += 1 + sovParams(uint64(m.MaxPoints))
 	}
+	if m.RevealInterval != 0 {
+		n += 1 + sovParams(uint64(m.RevealInterval))
+	}
+	l = len(m.VoterRewardShare)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -325,6 +365,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealInterval", wireType)
+			}
+			m.RevealInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterRewardShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterRewardShare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,212 +113,2733 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "kyve.bundles.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kyve.bundles.v1beta1.QueryParamsResponse")
+// QueryBundleProposalRequest is request type for the Query/BundleProposal RPC method.
+type QueryBundleProposalRequest struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("kyve/bundles/v1beta1/query.proto", fileDescriptor_417b774a70d5f5fd) }
+func (m *QueryBundleProposalRequest) Reset()         { *m = QueryBundleProposalRequest{} }
+func (m *QueryBundleProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBundleProposalRequest) ProtoMessage()    {}
+func (*QueryBundleProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_417b774a70d5f5fd, []int{2}
+}
+func (m *QueryBundleProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundleProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundleProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBundleProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundleProposalRequest.Merge(m, src)
+}
+func (m *QueryBundleProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundleProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundleProposalRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_417b774a70d5f5fd = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0xae, 0x2c, 0x4b,
-	0xd5, 0x4f, 0x2a, 0xcd, 0x4b, 0xc9, 0x49, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x01, 0xa9,
-	0xd0, 0x83, 0xaa, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd0, 0x07,
-	0xb1, 0x20, 0x6a, 0xa5, 0x64, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0x13, 0x0b, 0x32, 0xf5,
-	0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0xa1, 0xb2, 0x8a, 0x58, 0xed,
-	0x2a, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x2a, 0x51, 0x12, 0xe1, 0x12, 0x0a, 0x04, 0xd9, 0x1d, 0x00,
-	0x16, 0x0c, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a, 0xe4, 0x12, 0x46, 0x11, 0x2d, 0x2e,
-	0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0xb2, 0xe2, 0x62, 0x83, 0x68, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x36, 0x92, 0xd1, 0xc3, 0xe6, 0x54, 0x3d, 0x88, 0x2e, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82,
-	0xa0, 0x3a, 0x8c, 0x26, 0x31, 0x72, 0xb1, 0x82, 0xcd, 0x14, 0xea, 0x60, 0xe4, 0x62, 0x83, 0x28,
-	0x11, 0xd2, 0xc0, 0x6e, 0x00, 0xa6, 0x8b, 0xa4, 0x34, 0x89, 0x50, 0x09, 0x71, 0xa5, 0x92, 0x66,
-	0xd3, 0xe5, 0x27, 0x93, 0x99, 0x94, 0x85, 0x14, 0xf5, 0xbd, 0x23, 0xc3, 0x5c, 0xfd, 0x52, 0x4b,
-	0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0x93, 0x33, 0x12, 0x33, 0xf3, 0xe0, 0x61, 0x01, 0x71, 0x94, 0x93,
-	0xdb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa4, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0x62, 0x31, 0xa6, 0x02, 0x6e, 0x50, 0x49, 0x65, 0x41, 0x6a,
-	0x71, 0x12, 0x1b, 0x38, 0x30, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xf2, 0x16, 0x64,
-	0xdd, 0x01, 0x00, 0x00,
+var xxx_messageInfo_QueryBundleProposalRequest proto.InternalMessageInfo
+
+func (m *QueryBundleProposalRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// QueryBundleProposalResponse is response type for the Query/BundleProposal RPC method.
+type QueryBundleProposalResponse struct {
+	// bundle_proposal ...
+	BundleProposal BundleProposal `protobuf:"bytes,1,opt,name=bundle_proposal,json=bundleProposal,proto3" json:"bundle_proposal"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryBundleProposalResponse) Reset()         { *m = QueryBundleProposalResponse{} }
+func (m *QueryBundleProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBundleProposalResponse) ProtoMessage()    {}
+func (*QueryBundleProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_417b774a70d5f5fd, []int{3}
+}
+func (m *QueryBundleProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundleProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundleProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBundleProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundleProposalResponse.Merge(m, src)
+}
+func (m *QueryBundleProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundleProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundleProposalResponse.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryBundleProposalResponse proto.InternalMessageInfo
+
+func (m *QueryBundleProposalResponse) GetBundleProposal() BundleProposal {
+	if m != nil {
+		return m.BundleProposal
+	}
+	return BundleProposal{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryBundleProposalsRequest is request type for the Query/BundleProposals RPC method.
+type QueryBundleProposalsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryBundleProposalsRequest) Reset()         { *m = QueryBundleProposalsRequest{} }
+func (m *QueryBundleProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBundleProposalsRequest) ProtoMessage()    {}
+func (*QueryBundleProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_417b774a70d5f5fd, []int{4}
+}
+func (m *QueryBundleProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundleProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundleProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBundleProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundleProposalsRequest.Merge(m, src)
+}
+func (m *QueryBundleProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundleProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundleProposalsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBundleProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryBundleProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryBundleProposalsResponse is response type for the Query/BundleProposals RPC method.
+type QueryBundleProposalsResponse struct {
+	// bundle_proposals ...
+	BundleProposals []BundleProposal `protobuf:"bytes,1,rep,name=bundle_proposals,json=bundleProposals,proto3" json:"bundle_proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryBundleProposalsResponse) Reset()         { *m = QueryBundleProposalsResponse{} }
+func (m *QueryBundleProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBundleProposalsResponse) ProtoMessage()    {}
+func (*QueryBundleProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_417b774a70d5f5fd, []int{5}
+}
+func (m *QueryBundleProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundleProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundleProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBundleProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundleProposalsResponse.Merge(m, src)
+}
+func (m *QueryBundleProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundleProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundleProposalsResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryBundleProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryBundleProposalsResponse) GetBundleProposals() []BundleProposal {
+	if m != nil {
+		return m.BundleProposals
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryBundleProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryFinalizedBundlesRequest is request type for the Query/FinalizedBundles RPC method.
+type QueryFinalizedBundlesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QueryFinalizedBundlesRequest) Reset()         { *m = QueryFinalizedBundlesRequest{} }
+func (m *QueryFinalizedBundlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizedBundlesRequest) ProtoMessage()    {}
+func (*QueryFinalizedBundlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_417b774a70d5f5fd, []int{6}
+}
+func (m *QueryFinalizedBundlesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedBundlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedBundlesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *QueryFinalizedBundlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedBundlesRequest.Merge(m, src)
+}
+func (m *QueryFinalizedBundlesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedBundlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedBundlesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizedBundlesRequest proto.InternalMessageInfo
+
+func (m *QueryFinalizedBundlesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.bundles.v1beta1.Query/Params",
+	return nil
+}
+
+func (m *QueryFinalizedBundlesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return 0
+}
+
+// QueryFinalizedBundlesResponse is response type for the Query/FinalizedBundles RPC method.
+type QueryFinalizedBundlesResponse struct {
+	// finalized_bundles ...
+	FinalizedBundles []FinalizedBundle `protobuf:"bytes,1,rep,name=finalized_bundles,json=finalizedBundles,proto3" json:"finalized_bundles"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFinalizedBundlesResponse) Reset()         { *m = QueryFinalizedBundlesResponse{} }
+func (m *QueryFinalizedBundlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizedBundlesResponse) ProtoMessage()    {}
+func (*QueryFinalizedBundlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_417b774a70d5f5fd, []int{7}
+}
+func (m *QueryFinalizedBundlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedBundlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedBundlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFinalizedBundlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedBundlesResponse.Merge(m, src)
+}
+func (m *QueryFinalizedBundlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedBundlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedBundlesResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kyve.bundles.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kyve/bundles/v1beta1/query.proto",
+var xxx_messageInfo_QueryFinalizedBundlesResponse proto.InternalMessageInfo
+
+func (m *QueryFinalizedBundlesResponse) GetFinalizedBundles() []FinalizedBundle {
+	if m != nil {
+		return m.FinalizedBundles
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFinalizedBundlesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFinalizedBundleRequest is request type for the Query/FinalizedBundle RPC method.
+type QueryFinalizedBundleRequest struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryFinalizedBundleRequest) Reset()         { *m = QueryFinalizedBundleRequest{} }
+func (m *QueryFinalizedBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizedBundleRequest) ProtoMessage()    {}
+func (*QueryFinalizedBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_417b774a70d5f5fd, []int{8}
+}
+func (m *QueryFinalizedBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalizedBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedBundleRequest.Merge(m, src)
+}
+func (m *QueryFinalizedBundleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedBundleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizedBundleRequest proto.InternalMessageInfo
+
+func (m *QueryFinalizedBundleRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryFinalizedBundleRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryFinalizedBundleResponse is response type for the Query/FinalizedBundle RPC method.
+type QueryFinalizedBundleResponse struct {
+	// finalized_bundle ...
+	FinalizedBundle FinalizedBundle `protobuf:"bytes,1,opt,name=finalized_bundle,json=finalizedBundle,proto3" json:"finalized_bundle"`
+}
+
+func (m *QueryFinalizedBundleResponse) Reset()         { *m = QueryFinalizedBundleResponse{} }
+func (m *QueryFinalizedBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizedBundleResponse) ProtoMessage()    {}
+func (*QueryFinalizedBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_417b774a70d5f5fd, []int{9}
+}
+func (m *QueryFinalizedBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalizedBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedBundleResponse.Merge(m, src)
+}
+func (m *QueryFinalizedBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizedBundleResponse proto.InternalMessageInfo
+
+func (m *QueryFinalizedBundleResponse) GetFinalizedBundle() FinalizedBundle {
+	if m != nil {
+		return m.FinalizedBundle
+	}
+	return FinalizedBundle{}
+}
+
+// QueryFinalizedBundleByStorageIdRequest is request type for the Query/FinalizedBundleByStorageId RPC method.
+type QueryFinalizedBundleByStorageIdRequest struct {
+	// storage_id ...
+	StorageId string `protobuf:"bytes,1,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
+}
+
+func (m *QueryFinalizedBundleByStorageIdRequest) Reset() {
+	*m = QueryFinalizedBundleByStorageIdRequest{}
+}
+func (m *QueryFinalizedBundleByStorageIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizedBundleByStorageIdRequest) ProtoMessage()    {}
+func (*QueryFinalizedBundleByStorageIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_417b774a70d5f5fd, []int{10}
+}
+func (m *QueryFinalizedBundleByStorageIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedBundleByStorageIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedBundleByStorageIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalizedBundleByStorageIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedBundleByStorageIdRequest.Merge(m, src)
+}
+func (m *QueryFinalizedBundleByStorageIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedBundleByStorageIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedBundleByStorageIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizedBundleByStorageIdRequest proto.InternalMessageInfo
+
+func (m *QueryFinalizedBundleByStorageIdRequest) GetStorageId() string {
+	if m != nil {
+		return m.StorageId
+	}
+	return ""
+}
+
+// QueryFinalizedBundleByStorageIdResponse is response type for the Query/FinalizedBundleByStorageId RPC method.
+type QueryFinalizedBundleByStorageIdResponse struct {
+	// finalized_bundle ...
+	FinalizedBundle FinalizedBundle `protobuf:"bytes,1,opt,name=finalized_bundle,json=finalizedBundle,proto3" json:"finalized_bundle"`
+}
+
+func (m *QueryFinalizedBundleByStorageIdResponse) Reset() {
+	*m = QueryFinalizedBundleByStorageIdResponse{}
+}
+func (m *QueryFinalizedBundleByStorageIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizedBundleByStorageIdResponse) ProtoMessage()    {}
+func (*QueryFinalizedBundleByStorageIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_417b774a70d5f5fd, []int{11}
+}
+func (m *QueryFinalizedBundleByStorageIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedBundleByStorageIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedBundleByStorageIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalizedBundleByStorageIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedBundleByStorageIdResponse.Merge(m, src)
+}
+func (m *QueryFinalizedBundleByStorageIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedBundleByStorageIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedBundleByStorageIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizedBundleByStorageIdResponse proto.InternalMessageInfo
+
+func (m *QueryFinalizedBundleByStorageIdResponse) GetFinalizedBundle() FinalizedBundle {
+	if m != nil {
+		return m.FinalizedBundle
+	}
+	return FinalizedBundle{}
+}
+
+// QueryFinalizedBundleByHeightRequest is request type for the Query/FinalizedBundleByHeight RPC method.
+type QueryFinalizedBundleByHeightRequest struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// height ...
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryFinalizedBundleByHeightRequest) Reset()         { *m = QueryFinalizedBundleByHeightRequest{} }
+func (m *QueryFinalizedBundleByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizedBundleByHeightRequest) ProtoMessage()    {}
+func (*QueryFinalizedBundleByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_417b774a70d5f5fd, []int{12}
+}
+func (m *QueryFinalizedBundleByHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedBundleByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedBundleByHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalizedBundleByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedBundleByHeightRequest.Merge(m, src)
+}
+func (m *QueryFinalizedBundleByHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedBundleByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedBundleByHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizedBundleByHeightRequest proto.InternalMessageInfo
+
+func (m *QueryFinalizedBundleByHeightRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryFinalizedBundleByHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryFinalizedBundleByHeightResponse is response type for the Query/FinalizedBundleByHeight RPC method.
+type QueryFinalizedBundleByHeightResponse struct {
+	// finalized_bundle ...
+	FinalizedBundle FinalizedBundle `protobuf:"bytes,1,opt,name=finalized_bundle,json=finalizedBundle,proto3" json:"finalized_bundle"`
+}
+
+func (m *QueryFinalizedBundleByHeightResponse) Reset()         { *m = QueryFinalizedBundleByHeightResponse{} }
+func (m *QueryFinalizedBundleByHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizedBundleByHeightResponse) ProtoMessage()    {}
+func (*QueryFinalizedBundleByHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_417b774a70d5f5fd, []int{13}
+}
+func (m *QueryFinalizedBundleByHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedBundleByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedBundleByHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalizedBundleByHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedBundleByHeightResponse.Merge(m, src)
+}
+func (m *QueryFinalizedBundleByHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedBundleByHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedBundleByHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizedBundleByHeightResponse proto.InternalMessageInfo
+
+func (m *QueryFinalizedBundleByHeightResponse) GetFinalizedBundle() FinalizedBundle {
+	if m != nil {
+		return m.FinalizedBundle
+	}
+	return FinalizedBundle{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "kyve.bundles.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kyve.bundles.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryBundleProposalRequest)(nil), "kyve.bundles.v1beta1.QueryBundleProposalRequest")
+	proto.RegisterType((*QueryBundleProposalResponse)(nil), "kyve.bundles.v1beta1.QueryBundleProposalResponse")
+	proto.RegisterType((*QueryBundleProposalsRequest)(nil), "kyve.bundles.v1beta1.QueryBundleProposalsRequest")
+	proto.RegisterType((*QueryBundleProposalsResponse)(nil), "kyve.bundles.v1beta1.QueryBundleProposalsResponse")
+	proto.RegisterType((*QueryFinalizedBundlesRequest)(nil), "kyve.bundles.v1beta1.QueryFinalizedBundlesRequest")
+	proto.RegisterType((*QueryFinalizedBundlesResponse)(nil), "kyve.bundles.v1beta1.QueryFinalizedBundlesResponse")
+	proto.RegisterType((*QueryFinalizedBundleRequest)(nil), "kyve.bundles.v1beta1.QueryFinalizedBundleRequest")
+	proto.RegisterType((*QueryFinalizedBundleResponse)(nil), "kyve.bundles.v1beta1.QueryFinalizedBundleResponse")
+	proto.RegisterType((*QueryFinalizedBundleByStorageIdRequest)(nil), "kyve.bundles.v1beta1.QueryFinalizedBundleByStorageIdRequest")
+	proto.RegisterType((*QueryFinalizedBundleByStorageIdResponse)(nil), "kyve.bundles.v1beta1.QueryFinalizedBundleByStorageIdResponse")
+	proto.RegisterType((*QueryFinalizedBundleByHeightRequest)(nil), "kyve.bundles.v1beta1.QueryFinalizedBundleByHeightRequest")
+	proto.RegisterType((*QueryFinalizedBundleByHeightResponse)(nil), "kyve.bundles.v1beta1.QueryFinalizedBundleByHeightResponse")
+}
+
+func init() { proto.RegisterFile("kyve/bundles/v1beta1/query.proto", fileDescriptor_417b774a70d5f5fd) }
+
+var fileDescriptor_417b774a70d5f5fd = []byte{
+	// 831 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x4f, 0xdb, 0x48,
+	0x18, 0xc7, 0x33, 0x59, 0x36, 0x2b, 0x9e, 0x95, 0x48, 0x76, 0x16, 0x2d, 0x2b, 0x2f, 0x64, 0xc1,
+	0xb0, 0xbc, 0xac, 0x56, 0xf6, 0x26, 0x08, 0x21, 0x50, 0x39, 0x34, 0x88, 0x50, 0x84, 0x54, 0x41,
+	0x50, 0x51, 0xdb, 0x4b, 0xe4, 0x90, 0xc1, 0xb1, 0x08, 0x1e, 0x13, 0x3b, 0xb4, 0x29, 0xa2, 0x55,
+	0x7b, 0xea, 0xb1, 0x52, 0xbf, 0x04, 0xa7, 0x1e, 0xdb, 0x5b, 0x0f, 0x3d, 0x71, 0x44, 0xea, 0xa5,
+	0x97, 0x56, 0x15, 0xf0, 0x41, 0xaa, 0xcc, 0x4c, 0x5e, 0x6c, 0x26, 0x6f, 0x08, 0x6e, 0xf6, 0xf8,
+	0x79, 0xf9, 0xfd, 0xff, 0x33, 0x79, 0x26, 0x30, 0xba, 0x57, 0x39, 0x24, 0x7a, 0xae, 0x6c, 0xe7,
+	0x8b, 0xc4, 0xd5, 0x0f, 0x13, 0x39, 0xe2, 0x19, 0x09, 0xfd, 0xa0, 0x4c, 0x4a, 0x15, 0xcd, 0x29,
+	0x51, 0x8f, 0xe2, 0xc1, 0x6a, 0x84, 0x26, 0x22, 0x34, 0x11, 0xa1, 0xfc, 0xbb, 0x43, 0xdd, 0x7d,
+	0xea, 0xea, 0x39, 0xc3, 0x25, 0x3c, 0xbc, 0x9e, 0xec, 0x18, 0xa6, 0x65, 0x1b, 0x9e, 0x45, 0x6d,
+	0x5e, 0x41, 0x19, 0x34, 0xa9, 0x49, 0xd9, 0xa3, 0x5e, 0x7d, 0x12, 0xab, 0xc3, 0x26, 0xa5, 0x66,
+	0x91, 0xe8, 0x86, 0x63, 0xe9, 0x86, 0x6d, 0x53, 0x8f, 0xa5, 0xb8, 0xe2, 0xab, 0x2a, 0xe5, 0xaa,
+	0x51, 0xf0, 0x98, 0x31, 0x69, 0x8c, 0x63, 0x94, 0x8c, 0x7d, 0x11, 0xa2, 0x0e, 0x02, 0xde, 0xac,
+	0xc2, 0x6d, 0xb0, 0xc5, 0x0c, 0x39, 0x28, 0x13, 0xd7, 0x53, 0x37, 0xe1, 0x77, 0xdf, 0xaa, 0xeb,
+	0x50, 0xdb, 0x25, 0x78, 0x11, 0x22, 0x3c, 0xf9, 0x4f, 0x34, 0x8a, 0xa6, 0x7f, 0x4d, 0x0e, 0x6b,
+	0x32, 0xe9, 0x1a, 0xcf, 0x4a, 0xf5, 0x9d, 0x7e, 0xfb, 0x3b, 0x94, 0x11, 0x19, 0xea, 0x1c, 0x28,
+	0xac, 0x64, 0x8a, 0x05, 0x6f, 0x94, 0xa8, 0x43, 0x5d, 0xa3, 0x28, 0x1a, 0xe2, 0x21, 0xf8, 0xc5,
+	0xa1, 0xb4, 0x98, 0xb5, 0xf2, 0xac, 0x74, 0x5f, 0x26, 0x52, 0x7d, 0x5d, 0xcb, 0xab, 0x25, 0xf8,
+	0x4b, 0x9a, 0x26, 0x88, 0xb6, 0x20, 0xca, 0xbb, 0x67, 0x1d, 0xf1, 0x49, 0xa0, 0x4d, 0xc8, 0xd1,
+	0xfc, 0x65, 0x04, 0xe2, 0x40, 0xce, 0xb7, 0xaa, 0x12, 0x69, 0xcf, 0x9a, 0x39, 0x38, 0x0d, 0xd0,
+	0xd8, 0x41, 0xd1, 0x6e, 0x52, 0xe3, 0xdb, 0xad, 0x55, 0xb7, 0x5b, 0xe3, 0xa7, 0xa3, 0x61, 0x87,
+	0x49, 0x44, 0x6e, 0xa6, 0x29, 0x53, 0xfd, 0x88, 0x60, 0x58, 0xde, 0x47, 0x88, 0x7b, 0x00, 0xb1,
+	0x80, 0xb8, 0xaa, 0xf1, 0x3f, 0xf5, 0xa8, 0x2e, 0xea, 0x57, 0xe7, 0xe2, 0x55, 0x1f, 0x7f, 0x98,
+	0xf1, 0x4f, 0x75, 0xe4, 0xe7, 0x4c, 0x3e, 0x01, 0x2f, 0x04, 0x7f, 0xda, 0xb2, 0x8d, 0xa2, 0xf5,
+	0x8c, 0xe4, 0x79, 0xff, 0x9b, 0x36, 0xaa, 0xf9, 0x70, 0x84, 0x7d, 0x87, 0xe3, 0x13, 0x82, 0x91,
+	0x16, 0x04, 0xc2, 0xc2, 0x87, 0xf0, 0xdb, 0x6e, 0xed, 0x5b, 0x56, 0xd8, 0x25, 0x3c, 0xfc, 0x47,
+	0xee, 0x61, 0xa0, 0x94, 0x30, 0x31, 0xb6, 0x1b, 0xe8, 0x70, 0x73, 0x2e, 0xa6, 0xc5, 0x69, 0x0b,
+	0x34, 0xee, 0xf4, 0xcb, 0xc0, 0x03, 0x10, 0xae, 0x1b, 0x12, 0xb6, 0xf2, 0xea, 0xa1, 0x7c, 0x37,
+	0xea, 0x56, 0x6c, 0x43, 0x2c, 0x68, 0x85, 0xd8, 0x93, 0x9e, 0x9c, 0x88, 0x06, 0x9c, 0x50, 0x57,
+	0x61, 0x52, 0xd6, 0x37, 0x55, 0xd9, 0xf2, 0x68, 0xc9, 0x30, 0xc9, 0x5a, 0xbe, 0x26, 0x65, 0x04,
+	0xc0, 0xe5, 0x6b, 0x35, 0x35, 0xfd, 0x99, 0x7e, 0xb7, 0x16, 0xa5, 0xbe, 0x44, 0x30, 0xd5, 0xb1,
+	0xd2, 0x2d, 0x8b, 0xd9, 0x86, 0x71, 0x39, 0xc2, 0x3d, 0x62, 0x99, 0x05, 0xaf, 0xe3, 0xa6, 0xfc,
+	0x01, 0x91, 0x02, 0x8b, 0xac, 0x9d, 0x54, 0xfe, 0xa6, 0x3e, 0x87, 0x89, 0xf6, 0x75, 0x6f, 0x57,
+	0x57, 0xf2, 0x04, 0xe0, 0x67, 0x06, 0x80, 0x5f, 0x23, 0x88, 0xf0, 0x01, 0x8d, 0xa7, 0xe5, 0x25,
+	0xaf, 0xde, 0x07, 0xca, 0x4c, 0x17, 0x91, 0x5c, 0x81, 0x3a, 0xf3, 0xea, 0xf3, 0xe5, 0xdb, 0xf0,
+	0x38, 0x1e, 0xd3, 0xd7, 0x1f, 0x6d, 0xaf, 0xdc, 0x27, 0xde, 0x13, 0x5a, 0xda, 0xd3, 0x77, 0x0a,
+	0x86, 0x65, 0xd7, 0x6f, 0x22, 0x7e, 0x25, 0xe0, 0x77, 0x08, 0x06, 0xfc, 0x23, 0x0b, 0xff, 0xdf,
+	0xa6, 0x91, 0xf4, 0xe6, 0x50, 0x12, 0x3d, 0x64, 0x08, 0xc4, 0x79, 0x86, 0x98, 0xc0, 0xba, 0xde,
+	0xe6, 0x0e, 0xad, 0xcf, 0x5c, 0xfd, 0x48, 0x6c, 0xf5, 0x31, 0x3e, 0x41, 0x10, 0x0d, 0x0c, 0x6b,
+	0xdc, 0x7d, 0xff, 0xba, 0x9b, 0xc9, 0x5e, 0x52, 0x04, 0xb3, 0xc6, 0x98, 0xa7, 0xf1, 0x64, 0x57,
+	0xcc, 0x2e, 0x7e, 0x8f, 0x20, 0x16, 0x9c, 0x8a, 0xb8, 0x5d, 0xe3, 0x16, 0x43, 0x5c, 0x99, 0xed,
+	0x29, 0x47, 0xd0, 0x2e, 0x30, 0xda, 0x59, 0x9c, 0x90, 0xd3, 0x5e, 0x19, 0xc9, 0x4d, 0x1e, 0x7f,
+	0x40, 0x10, 0x0d, 0xd4, 0x6d, 0xeb, 0xb1, 0x7c, 0x6c, 0x2a, 0xc9, 0x5e, 0x52, 0x04, 0xf5, 0x12,
+	0xa3, 0x9e, 0xc7, 0x73, 0xdd, 0x51, 0x37, 0xa0, 0xf5, 0xa3, 0x2a, 0xf9, 0x25, 0x02, 0xa5, 0xf5,
+	0xe8, 0xc2, 0x77, 0xba, 0x27, 0xba, 0x3a, 0x3b, 0x95, 0xa5, 0x6b, 0x66, 0x0b, 0x69, 0x6b, 0x4c,
+	0xda, 0x32, 0xbe, 0xdb, 0x9d, 0xb4, 0x6c, 0xae, 0x92, 0x6d, 0x8c, 0x6a, 0xfd, 0xa8, 0xf1, 0x7c,
+	0x8c, 0xbf, 0x22, 0x18, 0x6a, 0x31, 0xc6, 0xf0, 0x42, 0x2f, 0x94, 0xbe, 0x91, 0xaa, 0x2c, 0x5e,
+	0x27, 0x55, 0xa8, 0x5b, 0x67, 0xea, 0x56, 0xf0, 0x72, 0xf7, 0xea, 0xf8, 0x5c, 0x6e, 0xde, 0x42,
+	0xbe, 0x72, 0x9c, 0x4a, 0x9f, 0x9e, 0xc7, 0xd1, 0xd9, 0x79, 0x1c, 0x7d, 0x3f, 0x8f, 0xa3, 0x37,
+	0x17, 0xf1, 0xd0, 0xd9, 0x45, 0x3c, 0xf4, 0xe5, 0x22, 0x1e, 0x7a, 0xfc, 0x9f, 0x69, 0x79, 0x85,
+	0x72, 0x4e, 0xdb, 0xa1, 0xfb, 0x92, 0xe1, 0xf6, 0xb4, 0xde, 0xd7, 0xab, 0x38, 0xc4, 0xcd, 0x45,
+	0xd8, 0x1f, 0xec, 0xd9, 0x1f, 0x03, 0x00, 0x86, 0x81, 0x2d, 0x94, 0x41, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BundleProposal returns the current bundle proposal of a pool.
+	BundleProposal(ctx context.Context, in *QueryBundleProposalRequest, opts ...grpc.CallOption) (*QueryBundleProposalResponse, error)
+	// BundleProposals returns the current bundle proposals of all pools.
+	BundleProposals(ctx context.Context, in *QueryBundleProposalsRequest, opts ...grpc.CallOption) (*QueryBundleProposalsResponse, error)
+	// FinalizedBundles returns all finalized bundles of a pool.
+	FinalizedBundles(ctx context.Context, in *QueryFinalizedBundlesRequest, opts ...grpc.CallOption) (*QueryFinalizedBundlesResponse, error)
+	// FinalizedBundle returns a single finalized bundle of a pool.
+	FinalizedBundle(ctx context.Context, in *QueryFinalizedBundleRequest, opts ...grpc.CallOption) (*QueryFinalizedBundleResponse, error)
+	// FinalizedBundleByStorageId returns the finalized bundle stored under the given storage id.
+	FinalizedBundleByStorageId(ctx context.Context, in *QueryFinalizedBundleByStorageIdRequest, opts ...grpc.CallOption) (*QueryFinalizedBundleByStorageIdResponse, error)
+	// FinalizedBundleByHeight returns the finalized bundle of a pool which contains the given height.
+	FinalizedBundleByHeight(ctx context.Context, in *QueryFinalizedBundleByHeightRequest, opts ...grpc.CallOption) (*QueryFinalizedBundleByHeightResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BundleProposal(ctx context.Context, in *QueryBundleProposalRequest, opts ...grpc.CallOption) (*QueryBundleProposalResponse, error) {
+	out := new(QueryBundleProposalResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Query/BundleProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BundleProposals(ctx context.Context, in *QueryBundleProposalsRequest, opts ...grpc.CallOption) (*QueryBundleProposalsResponse, error) {
+	out := new(QueryBundleProposalsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Query/BundleProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalizedBundles(ctx context.Context, in *QueryFinalizedBundlesRequest, opts ...grpc.CallOption) (*QueryFinalizedBundlesResponse, error) {
+	out := new(QueryFinalizedBundlesResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Query/FinalizedBundles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalizedBundle(ctx context.Context, in *QueryFinalizedBundleRequest, opts ...grpc.CallOption) (*QueryFinalizedBundleResponse, error) {
+	out := new(QueryFinalizedBundleResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Query/FinalizedBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalizedBundleByStorageId(ctx context.Context, in *QueryFinalizedBundleByStorageIdRequest, opts ...grpc.CallOption) (*QueryFinalizedBundleByStorageIdResponse, error) {
+	out := new(QueryFinalizedBundleByStorageIdResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Query/FinalizedBundleByStorageId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalizedBundleByHeight(ctx context.Context, in *QueryFinalizedBundleByHeightRequest, opts ...grpc.CallOption) (*QueryFinalizedBundleByHeightResponse, error) {
+	out := new(QueryFinalizedBundleByHeightResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Query/FinalizedBundleByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BundleProposal returns the current bundle proposal of a pool.
+	BundleProposal(context.Context, *QueryBundleProposalRequest) (*QueryBundleProposalResponse, error)
+	// BundleProposals returns the current bundle proposals of all pools.
+	BundleProposals(context.Context, *QueryBundleProposalsRequest) (*QueryBundleProposalsResponse, error)
+	// FinalizedBundles returns all finalized bundles of a pool.
+	FinalizedBundles(context.Context, *QueryFinalizedBundlesRequest) (*QueryFinalizedBundlesResponse, error)
+	// FinalizedBundle returns a single finalized bundle of a pool.
+	FinalizedBundle(context.Context, *QueryFinalizedBundleRequest) (*QueryFinalizedBundleResponse, error)
+	// FinalizedBundleByStorageId returns the finalized bundle stored under the given storage id.
+	FinalizedBundleByStorageId(context.Context, *QueryFinalizedBundleByStorageIdRequest) (*QueryFinalizedBundleByStorageIdResponse, error)
+	// FinalizedBundleByHeight returns the finalized bundle of a pool which contains the given height.
+	FinalizedBundleByHeight(context.Context, *QueryFinalizedBundleByHeightRequest) (*QueryFinalizedBundleByHeightResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) BundleProposal(ctx context.Context, req *QueryBundleProposalRequest) (*QueryBundleProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BundleProposal not implemented")
+}
+func (*UnimplementedQueryServer) BundleProposals(ctx context.Context, req *QueryBundleProposalsRequest) (*QueryBundleProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BundleProposals not implemented")
+}
+func (*UnimplementedQueryServer) FinalizedBundles(ctx context.Context, req *QueryFinalizedBundlesRequest) (*QueryFinalizedBundlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizedBundles not implemented")
+}
+func (*UnimplementedQueryServer) FinalizedBundle(ctx context.Context, req *QueryFinalizedBundleRequest) (*QueryFinalizedBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizedBundle not implemented")
+}
+func (*UnimplementedQueryServer) FinalizedBundleByStorageId(ctx context.Context, req *QueryFinalizedBundleByStorageIdRequest) (*QueryFinalizedBundleByStorageIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizedBundleByStorageId not implemented")
+}
+func (*UnimplementedQueryServer) FinalizedBundleByHeight(ctx context.Context, req *QueryFinalizedBundleByHeightRequest) (*QueryFinalizedBundleByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizedBundleByHeight not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BundleProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBundleProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BundleProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Query/BundleProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BundleProposal(ctx, req.(*QueryBundleProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BundleProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBundleProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BundleProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Query/BundleProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BundleProposals(ctx, req.(*QueryBundleProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalizedBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalizedBundlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalizedBundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Query/FinalizedBundles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalizedBundles(ctx, req.(*QueryFinalizedBundlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalizedBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalizedBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalizedBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Query/FinalizedBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalizedBundle(ctx, req.(*QueryFinalizedBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalizedBundleByStorageId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalizedBundleByStorageIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalizedBundleByStorageId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Query/FinalizedBundleByStorageId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalizedBundleByStorageId(ctx, req.(*QueryFinalizedBundleByStorageIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalizedBundleByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalizedBundleByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalizedBundleByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Query/FinalizedBundleByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalizedBundleByHeight(ctx, req.(*QueryFinalizedBundleByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kyve.bundles.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BundleProposal",
+			Handler:    _Query_BundleProposal_Handler,
+		},
+		{
+			MethodName: "BundleProposals",
+			Handler:    _Query_BundleProposals_Handler,
+		},
+		{
+			MethodName: "FinalizedBundles",
+			Handler:    _Query_FinalizedBundles_Handler,
+		},
+		{
+			MethodName: "FinalizedBundle",
+			Handler:    _Query_FinalizedBundle_Handler,
+		},
+		{
+			MethodName: "FinalizedBundleByStorageId",
+			Handler:    _Query_FinalizedBundleByStorageId_Handler,
+		},
+		{
+			MethodName: "FinalizedBundleByHeight",
+			Handler:    _Query_FinalizedBundleByHeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kyve/bundles/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundleProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundleProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundleProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundleProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundleProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundleProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BundleProposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundleProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundleProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundleProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundleProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundleProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundleProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BundleProposals) > 0 {
+		for iNdEx := len(m.BundleProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BundleProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedBundlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedBundlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedBundlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedBundlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedBundlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedBundlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FinalizedBundles) > 0 {
+		for iNdEx := len(m.FinalizedBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizedBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedBundleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedBundleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FinalizedBundle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedBundleByStorageIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedBundleByStorageIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedBundleByStorageIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedBundleByStorageIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedBundleByStorageIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedBundleByStorageIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FinalizedBundle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedBundleByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedBundleByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedBundleByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedBundleByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedBundleByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedBundleByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FinalizedBundle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBundleProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryBundleProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BundleProposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBundleProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBundleProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BundleProposals) > 0 {
+		for _, e := range m.BundleProposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalizedBundlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryFinalizedBundlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalizedBundles) > 0 {
+		for _, e := range m.FinalizedBundles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalizedBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryFinalizedBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FinalizedBundle.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFinalizedBundleByStorageIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalizedBundleByStorageIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FinalizedBundle.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFinalizedBundleByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFinalizedBundleByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FinalizedBundle.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBundleProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBundleProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBundleProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBundleProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBundleProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBundleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BundleProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBundleProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBundleProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBundleProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBundleProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBundleProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBundleProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleProposals = append(m.BundleProposals, BundleProposal{})
+			if err := m.BundleProposals[len(m.BundleProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizedBundlesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedBundlesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedBundlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizedBundlesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedBundlesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedBundlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedBundles = append(m.FinalizedBundles, FinalizedBundle{})
+			if err := m.FinalizedBundles[len(m.FinalizedBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizedBundleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedBundleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizedBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalizedBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (m *QueryFinalizedBundleByStorageIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedBundleByStorageIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedBundleByStorageIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFinalizedBundleByStorageIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedBundleByStorageIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedBundleByStorageIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalizedBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalizedBundleByHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -340,12 +2862,50 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalizedBundleByHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalizedBundleByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -367,7 +2927,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalizedBundleByHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -390,15 +2950,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalizedBundleByHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalizedBundleByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBundle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -425,7 +2985,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinalizedBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -567,6 +567,253 @@ func (m *MsgSkipUploaderRoleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSkipUploaderRoleResponse proto.InternalMessageInfo
 
+// MsgCommitBundleVote defines a SDK message for committing to a hidden vote on a bundle proposal.
+type MsgCommitBundleVote struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// storage_id ...
+	StorageId string `protobuf:"bytes,4,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
+	// commit_hash ...
+	CommitHash string `protobuf:"bytes,5,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+}
+
+func (m *MsgCommitBundleVote) Reset()         { *m = MsgCommitBundleVote{} }
+func (m *MsgCommitBundleVote) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBundleVote) ProtoMessage()    {}
+func (*MsgCommitBundleVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ed52bfae1633bf9, []int{8}
+}
+func (m *MsgCommitBundleVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitBundleVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitBundleVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitBundleVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBundleVote.Merge(m, src)
+}
+func (m *MsgCommitBundleVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitBundleVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBundleVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitBundleVote proto.InternalMessageInfo
+
+func (m *MsgCommitBundleVote) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCommitBundleVote) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *MsgCommitBundleVote) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgCommitBundleVote) GetStorageId() string {
+	if m != nil {
+		return m.StorageId
+	}
+	return ""
+}
+
+func (m *MsgCommitBundleVote) GetCommitHash() string {
+	if m != nil {
+		return m.CommitHash
+	}
+	return ""
+}
+
+// MsgCommitBundleVoteResponse defines the Msg/CommitBundleVote response type.
+type MsgCommitBundleVoteResponse struct {
+}
+
+func (m *MsgCommitBundleVoteResponse) Reset()         { *m = MsgCommitBundleVoteResponse{} }
+func (m *MsgCommitBundleVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBundleVoteResponse) ProtoMessage()    {}
+func (*MsgCommitBundleVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ed52bfae1633bf9, []int{9}
+}
+func (m *MsgCommitBundleVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitBundleVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitBundleVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitBundleVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBundleVoteResponse.Merge(m, src)
+}
+func (m *MsgCommitBundleVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitBundleVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBundleVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitBundleVoteResponse proto.InternalMessageInfo
+
+// MsgRevealBundleVote defines a SDK message for revealing a previously committed vote.
+type MsgRevealBundleVote struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// storage_id ...
+	StorageId string `protobuf:"bytes,4,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
+	// vote ...
+	Vote VoteType `protobuf:"varint,5,opt,name=vote,proto3,enum=kyve.bundles.v1beta1.VoteType" json:"vote,omitempty"`
+	// salt is the hex encoded random salt of 32 bytes the vote was committed with.
+	Salt string `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (m *MsgRevealBundleVote) Reset()         { *m = MsgRevealBundleVote{} }
+func (m *MsgRevealBundleVote) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBundleVote) ProtoMessage()    {}
+func (*MsgRevealBundleVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ed52bfae1633bf9, []int{10}
+}
+func (m *MsgRevealBundleVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBundleVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBundleVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealBundleVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBundleVote.Merge(m, src)
+}
+func (m *MsgRevealBundleVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBundleVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBundleVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealBundleVote proto.InternalMessageInfo
+
+func (m *MsgRevealBundleVote) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRevealBundleVote) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *MsgRevealBundleVote) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgRevealBundleVote) GetStorageId() string {
+	if m != nil {
+		return m.StorageId
+	}
+	return ""
+}
+
+func (m *MsgRevealBundleVote) GetVote() VoteType {
+	if m != nil {
+		return m.Vote
+	}
+	return VOTE_TYPE_UNSPECIFIED
+}
+
+func (m *MsgRevealBundleVote) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+// MsgRevealBundleVoteResponse defines the Msg/RevealBundleVote response type.
+type MsgRevealBundleVoteResponse struct {
+}
+
+func (m *MsgRevealBundleVoteResponse) Reset()         { *m = MsgRevealBundleVoteResponse{} }
+func (m *MsgRevealBundleVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBundleVoteResponse) ProtoMessage()    {}
+func (*MsgRevealBundleVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ed52bfae1633bf9, []int{11}
+}
+func (m *MsgRevealBundleVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBundleVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBundleVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealBundleVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBundleVoteResponse.Merge(m, src)
+}
+func (m *MsgRevealBundleVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBundleVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBundleVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealBundleVoteResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("kyve.bundles.v1beta1.VoteType", VoteType_name, VoteType_value)
 	proto.RegisterType((*MsgSubmitBundleProposal)(nil), "kyve.bundles.v1beta1.MsgSubmitBundleProposal")
@@ -577,52 +824,62 @@ func init() {
 	proto.RegisterType((*MsgClaimUploaderRoleResponse)(nil), "kyve.bundles.v1beta1.MsgClaimUploaderRoleResponse")
 	proto.RegisterType((*MsgSkipUploaderRole)(nil), "kyve.bundles.v1beta1.MsgSkipUploaderRole")
 	proto.RegisterType((*MsgSkipUploaderRoleResponse)(nil), "kyve.bundles.v1beta1.MsgSkipUploaderRoleResponse")
+	proto.RegisterType((*MsgCommitBundleVote)(nil), "kyve.bundles.v1beta1.MsgCommitBundleVote")
+	proto.RegisterType((*MsgCommitBundleVoteResponse)(nil), "kyve.bundles.v1beta1.MsgCommitBundleVoteResponse")
+	proto.RegisterType((*MsgRevealBundleVote)(nil), "kyve.bundles.v1beta1.MsgRevealBundleVote")
+	proto.RegisterType((*MsgRevealBundleVoteResponse)(nil), "kyve.bundles.v1beta1.MsgRevealBundleVoteResponse")
 }
 
 func init() { proto.RegisterFile("kyve/bundles/v1beta1/tx.proto", fileDescriptor_9ed52bfae1633bf9) }
 
 var fileDescriptor_9ed52bfae1633bf9 = []byte{
-	// 634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xc1, 0x4e, 0x1a, 0x51,
-	0x14, 0x65, 0x04, 0x11, 0xae, 0x6d, 0x83, 0xaf, 0x50, 0x47, 0x2c, 0xa3, 0x65, 0x65, 0x6d, 0xcb,
-	0x44, 0x4c, 0x3f, 0x40, 0x2d, 0x46, 0x62, 0x40, 0x03, 0x48, 0x62, 0x37, 0x93, 0x41, 0x5e, 0x67,
-	0x26, 0x0c, 0xde, 0xc9, 0xbc, 0x07, 0x15, 0xd3, 0x4d, 0x77, 0x5d, 0xf6, 0x1f, 0xfa, 0x0d, 0xfd,
-	0x87, 0x2e, 0xba, 0x70, 0xd9, 0x65, 0x83, 0x3f, 0xd2, 0xbc, 0x37, 0x80, 0x09, 0x0c, 0x89, 0x26,
-	0x6d, 0xba, 0xe3, 0x9e, 0x73, 0xee, 0xdc, 0x7b, 0x0f, 0x67, 0x32, 0x90, 0xeb, 0x0c, 0xfa, 0x54,
-	0x6f, 0xf5, 0x2e, 0xdb, 0x2e, 0x65, 0x7a, 0x7f, 0xa7, 0x45, 0xb9, 0xb9, 0xa3, 0xf3, 0xab, 0x82,
-	0xe7, 0x23, 0x47, 0x92, 0x16, 0x74, 0x61, 0x44, 0x17, 0x46, 0x74, 0x36, 0x6d, 0xa1, 0x85, 0x52,
-	0xa0, 0x8b, 0x5f, 0x81, 0x36, 0xff, 0x73, 0x01, 0x56, 0x2b, 0xcc, 0xaa, 0xf7, 0x5a, 0x5d, 0x87,
-	0xef, 0xcb, 0x96, 0x53, 0x1f, 0x3d, 0x64, 0xa6, 0x4b, 0x54, 0x58, 0xba, 0xf0, 0xa9, 0xc9, 0xd1,
-	0x57, 0x95, 0x4d, 0x65, 0x2b, 0x59, 0x1b, 0x97, 0xe4, 0x19, 0xc4, 0x19, 0x37, 0x3b, 0xd4, 0x57,
-	0x17, 0x24, 0x31, 0xaa, 0xc8, 0x2a, 0x2c, 0x79, 0x88, 0xae, 0xe1, 0xb4, 0xd5, 0xe8, 0xa6, 0xb2,
-	0x15, 0xab, 0xc5, 0x45, 0x59, 0x6e, 0x93, 0x1c, 0x00, 0xe3, 0xe8, 0x9b, 0x16, 0x15, 0x5c, 0x4c,
-	0x36, 0x25, 0x47, 0x48, 0xb9, 0x4d, 0xd6, 0x21, 0xd9, 0x1a, 0x70, 0x6a, 0x30, 0xe7, 0x9a, 0xaa,
-	0x8b, 0xb2, 0x33, 0x21, 0x80, 0xba, 0x73, 0x4d, 0xc9, 0x06, 0x2c, 0x7f, 0xf0, 0xb1, 0x6b, 0xd8,
-	0xd4, 0xb1, 0x6c, 0xae, 0xc6, 0x25, 0x0d, 0x02, 0x3a, 0x92, 0x88, 0xe8, 0xe6, 0x38, 0xa6, 0x97,
-	0x82, 0x6e, 0x8e, 0x23, 0x72, 0x0d, 0x12, 0xb2, 0xbb, 0x43, 0x07, 0x6a, 0x22, 0xb8, 0x42, 0xd4,
-	0xc7, 0x74, 0x40, 0x32, 0x10, 0xe7, 0x28, 0x89, 0xa4, 0x24, 0x16, 0x39, 0x0a, 0x78, 0x0d, 0x12,
-	0x1c, 0x8d, 0xbe, 0xe9, 0xf6, 0xa8, 0x0a, 0x41, 0x07, 0xc7, 0xa6, 0x28, 0xc5, 0x2a, 0x81, 0xad,
-	0x86, 0x6d, 0x32, 0x5b, 0x5d, 0x96, 0x2c, 0x04, 0xd0, 0x91, 0xc9, 0xec, 0xfc, 0x0b, 0xd8, 0x98,
-	0xe3, 0x66, 0x8d, 0x32, 0x0f, 0x2f, 0x19, 0xcd, 0x7f, 0x57, 0x20, 0x53, 0x61, 0x56, 0x13, 0x39,
-	0xfd, 0x6f, 0x7e, 0x17, 0x21, 0xd6, 0x47, 0x1e, 0x58, 0xfd, 0xa4, 0xa8, 0x15, 0xc2, 0x02, 0x53,
-	0x10, 0x1b, 0x36, 0x06, 0x1e, 0xad, 0x49, 0x6d, 0x7e, 0x03, 0x72, 0xa1, 0x6b, 0x4f, 0x0e, 0x33,
-	0x21, 0x5d, 0x61, 0xd6, 0x81, 0x6b, 0x3a, 0xdd, 0x33, 0xcf, 0x45, 0xb3, 0x4d, 0xfd, 0x1a, 0xba,
-	0xf4, 0x2f, 0x9e, 0x95, 0xd7, 0xe0, 0x79, 0xd8, 0x88, 0xc9, 0x0a, 0x9f, 0x15, 0x78, 0x2a, 0xfc,
-	0xef, 0x38, 0xde, 0x3f, 0x5a, 0x61, 0x3a, 0x8d, 0xb1, 0xe9, 0x34, 0xe6, 0x73, 0xb0, 0x1e, 0xb2,
-	0xc2, 0x78, 0xc5, 0x6d, 0x0b, 0x12, 0x63, 0x63, 0xc9, 0x1a, 0x64, 0x9a, 0x27, 0x8d, 0x92, 0xd1,
-	0x38, 0x3f, 0x2d, 0x19, 0x67, 0xd5, 0xfa, 0x69, 0xe9, 0xa0, 0x7c, 0x58, 0x2e, 0xbd, 0x4b, 0x45,
-	0xc8, 0x0a, 0x3c, 0xbe, 0xa3, 0xce, 0x4b, 0xf5, 0x94, 0x42, 0x52, 0xf0, 0xe8, 0x0e, 0xaa, 0x9e,
-	0xa4, 0x16, 0x48, 0x06, 0x56, 0xee, 0x90, 0xbd, 0xfd, 0x7a, 0x63, 0xaf, 0x5c, 0x4d, 0x45, 0xb3,
-	0xb1, 0x2f, 0xdf, 0xb4, 0x48, 0x71, 0x18, 0x85, 0x68, 0x85, 0x59, 0xe4, 0x13, 0xa4, 0x43, 0xdf,
-	0xee, 0x37, 0xe1, 0xff, 0xfa, 0x9c, 0xf8, 0x66, 0xdf, 0x3e, 0x48, 0x3e, 0x3e, 0x97, 0xf4, 0x81,
-	0x84, 0x24, 0xfd, 0xd5, 0xdc, 0x87, 0xcd, 0x8a, 0xb3, 0xbb, 0x0f, 0x10, 0x4f, 0xe6, 0x32, 0x58,
-	0x99, 0x4d, 0xe2, 0xf6, 0xdc, 0x27, 0xcd, 0x68, 0xb3, 0xc5, 0xfb, 0x6b, 0x27, 0x43, 0x3d, 0x48,
-	0xcd, 0x44, 0xef, 0xe5, 0x7c, 0xdf, 0xa6, 0xa4, 0xd9, 0x9d, 0x7b, 0x4b, 0xc7, 0x13, 0xf7, 0x0f,
-	0x7f, 0x0c, 0x35, 0xe5, 0x66, 0xa8, 0x29, 0xbf, 0x87, 0x9a, 0xf2, 0xf5, 0x56, 0x8b, 0xdc, 0xdc,
-	0x6a, 0x91, 0x5f, 0xb7, 0x5a, 0xe4, 0xfd, 0x6b, 0xcb, 0xe1, 0x76, 0xaf, 0x55, 0xb8, 0xc0, 0xae,
-	0x7e, 0x7c, 0xde, 0x2c, 0x55, 0x29, 0xff, 0x88, 0x7e, 0x47, 0xbf, 0xb0, 0x4d, 0xe7, 0x52, 0xbf,
-	0x9a, 0x7c, 0x3d, 0xf8, 0xc0, 0xa3, 0xac, 0x15, 0x97, 0x5f, 0x83, 0xdd, 0x3f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xf8, 0x13, 0x11, 0x10, 0x5a, 0x06, 0x00, 0x00,
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xc1, 0x6e, 0xda, 0x4a,
+	0x14, 0xc5, 0x09, 0x10, 0xb8, 0x79, 0xef, 0x89, 0xcc, 0x83, 0xc6, 0x71, 0x8a, 0x49, 0x59, 0xa5,
+	0x69, 0x0b, 0x82, 0xa8, 0x1f, 0x90, 0xa4, 0x44, 0x41, 0x11, 0x24, 0x02, 0x82, 0x94, 0x6e, 0x90,
+	0x81, 0xa9, 0xb1, 0x30, 0x19, 0xcb, 0x33, 0xd0, 0x10, 0x75, 0xd3, 0x5d, 0x97, 0xfd, 0x84, 0x4a,
+	0xfd, 0x86, 0xfe, 0x42, 0xdb, 0x45, 0x17, 0x59, 0x76, 0x59, 0x25, 0x3f, 0x52, 0xcd, 0x0c, 0x38,
+	0x11, 0x60, 0x89, 0x54, 0x6d, 0xb3, 0xf3, 0xdc, 0x73, 0xee, 0xdc, 0x73, 0xae, 0xaf, 0xaf, 0x0c,
+	0xc9, 0xee, 0x70, 0x80, 0xb3, 0xcd, 0xfe, 0x59, 0xdb, 0xc6, 0x34, 0x3b, 0xc8, 0x35, 0x31, 0x33,
+	0x72, 0x59, 0x76, 0x9e, 0x71, 0x5c, 0xc2, 0x08, 0x8a, 0x73, 0x38, 0x33, 0x82, 0x33, 0x23, 0x58,
+	0x8b, 0x9b, 0xc4, 0x24, 0x82, 0x90, 0xe5, 0x4f, 0x92, 0x9b, 0xfe, 0xb6, 0x00, 0xab, 0x25, 0x6a,
+	0x56, 0xfb, 0xcd, 0x9e, 0xc5, 0x76, 0x45, 0xca, 0xb1, 0x4b, 0x1c, 0x42, 0x0d, 0x1b, 0xa9, 0xb0,
+	0xd4, 0x72, 0xb1, 0xc1, 0x88, 0xab, 0x2a, 0x1b, 0xca, 0x66, 0xb4, 0x32, 0x3e, 0xa2, 0x07, 0x10,
+	0xa6, 0xcc, 0xe8, 0x62, 0x57, 0x5d, 0x10, 0xc0, 0xe8, 0x84, 0x56, 0x61, 0xc9, 0x21, 0xc4, 0x6e,
+	0x58, 0x6d, 0x75, 0x71, 0x43, 0xd9, 0x0c, 0x56, 0xc2, 0xfc, 0x58, 0x6c, 0xa3, 0x24, 0x00, 0x65,
+	0xc4, 0x35, 0x4c, 0xcc, 0xb1, 0xa0, 0x48, 0x8a, 0x8e, 0x22, 0xc5, 0x36, 0x5a, 0x87, 0x68, 0x73,
+	0xc8, 0x70, 0x83, 0x5a, 0x17, 0x58, 0x0d, 0x89, 0xcc, 0x08, 0x0f, 0x54, 0xad, 0x0b, 0x8c, 0x52,
+	0xb0, 0xfc, 0xca, 0x25, 0xbd, 0x46, 0x07, 0x5b, 0x66, 0x87, 0xa9, 0x61, 0x01, 0x03, 0x0f, 0x1d,
+	0x88, 0x08, 0xcf, 0x66, 0x64, 0x0c, 0x2f, 0xc9, 0x6c, 0x46, 0x46, 0xe0, 0x1a, 0x44, 0x44, 0x76,
+	0x17, 0x0f, 0xd5, 0x88, 0x74, 0xc1, 0xcf, 0x87, 0x78, 0x88, 0x12, 0x10, 0x66, 0x44, 0x00, 0x51,
+	0x01, 0x84, 0x18, 0xe1, 0xe1, 0x35, 0x88, 0x30, 0xd2, 0x18, 0x18, 0x76, 0x1f, 0xab, 0x20, 0x33,
+	0x18, 0xa9, 0xf3, 0x23, 0x97, 0x22, 0xdb, 0xda, 0xe8, 0x18, 0xb4, 0xa3, 0x2e, 0x0b, 0x14, 0x64,
+	0xe8, 0xc0, 0xa0, 0x9d, 0xf4, 0x23, 0x48, 0xf9, 0x74, 0xb3, 0x82, 0xa9, 0x43, 0xce, 0x28, 0x4e,
+	0x7f, 0x52, 0x20, 0x51, 0xa2, 0x66, 0x9d, 0x30, 0x7c, 0x6f, 0xfd, 0xce, 0x43, 0x70, 0x40, 0x98,
+	0x6c, 0xf5, 0x7f, 0x79, 0x3d, 0x33, 0x6b, 0x60, 0x32, 0x5c, 0x61, 0x6d, 0xe8, 0xe0, 0x8a, 0xe0,
+	0xa6, 0x53, 0x90, 0x9c, 0x29, 0xdb, 0x33, 0x66, 0x40, 0xbc, 0x44, 0xcd, 0x3d, 0xdb, 0xb0, 0x7a,
+	0x27, 0x8e, 0x4d, 0x8c, 0x36, 0x76, 0x2b, 0xc4, 0xc6, 0xbf, 0xd1, 0x56, 0x5a, 0x87, 0x87, 0xb3,
+	0x4a, 0x78, 0x12, 0xde, 0x2a, 0xf0, 0x3f, 0xef, 0x7f, 0xd7, 0x72, 0xfe, 0x90, 0x84, 0xc9, 0x69,
+	0x0c, 0x4e, 0x4e, 0x63, 0x3a, 0x09, 0xeb, 0x33, 0x24, 0x78, 0x12, 0x3f, 0x48, 0x89, 0x7b, 0xa4,
+	0xe7, 0x8d, 0x08, 0xef, 0xe9, 0x5f, 0x7c, 0xf9, 0x29, 0x58, 0x6e, 0x89, 0xea, 0x72, 0x88, 0x43,
+	0x72, 0x88, 0x65, 0x48, 0x0c, 0xb1, 0x74, 0x30, 0xa9, 0xd0, 0x73, 0xf0, 0x45, 0x3a, 0xa8, 0xe0,
+	0x01, 0x36, 0xec, 0x7b, 0x71, 0xf0, 0x0b, 0xe3, 0x8b, 0x10, 0x04, 0xa9, 0x61, 0xcb, 0xf5, 0x11,
+	0xad, 0x88, 0xe7, 0x91, 0xd1, 0x49, 0x23, 0x63, 0xa3, 0x5b, 0x26, 0x44, 0xc6, 0x97, 0xa0, 0x35,
+	0x48, 0xd4, 0x8f, 0x6a, 0x85, 0x46, 0xed, 0xf4, 0xb8, 0xd0, 0x38, 0x29, 0x57, 0x8f, 0x0b, 0x7b,
+	0xc5, 0xfd, 0x62, 0xe1, 0x45, 0x2c, 0x80, 0x56, 0xe0, 0xdf, 0x1b, 0xe8, 0xb4, 0x50, 0x8d, 0x29,
+	0x28, 0x06, 0xff, 0xdc, 0x84, 0xca, 0x47, 0xb1, 0x05, 0x94, 0x80, 0x95, 0x9b, 0xc8, 0xce, 0x6e,
+	0xb5, 0xb6, 0x53, 0x2c, 0xc7, 0x16, 0xb5, 0xe0, 0xbb, 0x8f, 0x7a, 0x20, 0xff, 0x39, 0x04, 0x8b,
+	0x25, 0x6a, 0xa2, 0x37, 0x10, 0x9f, 0xb9, 0x88, 0x9f, 0xcd, 0x76, 0xe8, 0xb3, 0x69, 0xb4, 0xe7,
+	0x77, 0xa2, 0x8f, 0xed, 0xa2, 0x01, 0xa0, 0x19, 0x4b, 0xe9, 0x89, 0xef, 0x65, 0xd3, 0x64, 0x6d,
+	0xfb, 0x0e, 0x64, 0xaf, 0x2e, 0x85, 0x95, 0xe9, 0xa5, 0xb1, 0xe5, 0x7b, 0xd3, 0x14, 0x57, 0xcb,
+	0xcf, 0xcf, 0xf5, 0x8a, 0x3a, 0x10, 0x9b, 0xda, 0x12, 0x8f, 0xfd, 0xfb, 0x36, 0x41, 0xd5, 0x72,
+	0x73, 0x53, 0x6f, 0x57, 0x9c, 0xfa, 0xe8, 0xfd, 0x2b, 0x4e, 0x52, 0xb5, 0xdc, 0xdc, 0xd4, 0xdb,
+	0x15, 0xa7, 0x3e, 0x52, 0xff, 0x8a, 0x93, 0x54, 0x2d, 0x37, 0x37, 0x75, 0x5c, 0x71, 0x77, 0xff,
+	0xeb, 0x95, 0xae, 0x5c, 0x5e, 0xe9, 0xca, 0x8f, 0x2b, 0x5d, 0x79, 0x7f, 0xad, 0x07, 0x2e, 0xaf,
+	0xf5, 0xc0, 0xf7, 0x6b, 0x3d, 0xf0, 0xf2, 0xa9, 0x69, 0xb1, 0x4e, 0xbf, 0x99, 0x69, 0x91, 0x5e,
+	0xf6, 0xf0, 0xb4, 0x5e, 0x28, 0x63, 0xf6, 0x9a, 0xb8, 0xdd, 0x6c, 0xab, 0x63, 0x58, 0x67, 0xd9,
+	0x73, 0xef, 0x67, 0x86, 0x0d, 0x1d, 0x4c, 0x9b, 0x61, 0xf1, 0x73, 0xb2, 0xfd, 0x73, 0x00, 0xc0,
+	0xec, 0xf5, 0x1f, 0xe9, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -646,6 +903,10 @@ type MsgClient interface {
 	ClaimUploaderRole(ctx context.Context, in *MsgClaimUploaderRole, opts ...grpc.CallOption) (*MsgClaimUploaderRoleResponse, error)
 	// SkipUploaderRole ...
 	SkipUploaderRole(ctx context.Context, in *MsgSkipUploaderRole, opts ...grpc.CallOption) (*MsgSkipUploaderRoleResponse, error)
+	// CommitBundleVote ...
+	CommitBundleVote(ctx context.Context, in *MsgCommitBundleVote, opts ...grpc.CallOption) (*MsgCommitBundleVoteResponse, error)
+	// RevealBundleVote ...
+	RevealBundleVote(ctx context.Context, in *MsgRevealBundleVote, opts ...grpc.CallOption) (*MsgRevealBundleVoteResponse, error)
 }
 
 type msgClient struct {
@@ -692,6 +953,24 @@ func (c *msgClient) SkipUploaderRole(ctx context.Context, in *MsgSkipUploaderRol
 	return out, nil
 }
 
+func (c *msgClient) CommitBundleVote(ctx context.Context, in *MsgCommitBundleVote, opts ...grpc.CallOption) (*MsgCommitBundleVoteResponse, error) {
+	out := new(MsgCommitBundleVoteResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/CommitBundleVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealBundleVote(ctx context.Context, in *MsgRevealBundleVote, opts ...grpc.CallOption) (*MsgRevealBundleVoteResponse, error) {
+	out := new(MsgRevealBundleVoteResponse)
+	err := c.cc.Invoke(ctx, "/kyve.bundles.v1beta1.Msg/RevealBundleVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// this line is used by starport scaffolding # proto/tx/rpc
@@ -703,6 +982,10 @@ type MsgServer interface {
 	ClaimUploaderRole(context.Context, *MsgClaimUploaderRole) (*MsgClaimUploaderRoleResponse, error)
 	// SkipUploaderRole ...
 	SkipUploaderRole(context.Context, *MsgSkipUploaderRole) (*MsgSkipUploaderRoleResponse, error)
+	// CommitBundleVote ...
+	CommitBundleVote(context.Context, *MsgCommitBundleVote) (*MsgCommitBundleVoteResponse, error)
+	// RevealBundleVote ...
+	RevealBundleVote(context.Context, *MsgRevealBundleVote) (*MsgRevealBundleVoteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -721,6 +1004,12 @@ func (*UnimplementedMsgServer) ClaimUploaderRole(ctx context.Context, req *MsgCl
 func (*UnimplementedMsgServer) SkipUploaderRole(ctx context.Context, req *MsgSkipUploaderRole) (*MsgSkipUploaderRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SkipUploaderRole not implemented")
 }
+func (*UnimplementedMsgServer) CommitBundleVote(ctx context.Context, req *MsgCommitBundleVote) (*MsgCommitBundleVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBundleVote not implemented")
+}
+func (*UnimplementedMsgServer) RevealBundleVote(ctx context.Context, req *MsgRevealBundleVote) (*MsgRevealBundleVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBundleVote not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -798,6 +1087,42 @@ func _Msg_SkipUploaderRole_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CommitBundleVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitBundleVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitBundleVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Msg/CommitBundleVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitBundleVote(ctx, req.(*MsgCommitBundleVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealBundleVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBundleVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBundleVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.bundles.v1beta1.Msg/RevealBundleVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBundleVote(ctx, req.(*MsgRevealBundleVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kyve.bundles.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -818,6 +1143,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SkipUploaderRole",
 			Handler:    _Msg_SkipUploaderRole_Handler,
 		},
+		{
+			MethodName: "CommitBundleVote",
+			Handler:    _Msg_CommitBundleVote_Handler,
+		},
+		{
+			MethodName: "RevealBundleVote",
+			Handler:    _Msg_RevealBundleVote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kyve/bundles/v1beta1/tx.proto",
@@ -1150,37 +1483,200 @@ func (m *MsgSkipUploaderRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCommitBundleVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSubmitBundleProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCommitBundleVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBundleVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CommitHash) > 0 {
+		i -= len(m.CommitHash)
+		copy(dAtA[i:], m.CommitHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CommitHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.StorageId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitBundleVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitBundleVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBundleVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBundleVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBundleVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBundleVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Vote != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Vote))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBundleVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBundleVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBundleVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitBundleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.ByteSize != 0 {
 		n += 1 + sovTx(uint64(m.ByteSize))
@@ -1316,6 +1812,83 @@ func (m *MsgSkipUploaderRoleResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCommitBundleVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CommitHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitBundleVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealBundleVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Vote != 0 {
+		n += 1 + sovTx(uint64(m.Vote))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealBundleVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1550,14 +2123,444 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBundleProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBundleProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBundleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteBundleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteBundleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteBundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			m.Vote = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vote |= VoteType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteBundleProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteBundleProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteBundleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimUploaderRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1585,11 +2588,11 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToKey = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1617,13 +2620,13 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToValue = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1633,24 +2636,11 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BundleHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1672,7 +2662,7 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitBundleProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimUploaderRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1695,10 +2685,10 @@ func (m *MsgSubmitBundleProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBundleProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimUploaderRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBundleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimUploaderRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1722,7 +2712,7 @@ func (m *MsgSubmitBundleProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBundleProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1745,10 +2735,10 @@ func (m *MsgVoteBundleProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBundleProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSkipUploaderRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSkipUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1835,42 +2825,10 @@ func (m *MsgVoteBundleProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StorageId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			m.Vote = 0
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1880,7 +2838,7 @@ func (m *MsgVoteBundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Vote |= VoteType(b&0x7F) << shift
+				m.FromHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1906,7 +2864,7 @@ func (m *MsgVoteBundleProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBundleProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSkipUploaderRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1929,10 +2887,10 @@ func (m *MsgVoteBundleProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBundleProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSkipUploaderRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBundleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSkipUploaderRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1956,7 +2914,7 @@ func (m *MsgVoteBundleProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitBundleVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1979,10 +2937,10 @@ func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUploaderRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitBundleVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitBundleVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2068,6 +3026,70 @@ func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2089,7 +3111,7 @@ func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUploaderRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitBundleVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2112,10 +3134,10 @@ func (m *MsgClaimUploaderRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUploaderRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitBundleVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUploaderRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitBundleVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2139,7 +3161,7 @@ func (m *MsgClaimUploaderRoleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealBundleVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2162,10 +3184,10 @@ func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSkipUploaderRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealBundleVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSkipUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealBundleVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2252,10 +3274,42 @@ func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
-			m.FromHeight = 0
+			m.Vote = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2265,11 +3319,43 @@ func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromHeight |= uint64(b&0x7F) << shift
+				m.Vote |= VoteType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2291,7 +3377,7 @@ func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSkipUploaderRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealBundleVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2314,10 +3400,10 @@ func (m *MsgSkipUploaderRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSkipUploaderRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealBundleVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSkipUploaderRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealBundleVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1,25 +1,34 @@
 package types
 
-type VoteDistribution struct {
-	// valid ...
-	Valid uint64
-	// invalid ...
-	Invalid uint64
-	// abstain ...
-	Abstain uint64
-	// total ...
-	Total uint64
-	// status ...
-	Status BundleStatus
-}
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type BundleReward struct {
 	// treasury ...
-	Treasury uint64
+	Treasury sdk.Coins
 	// uploader ...
-	Uploader uint64
+	Uploader sdk.Coins
 	// delegation ...
-	Delegation uint64
+	Delegation sdk.Coins
 	// total ...
-	Total uint64
+	Total sdk.Coins
+	// voters ...
+	Voters []VoterReward
+}
+
+// VoteSaltSize is the number of random bytes of the salt of a vote commit.
+// The salt is hex encoded, so that it can't contain the hash separator.
+const VoteSaltSize = 32
+
+// GetVoteCommitHash returns the hex encoded sha256 hash a staker has to commit
+// to in commit-reveal pools. The same hash is recomputed on reveal. The staker
+// is part of the hash so that a commit can't be copied by another staker.
+func GetVoteCommitHash(staker string, vote VoteType, salt string, storageId string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s:%d:%s:%s", staker, vote, salt, storageId)))
+	return hex.EncodeToString(hash[:])
 }
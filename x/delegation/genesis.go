@@ -38,6 +38,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetRedelegationCooldown(ctx, entry)
 	}
 
+	for _, entry := range genState.SlashRecordList {
+		k.SetSlashRecord(ctx, entry)
+	}
+
+	k.SetSlashRecordCount(ctx, genState.SlashRecordCount)
+
 	k.InitMemStore(ctx)
 
 }
@@ -62,5 +68,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.RedelegationCooldownList = k.GetAllRedelegationCooldownEntries(ctx)
 
+	genesis.SlashRecordList = k.GetAllSlashRecords(ctx)
+
+	genesis.SlashRecordCount = k.GetSlashRecordCount(ctx)
+
 	return genesis
 }
@@ -41,12 +41,12 @@ func (k Keeper) GetDelegationOfPool(ctx sdk.Context, poolId uint64) uint64 {
 	return totalDelegation
 }
 
-// PayoutRewards transfers `amount` $nKYVE from the `payerModuleName`-module to the delegation module.
+// PayoutRewards transfers `amount` from the `payerModuleName`-module to the delegation module.
 // It then awards these tokens internally to all delegators of staker `staker`.
 // Delegators can then receive these rewards if they call the `withdraw`-transaction.
 // This method return false if the payout failed. This happens usually if there are no
 // delegators for that staker. If this happens one should do something else with the rewards.
-func (k Keeper) PayoutRewards(ctx sdk.Context, staker string, amount uint64, payerModuleName string) (success bool) {
+func (k Keeper) PayoutRewards(ctx sdk.Context, staker string, amount sdk.Coins, payerModuleName string) (success bool) {
 	// Assert there are delegators
 	if k.DoesDelegationDataExist(ctx, staker) {
 
@@ -54,7 +54,7 @@ func (k Keeper) PayoutRewards(ctx sdk.Context, staker string, amount uint64, pay
 		k.AddAmountToDelegationRewards(ctx, staker, amount)
 
 		// Transfer tokens to the delegation module
-		err := util.TransferFromModuleToModule(k.bankKeeper, ctx, payerModuleName, types.ModuleName, amount)
+		err := util.TransferCoinsFromModuleToModule(k.bankKeeper, ctx, payerModuleName, types.ModuleName, amount)
 		if err != nil {
 			util.PanicHalt(k.upgradeKeeper, ctx, "Not enough tokens in module")
 			return false
@@ -65,8 +65,11 @@ func (k Keeper) PayoutRewards(ctx sdk.Context, staker string, amount uint64, pay
 }
 
 // SlashDelegators reduces the delegation of all delegators of `staker` by fraction
-// and transfers the amount to the Treasury.
-func (k Keeper) SlashDelegators(ctx sdk.Context, staker string, slashType stakerstypes.SlashType) {
+// and transfers the amount to the Treasury. The slash is recorded for the pool
+// with id `poolId` in which the staker misbehaved. If `hasBundle` is true,
+// `bundleId` is the id of the finalized bundle which triggered the slash,
+// otherwise it is ignored. The slashed amount is returned.
+func (k Keeper) SlashDelegators(ctx sdk.Context, poolId uint64, staker string, slashType stakerstypes.SlashType, bundleId uint64, hasBundle bool) (slashedAmount uint64) {
 
 	// Only slash if staker has delegators
 	if k.DoesDelegationDataExist(ctx, staker) {
@@ -75,19 +78,81 @@ func (k Keeper) SlashDelegators(ctx sdk.Context, staker string, slashType staker
 		k.RemoveStakerIndex(ctx, staker)
 		defer k.SetStakerIndex(ctx, staker)
 
+		// The delegation of all pools of the staker changes
+		k.expireUploadDeadlinesOfStaker(ctx, staker)
+
+		fraction := k.stakersKeeper.GetSlashFraction(ctx, slashType)
+
 		// Perform F1-slash and get slashed amount in nKYVE
-		slashedAmount := k.f1Slash(ctx, staker, k.stakersKeeper.GetSlashFraction(ctx, slashType))
+		var slashedIndex uint64
+		slashedIndex, slashedAmount = k.f1Slash(ctx, staker, fraction)
 
 		// Transfer tokens to the Treasury
 		if err := util.TransferFromModuleToTreasury(k.accountKeeper, k.distrKeeper, ctx, types.ModuleName, slashedAmount); err != nil {
 			util.PanicHalt(k.upgradeKeeper, ctx, "Not enough tokens in module")
 		}
+
+		k.AppendSlashRecord(ctx, types.SlashRecord{
+			Staker:    staker,
+			PoolId:    poolId,
+			SlashType: slashType,
+			Fraction:  fraction,
+			Amount:    slashedAmount,
+			KIndex:    slashedIndex,
+			BundleId:  bundleId,
+			HasBundle: hasBundle,
+			Height:    ctx.BlockHeight(),
+			Time:      uint64(ctx.BlockTime().Unix()),
+		})
+
+		// Emit a slash event.
+		if errEmit := ctx.EventManager().EmitTypedEvent(&stakerstypes.EventSlash{
+			PoolId:    poolId,
+			Address:   staker,
+			Amount:    slashedAmount,
+			SlashType: slashType,
+		}); errEmit != nil {
+			util.LogFatalLogicError("Event not parsable", errEmit.Error())
+		}
 	}
 
+	return slashedAmount
 }
 
 // GetOutstandingRewards calculates the current rewards a delegator has collected for
 // the given staker.
-func (k Keeper) GetOutstandingRewards(ctx sdk.Context, staker string, delegator string) uint64 {
+func (k Keeper) GetOutstandingRewards(ctx sdk.Context, staker string, delegator string) sdk.Coins {
 	return k.f1GetOutstandingRewards(ctx, staker, delegator)
 }
+
+// StartUnbondingAllDelegators schedules the unbonding of the full delegation of
+// every delegator of `staker`, including its self-delegation. It is used when a
+// staker retires. Unbondings are capped to the delegation which is left when
+// they are performed, therefore earlier entries of the same delegator are fine.
+func (k Keeper) StartUnbondingAllDelegators(ctx sdk.Context, staker string) {
+	for _, delegator := range k.GetDelegatorsOfStaker(ctx, staker) {
+		amount := k.GetDelegationAmountOfDelegator(ctx, staker, delegator.Delegator)
+		k.StartUnbondingDelegator(ctx, staker, delegator.Delegator, amount)
+	}
+}
+
+// RemoveStakerDelegationData removes the delegation data of a staker
+// without delegators together with all its F1 entries and slashes and
+// drops it from the query index. It is used when a retired staker gets
+// removed, so that the address can create a staker with a clean state again.
+func (k Keeper) RemoveStakerDelegationData(ctx sdk.Context, staker string) {
+	if delegationData, found := k.GetDelegationData(ctx, staker); found && delegationData.DelegatorCount != 0 {
+		util.PanicHalt(k.upgradeKeeper, ctx, "Delegation data of a staker with delegators can not be removed")
+	}
+
+	k.RemoveStakerIndex(ctx, staker)
+	k.RemoveDelegationData(ctx, staker)
+
+	for _, entry := range k.GetAllDelegationEntriesOfStaker(ctx, staker) {
+		k.RemoveDelegationEntry(ctx, staker, entry.KIndex)
+	}
+
+	for _, slash := range k.GetAllDelegationSlashEntriesOfStaker(ctx, staker) {
+		k.RemoveDelegationSlashEntry(ctx, staker, slash.KIndex)
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/KYVENetwork/chain/util"
 	"github.com/KYVENetwork/chain/x/delegation/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -73,3 +74,23 @@ func (k Keeper) GetAllDelegationEntries(ctx sdk.Context) (list []types.Delegatio
 
 	return
 }
+
+// GetAllDelegationEntriesOfStaker returns all delegationEntries of the given staker
+func (k Keeper) GetAllDelegationEntriesOfStaker(ctx sdk.Context, stakerAddress string) (list []types.DelegationEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationEntriesKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, util.GetByteKey(stakerAddress))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DelegationEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		// addresses of other stakers can start with the given address
+		if val.Staker == stakerAddress {
+			list = append(list, val)
+		}
+	}
+
+	return
+}
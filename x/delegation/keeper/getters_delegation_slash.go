@@ -72,6 +72,26 @@ func (k Keeper) GetAllDelegationSlashEntries(ctx sdk.Context) (list []types.Dele
 	return
 }
 
+// GetAllDelegationSlashEntriesOfStaker returns all delegation slash entries of the given staker
+func (k Keeper) GetAllDelegationSlashEntriesOfStaker(ctx sdk.Context, stakerAddress string) (list []types.DelegationSlash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationSlashEntriesKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, util.GetByteKey(stakerAddress))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DelegationSlash
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		// addresses of other stakers can start with the given address
+		if val.Staker == stakerAddress {
+			list = append(list, val)
+		}
+	}
+
+	return
+}
+
 // GetAllDelegationSlashesBetween returns all Slashes that happened between the given periods
 // `start` and `end` are both inclusive.
 func (k Keeper) GetAllDelegationSlashesBetween(ctx sdk.Context, staker string, start uint64, end uint64) (list []types.DelegationSlash) {
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/KYVENetwork/chain/util"
 	"github.com/KYVENetwork/chain/x/delegation/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -88,3 +89,19 @@ func (k Keeper) GetAllDelegators(ctx sdk.Context) (list []types.Delegator) {
 
 	return
 }
+
+// GetDelegatorsOfStaker returns all delegators of the given staker
+func (k Keeper) GetDelegatorsOfStaker(ctx sdk.Context, stakerAddress string) (list []types.Delegator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), util.GetByteKey(types.DelegatorKeyPrefix, stakerAddress))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Delegator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
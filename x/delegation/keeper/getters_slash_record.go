@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The `SlashRecord` entries store the full history of all slashes.
+// In contrast to the `DelegationSlash` entries, which are needed by the
+// F1-Fee algorithm, they are only used for auditing and queries.
+// Every record is indexed by the slashed staker, by the pool
+// in which the slash happened and by the slashed staker together with
+// the F1 period the slash was accounted to.
+
+// GetSlashRecordCount returns the total number of slash records
+func (k Keeper) GetSlashRecordCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.SlashRecordCountKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetSlashRecordCount sets the total number of slash records
+func (k Keeper) SetSlashRecordCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.SlashRecordCountKey, bz)
+}
+
+// AppendSlashRecord stores the given slash record with a new id and updates the count
+func (k Keeper) AppendSlashRecord(ctx sdk.Context, slashRecord types.SlashRecord) uint64 {
+	count := k.GetSlashRecordCount(ctx)
+
+	slashRecord.Id = count
+	k.SetSlashRecord(ctx, slashRecord)
+
+	k.SetSlashRecordCount(ctx, count+1)
+
+	return count
+}
+
+// SetSlashRecord stores the given slash record and updates all indices
+func (k Keeper) SetSlashRecord(ctx sdk.Context, slashRecord types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKeyPrefix)
+	b := k.cdc.MustMarshal(&slashRecord)
+	store.Set(types.SlashRecordKey(slashRecord.Id), b)
+
+	indexStakerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKeyPrefixIndexStaker)
+	indexStakerStore.Set(types.SlashRecordKeyIndexStaker(slashRecord.Staker, slashRecord.Id), []byte{})
+
+	indexPoolStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKeyPrefixIndexPool)
+	indexPoolStore.Set(types.SlashRecordKeyIndexPool(slashRecord.PoolId, slashRecord.Id), []byte{})
+
+	indexStakerKIndexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKeyPrefixIndexStakerKIndex)
+	indexStakerKIndexStore.Set(types.SlashRecordKeyIndexStakerKIndex(slashRecord.Staker, slashRecord.KIndex, slashRecord.Id), []byte{})
+}
+
+// GetSlashRecord returns the slash record with the given id
+func (k Keeper) GetSlashRecord(ctx sdk.Context, id uint64) (val types.SlashRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKeyPrefix)
+
+	b := store.Get(types.SlashRecordKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllSlashRecords returns all slash records ordered by their id
+func (k Keeper) GetAllSlashRecords(ctx sdk.Context) (list []types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SlashRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPaginatedSlashRecordsByStaker returns all slash records of the given staker, oldest first
+func (k Keeper) GetPaginatedSlashRecordsByStaker(ctx sdk.Context, pagination *query.PageRequest, staker string) ([]types.SlashRecord, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), util.GetByteKey(types.SlashRecordKeyPrefixIndexStaker, staker))
+	return k.getPaginatedSlashRecordsFromIndex(ctx, store, pagination)
+}
+
+// GetPaginatedSlashRecordsByPool returns all slash records of the given pool, oldest first
+func (k Keeper) GetPaginatedSlashRecordsByPool(ctx sdk.Context, pagination *query.PageRequest, poolId uint64) ([]types.SlashRecord, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), util.GetByteKey(types.SlashRecordKeyPrefixIndexPool, poolId))
+	return k.getPaginatedSlashRecordsFromIndex(ctx, store, pagination)
+}
+
+// GetPaginatedSlashRecordsByDelegator returns all slash records which reduced
+// a current delegation of the given delegator, oldest first. A slash affects a
+// delegator if it was accounted to a period in which the delegator was already
+// delegating to the slashed staker. The ids of the affected records are
+// collected from the staker and period index of every staker the delegator
+// delegates to, so only the records which affected the delegator are read.
+func (k Keeper) GetPaginatedSlashRecordsByDelegator(ctx sdk.Context, pagination *query.PageRequest, delegator string) ([]types.SlashRecord, *query.PageResponse, error) {
+	// The collected ids are merged in an in-memory index, ordered by id.
+	// The in-memory store does not allow iterating without a prefix.
+	affectedStore := prefix.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, types.SlashRecordKeyPrefix)
+
+	delegatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), util.GetByteKey(types.DelegatorKeyPrefixIndex2, delegator))
+	iterator := sdk.KVStorePrefixIterator(delegatorStore, nil)
+
+	for ; iterator.Valid(); iterator.Next() {
+		staker := string(iterator.Key()[0:43])
+		delegation, found := k.GetDelegator(ctx, staker, delegator)
+		if !found {
+			continue
+		}
+
+		// Only iterate the slashes starting from the period the delegator joined
+		stakerStore := prefix.NewStore(ctx.KVStore(k.storeKey), util.GetByteKey(types.SlashRecordKeyPrefixIndexStakerKIndex, staker))
+		slashIterator := stakerStore.Iterator(util.GetByteKey(delegation.KIndex), nil)
+
+		for ; slashIterator.Valid(); slashIterator.Next() {
+			affectedStore.Set(slashIterator.Key()[8:16], []byte{})
+		}
+		slashIterator.Close()
+	}
+	iterator.Close()
+
+	return k.getPaginatedSlashRecordsFromIndex(ctx, affectedStore, pagination)
+}
+
+// getPaginatedSlashRecordsFromIndex paginates over an index store whose keys
+// end with the id of the slash record and returns the referenced records.
+func (k Keeper) getPaginatedSlashRecordsFromIndex(ctx sdk.Context, indexStore prefix.Store, pagination *query.PageRequest) ([]types.SlashRecord, *query.PageResponse, error) {
+	var data []types.SlashRecord
+
+	pageRes, err := query.FilteredPaginate(indexStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			slashRecord, found := k.GetSlashRecord(ctx, binary.BigEndian.Uint64(key[0:8]))
+			if !found {
+				return false, nil
+			}
+
+			data = append(data, slashRecord)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return data, pageRes, nil
+}
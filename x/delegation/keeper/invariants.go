@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all delegation module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-assets", ModuleAssetsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the delegation module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ModuleAssetsInvariant(k)(ctx)
+	}
+}
+
+// ModuleAssetsInvariant checks that the balance of the delegation module
+// covers all delegations and all outstanding rewards of the delegators.
+// Due to rounding the module can hold slightly more than that, as
+// it is always the delegator who gets paid out less.
+func ModuleAssetsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expectedBalance := sdk.NewCoins()
+
+		for _, delegator := range k.GetAllDelegators(ctx) {
+			expectedBalance = expectedBalance.Add(util.KyveCoins(k.GetDelegationAmountOfDelegator(ctx, delegator.Staker, delegator.Delegator))...)
+			expectedBalance = expectedBalance.Add(k.GetOutstandingRewards(ctx, delegator.Staker, delegator.Delegator)...)
+		}
+
+		moduleAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		actualBalance := k.bankKeeper.GetAllBalances(ctx, moduleAcc)
+
+		_, broken := actualBalance.SafeSub(expectedBalance)
+
+		return sdk.FormatInvariant(types.ModuleName, "module-assets", fmt.Sprintf(
+			"\tdelegations and rewards: %v\n\tmodule balance: %v\n", expectedBalance, actualBalance,
+		)), broken
+	}
+}
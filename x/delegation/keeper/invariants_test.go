@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/keeper"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - invariants
+
+* Invariants hold for a staker with delegators
+* Module assets invariant breaks if the module holds less than the delegations
+* Module assets invariant holds if the module holds more than the delegations
+
+*/
+
+var _ = Describe("invariants", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreateFundedPool(&s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.BOB,
+			Staker:  i.ALICE,
+			Amount:  10 * i.KYVE,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Invariants hold for a staker with delegators", func() {
+		// Act
+		_, broken := keeper.AllInvariants(s.App().DelegationKeeper)(s.Ctx())
+
+		// Assert
+		Expect(broken).To(BeFalse())
+	})
+
+	It("Module assets invariant breaks if the module holds less than the delegations", func() {
+		// Arrange
+		// break the state in a cached context, so the validity checks still pass
+		ctx, _ := s.Ctx().CacheContext()
+
+		receiver, _ := sdk.AccAddressFromBech32(i.CHARLIE)
+		err := s.App().BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, i.KYVECoins(1))
+		Expect(err).To(BeNil())
+
+		// Act
+		msg, broken := keeper.ModuleAssetsInvariant(s.App().DelegationKeeper)(ctx)
+
+		// Assert
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("module-assets"))
+	})
+
+	It("Module assets invariant holds if the module holds more than the delegations", func() {
+		// Arrange
+		ctx, _ := s.Ctx().CacheContext()
+
+		sender, _ := sdk.AccAddressFromBech32(i.CHARLIE)
+		err := s.App().BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, i.KYVECoins(1))
+		Expect(err).To(BeNil())
+
+		// Act
+		_, broken := keeper.ModuleAssetsInvariant(s.App().DelegationKeeper)(ctx)
+
+		// Assert
+		Expect(broken).To(BeFalse())
+	})
+})
@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+/*
+
+TEST CASES - keeper_suite_slash_records_test.go
+
+* Slash a staker and check the slash record and event
+* Slash a staker for the first finalized bundle
+* Query slashes by staker with pagination
+* Query slashes by pool
+* Query slashes by delegator only returns slashes which affected the delegator
+* Query slashes by delegator of multiple stakers with pagination
+
+*/
+
+var _ = Describe("Delegation - Slash Records", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreateFundedPool(&s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.ALICE,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.BOB,
+			Amount:  100 * i.KYVE,
+		})
+
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.UploadSlash = "0.1"
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		s.CommitAfterSeconds(7)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Slash a staker and check the slash record and event", func() {
+		// Act
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+
+		// Assert
+		records := s.App().DelegationKeeper.GetAllSlashRecords(s.Ctx())
+		Expect(records).To(HaveLen(1))
+
+		Expect(records[0].Id).To(Equal(uint64(0)))
+		Expect(records[0].Staker).To(Equal(i.ALICE))
+		Expect(records[0].PoolId).To(Equal(uint64(0)))
+		Expect(records[0].SlashType).To(Equal(stakerstypes.SLASH_TYPE_UPLOAD))
+		Expect(records[0].Fraction).To(Equal(sdk.MustNewDecFromStr("0.1")))
+		Expect(records[0].Amount).To(Equal(10 * i.KYVE))
+		Expect(records[0].BundleId).To(Equal(uint64(0)))
+		Expect(records[0].HasBundle).To(BeFalse())
+		Expect(records[0].Height).To(Equal(s.Ctx().BlockHeight()))
+		Expect(records[0].Time).To(Equal(uint64(s.Ctx().BlockTime().Unix())))
+
+		Expect(s.App().DelegationKeeper.GetSlashRecordCount(s.Ctx())).To(Equal(uint64(1)))
+
+		var slashEvent *sdk.Event
+		for _, event := range s.Ctx().EventManager().Events() {
+			if event.Type == "kyve.stakers.v1beta1.EventSlash" {
+				e := event
+				slashEvent = &e
+			}
+		}
+		Expect(slashEvent).NotTo(BeNil())
+
+		parsedEvent, err := sdk.ParseTypedEvent(abci.Event(*slashEvent))
+		Expect(err).To(BeNil())
+		Expect(parsedEvent).To(Equal(&stakerstypes.EventSlash{
+			PoolId:    0,
+			Address:   i.ALICE,
+			Amount:    10 * i.KYVE,
+			SlashType: stakerstypes.SLASH_TYPE_UPLOAD,
+		}))
+	})
+
+	It("Slash a staker for the first finalized bundle", func() {
+		// Act
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_VOTE, 0, true)
+
+		// Assert
+		records := s.App().DelegationKeeper.GetAllSlashRecords(s.Ctx())
+		Expect(records).To(HaveLen(1))
+
+		Expect(records[0].SlashType).To(Equal(stakerstypes.SLASH_TYPE_VOTE))
+		Expect(records[0].BundleId).To(Equal(uint64(0)))
+		Expect(records[0].HasBundle).To(BeTrue())
+	})
+
+	It("Query slashes by staker with pagination", func() {
+		// Arrange
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.BOB, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+
+		// Act
+		firstPage, firstErr := s.App().QueryKeeper.SlashesByStaker(sdk.WrapSDKContext(s.Ctx()), &querytypes.QuerySlashesByStakerRequest{
+			Staker:     i.ALICE,
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		secondPage, secondErr := s.App().QueryKeeper.SlashesByStaker(sdk.WrapSDKContext(s.Ctx()), &querytypes.QuerySlashesByStakerRequest{
+			Staker:     i.ALICE,
+			Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey},
+		})
+
+		// Assert
+		Expect(firstErr).To(BeNil())
+		Expect(firstPage.Pagination.Total).To(Equal(uint64(2)))
+		Expect(firstPage.Slashes).To(HaveLen(1))
+		Expect(firstPage.Slashes[0].Id).To(Equal(uint64(0)))
+		Expect(firstPage.Slashes[0].Amount).To(Equal(10 * i.KYVE))
+
+		Expect(secondErr).To(BeNil())
+		Expect(secondPage.Slashes).To(HaveLen(1))
+		Expect(secondPage.Slashes[0].Id).To(Equal(uint64(2)))
+		Expect(secondPage.Slashes[0].Amount).To(Equal(9 * i.KYVE))
+	})
+
+	It("Query slashes by pool", func() {
+		// Arrange
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 1, i.BOB, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+
+		// Act
+		poolZero, poolZeroErr := s.App().QueryKeeper.SlashesByPool(sdk.WrapSDKContext(s.Ctx()), &querytypes.QuerySlashesByPoolRequest{
+			PoolId: 0,
+		})
+		poolOne, poolOneErr := s.App().QueryKeeper.SlashesByPool(sdk.WrapSDKContext(s.Ctx()), &querytypes.QuerySlashesByPoolRequest{
+			PoolId: 1,
+		})
+
+		// Assert
+		Expect(poolZeroErr).To(BeNil())
+		Expect(poolZero.Slashes).To(HaveLen(1))
+		Expect(poolZero.Slashes[0].Staker).To(Equal(i.ALICE))
+
+		Expect(poolOneErr).To(BeNil())
+		Expect(poolOne.Slashes).To(HaveLen(1))
+		Expect(poolOne.Slashes[0].Staker).To(Equal(i.BOB))
+	})
+
+	It("Query slashes by delegator only returns slashes which affected the delegator", func() {
+		// Arrange
+		// slash before the delegator joined
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  10 * i.KYVE,
+		})
+
+		// slash of a staker the delegator did not delegate to
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.BOB, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+
+		// slash which affects the delegator
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+
+		// Act
+		res, err := s.App().QueryKeeper.SlashesByDelegator(sdk.WrapSDKContext(s.Ctx()), &querytypes.QuerySlashesByDelegatorRequest{
+			Delegator:  i.DUMMY[0],
+			Pagination: &query.PageRequest{CountTotal: true},
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(res.Pagination.Total).To(Equal(uint64(1)))
+		Expect(res.Slashes).To(HaveLen(1))
+		Expect(res.Slashes[0].Id).To(Equal(uint64(2)))
+		Expect(res.Slashes[0].Staker).To(Equal(i.ALICE))
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(9 * i.KYVE))
+	})
+	It("Query slashes by delegator of multiple stakers with pagination", func() {
+		// Arrange
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  10 * i.KYVE,
+		})
+
+		// slash of a staker the delegator did not delegate to yet
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.BOB, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.BOB,
+			Amount:  10 * i.KYVE,
+		})
+
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.BOB, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+
+		// Act
+		firstPage, firstErr := s.App().QueryKeeper.SlashesByDelegator(sdk.WrapSDKContext(s.Ctx()), &querytypes.QuerySlashesByDelegatorRequest{
+			Delegator:  i.DUMMY[0],
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		secondPage, secondErr := s.App().QueryKeeper.SlashesByDelegator(sdk.WrapSDKContext(s.Ctx()), &querytypes.QuerySlashesByDelegatorRequest{
+			Delegator:  i.DUMMY[0],
+			Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey},
+		})
+
+		// Assert
+		Expect(firstErr).To(BeNil())
+		Expect(firstPage.Pagination.Total).To(Equal(uint64(3)))
+		Expect(firstPage.Slashes).To(HaveLen(2))
+		Expect(firstPage.Slashes[0].Id).To(Equal(uint64(1)))
+		Expect(firstPage.Slashes[0].Staker).To(Equal(i.ALICE))
+		Expect(firstPage.Slashes[1].Id).To(Equal(uint64(2)))
+		Expect(firstPage.Slashes[1].Staker).To(Equal(i.BOB))
+
+		Expect(secondErr).To(BeNil())
+		Expect(secondPage.Slashes).To(HaveLen(1))
+		Expect(secondPage.Slashes[0].Id).To(Equal(uint64(3)))
+		Expect(secondPage.Slashes[0].Staker).To(Equal(i.ALICE))
+		Expect(secondPage.Pagination.NextKey).To(BeNil())
+	})
+})
@@ -15,9 +15,9 @@ func TestKeeper(t *testing.T) {
 }
 
 func PayoutRewards(s *i.KeeperTestSuite, staker string, amount uint64) {
-	err := s.App().PoolKeeper.ChargeFundersOfPool(s.Ctx(), 0, amount)
+	_, err := s.App().PoolKeeper.ChargeFundersOfPool(s.Ctx(), 0, amount)
 	Expect(err).To(BeNil())
-	success := s.App().DelegationKeeper.PayoutRewards(s.Ctx(), staker, amount, pooltypes.ModuleName)
+	success := s.App().DelegationKeeper.PayoutRewards(s.Ctx(), staker, i.KYVECoins(amount), pooltypes.ModuleName)
 	Expect(success).To(BeTrue())
 }
 
@@ -37,14 +37,14 @@ func CreateFundedPool(s *i.KeeperTestSuite) {
 	s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 		Creator: i.ALICE,
 		Id:      0,
-		Amount:  50 * i.KYVE,
+		Amount:  i.KYVECoins(50 * i.KYVE),
 	})
 
 	s.CommitAfterSeconds(7)
 
 	pool, poolFound := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 	Expect(poolFound).To(BeTrue())
-	Expect(pool.TotalFunds).To(Equal(50 * i.KYVE))
+	Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(50 * i.KYVE))
 }
 
 func CheckAndContinueChainForOneMonth(s *i.KeeperTestSuite) {
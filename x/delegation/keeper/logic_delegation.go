@@ -14,10 +14,13 @@ func (k Keeper) performDelegation(ctx sdk.Context, stakerAddress string, delegat
 	k.RemoveStakerIndex(ctx, stakerAddress)
 	defer k.SetStakerIndex(ctx, stakerAddress)
 
+	// The delegation of all pools of the staker changes
+	k.expireUploadDeadlinesOfStaker(ctx, stakerAddress)
+
 	if k.DoesDelegatorExist(ctx, stakerAddress, delegatorAddress) {
 		// If the sender is already a delegator, first perform an undelegation, before then delegating.
 		reward := k.f1WithdrawRewards(ctx, stakerAddress, delegatorAddress)
-		err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, delegatorAddress, reward)
+		err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, delegatorAddress, reward)
 		if err != nil {
 			util.PanicHalt(k.upgradeKeeper, ctx, "no money left in module")
 		}
@@ -46,11 +49,14 @@ func (k Keeper) performUndelegation(ctx sdk.Context, stakerAddress string, deleg
 	k.RemoveStakerIndex(ctx, stakerAddress)
 	defer k.SetStakerIndex(ctx, stakerAddress)
 
+	// The delegation of all pools of the staker changes
+	k.expireUploadDeadlinesOfStaker(ctx, stakerAddress)
+
 	// Withdraw all rewards for the sender.
 	reward := k.f1WithdrawRewards(ctx, stakerAddress, delegatorAddress)
 
 	// Transfer tokens from this module to sender.
-	if err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, delegatorAddress, reward); err != nil {
+	if err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, delegatorAddress, reward); err != nil {
 		util.PanicHalt(k.upgradeKeeper, ctx, "not enough money in module")
 	}
 
@@ -74,3 +80,12 @@ func (k Keeper) performUndelegation(ctx sdk.Context, stakerAddress string, deleg
 
 	return undelegatedAmount - redelegation
 }
+
+// expireUploadDeadlinesOfStaker lets the bundles module check every pool the
+// staker is participating in, because a change of the delegation could
+// affect whether these pools reach their min stake.
+func (k Keeper) expireUploadDeadlinesOfStaker(ctx sdk.Context, stakerAddress string) {
+	for _, valaccount := range k.stakersKeeper.GetValaccountsFromStaker(ctx, stakerAddress) {
+		k.poolKeeper.ExpireUploadDeadline(ctx, valaccount.PoolId)
+	}
+}
@@ -33,22 +33,15 @@ func (k Keeper) f1StartNewPeriod(ctx sdk.Context, staker string, delegationData
 	// F1: corresponds to $Entry_{f-1}$
 	previousEntry, found := k.GetDelegationEntry(ctx, staker, delegationData.LatestIndexK)
 	if !found {
-		previousEntry.Value = sdk.NewDec(0)
+		previousEntry.Value = sdk.NewDecCoins()
 	}
 
 	// Calculate quotient of current period
 	// If totalDelegation is zero the quotient is also zero
-	currentPeriodValue := sdk.NewDec(0)
-	if delegationData.TotalDelegation != 0 {
-		decCurrentRewards := sdk.NewDec(int64(delegationData.CurrentRewards))
-		decTotalDelegation := sdk.NewDec(int64(delegationData.TotalDelegation))
-
-		// F1: $T_f / n_f$
-		currentPeriodValue = decCurrentRewards.Quo(decTotalDelegation)
-	}
+	currentPeriodValue := k.f1GetCurrentPeriodValue(*delegationData)
 
 	// Add previous entry to current one
-	currentPeriodValue = currentPeriodValue.Add(previousEntry.Value)
+	currentPeriodValue = currentPeriodValue.Add(previousEntry.Value...)
 
 	// Increment index for the next period
 	indexF := delegationData.LatestIndexK + 1
@@ -62,7 +55,7 @@ func (k Keeper) f1StartNewPeriod(ctx sdk.Context, staker string, delegationData
 
 	// Reset the rewards for the next period back to zero
 	// and update to the new index
-	delegationData.CurrentRewards = 0
+	delegationData.CurrentRewards = sdk.NewCoins()
 	delegationData.LatestIndexK = indexF
 
 	if delegationData.LatestIndexWasUndelegation {
@@ -154,14 +147,14 @@ func (k Keeper) f1RemoveDelegator(ctx sdk.Context, stakerAddress string, delegat
 // It ends the current period and start a new one with reduced total delegation.
 // A slash entry is created which is needed to calculate the correct delegation amount
 // of every delegator.
-func (k Keeper) f1Slash(ctx sdk.Context, stakerAddress string, fraction sdk.Dec) (amount uint64) {
+func (k Keeper) f1Slash(ctx sdk.Context, stakerAddress string, fraction sdk.Dec) (slashedIndex uint64, amount uint64) {
 
 	delegationData, _ := k.GetDelegationData(ctx, stakerAddress)
 
 	// Finish current period because in the new one there will be
 	// a reduced total delegation for the slashed staker
 	// The slash will be accounted to the period with index `slashedIndex`
-	slashedIndex := k.f1StartNewPeriod(ctx, stakerAddress, &delegationData)
+	slashedIndex = k.f1StartNewPeriod(ctx, stakerAddress, &delegationData)
 
 	k.SetDelegationSlashEntry(ctx, types.DelegationSlash{
 		Staker:   stakerAddress,
@@ -177,15 +170,15 @@ func (k Keeper) f1Slash(ctx sdk.Context, stakerAddress string, fraction sdk.Dec)
 	delegationData.TotalDelegation -= slashedAmount
 	k.SetDelegationData(ctx, delegationData)
 
-	return slashedAmount
+	return slashedIndex, slashedAmount
 }
 
 // f1WithdrawRewards calculates all outstanding rewards and withdraws them from
 // the f1-logic. A new period starts.
-func (k Keeper) f1WithdrawRewards(ctx sdk.Context, stakerAddress string, delegatorAddress string) (rewards uint64) {
+func (k Keeper) f1WithdrawRewards(ctx sdk.Context, stakerAddress string, delegatorAddress string) (rewards sdk.Coins) {
 	delegator, found := k.GetDelegator(ctx, stakerAddress, delegatorAddress)
 	if !found {
-		return 0
+		return sdk.NewCoins()
 	}
 
 	// Fetch metadata
@@ -202,15 +195,15 @@ func (k Keeper) f1WithdrawRewards(ctx sdk.Context, stakerAddress string, delegat
 	// delegation amount for the period.
 	// To incorporate slashing one needs to iterate all slashes and calculate the reward for every period
 	// separately and then sum it.
-	reward := sdk.NewDec(0)
+	reward := sdk.NewDecCoins()
 	k.f1IterateConstantDelegationPeriods(ctx, stakerAddress, delegatorAddress, delegator.KIndex, endIndex,
 		func(startIndex uint64, endIndex uint64, delegation sdk.Dec) {
 			// entry difference
 			difference := k.f1GetEntryDifference(ctx, stakerAddress, startIndex, endIndex)
 
-			periodReward := difference.Mul(delegation)
+			periodReward := difference.MulDec(delegation)
 
-			reward = reward.Add(periodReward)
+			reward = reward.Add(periodReward...)
 		})
 
 	// Delete Delegator entry as he has no outstanding rewards anymore.
@@ -221,7 +214,8 @@ func (k Keeper) f1WithdrawRewards(ctx sdk.Context, stakerAddress string, delegat
 	delegator.InitialAmount = k.f1GetCurrentDelegation(ctx, delegator.Staker, delegator.Delegator)
 	k.SetDelegator(ctx, delegator)
 
-	return reward.TruncateInt().Uint64()
+	rewards, _ = reward.TruncateDecimal()
+	return rewards
 }
 
 // f1IterateConstantDelegationPeriods iterates all periods between minIndex and maxIndex (both inclusive)
@@ -275,10 +269,10 @@ func (k Keeper) f1GetCurrentDelegation(ctx sdk.Context, stakerAddress string, de
 
 // f1GetOutstandingRewards calculates the current outstanding rewards without modifying the f1-state.
 // This method can be used for queries.
-func (k Keeper) f1GetOutstandingRewards(ctx sdk.Context, stakerAddress string, delegatorAddress string) uint64 {
+func (k Keeper) f1GetOutstandingRewards(ctx sdk.Context, stakerAddress string, delegatorAddress string) sdk.Coins {
 	delegator, found := k.GetDelegator(ctx, stakerAddress, delegatorAddress)
 	if !found {
-		return 0
+		return sdk.NewCoins()
 	}
 
 	// Fetch metadata
@@ -294,16 +288,16 @@ func (k Keeper) f1GetOutstandingRewards(ctx sdk.Context, stakerAddress string, d
 	// delegation amount for the period.
 	// To incorporate slashing one needs to iterate all slashes and calculate the reward for every period
 	// separately and then sum it.
-	reward := sdk.NewDec(0)
+	reward := sdk.NewDecCoins()
 	latestBalance := sdk.NewDec(int64(delegator.InitialAmount))
 	k.f1IterateConstantDelegationPeriods(ctx, stakerAddress, delegatorAddress, delegator.KIndex, endIndex,
 		func(startIndex uint64, endIndex uint64, delegation sdk.Dec) {
 
 			difference := k.f1GetEntryDifference(ctx, stakerAddress, startIndex, endIndex)
 			// Multiply with delegation for period
-			periodReward := difference.Mul(delegation)
+			periodReward := difference.MulDec(delegation)
 			// Add to total rewards
-			reward = reward.Add(periodReward)
+			reward = reward.Add(periodReward...)
 
 			// For calculating the last (ongoing) period
 			latestBalance = delegation
@@ -316,22 +310,31 @@ func (k Keeper) f1GetOutstandingRewards(ctx sdk.Context, stakerAddress string, d
 	}
 	_ = entry
 
-	currentPeriodValue := sdk.NewDec(0)
-	if delegationData.TotalDelegation != 0 {
-		decCurrentRewards := sdk.NewDec(int64(delegationData.CurrentRewards))
-		decTotalDelegation := sdk.NewDec(int64(delegationData.TotalDelegation))
+	currentPeriodValue := k.f1GetCurrentPeriodValue(delegationData)
+
+	ongoingPeriodReward := currentPeriodValue.MulDec(latestBalance)
+
+	reward = reward.Add(ongoingPeriodReward...)
+
+	rewards, _ := reward.TruncateDecimal()
+	return rewards
+}
 
-		// F1: $T_f / n_f$
-		currentPeriodValue = decCurrentRewards.Quo(decTotalDelegation)
+// f1GetCurrentPeriodValue calculates the quotient of the rewards collected in the
+// ongoing period and the total delegation. If totalDelegation is zero the quotient is also zero
+func (k Keeper) f1GetCurrentPeriodValue(delegationData types.DelegationData) sdk.DecCoins {
+	if delegationData.TotalDelegation == 0 {
+		return sdk.NewDecCoins()
 	}
 
-	ongoingPeriodReward := currentPeriodValue.Mul(latestBalance)
+	decCurrentRewards := sdk.NewDecCoinsFromCoins(delegationData.CurrentRewards...)
+	decTotalDelegation := sdk.NewDec(int64(delegationData.TotalDelegation))
 
-	reward = reward.Add(ongoingPeriodReward)
-	return reward.TruncateInt().Uint64()
+	// F1: $T_f / n_f$
+	return decCurrentRewards.QuoDec(decTotalDelegation)
 }
 
-func (k Keeper) f1GetEntryDifference(ctx sdk.Context, stakerAddress string, lowIndex uint64, highIndex uint64) sdk.Dec {
+func (k Keeper) f1GetEntryDifference(ctx sdk.Context, stakerAddress string, lowIndex uint64, highIndex uint64) sdk.DecCoins {
 	// entry difference
 	firstEntry, found := k.GetDelegationEntry(ctx, stakerAddress, lowIndex)
 	if !found {
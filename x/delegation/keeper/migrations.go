@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/util"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the F1 reward bookkeeping from plain $KYVE amounts
+// to coins, so that rewards can be paid out in every funding denom.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, entry := range m.keeper.GetAllDelegationEntries(ctx) {
+		entry.Value = sdk.NewDecCoins()
+		if !entry.LegacyValue.IsNil() && entry.LegacyValue.IsPositive() {
+			entry.Value = sdk.NewDecCoins(sdk.NewDecCoinFromDec(util.KyveDenom, entry.LegacyValue))
+		}
+		entry.LegacyValue = sdk.ZeroDec()
+
+		m.keeper.SetDelegationEntry(ctx, entry)
+	}
+
+	for _, delegationData := range m.keeper.GetAllDelegationData(ctx) {
+		delegationData.CurrentRewards = util.KyveCoins(delegationData.LegacyCurrentRewards)
+		delegationData.LegacyCurrentRewards = 0
+
+		m.keeper.SetDelegationData(ctx, delegationData)
+	}
+
+	return nil
+}
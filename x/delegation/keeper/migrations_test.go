@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/delegation/keeper"
+	"github.com/KYVENetwork/chain/x/delegation/types"
+)
+
+/*
+
+TEST CASES - migrations.go
+
+* Migrate legacy $KYVE rewards to coins
+
+*/
+
+var _ = Describe("Delegation - Migrations", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		CreateFundedPool(&s)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{Creator: i.ALICE, Amount: 100 * i.KYVE})
+	})
+
+	AfterEach(func() {
+		CheckAndContinueChainForOneMonth(&s)
+	})
+
+	It("Migrate legacy $KYVE rewards to coins", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[0],
+			Staker:  i.ALICE,
+			Amount:  10 * i.KYVE,
+		})
+
+		PayoutRewards(&s, i.ALICE, 10*i.KYVE)
+
+		s.RunTxDelegatorSuccess(&types.MsgDelegate{
+			Creator: i.DUMMY[1],
+			Staker:  i.ALICE,
+			Amount:  10 * i.KYVE,
+		})
+
+		PayoutRewards(&s, i.ALICE, 5*i.KYVE)
+
+		entries := s.App().DelegationKeeper.GetAllDelegationEntries(s.Ctx())
+		delegationData := s.App().DelegationKeeper.GetAllDelegationData(s.Ctx())
+
+		outstandingRewards := make(map[string]sdk.Coins)
+		for _, delegator := range []string{i.ALICE, i.DUMMY[0], i.DUMMY[1]} {
+			outstandingRewards[delegator] = s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, delegator)
+		}
+
+		// write the state in the format of the previous version
+		for _, entry := range entries {
+			entry.LegacyValue = entry.Value.AmountOf(i.KYVE_DENOM)
+			entry.Value = nil
+			s.App().DelegationKeeper.SetDelegationEntry(s.Ctx(), entry)
+		}
+
+		for _, data := range delegationData {
+			data.LegacyCurrentRewards = data.CurrentRewards.AmountOf(i.KYVE_DENOM).Uint64()
+			data.CurrentRewards = nil
+			s.App().DelegationKeeper.SetDelegationData(s.Ctx(), data)
+		}
+
+		// ACT
+		err := keeper.NewMigrator(s.App().DelegationKeeper).Migrate2to3(s.Ctx())
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		Expect(s.App().DelegationKeeper.GetAllDelegationEntries(s.Ctx())).To(Equal(entries))
+		Expect(s.App().DelegationKeeper.GetAllDelegationData(s.Ctx())).To(Equal(delegationData))
+
+		for delegator, rewards := range outstandingRewards {
+			Expect(rewards.IsZero()).To(BeFalse())
+			Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, delegator)).To(Equal(rewards))
+		}
+	})
+})
@@ -11,8 +11,8 @@ import (
 )
 
 // Delegate handles the transaction of delegating a specific amount of $KYVE to a staker
-// The only requirement for the transaction to succeed is that the staker exists
-// and the user has enough balance.
+// The only requirement for the transaction to succeed is that the staker exists,
+// is not retiring and the user has enough balance.
 func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -20,6 +20,10 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 		return nil, sdkErrors.Wrap(types.ErrStakerDoesNotExist, msg.Staker)
 	}
 
+	if k.stakersKeeper.IsStakerRetiring(ctx, msg.Staker) {
+		return nil, sdkErrors.Wrap(types.ErrStakerRetiring, msg.Staker)
+	}
+
 	// Performs logical delegation without transferring the amount
 	k.performDelegation(ctx, msg.Staker, msg.Creator, msg.Amount)
 
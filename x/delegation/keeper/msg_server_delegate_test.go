@@ -132,8 +132,8 @@ var _ = Describe("Delegation - Delegation", Ordered, func() {
 		})
 		poolModuleBalance := s.GetBalanceFromModule(pooltypes.ModuleName)
 		Expect(poolModuleBalance).To(Equal(50 * i.KYVE))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeZero())
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1])).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0]).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1]).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
 		s.PerformValidityChecks()
 
 		// Act
@@ -143,9 +143,9 @@ var _ = Describe("Delegation - Delegation", Ordered, func() {
 		// Alice:   100		100/(409) * 10 * 1e9 = 2.444.987.775
 		// Dummy0:  100		100/(409) * 10 * 1e9 = 2.444.987.775
 		// Dummy1:  209		209/(409) * 10 * 1e9 = 5.110.024.449
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.ALICE)).To(Equal(uint64(2_444_987_775)))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(uint64(2_444_987_775)))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(uint64(5_110_024_449)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.ALICE).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(2_444_987_775)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(2_444_987_775)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(5_110_024_449)))
 
 		s.RunTxDelegatorSuccess(&types.MsgWithdrawRewards{
 			Creator: i.DUMMY[0],
@@ -153,9 +153,9 @@ var _ = Describe("Delegation - Delegation", Ordered, func() {
 		})
 
 		// Assert
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.ALICE)).To(Equal(uint64(2_444_987_775)))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(uint64(0)))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(uint64(5_110_024_449)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.ALICE).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(2_444_987_775)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(0)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(5_110_024_449)))
 
 		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(uint64(900*i.KYVE + 2_444_987_775)))
 		Expect(s.GetBalanceFromModule(pooltypes.ModuleName)).To(Equal(40 * i.KYVE))
@@ -177,16 +177,16 @@ var _ = Describe("Delegation - Delegation", Ordered, func() {
 		})
 		poolModuleBalance := s.GetBalanceFromModule(pooltypes.ModuleName)
 		Expect(poolModuleBalance).To(Equal(50 * i.KYVE))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(BeZero())
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1])).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0]).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1]).AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
 
 		PayoutRewards(&s, i.ALICE, 10*i.KYVE)
 
 		// Alice: 100
 		// Dummy0: 100
 		// Dummy1: 200
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(uint64(2_500_000_000)))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(uint64(5_000_000_000)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(2_500_000_000)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(5_000_000_000)))
 		s.PerformValidityChecks()
 
 		// Act
@@ -200,8 +200,8 @@ var _ = Describe("Delegation - Delegation", Ordered, func() {
 		})
 
 		// Assert
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(uint64(0)))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(uint64(5_000_000_000)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(0)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(5_000_000_000)))
 
 		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(uint64(900*i.KYVE + 2_500_000_000)))
 		Expect(s.GetBalanceFromModule(pooltypes.ModuleName)).To(Equal(40 * i.KYVE))
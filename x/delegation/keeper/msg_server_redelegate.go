@@ -25,6 +25,11 @@ func (k msgServer) Redelegate(goCtx context.Context, msg *types.MsgRedelegate) (
 		return nil, types.ErrStakerDoesNotExist
 	}
 
+	// Retiring stakers do not accept new delegations
+	if k.stakersKeeper.IsStakerRetiring(ctx, msg.ToStaker) {
+		return nil, sdkErrors.Wrap(types.ErrStakerRetiring, msg.ToStaker)
+	}
+
 	// Check if the sender is trying to undelegate more than he has delegated.
 	if msg.Amount > k.GetDelegationAmountOfDelegator(ctx, msg.FromStaker, msg.Creator) {
 		return nil, sdkErrors.Wrapf(sdkErrors.ErrInsufficientFunds, types.ErrNotEnoughDelegation.Error(), msg.Amount)
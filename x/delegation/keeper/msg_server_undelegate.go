@@ -15,6 +15,11 @@ import (
 func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// The delegations of a retiring staker are already unbonding
+	if k.stakersKeeper.IsStakerRetiring(ctx, msg.Staker) {
+		return nil, errors.Wrap(types.ErrStakerRetiring, msg.Staker)
+	}
+
 	// Don't allow to undelegate more than currently delegated
 	if msg.Amount > k.GetDelegationAmountOfDelegator(ctx, msg.Staker, msg.Creator) {
 		return nil, errors.Wrapf(types.ErrNotEnoughDelegation, "")
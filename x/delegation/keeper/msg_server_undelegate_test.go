@@ -218,7 +218,7 @@ var _ = Describe("Delegation - Undelegation", Ordered, func() {
 		params := s.App().StakersKeeper.GetParams(s.Ctx())
 		params.UploadSlash = "0.1"
 		s.App().StakersKeeper.SetParams(s.Ctx(), params)
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
 		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(9 * i.KYVE))
 
 		s.CommitAfterSeconds(s.App().DelegationKeeper.UnbondingDelegationTime(s.Ctx()) + 1)
@@ -369,7 +369,7 @@ var _ = Describe("Delegation - Undelegation", Ordered, func() {
 		params := s.App().StakersKeeper.GetParams(s.Ctx())
 		params.UploadSlash = "0.1"
 		s.App().StakersKeeper.SetParams(s.Ctx(), params)
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
 
 		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(9 * i.KYVE))
 		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(18 * i.KYVE))
@@ -422,7 +422,7 @@ var _ = Describe("Delegation - Undelegation", Ordered, func() {
 		s.PerformValidityChecks()
 
 		// Slash 50%
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
 
 		s.RunTxDelegatorSuccess(&types.MsgDelegate{
 			Creator: i.DUMMY[1],
@@ -442,8 +442,8 @@ var _ = Describe("Delegation - Undelegation", Ordered, func() {
 		PayoutRewards(&s, i.ALICE, 10*i.KYVE)
 
 		// Assert
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(uint64(666_666_666)))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(uint64(2_666_666_666)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(666_666_666)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(2_666_666_666)))
 	})
 
 	It("Slash twice", func() {
@@ -467,8 +467,8 @@ var _ = Describe("Delegation - Undelegation", Ordered, func() {
 		s.App().StakersKeeper.SetParams(s.Ctx(), params)
 
 		// Slash 50% twice
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD)
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
 
 		// Assert
 		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.ALICE)).To(Equal(25*i.KYVE + uint64(2_500_000_000+5_000_000_000)))
@@ -506,8 +506,8 @@ var _ = Describe("Delegation - Undelegation", Ordered, func() {
 		params := s.App().StakersKeeper.GetParams(s.Ctx())
 		params.UploadSlash = "0.5"
 		s.App().StakersKeeper.SetParams(s.Ctx(), params)
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD)
-		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.ALICE, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
 
 		// Alice: 25    25 / 32.5 * 1e10 = 7_692_307_692
 		// Dummy0: 2.5  2.5 / 32.5 * 1e10 = 769_230_769
@@ -23,8 +23,8 @@ func (k msgServer) WithdrawRewards(goCtx context.Context, msg *types.MsgWithdraw
 	// Withdraw all rewards of the sender.
 	reward := k.f1WithdrawRewards(ctx, msg.Staker, msg.Creator)
 
-	// Transfer reward $KYVE from this module to sender.
-	err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, msg.Creator, reward)
+	// Transfer rewards from this module to sender.
+	err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, msg.Creator, reward)
 	if err != nil {
 		return nil, err
 	}
@@ -91,9 +91,9 @@ var _ = Describe("Delegation - Withdraw Rewards", Ordered, func() {
 		Expect(delegationModuleBalanceAfter).To(Equal(delegationModuleBalanceBefore + 20*i.KYVE))
 		Expect(poolModuleBalanceAfter).To(Equal(poolModuleBalanceBefore - 20*i.KYVE))
 
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0])).To(Equal(uint64(6666666666)))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1])).To(Equal(uint64(6666666666)))
-		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[2])).To(Equal(uint64(6666666666)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[0]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(6666666666)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[1]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(6666666666)))
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.ALICE, i.DUMMY[2]).AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(uint64(6666666666)))
 
 		s.RunTxDelegatorSuccess(&types.MsgWithdrawRewards{
 			Creator: i.DUMMY[0],
@@ -142,10 +142,10 @@ var _ = Describe("Delegation - Withdraw Rewards", Ordered, func() {
 
 	It("Test invalid Payout", func() {
 		forkedCtx, _ := s.Ctx().CacheContext()
-		success := s.App().DelegationKeeper.PayoutRewards(forkedCtx, i.ALICE, 20000*i.KYVE, pooltypes.ModuleName)
+		success := s.App().DelegationKeeper.PayoutRewards(forkedCtx, i.ALICE, i.KYVECoins(20000*i.KYVE), pooltypes.ModuleName)
 		Expect(success).To(BeFalse())
 
-		success = s.App().DelegationKeeper.PayoutRewards(s.Ctx(), i.DUMMY[20], 0*i.KYVE, pooltypes.ModuleName)
+		success = s.App().DelegationKeeper.PayoutRewards(s.Ctx(), i.DUMMY[20], i.KYVECoins(0*i.KYVE), pooltypes.ModuleName)
 		Expect(success).To(BeFalse())
 	})
 
@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	types1 "github.com/KYVENetwork/chain/x/stakers/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -105,8 +107,11 @@ type DelegationEntry struct {
 	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
 	// k_index is the of the period this entry ends
 	KIndex uint64 `protobuf:"varint,2,opt,name=k_index,json=kIndex,proto3" json:"k_index,omitempty"`
+	// legacy_value is the quotient of collected tkyve rewards and total stake before
+	// rewards could be paid out in multiple denoms. It is only read by the store migration.
+	LegacyValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=legacy_value,json=legacyValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"legacy_value"` // Deprecated: Do not use.
 	// value is the quotient of collected rewards and total stake according to F1-distribution
-	Value github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+	Value github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=value,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"value"`
 }
 
 func (m *DelegationEntry) Reset()         { *m = DelegationEntry{} }
@@ -156,12 +161,20 @@ func (m *DelegationEntry) GetKIndex() uint64 {
 	return 0
 }
 
+func (m *DelegationEntry) GetValue() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 // DelegationPoolData ...
 type DelegationData struct {
 	// staker ...
 	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
-	// current_rewards ...
-	CurrentRewards uint64 `protobuf:"varint,2,opt,name=current_rewards,json=currentRewards,proto3" json:"current_rewards,omitempty"`
+	// legacy_current_rewards are the tkyve rewards of the current period before rewards
+	// could be paid out in multiple denoms. It is only read by the store migration.
+	LegacyCurrentRewards uint64 `protobuf:"varint,2,opt,name=legacy_current_rewards,json=legacyCurrentRewards,proto3" json:"legacy_current_rewards,omitempty"` // Deprecated: Do not use.
 	// total_delegation ...
 	TotalDelegation uint64 `protobuf:"varint,3,opt,name=total_delegation,json=totalDelegation,proto3" json:"total_delegation,omitempty"`
 	// latest_index_k ...
@@ -170,6 +183,8 @@ type DelegationData struct {
 	DelegatorCount uint64 `protobuf:"varint,5,opt,name=delegator_count,json=delegatorCount,proto3" json:"delegator_count,omitempty"`
 	// latest_index_was_undelegation ...
 	LatestIndexWasUndelegation bool `protobuf:"varint,6,opt,name=latest_index_was_undelegation,json=latestIndexWasUndelegation,proto3" json:"latest_index_was_undelegation,omitempty"`
+	// current_rewards ...
+	CurrentRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=current_rewards,json=currentRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_rewards"`
 }
 
 func (m *DelegationData) Reset()         { *m = DelegationData{} }
@@ -212,9 +227,10 @@ func (m *DelegationData) GetStaker() string {
 	return ""
 }
 
-func (m *DelegationData) GetCurrentRewards() uint64 {
+// Deprecated: Do not use.
+func (m *DelegationData) GetLegacyCurrentRewards() uint64 {
 	if m != nil {
-		return m.CurrentRewards
+		return m.LegacyCurrentRewards
 	}
 	return 0
 }
@@ -247,6 +263,13 @@ func (m *DelegationData) GetLatestIndexWasUndelegation() bool {
 	return false
 }
 
+func (m *DelegationData) GetCurrentRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CurrentRewards
+	}
+	return nil
+}
+
 // DelegationSlash ...
 type DelegationSlash struct {
 	// staker ...
@@ -304,6 +327,141 @@ func (m *DelegationSlash) GetKIndex() uint64 {
 	return 0
 }
 
+// SlashRecord stores the details of a single slash of a staker.
+// It is kept for auditing, so that delegators can look up why
+// their delegation shrank.
+type SlashRecord struct {
+	// id is a unique, incrementing identifier of the slash record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// staker is the address of the slashed staker.
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id is the pool in which the slash happened.
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// slash_type is the reason for the slash.
+	SlashType types1.SlashType `protobuf:"varint,4,opt,name=slash_type,json=slashType,proto3,enum=kyve.stakers.v1beta1.SlashType" json:"slash_type,omitempty"`
+	// fraction is the percentage of the delegation which got slashed.
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	// amount is the total amount in $nKYVE which got slashed.
+	Amount uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// k_index is the F1 period of the staker the slash was accounted to.
+	// Only delegators who joined before or in this period were affected.
+	KIndex uint64 `protobuf:"varint,7,opt,name=k_index,json=kIndex,proto3" json:"k_index,omitempty"`
+	// bundle_id is the id of the finalized bundle which triggered the slash.
+	// It is only set if has_bundle is true, because zero is a valid bundle id.
+	BundleId uint64 `protobuf:"varint,8,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	// height is the block height of the slash.
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the unix time of the slash.
+	Time uint64 `protobuf:"varint,10,opt,name=time,proto3" json:"time,omitempty"`
+	// has_bundle is true if the slash was triggered by a finalized bundle.
+	// It is false for slashes like upload slashes of invalid bundles or
+	// timeout slashes, whose bundle_id is zero and must be ignored.
+	HasBundle bool `protobuf:"varint,11,opt,name=has_bundle,json=hasBundle,proto3" json:"has_bundle,omitempty"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e07f10cb3da486ac, []int{4}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetSlashType() types1.SlashType {
+	if m != nil {
+		return m.SlashType
+	}
+	return types1.SLASH_TYPE_UNSPECIFIED
+}
+
+func (m *SlashRecord) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetKIndex() uint64 {
+	if m != nil {
+		return m.KIndex
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetBundleId() uint64 {
+	if m != nil {
+		return m.BundleId
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetHasBundle() bool {
+	if m != nil {
+		return m.HasBundle
+	}
+	return false
+}
+
 // UndelegationQueueEntry ...
 type UndelegationQueueEntry struct {
 	// index ...
@@ -322,7 +480,7 @@ func (m *UndelegationQueueEntry) Reset()         { *m = UndelegationQueueEntry{}
 func (m *UndelegationQueueEntry) String() string { return proto.CompactTextString(m) }
 func (*UndelegationQueueEntry) ProtoMessage()    {}
 func (*UndelegationQueueEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e07f10cb3da486ac, []int{4}
+	return fileDescriptor_e07f10cb3da486ac, []int{5}
 }
 func (m *UndelegationQueueEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,7 +556,7 @@ func (m *QueueState) Reset()         { *m = QueueState{} }
 func (m *QueueState) String() string { return proto.CompactTextString(m) }
 func (*QueueState) ProtoMessage()    {}
 func (*QueueState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e07f10cb3da486ac, []int{5}
+	return fileDescriptor_e07f10cb3da486ac, []int{6}
 }
 func (m *QueueState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +611,7 @@ func (m *RedelegationCooldown) Reset()         { *m = RedelegationCooldown{} }
 func (m *RedelegationCooldown) String() string { return proto.CompactTextString(m) }
 func (*RedelegationCooldown) ProtoMessage()    {}
 func (*RedelegationCooldown) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e07f10cb3da486ac, []int{6}
+	return fileDescriptor_e07f10cb3da486ac, []int{7}
 }
 func (m *RedelegationCooldown) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,6 +659,7 @@ func init() {
 	proto.RegisterType((*DelegationEntry)(nil), "kyve.delegation.v1beta1.DelegationEntry")
 	proto.RegisterType((*DelegationData)(nil), "kyve.delegation.v1beta1.DelegationData")
 	proto.RegisterType((*DelegationSlash)(nil), "kyve.delegation.v1beta1.DelegationSlash")
+	proto.RegisterType((*SlashRecord)(nil), "kyve.delegation.v1beta1.SlashRecord")
 	proto.RegisterType((*UndelegationQueueEntry)(nil), "kyve.delegation.v1beta1.UndelegationQueueEntry")
 	proto.RegisterType((*QueueState)(nil), "kyve.delegation.v1beta1.QueueState")
 	proto.RegisterType((*RedelegationCooldown)(nil), "kyve.delegation.v1beta1.RedelegationCooldown")
@@ -511,44 +670,60 @@ func init() {
 }
 
 var fileDescriptor_e07f10cb3da486ac = []byte{
-	// 580 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xd3, 0x26, 0x8d, 0x47, 0x6d, 0x82, 0xac, 0x28, 0x8d, 0x0a, 0x75, 0x23, 0xf3, 0x15,
-	0x0e, 0xc4, 0xaa, 0xf8, 0x05, 0x6d, 0x5d, 0x89, 0x52, 0x09, 0x09, 0x97, 0x82, 0xe0, 0x62, 0x6d,
-	0xec, 0x25, 0xb1, 0xec, 0x78, 0xab, 0xdd, 0x75, 0xdc, 0x1c, 0xb9, 0x71, 0xaa, 0xf8, 0x0b, 0xfc,
-	0x9b, 0x1e, 0x7b, 0x44, 0x1c, 0x2a, 0x94, 0xfc, 0x11, 0xe4, 0xf5, 0xc6, 0xb1, 0x91, 0x72, 0xe8,
-	0x29, 0x99, 0xb7, 0xe3, 0x7d, 0x6f, 0xde, 0x1b, 0x2d, 0xf4, 0x83, 0xd9, 0x14, 0x9b, 0x1e, 0x0e,
-	0xf1, 0x08, 0x71, 0x9f, 0x44, 0xe6, 0xf4, 0x70, 0x88, 0x39, 0x3a, 0x2c, 0x40, 0x83, 0x2b, 0x4a,
-	0x38, 0xd1, 0x76, 0xd3, 0xce, 0x41, 0x01, 0x96, 0x9d, 0x7b, 0xed, 0x11, 0x19, 0x11, 0xd1, 0x63,
-	0xa6, 0xff, 0xb2, 0x76, 0xe3, 0xbb, 0x02, 0xaa, 0x95, 0x35, 0x13, 0xaa, 0x75, 0xa0, 0xce, 0x38,
-	0x0a, 0x30, 0xed, 0x2a, 0x3d, 0xa5, 0xaf, 0xda, 0xb2, 0xd2, 0x9e, 0x80, 0xea, 0x2d, 0x9b, 0xba,
-	0x55, 0x71, 0xb4, 0x02, 0xb4, 0x5d, 0xd8, 0x0a, 0x1c, 0x3f, 0xf2, 0xf0, 0x75, 0x77, 0xa3, 0xa7,
-	0xf4, 0x37, 0xed, 0x7a, 0x70, 0x96, 0x56, 0xda, 0x73, 0x68, 0xfa, 0x91, 0xcf, 0x7d, 0x14, 0x3a,
-	0x68, 0x42, 0xe2, 0x88, 0x77, 0x37, 0xc5, 0xf9, 0x8e, 0x44, 0x8f, 0x04, 0x68, 0xfc, 0x50, 0xa0,
-	0x65, 0xe5, 0x82, 0x4f, 0x23, 0x4e, 0x67, 0x6b, 0x95, 0x14, 0xb8, 0xaa, 0x25, 0x2e, 0x0b, 0x6a,
-	0x53, 0x14, 0xc6, 0x58, 0x48, 0x50, 0x8f, 0x07, 0xb7, 0xf7, 0x07, 0x95, 0x3f, 0xf7, 0x07, 0x2f,
-	0x46, 0x3e, 0x1f, 0xc7, 0xc3, 0x81, 0x4b, 0x26, 0xa6, 0x4b, 0xd8, 0x84, 0x30, 0xf9, 0xf3, 0x9a,
-	0x79, 0x81, 0xc9, 0x67, 0x57, 0x98, 0x0d, 0x2c, 0xec, 0xda, 0xd9, 0xc7, 0xc6, 0x4d, 0x15, 0x9a,
-	0x2b, 0x29, 0x16, 0xe2, 0x68, 0xad, 0x92, 0x97, 0xd0, 0x72, 0x63, 0x4a, 0x71, 0xc4, 0x1d, 0x8a,
-	0x13, 0x44, 0x3d, 0x26, 0x15, 0x35, 0x25, 0x6c, 0x67, 0xa8, 0xf6, 0x0a, 0x1e, 0x71, 0xc2, 0x51,
-	0xe8, 0xac, 0x42, 0x91, 0x3e, 0xb5, 0x04, 0xbe, 0xe2, 0xd3, 0x9e, 0x41, 0x33, 0x44, 0x1c, 0x33,
-	0x9e, 0x8d, 0xe8, 0x04, 0xd2, 0xb0, 0xed, 0x0c, 0x15, 0x93, 0x9e, 0xa7, 0xcc, 0xb9, 0xf9, 0x8e,
-	0x2b, 0x7c, 0xad, 0x65, 0xcc, 0x39, 0x7c, 0x92, 0xa2, 0xda, 0x11, 0xec, 0x97, 0xae, 0x4b, 0x10,
-	0x73, 0xe2, 0xa8, 0x20, 0xa3, 0xde, 0x53, 0xfa, 0x0d, 0x7b, 0xaf, 0x70, 0xfb, 0x67, 0xc4, 0x2e,
-	0x0b, 0x1d, 0xc6, 0x4d, 0x29, 0x9b, 0x8b, 0x10, 0xb1, 0xf1, 0xc3, 0xb3, 0x79, 0x07, 0x8d, 0x6f,
-	0x14, 0xb9, 0xf9, 0xe4, 0x0f, 0x8f, 0x27, 0xff, 0xde, 0xf8, 0xa5, 0x40, 0xa7, 0xa8, 0xf0, 0x43,
-	0x8c, 0x63, 0x9c, 0xed, 0x4c, 0x1b, 0x6a, 0x19, 0xbb, 0x22, 0xd8, 0xb3, 0xa2, 0xa0, 0xb6, 0xba,
-	0x7e, 0xa7, 0x37, 0xfe, 0xdf, 0xe9, 0x0e, 0xd4, 0x4b, 0x2b, 0x2b, 0x2b, 0xed, 0x29, 0xec, 0xb8,
-	0x14, 0x0b, 0x66, 0x87, 0xfb, 0x13, 0x2c, 0x9d, 0xdf, 0x5e, 0x82, 0x1f, 0xfd, 0x09, 0x36, 0xde,
-	0x02, 0x08, 0x59, 0x17, 0x1c, 0x71, 0xac, 0x3d, 0x06, 0x35, 0x24, 0x89, 0x53, 0x94, 0xd6, 0x08,
-	0x49, 0x92, 0x59, 0xb3, 0x0f, 0x30, 0xf6, 0x47, 0xe3, 0x92, 0x6d, 0x6a, 0x8a, 0x88, 0x63, 0xe3,
-	0x12, 0xda, 0x36, 0x5e, 0x0d, 0x7b, 0x42, 0x48, 0xe8, 0x91, 0x24, 0xd2, 0xba, 0xb0, 0x85, 0x3c,
-	0x8f, 0x62, 0xc6, 0x64, 0x06, 0xcb, 0xb2, 0x24, 0xd0, 0x43, 0x1c, 0xcb, 0x3b, 0x73, 0x81, 0x16,
-	0xe2, 0xf8, 0xf8, 0xec, 0x76, 0xae, 0x2b, 0x77, 0x73, 0x5d, 0xf9, 0x3b, 0xd7, 0x95, 0x9f, 0x0b,
-	0xbd, 0x72, 0xb7, 0xd0, 0x2b, 0xbf, 0x17, 0x7a, 0xe5, 0xab, 0x59, 0x08, 0xe4, 0xfc, 0xcb, 0xa7,
-	0xd3, 0xf7, 0x98, 0x27, 0x84, 0x06, 0xa6, 0x3b, 0x46, 0x7e, 0x64, 0x5e, 0x17, 0x9f, 0x20, 0x91,
-	0xce, 0xb0, 0x2e, 0xde, 0x91, 0x37, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x6b, 0xca, 0x4e,
-	0xa2, 0x04, 0x00, 0x00,
+	// 844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0x5e, 0x3b, 0xd9, 0x6c, 0x7c, 0x76, 0x9b, 0xa0, 0x51, 0xb4, 0x35, 0x4b, 0x9b, 0x44, 0xe6,
+	0x2f, 0x08, 0x61, 0xf7, 0xe7, 0x86, 0x2b, 0xa4, 0x26, 0xa9, 0x44, 0xa8, 0x84, 0x84, 0xb7, 0x2d,
+	0x82, 0x1b, 0x6b, 0x62, 0x0f, 0xf1, 0x28, 0x8e, 0x27, 0xf2, 0x8c, 0x37, 0xcd, 0x1d, 0xbc, 0x00,
+	0xe2, 0x86, 0x07, 0xe0, 0x96, 0x27, 0xe9, 0x65, 0x2f, 0x11, 0x17, 0x05, 0xed, 0x3e, 0x02, 0x2f,
+	0x80, 0x66, 0xc6, 0x4e, 0xec, 0x15, 0x2b, 0xb5, 0xe2, 0x2a, 0x73, 0xce, 0x9c, 0x9f, 0xef, 0x7c,
+	0xdf, 0xc9, 0x18, 0x46, 0xcb, 0xed, 0x05, 0xf1, 0x22, 0x92, 0x90, 0x05, 0x16, 0x94, 0xa5, 0xde,
+	0xc5, 0xfd, 0x39, 0x11, 0xf8, 0x7e, 0xc5, 0xe5, 0xae, 0x33, 0x26, 0x18, 0xba, 0x2d, 0x23, 0xdd,
+	0x8a, 0xbb, 0x88, 0x3c, 0xeb, 0x87, 0x8c, 0xaf, 0x18, 0xf7, 0xe6, 0x98, 0x93, 0x5d, 0x7a, 0xc8,
+	0x68, 0x91, 0x78, 0xd6, 0x5b, 0xb0, 0x05, 0x53, 0x47, 0x4f, 0x9e, 0x0a, 0xaf, 0xa3, 0x1a, 0x73,
+	0x81, 0x97, 0x24, 0xe3, 0xbb, 0xb4, 0xc2, 0xd6, 0x31, 0xce, 0x4f, 0x06, 0x58, 0x53, 0xdd, 0x90,
+	0x65, 0xe8, 0x14, 0x5a, 0xfa, 0xda, 0x36, 0x86, 0xc6, 0xc8, 0xf2, 0x0b, 0x0b, 0xdd, 0x01, 0x2b,
+	0x2a, 0x83, 0x6c, 0x53, 0x5d, 0xed, 0x1d, 0xe8, 0x36, 0x1c, 0x2d, 0x03, 0x9a, 0x46, 0xe4, 0x85,
+	0xdd, 0x18, 0x1a, 0xa3, 0xa6, 0xdf, 0x5a, 0xce, 0xa4, 0x85, 0x3e, 0x84, 0x0e, 0x4d, 0xa9, 0xa0,
+	0x38, 0x09, 0xf0, 0x8a, 0xe5, 0xa9, 0xb0, 0x9b, 0xea, 0xfe, 0x56, 0xe1, 0x7d, 0xa4, 0x9c, 0xce,
+	0x8f, 0x26, 0x74, 0xa7, 0xbb, 0xa1, 0x1f, 0xa7, 0x22, 0xdb, 0xde, 0x88, 0xa4, 0xd2, 0xcb, 0xac,
+	0xf5, 0x3a, 0x87, 0x13, 0x59, 0x21, 0xdc, 0x06, 0x17, 0x38, 0xc9, 0x89, 0x42, 0x62, 0x8d, 0xef,
+	0xbd, 0x7c, 0x3d, 0x38, 0xf8, 0xf3, 0xf5, 0xe0, 0xa3, 0x05, 0x15, 0x71, 0x3e, 0x77, 0x43, 0xb6,
+	0xf2, 0x0a, 0x2e, 0xf5, 0xcf, 0x67, 0x3c, 0x5a, 0x7a, 0x62, 0xbb, 0x26, 0xdc, 0x9d, 0x92, 0xd0,
+	0x36, 0xfc, 0x63, 0x5d, 0xe5, 0xb9, 0x2c, 0x82, 0x16, 0x70, 0xa8, 0xab, 0x35, 0x87, 0x8d, 0xd1,
+	0xf1, 0x83, 0x3b, 0xae, 0x4e, 0x72, 0xa5, 0x0e, 0xa5, 0x38, 0x32, 0x6f, 0xc2, 0x68, 0x3a, 0x7e,
+	0x28, 0x7b, 0xfd, 0xfe, 0xd7, 0xe0, 0xd3, 0x37, 0xeb, 0x25, 0x73, 0xb8, 0xaf, 0xeb, 0x3b, 0xbf,
+	0x36, 0xa0, 0xb3, 0xa7, 0x60, 0x8a, 0x05, 0xbe, 0x91, 0x81, 0xcf, 0xe1, 0xb4, 0x18, 0x34, 0xcc,
+	0xb3, 0x8c, 0xa4, 0x22, 0xc8, 0xc8, 0x06, 0x67, 0x11, 0xd7, 0x84, 0x8c, 0x4d, 0xdb, 0xf0, 0x7b,
+	0x3a, 0x62, 0xa2, 0x03, 0x7c, 0x7d, 0x8f, 0x3e, 0x81, 0x77, 0x04, 0x13, 0x38, 0x09, 0xf6, 0x1b,
+	0x56, 0x08, 0xd6, 0x55, 0xfe, 0x3d, 0x00, 0xf4, 0x01, 0x74, 0x12, 0x2c, 0x08, 0x17, 0x9a, 0xeb,
+	0x60, 0x59, 0x28, 0x77, 0xa2, 0xbd, 0x8a, 0xf2, 0x27, 0xe8, 0x63, 0xe8, 0xee, 0xb6, 0x20, 0x08,
+	0x95, 0xc0, 0x87, 0x2a, 0xac, 0xb3, 0x73, 0x4f, 0xa4, 0x17, 0x3d, 0x82, 0xbb, 0xb5, 0x72, 0x1b,
+	0xcc, 0x83, 0x3c, 0xad, 0xc0, 0x68, 0x0d, 0x8d, 0x51, 0xdb, 0x3f, 0xab, 0x54, 0xff, 0x16, 0xf3,
+	0x67, 0x95, 0x08, 0x24, 0xa0, 0x7b, 0x7d, 0xde, 0x23, 0x25, 0xca, 0xbb, 0xff, 0x29, 0x8a, 0x52,
+	0xe4, 0x5e, 0xa1, 0xc8, 0xe8, 0x0d, 0x14, 0xd1, 0x72, 0x74, 0xc2, 0x1a, 0x65, 0xce, 0xcf, 0x46,
+	0x75, 0x35, 0xcf, 0x13, 0xcc, 0xe3, 0xb7, 0x5f, 0xcd, 0xaf, 0xa0, 0xfd, 0x43, 0x86, 0xc3, 0x1d,
+	0xdf, 0xd6, 0xd8, 0x7d, 0xbb, 0xb5, 0xf4, 0x77, 0xf9, 0xce, 0x3f, 0x26, 0x1c, 0x2b, 0x18, 0x3e,
+	0x09, 0x59, 0x16, 0xa1, 0x0e, 0x98, 0x34, 0x52, 0x40, 0x9a, 0xbe, 0x49, 0xa3, 0x0a, 0x38, 0xf3,
+	0x3a, 0xb8, 0x35, 0x63, 0x49, 0x40, 0xa3, 0xf2, 0x3f, 0x2a, 0xcd, 0x59, 0x84, 0xbe, 0x00, 0xe0,
+	0xb2, 0x5e, 0x20, 0xbb, 0x29, 0x95, 0x3b, 0x0f, 0x06, 0xae, 0x7a, 0x88, 0xca, 0x97, 0xa2, 0xe4,
+	0x54, 0xf5, 0x7d, 0xba, 0x5d, 0x13, 0xdf, 0xe2, 0xe5, 0xb1, 0x36, 0xdc, 0xe1, 0xff, 0x1b, 0x4e,
+	0x82, 0x2f, 0xde, 0x89, 0x96, 0xc6, 0xa8, 0xad, 0x2a, 0xb3, 0x47, 0x35, 0x66, 0xdf, 0x03, 0x6b,
+	0x9e, 0xa7, 0x51, 0x42, 0xe4, 0x5c, 0x6d, 0x75, 0xd5, 0xd6, 0x8e, 0x99, 0xa2, 0x22, 0x26, 0x74,
+	0x11, 0x0b, 0xdb, 0x1a, 0x1a, 0xa3, 0x86, 0x5f, 0x58, 0x08, 0x41, 0x53, 0xd0, 0x15, 0xb1, 0x41,
+	0xc5, 0xab, 0x33, 0xba, 0x0b, 0x10, 0x63, 0x1e, 0xe8, 0x5c, 0xfb, 0x58, 0x6d, 0xa3, 0x15, 0x63,
+	0x3e, 0x56, 0x0e, 0xe7, 0x37, 0x03, 0x4e, 0xab, 0xdb, 0xf8, 0x4d, 0x4e, 0x72, 0xa2, 0x1f, 0xaa,
+	0x1e, 0x1c, 0x6a, 0x64, 0x5a, 0x03, 0x6d, 0xdc, 0x28, 0x43, 0xed, 0x21, 0x6d, 0x5c, 0x7f, 0x48,
+	0xf7, 0xf3, 0x37, 0x6b, 0xf3, 0xbf, 0x0f, 0xb7, 0xc2, 0x8c, 0xa8, 0xce, 0x81, 0x82, 0xae, 0xff,
+	0x65, 0x27, 0xa5, 0xf3, 0x29, 0x5d, 0x11, 0xe7, 0x4b, 0x00, 0x05, 0xeb, 0x5c, 0x60, 0x41, 0x24,
+	0x33, 0x09, 0xdb, 0x04, 0x55, 0x68, 0xed, 0x84, 0x6d, 0x34, 0x6d, 0x72, 0x5a, 0xba, 0x88, 0x6b,
+	0xcb, 0x6a, 0x49, 0x8f, 0xba, 0x76, 0x9e, 0x41, 0xcf, 0x27, 0xfb, 0x61, 0x27, 0x8c, 0x25, 0x11,
+	0xdb, 0xa4, 0xc8, 0x86, 0x23, 0x1c, 0x45, 0x19, 0xe1, 0xbc, 0xd8, 0xfc, 0xd2, 0xac, 0x01, 0x8c,
+	0xb0, 0x20, 0xb6, 0x59, 0x07, 0x38, 0xc5, 0x82, 0x8c, 0x67, 0x2f, 0x2f, 0xfb, 0xc6, 0xab, 0xcb,
+	0xbe, 0xf1, 0xf7, 0x65, 0xdf, 0xf8, 0xe5, 0xaa, 0x7f, 0xf0, 0xea, 0xaa, 0x7f, 0xf0, 0xc7, 0x55,
+	0xff, 0xe0, 0x7b, 0xaf, 0xb2, 0x29, 0x4f, 0xbe, 0x7b, 0xfe, 0xf8, 0x6b, 0x22, 0x36, 0x2c, 0x5b,
+	0x7a, 0x61, 0x8c, 0x69, 0xea, 0xbd, 0xa8, 0x7e, 0x3b, 0xd5, 0xda, 0xcc, 0x5b, 0xea, 0xe3, 0xf5,
+	0xf0, 0xdf, 0x01, 0x00, 0xd6, 0x21, 0x8a, 0x89, 0x5b, 0x07, 0x00, 0x00,
 }
 
 func (m *Delegator) Marshal() (dAtA []byte, err error) {
@@ -618,10 +793,24 @@ func (m *DelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Value) > 0 {
+		for iNdEx := len(m.Value) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Value[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
-		size := m.Value.Size()
+		size := m.LegacyValue.Size()
 		i -= size
-		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LegacyValue.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintDelegation(dAtA, i, uint64(size))
@@ -663,6 +852,20 @@ func (m *DelegationData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CurrentRewards) > 0 {
+		for iNdEx := len(m.CurrentRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.LatestIndexWasUndelegation {
 		i--
 		if m.LatestIndexWasUndelegation {
@@ -688,8 +891,8 @@ func (m *DelegationData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.CurrentRewards != 0 {
-		i = encodeVarintDelegation(dAtA, i, uint64(m.CurrentRewards))
+	if m.LegacyCurrentRewards != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.LegacyCurrentRewards))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -748,6 +951,96 @@ func (m *DelegationSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasBundle {
+		i--
+		if m.HasBundle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Time != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Height != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BundleId != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.BundleId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.KIndex != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.KIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Amount != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.SlashType != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.SlashType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UndelegationQueueEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -915,8 +1208,14 @@ func (m *DelegationEntry) Size() (n int) {
 	if m.KIndex != 0 {
 		n += 1 + sovDelegation(uint64(m.KIndex))
 	}
-	l = m.Value.Size()
+	l = m.LegacyValue.Size()
 	n += 1 + l + sovDelegation(uint64(l))
+	if len(m.Value) > 0 {
+		for _, e := range m.Value {
+			l = e.Size()
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -930,8 +1229,8 @@ func (m *DelegationData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDelegation(uint64(l))
 	}
-	if m.CurrentRewards != 0 {
-		n += 1 + sovDelegation(uint64(m.CurrentRewards))
+	if m.LegacyCurrentRewards != 0 {
+		n += 1 + sovDelegation(uint64(m.LegacyCurrentRewards))
 	}
 	if m.TotalDelegation != 0 {
 		n += 1 + sovDelegation(uint64(m.TotalDelegation))
@@ -945,6 +1244,12 @@ func (m *DelegationData) Size() (n int) {
 	if m.LatestIndexWasUndelegation {
 		n += 2
 	}
+	if len(m.CurrentRewards) > 0 {
+		for _, e := range m.CurrentRewards {
+			l = e.Size()
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -966,6 +1271,48 @@ func (m *DelegationSlash) Size() (n int) {
 	return n
 }
 
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDelegation(uint64(m.Id))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovDelegation(uint64(m.PoolId))
+	}
+	if m.SlashType != 0 {
+		n += 1 + sovDelegation(uint64(m.SlashType))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovDelegation(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovDelegation(uint64(m.Amount))
+	}
+	if m.KIndex != 0 {
+		n += 1 + sovDelegation(uint64(m.KIndex))
+	}
+	if m.BundleId != 0 {
+		n += 1 + sovDelegation(uint64(m.BundleId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDelegation(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovDelegation(uint64(m.Time))
+	}
+	if m.HasBundle {
+		n += 2
+	}
+	return n
+}
+
 func (m *UndelegationQueueEntry) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1263,7 +1610,7 @@ func (m *DelegationEntry) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1291,7 +1638,41 @@ func (m *DelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LegacyValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value, types.DecCoin{})
+			if err := m.Value[len(m.Value)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1379,9 +1760,9 @@ func (m *DelegationData) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyCurrentRewards", wireType)
 			}
-			m.CurrentRewards = 0
+			m.LegacyCurrentRewards = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1391,7 +1772,7 @@ func (m *DelegationData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentRewards |= uint64(b&0x7F) << shift
+				m.LegacyCurrentRewards |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1473,6 +1854,40 @@ func (m *DelegationData) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.LatestIndexWasUndelegation = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentRewards = append(m.CurrentRewards, types.Coin{})
+			if err := m.CurrentRewards[len(m.CurrentRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
@@ -1629,6 +2044,294 @@ func (m *DelegationSlash) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashType", wireType)
+			}
+			m.SlashType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashType |= types1.SlashType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KIndex", wireType)
+			}
+			m.KIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
+			}
+			m.BundleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBundle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasBundle = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *UndelegationQueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,4 +10,5 @@ var (
 	ErrRedelegationOnCooldown          = sdkerrors.Register(ModuleName, 1002, "all redelegation slots are on cooldown")
 	ErrMultipleRedelegationInSameBlock = sdkerrors.Register(ModuleName, 1003, "only one redelegation per delegator per block")
 	ErrStakerDoesNotExist              = sdkerrors.Register(ModuleName, 1004, "staker does not exist: %s")
+	ErrStakerRetiring                  = sdkerrors.Register(ModuleName, 1005, "staker is retiring: %s")
 )
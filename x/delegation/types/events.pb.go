@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -230,7 +233,7 @@ type EventWithdrawRewards struct {
 	// from_node is the account address of the protocol node the users withdraws from.
 	FromNode string `protobuf:"bytes,2,opt,name=from_node,json=fromNode,proto3" json:"from_node,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
 func (m *EventWithdrawRewards) Reset()         { *m = EventWithdrawRewards{} }
@@ -280,11 +283,11 @@ func (m *EventWithdrawRewards) GetFromNode() string {
 	return ""
 }
 
-func (m *EventWithdrawRewards) GetAmount() uint64 {
+func (m *EventWithdrawRewards) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Amount
 	}
-	return 0
+	return nil
 }
 
 func init() {
@@ -299,25 +302,30 @@ func init() {
 }
 
 var fileDescriptor_d01988a9108a2e89 = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0xae, 0x2c, 0x4b,
-	0xd5, 0x4f, 0x49, 0xcd, 0x49, 0x4d, 0x4f, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x07, 0xa9, 0xd2, 0x43, 0xa8, 0xd2, 0x83, 0xaa, 0x52, 0x0a, 0xe5, 0xe2, 0x75, 0x05,
-	0x29, 0x74, 0x81, 0x48, 0xa5, 0x0a, 0x49, 0x70, 0xb1, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17,
-	0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xb8, 0x42, 0x42, 0x5c, 0x2c, 0x79, 0xf9, 0x29,
-	0xa9, 0x12, 0x4c, 0x60, 0x61, 0x30, 0x5b, 0x48, 0x8c, 0x8b, 0x2d, 0x31, 0x37, 0xbf, 0x34, 0xaf,
-	0x44, 0x82, 0x59, 0x81, 0x51, 0x83, 0x25, 0x08, 0xca, 0x53, 0x0a, 0xe7, 0xe2, 0x07, 0x1b, 0x1b,
-	0x9a, 0x97, 0x42, 0x5d, 0x83, 0x2b, 0xa1, 0x06, 0x07, 0xa5, 0x12, 0x61, 0xb0, 0x34, 0x17, 0x67,
-	0x5a, 0x51, 0x7e, 0x6e, 0x3c, 0x92, 0xe9, 0x1c, 0x20, 0x01, 0x3f, 0x90, 0x0d, 0xe2, 0x5c, 0xec,
-	0x25, 0xf9, 0x10, 0x29, 0x66, 0xb0, 0x14, 0x5b, 0x49, 0xbe, 0x1f, 0xaa, 0xd5, 0x2c, 0x28, 0x56,
-	0xa7, 0x72, 0x89, 0x80, 0xad, 0x0e, 0xcf, 0x2c, 0xc9, 0x48, 0x29, 0x4a, 0x2c, 0x0f, 0x4a, 0x2d,
-	0x4f, 0x2c, 0x4a, 0x29, 0x26, 0xd7, 0x7e, 0x1c, 0x3e, 0x74, 0xf2, 0x3c, 0xf1, 0x48, 0x8e, 0xf1,
-	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xfd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc,
-	0x5c, 0x7d, 0xef, 0xc8, 0x30, 0x57, 0xbf, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0xfd, 0xe4, 0x8c,
-	0xc4, 0xcc, 0x3c, 0xfd, 0x0a, 0xe4, 0x44, 0x50, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e,
-	0x7c, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0xba, 0x55, 0xe1, 0x24, 0x02, 0x00, 0x00,
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0xc6, 0xdb, 0x0b, 0x81, 0xcb, 0x18, 0x63, 0xd2, 0x10, 0xa9, 0x98, 0x14, 0x42, 0x5c, 0x74,
+	0x63, 0x47, 0xf4, 0x0d, 0x50, 0x16, 0xc6, 0x84, 0x45, 0x13, 0x24, 0xba, 0x31, 0xd3, 0xce, 0x58,
+	0x1a, 0xec, 0x1c, 0xd2, 0x19, 0x40, 0xde, 0xc2, 0xe7, 0x70, 0xe9, 0x53, 0xb0, 0x64, 0xe9, 0x4a,
+	0x0d, 0xbc, 0x88, 0xe9, 0xb4, 0x68, 0xd9, 0x19, 0xe3, 0xaa, 0x67, 0xce, 0x9f, 0xdf, 0x77, 0x9a,
+	0xef, 0xa0, 0xa3, 0xd1, 0x7c, 0xca, 0x30, 0x65, 0x0f, 0x2c, 0x20, 0x32, 0x04, 0x8e, 0xa7, 0x6d,
+	0x8f, 0x49, 0xd2, 0xc6, 0x6c, 0xca, 0xb8, 0x14, 0xce, 0x38, 0x06, 0x09, 0x46, 0x2d, 0xe9, 0x72,
+	0xbe, 0xbb, 0x9c, 0xac, 0xab, 0x6e, 0xf9, 0x20, 0x22, 0x10, 0xd8, 0x23, 0x82, 0x7d, 0x8d, 0xfa,
+	0x10, 0xf2, 0x74, 0xb0, 0x5e, 0x0d, 0x20, 0x00, 0x15, 0xe2, 0x24, 0x4a, 0xb3, 0xad, 0x3e, 0xda,
+	0xed, 0x26, 0xf8, 0x8b, 0x14, 0xc8, 0x0c, 0x13, 0x95, 0x09, 0xa5, 0x31, 0x13, 0xc2, 0xd4, 0x9b,
+	0xba, 0x5d, 0x71, 0x37, 0x4f, 0xc3, 0x40, 0x45, 0x0e, 0x94, 0x99, 0xff, 0x54, 0x5a, 0xc5, 0xc6,
+	0x3e, 0x2a, 0x91, 0x08, 0x26, 0x5c, 0x9a, 0x85, 0xa6, 0x6e, 0x17, 0xdd, 0xec, 0xd5, 0x1a, 0xa0,
+	0x3d, 0x85, 0xed, 0x73, 0xfa, 0xb7, 0xe0, 0x79, 0x06, 0x76, 0xd9, 0x0f, 0xc0, 0x87, 0xa8, 0x72,
+	0x1f, 0x43, 0x74, 0x97, 0xa3, 0xff, 0x4f, 0x12, 0xbd, 0x44, 0xa1, 0x86, 0xca, 0x12, 0xd2, 0x52,
+	0x41, 0x95, 0x4a, 0x12, 0x7a, 0xdb, 0xd2, 0xc5, 0x2d, 0xe9, 0x17, 0x1d, 0x55, 0x95, 0xf6, 0x20,
+	0x94, 0x43, 0x1a, 0x93, 0x99, 0xcb, 0x66, 0x24, 0xa6, 0xe2, 0xb7, 0x0b, 0xf8, 0xb9, 0x5f, 0x2c,
+	0xd8, 0x3b, 0xa7, 0x07, 0x4e, 0xea, 0xa0, 0x93, 0x38, 0xb8, 0xb1, 0xd5, 0x39, 0x87, 0x90, 0x77,
+	0x4e, 0x16, 0x6f, 0x0d, 0xed, 0xf9, 0xbd, 0x61, 0x07, 0xa1, 0x1c, 0x4e, 0x3c, 0xc7, 0x87, 0x08,
+	0x67, 0x76, 0xa7, 0x9f, 0x63, 0x41, 0x47, 0x58, 0xce, 0xc7, 0x4c, 0xa8, 0x01, 0xb1, 0x59, 0xba,
+	0x73, 0xb9, 0x58, 0x59, 0xfa, 0x72, 0x65, 0xe9, 0x1f, 0x2b, 0x4b, 0x7f, 0x5a, 0x5b, 0xda, 0x72,
+	0x6d, 0x69, 0xaf, 0x6b, 0x4b, 0xbb, 0xc5, 0x39, 0xd6, 0xd5, 0xcd, 0x75, 0xb7, 0xc7, 0xe4, 0x0c,
+	0xe2, 0x11, 0xf6, 0x87, 0x24, 0xe4, 0xf8, 0x31, 0x7f, 0x88, 0x0a, 0xec, 0x95, 0xd4, 0xc5, 0x9c,
+	0x7d, 0x0e, 0x00, 0xd3, 0xf6, 0x64, 0xc1, 0xa8, 0x02, 0x00, 0x00,
 }
 
 func (m *EventDelegate) Marshal() (dAtA []byte, err error) {
@@ -473,10 +481,19 @@ func (m *EventWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.FromNode) > 0 {
 		i -= len(m.FromNode)
@@ -584,8 +601,11 @@ func (m *EventWithdrawRewards) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
 	}
 	return n
 }
@@ -1121,10 +1141,10 @@ func (m *EventWithdrawRewards) Unmarshal(dAtA []byte) error {
 			m.FromNode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Amount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1134,11 +1154,26 @@ func (m *EventWithdrawRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
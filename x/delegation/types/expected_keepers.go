@@ -1,6 +1,7 @@
 package types
 
 import (
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
 	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -21,10 +22,13 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
 
 type PoolKeeper interface {
 	AssertPoolExists(ctx sdk.Context, poolId uint64) error
+	GetPool(ctx sdk.Context, id uint64) (val pooltypes.Pool, found bool)
+	ExpireUploadDeadline(ctx sdk.Context, poolId uint64)
 }
 
 type UpgradeKeeper interface {
@@ -37,4 +41,6 @@ type StakersKeeper interface {
 	GetAllStakerAddressesOfPool(ctx sdk.Context, poolId uint64) (stakers []string)
 	GetAllStakers(ctx sdk.Context) (list []stakerstypes.Staker)
 	GetStaker(ctx sdk.Context, staker string) (val stakerstypes.Staker, found bool)
+	GetValaccountsFromStaker(ctx sdk.Context, stakerAddress string) (val []*stakerstypes.Valaccount)
+	IsStakerRetiring(ctx sdk.Context, staker string) bool
 }
@@ -16,6 +16,7 @@ func DefaultGenesis() *GenesisState {
 		UndelegationQueueEntryList: []UndelegationQueueEntry{},
 		QueueStateUndelegation:     QueueState{},
 		RedelegationCooldownList:   []RedelegationCooldown{},
+		SlashRecordList:            []SlashRecord{},
 	}
 }
 
@@ -34,6 +35,10 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.validateSlashRecords(); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -132,3 +137,21 @@ func (gs *GenesisState) validateRedelegation() error {
 	}
 	return nil
 }
+
+func (gs *GenesisState) validateSlashRecords() error {
+	// Check for duplicated ids in slash records
+	slashRecordMap := make(map[string]struct{})
+
+	for _, elem := range gs.SlashRecordList {
+		index := string(SlashRecordKey(elem.Id))
+		if _, ok := slashRecordMap[index]; ok {
+			return fmt.Errorf("duplicated id for slash record %v", elem)
+		}
+		if elem.Id >= gs.SlashRecordCount {
+			return fmt.Errorf("slash record id higher than slash record count %v", elem)
+		}
+
+		slashRecordMap[index] = struct{}{}
+	}
+	return nil
+}
@@ -41,6 +41,10 @@ type GenesisState struct {
 	QueueStateUndelegation QueueState `protobuf:"bytes,7,opt,name=queue_state_undelegation,json=queueStateUndelegation,proto3" json:"queue_state_undelegation"`
 	// redelegation_cooldown_list ...
 	RedelegationCooldownList []RedelegationCooldown `protobuf:"bytes,8,rep,name=redelegation_cooldown_list,json=redelegationCooldownList,proto3" json:"redelegation_cooldown_list"`
+	// slash_record_list ...
+	SlashRecordList []SlashRecord `protobuf:"bytes,9,rep,name=slash_record_list,json=slashRecordList,proto3" json:"slash_record_list"`
+	// slash_record_count ...
+	SlashRecordCount uint64 `protobuf:"varint,10,opt,name=slash_record_count,json=slashRecordCount,proto3" json:"slash_record_count,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +136,20 @@ func (m *GenesisState) GetRedelegationCooldownList() []RedelegationCooldown {
 	return nil
 }
 
+func (m *GenesisState) GetSlashRecordList() []SlashRecord {
+	if m != nil {
+		return m.SlashRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashRecordCount() uint64 {
+	if m != nil {
+		return m.SlashRecordCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.delegation.v1beta1.GenesisState")
 }
@@ -141,35 +159,38 @@ func init() {
 }
 
 var fileDescriptor_0bd28fed64b7905b = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0xef, 0xd2, 0x30,
-	0x1c, 0xc6, 0x37, 0x7f, 0x38, 0x4d, 0x51, 0x0f, 0x13, 0x75, 0x59, 0xe2, 0x20, 0xa8, 0x71, 0x17,
-	0xd7, 0x80, 0x67, 0x2f, 0x08, 0x31, 0x46, 0xe3, 0x1f, 0x88, 0x26, 0x7a, 0x59, 0xba, 0xad, 0x19,
-	0x0b, 0x63, 0x85, 0xb5, 0xe3, 0xcf, 0xbb, 0xf0, 0xea, 0x3b, 0xe2, 0xc8, 0xd1, 0x93, 0x31, 0xf0,
-	0x46, 0xcc, 0xda, 0x0a, 0x25, 0xb2, 0xfc, 0xb8, 0x2d, 0xdf, 0x3d, 0xcf, 0xf3, 0xe9, 0xd3, 0x7c,
-	0x0b, 0x9e, 0x4d, 0xd6, 0x0b, 0x0c, 0x23, 0x9c, 0xe2, 0x18, 0xb1, 0x84, 0x64, 0x70, 0xd1, 0x09,
-	0x30, 0x43, 0x1d, 0x18, 0xe3, 0x0c, 0xd3, 0x84, 0x7a, 0xb3, 0x9c, 0x30, 0x62, 0x3e, 0x2a, 0x65,
-	0xde, 0x51, 0xe6, 0x49, 0x99, 0xdd, 0x88, 0x49, 0x4c, 0xb8, 0x06, 0x96, 0x5f, 0x42, 0x6e, 0xbb,
-	0x55, 0xa9, 0x4a, 0x82, 0x50, 0x3e, 0xad, 0x52, 0xce, 0x50, 0x8e, 0xa6, 0x12, 0xdf, 0xfe, 0x69,
-	0x80, 0x3b, 0x6f, 0xc4, 0x81, 0x46, 0x0c, 0x31, 0x6c, 0xbe, 0x02, 0x86, 0x10, 0x58, 0x7a, 0x4b,
-	0x77, 0xeb, 0xdd, 0xa6, 0x57, 0x71, 0x40, 0xef, 0x13, 0x97, 0xf5, 0x6a, 0x9b, 0xdf, 0x4d, 0x6d,
-	0x28, 0x4d, 0xe6, 0x47, 0x70, 0x4f, 0x4a, 0x49, 0xee, 0xa7, 0x09, 0x65, 0xd6, 0x8d, 0xd6, 0x95,
-	0x5b, 0xef, 0xb6, 0x2b, 0x63, 0xfa, 0xff, 0xe4, 0x32, 0xe9, 0xee, 0xc1, 0xff, 0x3e, 0xa1, 0xcc,
-	0x0c, 0xc0, 0x83, 0xa3, 0xc9, 0xc7, 0x19, 0xcb, 0xd7, 0x22, 0xf7, 0x8a, 0xe7, 0xba, 0xd7, 0xe5,
-	0x26, 0x24, 0x1b, 0x94, 0x26, 0x99, 0x7e, 0x3f, 0x3a, 0x1d, 0x73, 0x86, 0x0f, 0x1a, 0x0a, 0x23,
-	0x42, 0x0c, 0x09, 0x44, 0x8d, 0x23, 0x9e, 0x5f, 0x80, 0xe8, 0x23, 0x86, 0x24, 0xc1, 0x8c, 0x4e,
-	0xa6, 0x67, 0x4a, 0xd0, 0x14, 0xd1, 0xb1, 0x20, 0xdc, 0xbc, 0xb8, 0xc4, 0xa8, 0x34, 0xfd, 0x5f,
-	0x82, 0x8f, 0x39, 0x63, 0x05, 0x1e, 0x17, 0x99, 0x42, 0x99, 0x17, 0xb8, 0xc0, 0xea, 0x85, 0x19,
-	0x9c, 0x05, 0x2b, 0x59, 0x5f, 0x14, 0xf7, 0xe7, 0xd2, 0xac, 0xde, 0x9b, 0x5d, 0x9c, 0xfd, 0xcb,
-	0xc9, 0x21, 0xb0, 0x04, 0x8c, 0x96, 0x1b, 0xe4, 0xab, 0x4a, 0xeb, 0x16, 0x5f, 0xa2, 0x27, 0x95,
-	0x50, 0x1e, 0xc5, 0x37, 0x4f, 0x82, 0x1e, 0xce, 0x0f, 0x13, 0xf5, 0x40, 0xe6, 0x1c, 0xd8, 0x39,
-	0x56, 0xea, 0x85, 0x84, 0xa4, 0x11, 0x59, 0x66, 0xa2, 0xdb, 0x6d, 0xde, 0xed, 0x45, 0x25, 0x66,
-	0xa8, 0x58, 0x5f, 0x4b, 0xa7, 0x04, 0x5a, 0xf9, 0x99, 0x7f, 0x65, 0xaf, 0xde, 0xdb, 0xcd, 0xce,
-	0xd1, 0xb7, 0x3b, 0x47, 0xff, 0xb3, 0x73, 0xf4, 0x1f, 0x7b, 0x47, 0xdb, 0xee, 0x1d, 0xed, 0xd7,
-	0xde, 0xd1, 0xbe, 0xc3, 0x38, 0x61, 0xe3, 0x22, 0xf0, 0x42, 0x32, 0x85, 0xef, 0xbe, 0x7d, 0x1d,
-	0x7c, 0xc0, 0x6c, 0x49, 0xf2, 0x09, 0x0c, 0xc7, 0x28, 0xc9, 0xe0, 0x4a, 0x7d, 0x75, 0x6c, 0x3d,
-	0xc3, 0x34, 0x30, 0xf8, 0x6b, 0x7b, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xa4, 0xfe, 0x14,
-	0x15, 0x04, 0x00, 0x00,
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcb, 0x6e, 0xd3, 0x40,
+	0x18, 0x85, 0x63, 0x1a, 0x52, 0x98, 0x72, 0x35, 0x05, 0xac, 0x48, 0xb8, 0x51, 0x29, 0xc2, 0x0b,
+	0xf0, 0xa8, 0x65, 0xcd, 0xa6, 0x17, 0x21, 0x04, 0xe2, 0x92, 0x8a, 0x4a, 0xb0, 0x89, 0x26, 0xf6,
+	0xc8, 0xb1, 0xea, 0x7a, 0x92, 0x99, 0xdf, 0x6d, 0xf3, 0x16, 0xbc, 0x01, 0xaf, 0xd3, 0x65, 0x97,
+	0xac, 0x10, 0x4a, 0x5e, 0x04, 0xf9, 0x9f, 0xa1, 0x99, 0x88, 0x8c, 0xc8, 0xce, 0xfa, 0x7d, 0xce,
+	0xf9, 0xe6, 0xcc, 0x85, 0x3c, 0x3b, 0x1e, 0x9f, 0x72, 0x9a, 0xf2, 0x82, 0x67, 0x0c, 0x72, 0x51,
+	0xd2, 0xd3, 0xed, 0x3e, 0x07, 0xb6, 0x4d, 0x33, 0x5e, 0x72, 0x95, 0xab, 0x78, 0x28, 0x05, 0x08,
+	0xff, 0x71, 0x2d, 0x8b, 0x67, 0xb2, 0xd8, 0xc8, 0xda, 0xeb, 0x99, 0xc8, 0x04, 0x6a, 0x68, 0xfd,
+	0xa5, 0xe5, 0xed, 0xc8, 0x95, 0x6a, 0x25, 0x68, 0xe5, 0x96, 0x4b, 0x39, 0x64, 0x92, 0x9d, 0x18,
+	0xfc, 0xe6, 0x8f, 0x55, 0x72, 0xeb, 0x8d, 0x5e, 0xd0, 0x21, 0x30, 0xe0, 0xfe, 0x6b, 0xd2, 0xd2,
+	0x82, 0xc0, 0xeb, 0x78, 0xd1, 0xda, 0xce, 0x46, 0xec, 0x58, 0x60, 0xfc, 0x09, 0x65, 0xbb, 0xcd,
+	0x8b, 0x5f, 0x1b, 0x8d, 0xae, 0x31, 0xf9, 0x1f, 0xc9, 0x1d, 0x23, 0x15, 0xb2, 0x57, 0xe4, 0x0a,
+	0x82, 0x6b, 0x9d, 0x95, 0x68, 0x6d, 0x67, 0xd3, 0x19, 0xb3, 0xff, 0x57, 0x6e, 0x92, 0x6e, 0x5f,
+	0xf9, 0xdf, 0xe7, 0x0a, 0xfc, 0x3e, 0x79, 0x38, 0x33, 0xf5, 0x78, 0x09, 0x72, 0xac, 0x73, 0x57,
+	0x30, 0x37, 0xfa, 0x5f, 0x6e, 0x2e, 0xca, 0x83, 0xda, 0x64, 0xd2, 0x1f, 0xa4, 0xf3, 0x63, 0x64,
+	0xf4, 0xc8, 0xba, 0xc5, 0x48, 0x19, 0x30, 0x8d, 0x68, 0x22, 0xe2, 0xf9, 0x12, 0x88, 0x7d, 0x06,
+	0xcc, 0x10, 0xfc, 0x74, 0x6e, 0xba, 0xa0, 0x84, 0x2a, 0x98, 0x1a, 0x68, 0xc2, 0xf5, 0xa5, 0x4b,
+	0x1c, 0xd6, 0xa6, 0x7f, 0x4b, 0xe0, 0x18, 0x19, 0xe7, 0xe4, 0x49, 0x55, 0x5a, 0x94, 0x51, 0xc5,
+	0x2b, 0x6e, 0x6f, 0x58, 0x0b, 0x59, 0xd4, 0xc9, 0xfa, 0x62, 0xb9, 0x3f, 0xd7, 0x66, 0x7b, 0xdf,
+	0xda, 0xd5, 0xc2, 0xbf, 0x48, 0x4e, 0x48, 0xa0, 0x61, 0x0a, 0x18, 0xf0, 0x9e, 0xad, 0x0c, 0x56,
+	0xf1, 0x12, 0x3d, 0x75, 0x42, 0x31, 0x0a, 0x6f, 0x9e, 0x01, 0x3d, 0x1a, 0x5d, 0x4d, 0xec, 0x05,
+	0xf9, 0x23, 0xd2, 0x96, 0xdc, 0xaa, 0x97, 0x08, 0x51, 0xa4, 0xe2, 0xac, 0xd4, 0xdd, 0x6e, 0x60,
+	0xb7, 0x97, 0x4e, 0x4c, 0xd7, 0xb2, 0xee, 0x19, 0xa7, 0x01, 0x06, 0x72, 0xc1, 0x3f, 0xec, 0x75,
+	0x44, 0xee, 0xeb, 0xa3, 0x92, 0x3c, 0x11, 0x32, 0xd5, 0xa4, 0x9b, 0x48, 0xda, 0x72, 0x92, 0xf0,
+	0x40, 0xba, 0x68, 0x30, 0x80, 0xbb, 0x6a, 0x36, 0xc2, 0xdc, 0x17, 0xc4, 0x9f, 0xcb, 0x4d, 0x44,
+	0x55, 0x42, 0x40, 0x3a, 0x5e, 0xd4, 0xec, 0xde, 0xb3, 0xc4, 0x7b, 0xf5, 0x7c, 0xf7, 0xed, 0xc5,
+	0x24, 0xf4, 0x2e, 0x27, 0xa1, 0xf7, 0x7b, 0x12, 0x7a, 0xdf, 0xa7, 0x61, 0xe3, 0x72, 0x1a, 0x36,
+	0x7e, 0x4e, 0xc3, 0xc6, 0x37, 0x9a, 0xe5, 0x30, 0xa8, 0xfa, 0x71, 0x22, 0x4e, 0xe8, 0xbb, 0xaf,
+	0x47, 0x07, 0x1f, 0x38, 0x9c, 0x09, 0x79, 0x4c, 0x93, 0x01, 0xcb, 0x4b, 0x7a, 0x6e, 0xbf, 0x7d,
+	0x18, 0x0f, 0xb9, 0xea, 0xb7, 0xf0, 0xcd, 0xbf, 0xfa, 0x33, 0x00, 0x65, 0x62, 0x0f, 0xc2, 0x9b,
+	0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -192,6 +213,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SlashRecordCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashRecordCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.SlashRecordList) > 0 {
+		for iNdEx := len(m.SlashRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.RedelegationCooldownList) > 0 {
 		for iNdEx := len(m.RedelegationCooldownList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -356,6 +396,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SlashRecordList) > 0 {
+		for _, e := range m.SlashRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SlashRecordCount != 0 {
+		n += 1 + sovGenesis(uint64(m.SlashRecordCount))
+	}
 	return n
 }
 
@@ -664,6 +713,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecordList = append(m.SlashRecordList, SlashRecord{})
+			if err := m.SlashRecordList[len(m.SlashRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecordCount", wireType)
+			}
+			m.SlashRecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashRecordCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
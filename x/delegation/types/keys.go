@@ -52,6 +52,22 @@ var (
 
 	// RedelegationCooldownPrefix ...
 	RedelegationCooldownPrefix = []byte{7}
+
+	// SlashRecordKeyPrefix is the prefix to retrieve all SlashRecords by their id
+	SlashRecordKeyPrefix = []byte{8, 0}
+
+	// SlashRecordKeyPrefixIndexStaker is the prefix to retrieve all SlashRecords of a staker
+	SlashRecordKeyPrefixIndexStaker = []byte{8, 1}
+
+	// SlashRecordKeyPrefixIndexPool is the prefix to retrieve all SlashRecords of a pool
+	SlashRecordKeyPrefixIndexPool = []byte{8, 2}
+
+	// SlashRecordKeyPrefixIndexStakerKIndex is the prefix to retrieve all SlashRecords of a staker
+	// starting from a given F1 period
+	SlashRecordKeyPrefixIndexStakerKIndex = []byte{8, 3}
+
+	// SlashRecordCountKey ...
+	SlashRecordCountKey = []byte{9}
 )
 
 // DelegatorKey returns the store Key to retrieve a Delegator from the index fields
@@ -90,6 +106,22 @@ func DelegationSlashEntriesKey(stakerAddress string, kIndex uint64) []byte {
 	return util.GetByteKey(stakerAddress, kIndex)
 }
 
+func SlashRecordKey(id uint64) []byte {
+	return util.GetByteKey(id)
+}
+
+func SlashRecordKeyIndexStaker(stakerAddress string, id uint64) []byte {
+	return util.GetByteKey(stakerAddress, id)
+}
+
+func SlashRecordKeyIndexPool(poolId uint64, id uint64) []byte {
+	return util.GetByteKey(poolId, id)
+}
+
+func SlashRecordKeyIndexStakerKIndex(stakerAddress string, kIndex uint64, id uint64) []byte {
+	return util.GetByteKey(stakerAddress, kIndex, id)
+}
+
 func StakerIndexKey(amount uint64, stakerAddress string) []byte {
 	return util.GetByteKey(amount, stakerAddress)
 }
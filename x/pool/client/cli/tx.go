@@ -1,12 +1,14 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -20,6 +22,24 @@ var (
 	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
 )
 
+const (
+	FlagValidQuorum   = "valid-quorum"
+	FlagInvalidQuorum = "invalid-quorum"
+	FlagUploadTimeout = "upload-timeout"
+	FlagMaxPoints     = "max-points"
+	FlagConfigSchema  = "config-schema"
+	FlagPoolIds       = "pool-ids"
+	FlagCanaryPoolId  = "canary-pool-id"
+	FlagCanaryBundles = "canary-bundles"
+	FlagChargingMode  = "charging-mode"
+
+	FlagAdmin                 = "admin"
+	FlagFunderAllowList       = "funder-allow-list"
+	FlagMaxFunders            = "max-funders"
+	FlagDisableFunderEviction = "disable-funder-eviction"
+	FlagCommitRevealVoting    = "commit-reveal-voting"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -32,6 +52,10 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdFundPool())
 	cmd.AddCommand(CmdDefundPool())
+	cmd.AddCommand(CmdUpdateFunderAllowList())
+	cmd.AddCommand(CmdPausePool())
+	cmd.AddCommand(CmdUnpausePool())
+	cmd.AddCommand(CmdUpdatePoolMetadata())
 
 	cmd.AddCommand(CmdSubmitCreatePoolProposal())
 	cmd.AddCommand(CmdSubmitUpdatePoolProposal())
@@ -39,6 +63,11 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdSubmitUnpausePoolProposal())
 	cmd.AddCommand(CmdSubmitSchedulePoolUpgradeProposal())
 	cmd.AddCommand(CmdSubmitCancelPoolUpgradeProposal())
+	cmd.AddCommand(CmdSubmitResetPoolProposal())
+
+	cmd.AddCommand(CmdSubmitCreateRuntimeProposal())
+	cmd.AddCommand(CmdSubmitUpdateRuntimeProposal())
+	cmd.AddCommand(CmdSubmitDeprecateRuntimeProposal())
 
 	return cmd
 }
@@ -95,7 +124,62 @@ func CmdSubmitCreatePoolProposal() *cobra.Command {
 				return err
 			}
 
-			content := types.NewCreatePoolProposal(title, description, args[0], args[1], args[2], args[3], args[4], uploadInterval, operatingCost, minStake, maxBundleSize, args[9], args[10])
+			validQuorum, err := cmd.Flags().GetString(FlagValidQuorum)
+			if err != nil {
+				return err
+			}
+
+			invalidQuorum, err := cmd.Flags().GetString(FlagInvalidQuorum)
+			if err != nil {
+				return err
+			}
+
+			uploadTimeout, err := cmd.Flags().GetUint64(FlagUploadTimeout)
+			if err != nil {
+				return err
+			}
+
+			maxPoints, err := cmd.Flags().GetUint64(FlagMaxPoints)
+			if err != nil {
+				return err
+			}
+
+			chargingModeStr, err := cmd.Flags().GetString(FlagChargingMode)
+			if err != nil {
+				return err
+			}
+
+			chargingMode, err := types.ChargingModeFromString(chargingModeStr)
+			if err != nil {
+				return err
+			}
+
+			admin, err := cmd.Flags().GetString(FlagAdmin)
+			if err != nil {
+				return err
+			}
+
+			funderAllowList, err := cmd.Flags().GetStringSlice(FlagFunderAllowList)
+			if err != nil {
+				return err
+			}
+
+			maxFunders, err := cmd.Flags().GetUint64(FlagMaxFunders)
+			if err != nil {
+				return err
+			}
+
+			disableFunderEviction, err := cmd.Flags().GetBool(FlagDisableFunderEviction)
+			if err != nil {
+				return err
+			}
+
+			commitRevealVoting, err := cmd.Flags().GetBool(FlagCommitRevealVoting)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCreatePoolProposal(title, description, args[0], args[1], args[2], args[3], args[4], uploadInterval, operatingCost, minStake, maxBundleSize, args[9], args[10], validQuorum, invalidQuorum, uploadTimeout, maxPoints, chargingMode, admin, funderAllowList, maxFunders, disableFunderEviction, commitRevealVoting)
 
 			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
 			if err != nil {
@@ -119,6 +203,16 @@ func CmdSubmitCreatePoolProposal() *cobra.Command {
 	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
 	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
 	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().String(FlagValidQuorum, types.DefaultValidQuorum, "The share of delegation which has to vote valid")
+	cmd.Flags().String(FlagInvalidQuorum, types.DefaultInvalidQuorum, "The share of delegation which has to vote invalid")
+	cmd.Flags().Uint64(FlagUploadTimeout, 0, "The upload timeout of the pool, uses the module param if zero")
+	cmd.Flags().Uint64(FlagMaxPoints, 0, "The max points of the pool, uses the module param if zero")
+	cmd.Flags().String(FlagChargingMode, "equal", "How the bundle costs are split between the funders, either equal or pro-rata")
+	cmd.Flags().String(FlagAdmin, "", "The address which can manage the pool without governance")
+	cmd.Flags().StringSlice(FlagFunderAllowList, []string{}, "The only addresses which can fund the pool, everybody can if empty")
+	cmd.Flags().Uint64(FlagMaxFunders, 0, "The maximum number of funders of the pool, uses the default if zero")
+	cmd.Flags().Bool(FlagDisableFunderEviction, false, "If true, new funders can not replace the lowest funder of a full pool")
+	cmd.Flags().Bool(FlagCommitRevealVoting, false, "If true, stakers commit to their votes before revealing them")
 	_ = cmd.MarkFlagRequired(cli.FlagTitle)
 	_ = cmd.MarkFlagRequired(cli.FlagDescription)
 
@@ -128,8 +222,10 @@ func CmdSubmitCreatePoolProposal() *cobra.Command {
 func CmdSubmitUpdatePoolProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-pool [id] [payload]",
-		Args:  cobra.ExactArgs(2),
+		Args:  cobra.RangeArgs(1, 2),
 		Short: "Submit a proposal to update a pool.",
+		Long: "Submit a proposal to update a pool. Only the fields whose flags are set are updated, " +
+			"e.g. --upload-interval 120. The JSON payload argument is deprecated and can not be combined with these flags.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -162,7 +258,19 @@ func CmdSubmitUpdatePoolProposal() *cobra.Command {
 				return err
 			}
 
-			content := types.NewUpdatePoolProposal(title, description, id, args[1])
+			mask, update, err := parsePoolUpdateFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdatePoolProposal(title, description, id, mask, update)
+			if len(args) == 2 {
+				if len(mask) > 0 {
+					return fmt.Errorf("payload can not be combined with update flags")
+				}
+
+				content = types.NewLegacyUpdatePoolProposal(title, description, id, args[1])
+			}
 
 			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
 			if err != nil {
@@ -186,12 +294,111 @@ func CmdSubmitUpdatePoolProposal() *cobra.Command {
 	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
 	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
 	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().String(poolUpdateFlag(types.PoolUpdateName), "", "The new name of the pool")
+	cmd.Flags().String(poolUpdateFlag(types.PoolUpdateRuntime), "", "The new runtime of the pool")
+	cmd.Flags().String(poolUpdateFlag(types.PoolUpdateLogo), "", "The new logo of the pool")
+	cmd.Flags().String(poolUpdateFlag(types.PoolUpdateConfig), "", "The new config of the pool")
+	cmd.Flags().Uint64(poolUpdateFlag(types.PoolUpdateUploadInterval), 0, "The new upload interval of the pool")
+	cmd.Flags().Uint64(poolUpdateFlag(types.PoolUpdateOperatingCost), 0, "The new operating cost of the pool")
+	cmd.Flags().Uint64(poolUpdateFlag(types.PoolUpdateMinStake), 0, "The new min stake of the pool")
+	cmd.Flags().Uint64(poolUpdateFlag(types.PoolUpdateMaxBundleSize), 0, "The new max bundle size of the pool")
+	cmd.Flags().Bool(poolUpdateFlag(types.PoolUpdateCommitRevealVoting), false, "Enable or disable commit-reveal voting for the pool")
+	cmd.Flags().String(poolUpdateFlag(types.PoolUpdateValidQuorum), "", "The share of delegation which has to vote valid")
+	cmd.Flags().String(poolUpdateFlag(types.PoolUpdateInvalidQuorum), "", "The share of delegation which has to vote invalid")
+	cmd.Flags().Uint64(poolUpdateFlag(types.PoolUpdateUploadTimeout), 0, "The upload timeout of the pool, uses the module param if zero")
+	cmd.Flags().Uint64(poolUpdateFlag(types.PoolUpdateMaxPoints), 0, "The max points of the pool, uses the module param if zero")
+	cmd.Flags().String(poolUpdateFlag(types.PoolUpdateChargingMode), "", "How the bundle costs are split between the funders, either equal or pro-rata")
+	cmd.Flags().String(poolUpdateFlag(types.PoolUpdateAdmin), "", "The address which can manage the pool without governance, removes the admin if empty")
+	cmd.Flags().StringSlice(poolUpdateFlag(types.PoolUpdateFunderAllowList), []string{}, "The only addresses which can fund the pool, everybody can if empty")
+	cmd.Flags().Uint64(poolUpdateFlag(types.PoolUpdateMaxFunders), 0, "The maximum number of funders of the pool, uses the default if zero")
+	cmd.Flags().Bool(poolUpdateFlag(types.PoolUpdateDisableFunderEviction), false, "If true, new funders can not replace the lowest funder of a full pool")
 	_ = cmd.MarkFlagRequired(cli.FlagTitle)
 	_ = cmd.MarkFlagRequired(cli.FlagDescription)
 
 	return cmd
 }
 
+// poolUpdateFlag returns the flag of the update-pool command for a field of
+// the update mask.
+func poolUpdateFlag(field string) string {
+	return strings.ReplaceAll(field, "_", "-")
+}
+
+// parsePoolUpdateFlags returns the update mask and the new values of all
+// fields whose flags were set.
+func parsePoolUpdateFlags(cmd *cobra.Command) ([]string, types.PoolUpdate, error) {
+	var mask []string
+	var update types.PoolUpdate
+
+	fields := []string{
+		types.PoolUpdateName, types.PoolUpdateRuntime, types.PoolUpdateLogo, types.PoolUpdateConfig,
+		types.PoolUpdateUploadInterval, types.PoolUpdateOperatingCost, types.PoolUpdateMinStake,
+		types.PoolUpdateMaxBundleSize, types.PoolUpdateCommitRevealVoting, types.PoolUpdateValidQuorum,
+		types.PoolUpdateInvalidQuorum, types.PoolUpdateUploadTimeout, types.PoolUpdateMaxPoints,
+		types.PoolUpdateChargingMode, types.PoolUpdateAdmin, types.PoolUpdateFunderAllowList,
+		types.PoolUpdateMaxFunders, types.PoolUpdateDisableFunderEviction,
+	}
+
+	for _, field := range fields {
+		flag := poolUpdateFlag(field)
+		if !cmd.Flags().Changed(flag) {
+			continue
+		}
+
+		var err error
+
+		switch field {
+		case types.PoolUpdateName:
+			update.Name, err = cmd.Flags().GetString(flag)
+		case types.PoolUpdateRuntime:
+			update.Runtime, err = cmd.Flags().GetString(flag)
+		case types.PoolUpdateLogo:
+			update.Logo, err = cmd.Flags().GetString(flag)
+		case types.PoolUpdateConfig:
+			update.Config, err = cmd.Flags().GetString(flag)
+		case types.PoolUpdateUploadInterval:
+			update.UploadInterval, err = cmd.Flags().GetUint64(flag)
+		case types.PoolUpdateOperatingCost:
+			update.OperatingCost, err = cmd.Flags().GetUint64(flag)
+		case types.PoolUpdateMinStake:
+			update.MinStake, err = cmd.Flags().GetUint64(flag)
+		case types.PoolUpdateMaxBundleSize:
+			update.MaxBundleSize, err = cmd.Flags().GetUint64(flag)
+		case types.PoolUpdateCommitRevealVoting:
+			update.CommitRevealVoting, err = cmd.Flags().GetBool(flag)
+		case types.PoolUpdateValidQuorum:
+			update.ValidQuorum, err = cmd.Flags().GetString(flag)
+		case types.PoolUpdateInvalidQuorum:
+			update.InvalidQuorum, err = cmd.Flags().GetString(flag)
+		case types.PoolUpdateUploadTimeout:
+			update.UploadTimeout, err = cmd.Flags().GetUint64(flag)
+		case types.PoolUpdateMaxPoints:
+			update.MaxPoints, err = cmd.Flags().GetUint64(flag)
+		case types.PoolUpdateChargingMode:
+			var chargingMode string
+			if chargingMode, err = cmd.Flags().GetString(flag); err == nil {
+				update.ChargingMode, err = types.ChargingModeFromString(chargingMode)
+			}
+		case types.PoolUpdateAdmin:
+			update.Admin, err = cmd.Flags().GetString(flag)
+		case types.PoolUpdateFunderAllowList:
+			update.FunderAllowList, err = cmd.Flags().GetStringSlice(flag)
+		case types.PoolUpdateMaxFunders:
+			update.MaxFunders, err = cmd.Flags().GetUint64(flag)
+		case types.PoolUpdateDisableFunderEviction:
+			update.DisableFunderEviction, err = cmd.Flags().GetBool(flag)
+		}
+
+		if err != nil {
+			return nil, types.PoolUpdate{}, err
+		}
+
+		mask = append(mask, field)
+	}
+
+	return mask, update, nil
+}
+
 func CmdSubmitPausePoolProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pause-pool [id]",
@@ -368,7 +575,27 @@ func CmdSubmitSchedulePoolUpgradeProposal() *cobra.Command {
 				return err
 			}
 
-			content := types.NewSchedulePoolUpgradeProposal(title, description, args[0], args[1], scheduledAt, duration, args[4])
+			poolIds, err := cmd.Flags().GetUintSlice(FlagPoolIds)
+			if err != nil {
+				return err
+			}
+
+			var targetPoolIds []uint64
+			for _, poolId := range poolIds {
+				targetPoolIds = append(targetPoolIds, uint64(poolId))
+			}
+
+			canaryPoolId, err := cmd.Flags().GetUint64(FlagCanaryPoolId)
+			if err != nil {
+				return err
+			}
+
+			canaryBundles, err := cmd.Flags().GetUint64(FlagCanaryBundles)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSchedulePoolUpgradeProposal(title, description, args[0], args[1], scheduledAt, duration, args[4], targetPoolIds, canaryPoolId, canaryBundles)
 
 			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
 			if err != nil {
@@ -392,6 +619,9 @@ func CmdSubmitSchedulePoolUpgradeProposal() *cobra.Command {
 	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
 	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
 	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().UintSlice(FlagPoolIds, nil, "The pools to upgrade, upgrades all pools of the runtime if empty")
+	cmd.Flags().Uint64(FlagCanaryPoolId, 0, "The pool which gets upgraded first if canary bundles are set")
+	cmd.Flags().Uint64(FlagCanaryBundles, 0, "The bundles the canary pool has to finalize within the duration before all other pools get upgraded")
 	_ = cmd.MarkFlagRequired(cli.FlagTitle)
 	_ = cmd.MarkFlagRequired(cli.FlagDescription)
 
@@ -459,3 +689,289 @@ func CmdSubmitCancelPoolUpgradeProposal() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSubmitResetPoolProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-pool [id] [bundle_id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to reset a pool to a finalized bundle.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bundleId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewResetPoolProposal(title, description, id, bundleId)
+
+			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+func CmdSubmitCreateRuntimeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-runtime [name] [versions]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to register a runtime.",
+		Long: `Submit a proposal to register a runtime.
+The versions are given as json, e.g.
+[{"version":"1.0.0","binaries":[{"platform":"linux-x64","url":"https://...","sha256":"..."}]}]`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var versions []types.RuntimeVersion
+			if err := json.Unmarshal([]byte(args[1]), &versions); err != nil {
+				return err
+			}
+
+			configSchema, err := cmd.Flags().GetString(FlagConfigSchema)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCreateRuntimeProposal(title, description, args[0], configSchema, versions)
+
+			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().String(FlagConfigSchema, "", "The JSON schema of the pool configs (optional)")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+func CmdSubmitUpdateRuntimeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-runtime [name] [versions]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to replace the config schema and the versions of a runtime.",
+		Long: `Submit a proposal to replace the config schema and the versions of a runtime.
+The versions are given as json, e.g.
+[{"version":"1.0.0","binaries":[{"platform":"linux-x64","url":"https://...","sha256":"..."}]}]`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var versions []types.RuntimeVersion
+			if err := json.Unmarshal([]byte(args[1]), &versions); err != nil {
+				return err
+			}
+
+			configSchema, err := cmd.Flags().GetString(FlagConfigSchema)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateRuntimeProposal(title, description, args[0], configSchema, versions)
+
+			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().String(FlagConfigSchema, "", "The JSON schema of the pool configs (optional)")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+func CmdSubmitDeprecateRuntimeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprecate-runtime [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to deprecate a runtime.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewDeprecateRuntimeProposal(title, description, args[0])
+
+			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdUpdatePoolMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pool-metadata [id]",
+		Short: "Broadcast message update-pool-metadata, only the fields whose flags are set are updated",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Only the metadata flags are registered, all other fields are never set
+			mask, update, err := parsePoolUpdateFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdatePoolMetadata(
+				clientCtx.GetFromAddress().String(),
+				argId,
+				mask,
+				update.Name,
+				update.Logo,
+				update.Config,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(poolUpdateFlag(types.PoolUpdateName), "", "The new name of the pool")
+	cmd.Flags().String(poolUpdateFlag(types.PoolUpdateLogo), "", "The new logo of the pool")
+	cmd.Flags().String(poolUpdateFlag(types.PoolUpdateConfig), "", "The new config of the pool")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
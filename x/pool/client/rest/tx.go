@@ -30,6 +30,18 @@ type CreatePoolRequest struct {
 	MaxBundleSize  uint64 `json:"maxBundleSize" yaml:"maxBundleSize"`
 	Version        string `json:"version" yaml:"version"`
 	Binaries       string `json:"binaries" yaml:"binaries"`
+	ValidQuorum    string `json:"validQuorum" yaml:"validQuorum"`
+	InvalidQuorum  string `json:"invalidQuorum" yaml:"invalidQuorum"`
+	UploadTimeout  uint64 `json:"uploadTimeout" yaml:"uploadTimeout"`
+	MaxPoints      uint64 `json:"maxPoints" yaml:"maxPoints"`
+
+	ChargingMode types.ChargingMode `json:"chargingMode" yaml:"chargingMode"`
+
+	Admin                 string   `json:"admin" yaml:"admin"`
+	FunderAllowList       []string `json:"funderAllowList" yaml:"funderAllowList"`
+	MaxFunders            uint64   `json:"maxFunders" yaml:"maxFunders"`
+	DisableFunderEviction bool     `json:"disableFunderEviction" yaml:"disableFunderEviction"`
+	CommitRevealVoting    bool     `json:"commitRevealVoting" yaml:"commitRevealVoting"`
 }
 
 func ProposalCreatePoolRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
@@ -57,7 +69,7 @@ func newCreatePoolHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		content := types.NewCreatePoolProposal(req.Title, req.Description, req.Name, req.Runtime, req.Logo, req.Config, req.StartKey, req.UploadInterval, req.OperatingCost, req.MinStake, req.MaxBundleSize, req.Version, req.Binaries)
+		content := types.NewCreatePoolProposal(req.Title, req.Description, req.Name, req.Runtime, req.Logo, req.Config, req.StartKey, req.UploadInterval, req.OperatingCost, req.MinStake, req.MaxBundleSize, req.Version, req.Binaries, req.ValidQuorum, req.InvalidQuorum, req.UploadTimeout, req.MaxPoints, req.ChargingMode, req.Admin, req.FunderAllowList, req.MaxFunders, req.DisableFunderEviction, req.CommitRevealVoting)
 		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
 		if rest.CheckBadRequestError(w, err) {
 			return
@@ -77,8 +89,10 @@ type UpdatePoolRequest struct {
 	IsExpedited bool         `json:"is_expedited" yaml:"is_expedited"`
 	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
 
-	Id      uint64 `json:"id" yaml:"id"`
-	Payload string `json:"payload" yaml:"payload"`
+	Id         uint64           `json:"id" yaml:"id"`
+	Payload    string           `json:"payload" yaml:"payload"`
+	UpdateMask []string         `json:"update_mask" yaml:"update_mask"`
+	Update     types.PoolUpdate `json:"update" yaml:"update"`
 }
 
 func ProposalUpdatePoolRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
@@ -106,7 +120,15 @@ func newUpdatePoolHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		content := types.NewUpdatePoolProposal(req.Title, req.Description, req.Id, req.Payload)
+		content := types.NewUpdatePoolProposal(req.Title, req.Description, req.Id, req.UpdateMask, req.Update)
+		if req.Payload != "" {
+			if len(req.UpdateMask) > 0 {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "payload can not be combined with an update mask")
+				return
+			}
+
+			content = types.NewLegacyUpdatePoolProposal(req.Title, req.Description, req.Id, req.Payload)
+		}
 		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
 		if rest.CheckBadRequestError(w, err) {
 			return
@@ -227,6 +249,10 @@ type SchedulePoolUpgradeRequest struct {
 	ScheduledAt uint64 `json:"scheduled_at" yaml:"scheduled_at"`
 	Duration    uint64 `json:"duration" yaml:"duration"`
 	Binaries    string `json:"binaries" yaml:"binaries"`
+
+	PoolIds       []uint64 `json:"pool_ids" yaml:"pool_ids"`
+	CanaryPoolId  uint64   `json:"canary_pool_id" yaml:"canary_pool_id"`
+	CanaryBundles uint64   `json:"canary_bundles" yaml:"canary_bundles"`
 }
 
 func ProposalSchedulePoolUpgradeRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
@@ -254,7 +280,7 @@ func newSchedulePoolUpgradeHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		content := types.NewSchedulePoolUpgradeProposal(req.Title, req.Description, req.Runtime, req.Version, req.ScheduledAt, req.Duration, req.Binaries)
+		content := types.NewSchedulePoolUpgradeProposal(req.Title, req.Description, req.Runtime, req.Version, req.ScheduledAt, req.Duration, req.Binaries, req.PoolIds, req.CanaryPoolId, req.CanaryBundles)
 		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
 		if rest.CheckBadRequestError(w, err) {
 			return
@@ -314,3 +340,200 @@ func newCancelPoolUpgradeHandler(clientCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+type ResetPoolRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	IsExpedited bool         `json:"is_expedited" yaml:"is_expedited"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+
+	Id       uint64 `json:"id" yaml:"id"`
+	BundleId uint64 `json:"bundle_id" yaml:"bundle_id"`
+}
+
+func ProposalResetPoolRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "reset-pool",
+		Handler:  newResetPoolHandler(clientCtx),
+	}
+}
+
+func newResetPoolHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ResetPoolRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewResetPoolProposal(req.Title, req.Description, req.Id, req.BundleId)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type CreateRuntimeRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	IsExpedited bool         `json:"is_expedited" yaml:"is_expedited"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+
+	Name         string                 `json:"name" yaml:"name"`
+	ConfigSchema string                 `json:"config_schema" yaml:"config_schema"`
+	Versions     []types.RuntimeVersion `json:"versions" yaml:"versions"`
+}
+
+func ProposalCreateRuntimeRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "create-runtime",
+		Handler:  newCreateRuntimeHandler(clientCtx),
+	}
+}
+
+func newCreateRuntimeHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateRuntimeRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewCreateRuntimeProposal(req.Title, req.Description, req.Name, req.ConfigSchema, req.Versions)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type UpdateRuntimeRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	IsExpedited bool         `json:"is_expedited" yaml:"is_expedited"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+
+	Name         string                 `json:"name" yaml:"name"`
+	ConfigSchema string                 `json:"config_schema" yaml:"config_schema"`
+	Versions     []types.RuntimeVersion `json:"versions" yaml:"versions"`
+}
+
+func ProposalUpdateRuntimeRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update-runtime",
+		Handler:  newUpdateRuntimeHandler(clientCtx),
+	}
+}
+
+func newUpdateRuntimeHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateRuntimeRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewUpdateRuntimeProposal(req.Title, req.Description, req.Name, req.ConfigSchema, req.Versions)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type DeprecateRuntimeRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	IsExpedited bool         `json:"is_expedited" yaml:"is_expedited"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+
+	Name string `json:"name" yaml:"name"`
+}
+
+func ProposalDeprecateRuntimeRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "deprecate-runtime",
+		Handler:  newDeprecateRuntimeHandler(clientCtx),
+	}
+}
+
+func newDeprecateRuntimeHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DeprecateRuntimeRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewDeprecateRuntimeProposal(req.Title, req.Description, req.Name)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
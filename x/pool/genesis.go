@@ -20,6 +20,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set pool count
 	k.SetPoolCount(ctx, genState.PoolCount)
+
+	// Set all runtimes
+	for _, elem := range genState.RuntimeList {
+		k.SetRuntime(ctx, elem)
+	}
+
+	// Set all upgrade rollouts
+	for _, elem := range genState.UpgradeRolloutList {
+		k.SetUpgradeRollout(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -35,5 +45,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Import the number of pools
 	genesis.PoolCount = k.GetPoolCount(ctx)
 
+	// Import all runtimes
+	genesis.RuntimeList = k.GetAllRuntimes(ctx)
+
+	// Import all upgrade rollouts
+	genesis.UpgradeRolloutList = k.GetAllUpgradeRollouts(ctx)
+
 	return genesis
 }
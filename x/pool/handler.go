@@ -24,6 +24,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgDefundPool:
 			res, err := msgServer.DefundPool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateFunderAllowList:
+			res, err := msgServer.UpdateFunderAllowList(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPausePool:
+			res, err := msgServer.PausePool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUnpausePool:
+			res, err := msgServer.UnpausePool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdatePoolMetadata:
+			res, err := msgServer.UpdatePoolMetadata(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
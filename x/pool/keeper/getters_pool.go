@@ -54,6 +54,8 @@ func (k Keeper) AppendPool(
 	// Update pool count
 	k.SetPoolCount(ctx, count+1)
 
+	k.ExpireUploadDeadline(ctx, pool.Id)
+
 	return count
 }
 
@@ -62,6 +64,9 @@ func (k Keeper) SetPool(ctx sdk.Context, pool types.Pool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PoolKey)
 	b := k.cdc.MustMarshal(&pool)
 	store.Set(types.PoolKeyPrefix(pool.Id), b)
+
+	// every change of the pool could affect whether it can run
+	k.ExpireUploadDeadline(ctx, pool.Id)
 }
 
 // GetPool returns a pool from its id
@@ -79,6 +84,8 @@ func (k Keeper) GetPool(ctx sdk.Context, id uint64) (val types.Pool, found bool)
 func (k Keeper) RemovePool(ctx sdk.Context, id uint64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PoolKey)
 	store.Delete(types.PoolKeyPrefix(id))
+
+	k.RemoveUploadDeadline(ctx, id)
 }
 
 // GetAllPools returns all pools
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetRuntime stores the given runtime
+func (k Keeper) SetRuntime(ctx sdk.Context, runtime types.Runtime) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RuntimeKeyPrefix)
+	b := k.cdc.MustMarshal(&runtime)
+	store.Set(types.RuntimeKey(runtime.Name), b)
+}
+
+// GetRuntime returns the runtime with the given name
+func (k Keeper) GetRuntime(ctx sdk.Context, name string) (val types.Runtime, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RuntimeKeyPrefix)
+
+	b := store.Get(types.RuntimeKey(name))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// DoesRuntimeExist checks if the runtime with the given name is registered
+func (k Keeper) DoesRuntimeExist(ctx sdk.Context, name string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RuntimeKeyPrefix)
+	return store.Has(types.RuntimeKey(name))
+}
+
+// GetAllRuntimes returns all registered runtimes
+func (k Keeper) GetAllRuntimes(ctx sdk.Context) (list []types.Runtime) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RuntimeKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Runtime
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPaginatedRuntimesQuery returns all registered runtimes ordered by their name
+func (k Keeper) GetPaginatedRuntimesQuery(ctx sdk.Context, pagination *query.PageRequest) ([]types.Runtime, *query.PageResponse, error) {
+	var runtimes []types.Runtime
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RuntimeKeyPrefix)
+
+	pageRes, err := query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		var runtime types.Runtime
+		if err := k.cdc.Unmarshal(value, &runtime); err != nil {
+			return err
+		}
+
+		runtimes = append(runtimes, runtime)
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return runtimes, pageRes, nil
+}
+
+// GetProtocolBinaries returns the parsed binaries of the given version. If the
+// version is registered in the runtime the binaries contain their checksums.
+func (k Keeper) GetProtocolBinaries(ctx sdk.Context, runtime string, version string, binaries string) []types.Binary {
+	if r, found := k.GetRuntime(ctx, runtime); found {
+		if v, found := r.GetVersion(version); found && v.MatchBinaries(binaries) == nil {
+			return v.Binaries
+		}
+	}
+
+	return types.ParseBinaries(binaries)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetUpgradeRollout stores the given rollout under its canary pool
+func (k Keeper) SetUpgradeRollout(ctx sdk.Context, rollout types.UpgradeRollout) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UpgradeRolloutKeyPrefix)
+	b := k.cdc.MustMarshal(&rollout)
+	store.Set(types.UpgradeRolloutKey(rollout.CanaryPoolId), b)
+}
+
+// GetUpgradeRollout returns the rollout which uses the given pool as canary
+func (k Keeper) GetUpgradeRollout(ctx sdk.Context, canaryPoolId uint64) (val types.UpgradeRollout, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UpgradeRolloutKeyPrefix)
+
+	b := store.Get(types.UpgradeRolloutKey(canaryPoolId))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveUpgradeRollout removes the rollout which uses the given pool as canary
+func (k Keeper) RemoveUpgradeRollout(ctx sdk.Context, canaryPoolId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UpgradeRolloutKeyPrefix)
+	store.Delete(types.UpgradeRolloutKey(canaryPoolId))
+}
+
+// GetAllUpgradeRollouts returns all active rollouts ordered by their canary pool
+func (k Keeper) GetAllUpgradeRollouts(ctx sdk.Context) (list []types.UpgradeRollout) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UpgradeRolloutKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.UpgradeRollout
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The upload deadline of a pool is the earliest time at which the upload
+// timeout end blocker of the bundles module has to look at the pool again.
+// It is set by the bundles module whenever the bundle proposal of the pool
+// changes. Everything else that decides whether a pool can run, like the
+// pool itself, its stakers or their delegation, expires the deadline so that
+// the pool is checked in the next end block.
+
+// SetUploadDeadline sets the upload deadline of the given pool and replaces
+// the previous one.
+func (k Keeper) SetUploadDeadline(ctx sdk.Context, poolId uint64, deadline uint64) {
+	k.RemoveUploadDeadline(ctx, poolId)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadDeadlineKeyPrefix)
+	store.Set(types.UploadDeadlineKey(deadline, poolId), []byte{})
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadDeadlineKeyPrefixIndex2)
+	indexStore.Set(types.UploadDeadlineKeyIndex2(poolId), util.GetByteKey(deadline))
+}
+
+// LowerUploadDeadline sets the upload deadline of the given pool
+// if the pool has no deadline yet or if its deadline is later.
+func (k Keeper) LowerUploadDeadline(ctx sdk.Context, poolId uint64, deadline uint64) {
+	if current, found := k.GetUploadDeadline(ctx, poolId); found && current <= deadline {
+		return
+	}
+
+	k.SetUploadDeadline(ctx, poolId, deadline)
+}
+
+// ExpireUploadDeadline makes the bundles module check the given pool in the
+// next end block. It has to be called for every change which could affect
+// whether the pool can run.
+func (k Keeper) ExpireUploadDeadline(ctx sdk.Context, poolId uint64) {
+	k.LowerUploadDeadline(ctx, poolId, 0)
+}
+
+// GetUploadDeadline returns the upload deadline of the given pool
+func (k Keeper) GetUploadDeadline(ctx sdk.Context, poolId uint64) (deadline uint64, found bool) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadDeadlineKeyPrefixIndex2)
+
+	bz := indexStore.Get(types.UploadDeadlineKeyIndex2(poolId))
+	if bz == nil {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// RemoveUploadDeadline removes the upload deadline of the given pool
+func (k Keeper) RemoveUploadDeadline(ctx sdk.Context, poolId uint64) {
+	deadline, found := k.GetUploadDeadline(ctx, poolId)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadDeadlineKeyPrefix)
+	store.Delete(types.UploadDeadlineKey(deadline, poolId))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadDeadlineKeyPrefixIndex2)
+	indexStore.Delete(types.UploadDeadlineKeyIndex2(poolId))
+}
+
+// GetExpiredUploadDeadlines returns the ids of all pools whose
+// upload deadline is less than or equal to the given time.
+// The pool ids are ordered by their deadline.
+func (k Keeper) GetExpiredUploadDeadlines(ctx sdk.Context, time uint64) (poolIds []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadDeadlineKeyPrefix)
+	iterator := store.Iterator(nil, util.GetByteKey(time+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		poolIds = append(poolIds, binary.BigEndian.Uint64(iterator.Key()[8:16]))
+	}
+
+	return
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Runtimes(c context.Context, req *types.QueryRuntimesRequest) (*types.QueryRuntimesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	runtimes, pageRes, err := k.GetPaginatedRuntimesQuery(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRuntimesResponse{Runtimes: runtimes, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Runtime(c context.Context, req *types.QueryRuntimeRequest) (*types.QueryRuntimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	runtime, found := k.GetRuntime(ctx, req.Name)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryRuntimeResponse{Runtime: runtime}, nil
+}
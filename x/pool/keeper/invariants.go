@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all pool module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-assets", ModuleAssetsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-funds", TotalFundsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the pool module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := ModuleAssetsInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return TotalFundsInvariant(k)(ctx)
+	}
+}
+
+// ModuleAssetsInvariant checks that the balance of the pool module covers
+// the sum of all funds provided by the funders of all pools. The balance may
+// be higher, because anybody can send coins to the module account directly
+// and rounding dust can be left behind. This must not halt the chain.
+func ModuleAssetsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expectedBalance := sdk.NewCoins()
+
+		for _, pool := range k.GetAllPools(ctx) {
+			for _, funder := range pool.Funders {
+				expectedBalance = expectedBalance.Add(funder.Amount...)
+			}
+		}
+
+		moduleAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		actualBalance := k.bankKeeper.GetAllBalances(ctx, moduleAcc)
+
+		broken := !actualBalance.IsAllGTE(expectedBalance)
+
+		return sdk.FormatInvariant(types.ModuleName, "module-assets", fmt.Sprintf(
+			"\tsum of funders: %v\n\tmodule balance: %v\n", expectedBalance, actualBalance,
+		)), broken
+	}
+}
+
+// TotalFundsInvariant checks that the total funds of every pool
+// equal the sum of the funds of its funders.
+func TotalFundsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, pool := range k.GetAllPools(ctx) {
+			expectedFunds := sdk.NewCoins()
+
+			for _, funder := range pool.Funders {
+				expectedFunds = expectedFunds.Add(funder.Amount...)
+			}
+
+			if pool.TotalFunds.String() != expectedFunds.String() {
+				broken = true
+				msg += fmt.Sprintf("\tpool %d has total funds %v but funders sum up to %v\n", pool.Id, pool.TotalFunds, expectedFunds)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "total-funds", msg), broken
+	}
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/pool/keeper"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - invariants
+
+* Invariants hold for a funded pool
+* Module assets invariant holds if the module holds more than the funders provided
+* Module assets invariant breaks if the module holds less than the funders provided
+* Total funds invariant breaks if the total funds don't match the funders
+* Invariants are registered in the crisis module
+
+*/
+
+var _ = Describe("invariants", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name: "Moontest",
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Invariants hold for a funded pool", func() {
+		// ACT
+		_, broken := keeper.AllInvariants(s.App().PoolKeeper)(s.Ctx())
+
+		// ASSERT
+		Expect(broken).To(BeFalse())
+	})
+
+	It("Module assets invariant holds if the module holds more than the funders provided", func() {
+		// ARRANGE
+		sender, _ := sdk.AccAddressFromBech32(i.ALICE)
+		err := s.App().BankKeeper.SendCoinsFromAccountToModule(s.Ctx(), sender, pooltypes.ModuleName, i.KYVECoins(1))
+		Expect(err).To(BeNil())
+
+		// ACT
+		_, broken := keeper.ModuleAssetsInvariant(s.App().PoolKeeper)(s.Ctx())
+
+		// ASSERT
+		Expect(broken).To(BeFalse())
+
+		// restore a valid state for the validity checks
+		err = s.App().BankKeeper.SendCoinsFromModuleToAccount(s.Ctx(), pooltypes.ModuleName, sender, i.KYVECoins(1))
+		Expect(err).To(BeNil())
+	})
+
+	It("Module assets invariant breaks if the module holds less than the funders provided", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.Funders[0].Amount = pool.Funders[0].Amount.Add(sdk.NewInt64Coin(i.KYVE_DENOM, 1))
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		// ACT
+		msg, broken := keeper.ModuleAssetsInvariant(s.App().PoolKeeper)(s.Ctx())
+
+		// ASSERT
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("module-assets"))
+
+		// restore a valid state for the validity checks
+		pool.Funders[0].Amount = i.KYVECoins(100 * i.KYVE)
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+	})
+
+	It("Total funds invariant breaks if the total funds don't match the funders", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.TotalFunds = pool.TotalFunds.Add(sdk.NewInt64Coin(i.KYVE_DENOM, 1))
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		// ACT
+		msg, broken := keeper.TotalFundsInvariant(s.App().PoolKeeper)(s.Ctx())
+
+		// ASSERT
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("pool 0"))
+
+		// restore a valid state for the validity checks
+		pool.TotalFunds = i.KYVECoins(100 * i.KYVE)
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+	})
+
+	It("Invariants are registered in the crisis module", func() {
+		// ACT
+		routes := s.App().CrisisKeeper.Routes()
+
+		// ASSERT
+		registered := make([]string, 0)
+		for _, route := range routes {
+			registered = append(registered, route.FullRoute())
+		}
+
+		Expect(registered).To(ContainElements(
+			"pool/module-assets",
+			"pool/total-funds",
+			"stakers/valaccount-references",
+			"delegation/module-assets",
+			"bundles/bundle-proposal-references",
+		))
+	})
+})
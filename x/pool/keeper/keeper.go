@@ -26,6 +26,7 @@ type (
 		bankKeeper    bankkeeper.Keeper
 		distrkeeper   distrkeeper.Keeper
 		upgradeKeeper types.UpgradeKeeper
+		bundlesKeeper types.BundlesKeeper
 	}
 )
 
@@ -58,6 +59,12 @@ func NewKeeper(
 	}
 }
 
+// SetBundlesKeeper sets the bundles keeper afterwards, as the bundles
+// module depends on the pool module and is therefore created later
+func SetBundlesKeeper(k *Keeper, bundlesKeeper types.BundlesKeeper) {
+	k.bundlesKeeper = bundlesKeeper
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
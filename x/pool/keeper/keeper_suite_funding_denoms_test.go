@@ -0,0 +1,338 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/util"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - funding denoms
+
+* Try to fund a pool with a denom which is not allowed
+* Fund a pool with multiple allowed denoms
+* Defund one of multiple denoms
+* Charge funders pro-rata across their denoms
+* Remove a funder who can't afford the charge with multiple denoms
+* Charge the remainder of the rounding in the denom with the highest value
+* Refund delisted denoms of a funder who can't afford the charge
+* Never charge a funder more than the amount
+
+*/
+
+var _ = Describe("funding denoms", Ordered, func() {
+	s := i.NewCleanChain()
+
+	otherDenom := "acoin"
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// allow funding with a second denom, which is worth half a tkyve
+		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.NewParams([]pooltypes.FundingDenom{
+			{Denom: i.KYVE_DENOM, Weight: sdk.OneDec()},
+			{Denom: otherDenom, Weight: sdk.MustNewDecFromStr("0.5")},
+		}))
+
+		Expect(s.MintCoins(i.ALICE, sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(1000*i.KYVE))))).To(BeNil())
+		Expect(s.MintCoins(i.BOB, sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(1000*i.KYVE))))).To(BeNil())
+		Expect(s.MintCoins(i.BOB, sdk.NewCoins(sdk.NewInt64Coin("bcoin", int64(1000*i.KYVE))))).To(BeNil())
+
+		// create clean pool for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name: "Moontest",
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Try to fund a pool with a denom which is not allowed", func() {
+		// ARRANGE
+		balanceBefore := s.GetCoinsFromAddress(i.BOB)
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount: sdk.NewCoins(
+				sdk.NewInt64Coin(i.KYVE_DENOM, int64(100*i.KYVE)),
+				sdk.NewInt64Coin("bcoin", int64(100*i.KYVE)),
+			),
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(BeEmpty())
+		Expect(pool.TotalFunds.IsZero()).To(BeTrue())
+		Expect(s.GetCoinsFromAddress(i.BOB)).To(Equal(balanceBefore))
+	})
+
+	It("Fund a pool with multiple allowed denoms", func() {
+		// ARRANGE
+		balanceBefore := s.GetCoinsFromAddress(i.ALICE)
+		amount := sdk.NewCoins(
+			sdk.NewInt64Coin(i.KYVE_DENOM, int64(100*i.KYVE)),
+			sdk.NewInt64Coin(otherDenom, int64(200*i.KYVE)),
+		)
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  amount,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.TotalFunds).To(Equal(amount))
+
+		funder, funderFound := pool.GetFunder(i.ALICE)
+
+		Expect(funderFound).To(BeTrue())
+		Expect(funder.Amount).To(Equal(amount))
+		Expect(funder.GetValue(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(sdk.NewDec(int64(200 * i.KYVE))))
+
+		Expect(balanceBefore.Sub(s.GetCoinsFromAddress(i.ALICE))).To(Equal(amount))
+	})
+
+	It("Defund one of multiple denoms", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount: sdk.NewCoins(
+				sdk.NewInt64Coin(i.KYVE_DENOM, int64(100*i.KYVE)),
+				sdk.NewInt64Coin(otherDenom, int64(200*i.KYVE)),
+			),
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgDefundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(200*i.KYVE))),
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.TotalFunds).To(Equal(i.KYVECoins(100 * i.KYVE)))
+
+		funder, funderFound := pool.GetFunder(i.ALICE)
+
+		Expect(funderFound).To(BeTrue())
+		Expect(funder.Amount).To(Equal(i.KYVECoins(100 * i.KYVE)))
+	})
+
+	It("Charge funders pro-rata across their denoms", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount: sdk.NewCoins(
+				sdk.NewInt64Coin(i.KYVE_DENOM, int64(100*i.KYVE)),
+				sdk.NewInt64Coin(otherDenom, int64(200*i.KYVE)),
+			),
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(400*i.KYVE))),
+		})
+
+		// ACT
+		payout, err := s.App().PoolKeeper.ChargeFundersOfPool(s.Ctx(), 0, 20*i.KYVE)
+		Expect(err).To(BeNil())
+
+		// distribute the payout like the bundles module does
+		err = util.TransferCoinsFromModuleToAddress(s.App().BankKeeper, s.Ctx(), pooltypes.ModuleName, i.CHARLIE, payout)
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		// alice pays her share of 10 $KYVE half in tkyve and half in acoin
+		// bob pays his share of 10 $KYVE with 20 acoin
+		Expect(payout).To(Equal(sdk.NewCoins(
+			sdk.NewInt64Coin(otherDenom, int64(30*i.KYVE)),
+			sdk.NewInt64Coin(i.KYVE_DENOM, int64(5*i.KYVE)),
+		)))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		funderAlice, _ := pool.GetFunder(i.ALICE)
+		Expect(funderAlice.Amount).To(Equal(sdk.NewCoins(
+			sdk.NewInt64Coin(i.KYVE_DENOM, int64(95*i.KYVE)),
+			sdk.NewInt64Coin(otherDenom, int64(190*i.KYVE)),
+		)))
+
+		funderBob, _ := pool.GetFunder(i.BOB)
+		Expect(funderBob.Amount).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(380*i.KYVE)))))
+	})
+
+	It("Remove a funder who can't afford the charge with multiple denoms", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount: sdk.NewCoins(
+				sdk.NewInt64Coin(i.KYVE_DENOM, int64(1*i.KYVE)),
+				sdk.NewInt64Coin(otherDenom, int64(2*i.KYVE)),
+			),
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(400*i.KYVE))),
+		})
+
+		// ACT
+		payout, err := s.App().PoolKeeper.ChargeFundersOfPool(s.Ctx(), 0, 10*i.KYVE)
+		Expect(err).To(BeNil())
+
+		// distribute the payout like the bundles module does
+		err = util.TransferCoinsFromModuleToAddress(s.App().BankKeeper, s.Ctx(), pooltypes.ModuleName, i.CHARLIE, payout)
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		// alice is only worth 2 $KYVE and gets removed, bob pays everything
+		Expect(payout).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(20*i.KYVE)))))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		_, funderAliceFound := pool.GetFunder(i.ALICE)
+		Expect(funderAliceFound).To(BeFalse())
+
+		funderBob, _ := pool.GetFunder(i.BOB)
+		Expect(funderBob.Amount).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(380*i.KYVE)))))
+	})
+
+	It("Charge the remainder of the rounding in the denom with the highest value", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount: sdk.NewCoins(
+				sdk.NewInt64Coin(i.KYVE_DENOM, 3),
+				sdk.NewInt64Coin(otherDenom, 3),
+			),
+		})
+
+		// ACT
+		payout, err := s.App().PoolKeeper.ChargeFundersOfPool(s.Ctx(), 0, 1)
+		Expect(err).To(BeNil())
+
+		// distribute the payout like the bundles module does
+		err = util.TransferCoinsFromModuleToAddress(s.App().BankKeeper, s.Ctx(), pooltypes.ModuleName, i.CHARLIE, payout)
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		// both shares are rounded down to zero, the remainder is paid in tkyve
+		Expect(payout).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(i.KYVE_DENOM, 1))))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		funderAlice, _ := pool.GetFunder(i.ALICE)
+		Expect(funderAlice.Amount).To(Equal(sdk.NewCoins(
+			sdk.NewInt64Coin(i.KYVE_DENOM, 2),
+			sdk.NewInt64Coin(otherDenom, 3),
+		)))
+	})
+
+	It("Refund delisted denoms of a funder who can't afford the charge", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount: sdk.NewCoins(
+				sdk.NewInt64Coin(i.KYVE_DENOM, int64(1*i.KYVE)),
+				sdk.NewInt64Coin(otherDenom, int64(200*i.KYVE)),
+			),
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  i.KYVECoins(400 * i.KYVE),
+		})
+
+		// delist the second denom
+		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.NewParams([]pooltypes.FundingDenom{
+			{Denom: i.KYVE_DENOM, Weight: sdk.OneDec()},
+		}))
+
+		balanceBefore := s.GetCoinsFromAddress(i.ALICE)
+
+		// ACT
+		payout, err := s.App().PoolKeeper.ChargeFundersOfPool(s.Ctx(), 0, 10*i.KYVE)
+		Expect(err).To(BeNil())
+
+		// distribute the payout like the bundles module does
+		err = util.TransferCoinsFromModuleToAddress(s.App().BankKeeper, s.Ctx(), pooltypes.ModuleName, i.CHARLIE, payout)
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		// alice is only worth 1 $KYVE and gets removed, bob pays everything
+		Expect(payout).To(Equal(i.KYVECoins(10 * i.KYVE)))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		_, funderAliceFound := pool.GetFunder(i.ALICE)
+		Expect(funderAliceFound).To(BeFalse())
+
+		// the delisted denom is refunded, the tkyve goes to the treasury
+		Expect(s.GetCoinsFromAddress(i.ALICE).Sub(balanceBefore)).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(200*i.KYVE)))))
+	})
+	It("Never charge a funder more than the amount", func() {
+		// ARRANGE
+		// the second denom is worth two tkyve
+		s.App().PoolKeeper.SetParams(s.Ctx(), pooltypes.NewParams([]pooltypes.FundingDenom{
+			{Denom: i.KYVE_DENOM, Weight: sdk.OneDec()},
+			{Denom: otherDenom, Weight: sdk.NewDec(2)},
+		}))
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount: sdk.NewCoins(
+				sdk.NewInt64Coin(i.KYVE_DENOM, 1),
+				sdk.NewInt64Coin(otherDenom, 3),
+			),
+		})
+
+		// ACT
+		payout, err := s.App().PoolKeeper.ChargeFundersOfPool(s.Ctx(), 0, 3)
+		Expect(err).To(BeNil())
+
+		err = util.TransferCoinsFromModuleToAddress(s.App().BankKeeper, s.Ctx(), pooltypes.ModuleName, i.CHARLIE, payout)
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		// another acoin for the remainder would exceed the amount
+		Expect(payout).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(otherDenom, 1))))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		funderAlice, _ := pool.GetFunder(i.ALICE)
+		Expect(funderAlice.Amount).To(Equal(sdk.NewCoins(
+			sdk.NewInt64Coin(i.KYVE_DENOM, 1),
+			sdk.NewInt64Coin(otherDenom, 2),
+		)))
+	})
+})
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/KYVENetwork/chain/util"
 	"github.com/KYVENetwork/chain/x/pool/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -26,4 +27,78 @@ func (k Keeper) HandlePoolUpgrades(ctx sdk.Context) {
 			k.SetPool(ctx, pool)
 		}
 	}
+
+	k.handleUpgradeRollouts(ctx)
+}
+
+// handleUpgradeRollouts advances all staged rollouts. Once the canary pool
+// finished its upgrade it has to finalize the required number of bundles
+// within the upgrade duration. If it does the upgrade gets scheduled for the
+// remaining pools, otherwise the canary pool gets rolled back.
+func (k Keeper) handleUpgradeRollouts(ctx sdk.Context) {
+	now := uint64(ctx.BlockTime().Unix())
+
+	for _, rollout := range k.GetAllUpgradeRollouts(ctx) {
+		canary, found := k.GetPool(ctx, rollout.CanaryPoolId)
+		if !found {
+			k.RemoveUpgradeRollout(ctx, rollout.CanaryPoolId)
+			continue
+		}
+
+		switch rollout.Stage {
+		case types.UPGRADE_ROLLOUT_STAGE_SCHEDULED:
+			// Wait until the canary pool finished its upgrade
+			if canary.UpgradePlan.ScheduledAt > 0 {
+				continue
+			}
+
+			rollout.Stage = types.UPGRADE_ROLLOUT_STAGE_EVALUATING
+			rollout.StartBundles = canary.TotalBundles
+			rollout.Deadline = now + rollout.Duration
+
+			k.SetUpgradeRollout(ctx, rollout)
+			k.emitUpgradeRolloutEvent(ctx, rollout, 0)
+
+		case types.UPGRADE_ROLLOUT_STAGE_EVALUATING:
+			// Bundles can be removed by a pool reset
+			finalizedBundles := uint64(0)
+			if canary.TotalBundles > rollout.StartBundles {
+				finalizedBundles = canary.TotalBundles - rollout.StartBundles
+			}
+
+			if finalizedBundles >= rollout.CanaryBundles {
+				for _, poolId := range rollout.PoolIds {
+					pool, found := k.GetPool(ctx, poolId)
+					if !found || pool.Runtime != canary.Runtime || k.isPoolUpgrading(ctx, pool) {
+						continue
+					}
+
+					k.schedulePoolUpgrade(ctx, pool, rollout.Version, rollout.Binaries, now, rollout.Duration)
+				}
+
+				rollout.Stage = types.UPGRADE_ROLLOUT_STAGE_PROMOTED
+			} else if now >= rollout.Deadline {
+				k.schedulePoolUpgrade(ctx, canary, rollout.PreviousProtocol.Version, rollout.PreviousProtocol.Binaries, now, rollout.Duration)
+
+				rollout.Stage = types.UPGRADE_ROLLOUT_STAGE_ROLLED_BACK
+			} else {
+				continue
+			}
+
+			k.RemoveUpgradeRollout(ctx, rollout.CanaryPoolId)
+			k.emitUpgradeRolloutEvent(ctx, rollout, finalizedBundles)
+		}
+	}
+}
+
+func (k Keeper) emitUpgradeRolloutEvent(ctx sdk.Context, rollout types.UpgradeRollout, finalizedBundles uint64) {
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventPoolUpgradeRollout{
+		CanaryPoolId:     rollout.CanaryPoolId,
+		Stage:            rollout.Stage,
+		Version:          rollout.Version,
+		PoolIds:          rollout.PoolIds,
+		FinalizedBundles: finalizedBundles,
+	}); errEmit != nil {
+		util.LogFatalLogicError("Event not parsable", errEmit.Error())
+	}
 }
@@ -0,0 +1,298 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+/*
+
+TEST CASES - logic_end_block_handle_pool_upgrades.go (rollouts)
+
+* Schedule a pool upgrade for explicit pool ids
+* Schedule a pool upgrade for a pool which is already upgrading
+* Schedule a pool upgrade for a pool of another runtime
+* Schedule a staged rollout with invalid parameters
+* Promote a staged rollout after the canary pool finalized enough bundles
+* Roll back a staged rollout if the canary pool does not finalize enough bundles
+* Cancel a staged rollout
+
+*/
+
+var _ = Describe("logic_end_block_handle_pool_upgrades.go (rollouts)", Ordered, func() {
+	s := i.NewCleanChain()
+
+	// getRolloutStages returns the stages of all emitted rollout events
+	getRolloutStages := func() (stages []pooltypes.UpgradeRolloutStage) {
+		for _, event := range s.Ctx().EventManager().Events() {
+			if event.Type != "kyve.pool.v1beta1.EventPoolUpgradeRollout" {
+				continue
+			}
+
+			parsedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			Expect(err).To(BeNil())
+			stages = append(stages, parsedEvent.(*pooltypes.EventPoolUpgradeRollout).Stage)
+		}
+
+		return
+	}
+
+	scheduleRollout := func() {
+		err := s.App().PoolKeeper.UpgradePool(s.Ctx(), &pooltypes.SchedulePoolUpgradeProposal{
+			Title:         i.GOV,
+			Description:   "desc",
+			Runtime:       "@kyve/evm",
+			Version:       "new version",
+			ScheduledAt:   uint64(s.Ctx().BlockTime().Unix()),
+			Duration:      60,
+			PoolIds:       []uint64{0, 2},
+			CanaryPoolId:  1,
+			CanaryBundles: 2,
+		})
+		Expect(err).To(BeNil())
+		Expect(getRolloutStages()).To(Equal([]pooltypes.UpgradeRolloutStage{pooltypes.UPGRADE_ROLLOUT_STAGE_SCHEDULED}))
+
+		// canary pool finishes its upgrade
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		rollout, found := s.App().PoolKeeper.GetUpgradeRollout(s.Ctx(), 1)
+		Expect(found).To(BeTrue())
+		Expect(rollout.Stage).To(Equal(pooltypes.UPGRADE_ROLLOUT_STAGE_EVALUATING))
+		Expect(rollout.PreviousProtocol.Version).To(Equal("1"))
+
+		canary, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(canary.Protocol.Version).To(Equal("new version"))
+		Expect(canary.UpgradePlan.ScheduledAt).To(BeZero())
+
+		for _, poolId := range []uint64{0, 2} {
+			pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), poolId)
+			Expect(pool.Protocol.Version).To(Equal("1"))
+			Expect(pool.UpgradePlan.ScheduledAt).To(BeZero())
+		}
+	}
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		createRuntimes(&s)
+
+		for _, runtime := range []string{"@kyve/evm", "@kyve/evm", "@kyve/evm", "@kyve/bitcoin"} {
+			err := s.App().PoolKeeper.CreatePool(s.Ctx(), &pooltypes.CreatePoolProposal{
+				Title:          i.GOV,
+				Description:    "desc",
+				Name:           "Moonbeam",
+				Runtime:        runtime,
+				Logo:           "https://arweave.net/9FJDam56yBbmvn8rlamEucATH5UcYqSBw468rlCXn8E",
+				Config:         "{\"config\": \"test\"}",
+				StartKey:       "0",
+				UploadInterval: 60,
+				OperatingCost:  2_500_000_000,
+				MinStake:       100_000_000_000,
+				MaxBundleSize:  100,
+				Version:        "1",
+				Binaries:       "{\"b1\": \"https://example.com/b1\"}",
+			})
+			Expect(err).To(BeNil())
+		}
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Schedule a pool upgrade for explicit pool ids", func() {
+		// Act
+		err := s.App().PoolKeeper.UpgradePool(s.Ctx(), &pooltypes.SchedulePoolUpgradeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Runtime:     "@kyve/evm",
+			Version:     "new version",
+			ScheduledAt: uint64(s.Ctx().BlockTime().Unix() + 1000),
+			Duration:    60,
+			PoolIds:     []uint64{0, 2},
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		for poolId, upgrading := range []bool{true, false, true, false} {
+			pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), uint64(poolId))
+			Expect(pool.UpgradePlan.ScheduledAt > 0).To(Equal(upgrading))
+		}
+
+		Expect(s.App().PoolKeeper.GetAllUpgradeRollouts(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Schedule a pool upgrade for a pool which is already upgrading", func() {
+		// Arrange
+		proposal := &pooltypes.SchedulePoolUpgradeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Runtime:     "@kyve/evm",
+			Version:     "new version",
+			ScheduledAt: uint64(s.Ctx().BlockTime().Unix() + 1000),
+			Duration:    60,
+			PoolIds:     []uint64{0},
+		}
+		Expect(s.App().PoolKeeper.UpgradePool(s.Ctx(), proposal)).To(BeNil())
+
+		// Act
+		proposal.PoolIds = []uint64{1, 0}
+		err := s.App().PoolKeeper.UpgradePool(s.Ctx(), proposal)
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+
+		// no pool got upgraded as the proposal failed as a whole
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(pool.UpgradePlan.ScheduledAt).To(BeZero())
+	})
+
+	It("Schedule a pool upgrade for a pool of another runtime", func() {
+		// Act
+		err := s.App().PoolKeeper.UpgradePool(s.Ctx(), &pooltypes.SchedulePoolUpgradeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Runtime:     "@kyve/evm",
+			Version:     "new version",
+			ScheduledAt: uint64(s.Ctx().BlockTime().Unix() + 1000),
+			Duration:    60,
+			PoolIds:     []uint64{3},
+		})
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 3)
+		Expect(pool.UpgradePlan.ScheduledAt).To(BeZero())
+
+		// Act
+		err = s.App().PoolKeeper.UpgradePool(s.Ctx(), &pooltypes.SchedulePoolUpgradeProposal{
+			Title:         i.GOV,
+			Description:   "desc",
+			Runtime:       "@kyve/evm",
+			Version:       "new version",
+			ScheduledAt:   uint64(s.Ctx().BlockTime().Unix() + 1000),
+			Duration:      60,
+			CanaryPoolId:  3,
+			CanaryBundles: 1,
+		})
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+		Expect(s.App().PoolKeeper.GetAllUpgradeRollouts(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Schedule a staged rollout with invalid parameters", func() {
+		Expect(pooltypes.NewSchedulePoolUpgradeProposal(i.GOV, "desc", "@kyve/evm", "new version", 0, 60, "", []uint64{0, 0}, 0, 0).ValidateBasic()).NotTo(BeNil())
+		Expect(pooltypes.NewSchedulePoolUpgradeProposal(i.GOV, "desc", "@kyve/evm", "new version", 0, 60, "", []uint64{0, 2}, 2, 1).ValidateBasic()).NotTo(BeNil())
+		Expect(pooltypes.NewSchedulePoolUpgradeProposal(i.GOV, "desc", "@kyve/evm", "new version", 0, 0, "", []uint64{0, 2}, 1, 1).ValidateBasic()).NotTo(BeNil())
+		Expect(pooltypes.NewSchedulePoolUpgradeProposal(i.GOV, "desc", "@kyve/evm", "new version", 0, 60, "", []uint64{0, 2}, 1, 1).ValidateBasic()).To(BeNil())
+		Expect(pooltypes.NewSchedulePoolUpgradeProposal(i.GOV, "desc", "@kyve/evm", "new version", 0, 0, "", []uint64{0, 2}, 2, 0).ValidateBasic()).To(BeNil())
+	})
+
+	It("Promote a staged rollout after the canary pool finalized enough bundles", func() {
+		// Arrange
+		scheduleRollout()
+
+		// Act
+		s.App().PoolKeeper.IncrementBundleInformation(s.Ctx(), 1, 100, "99", "value")
+		s.CommitAfterSeconds(30)
+
+		// Assert
+		rollout, _ := s.App().PoolKeeper.GetUpgradeRollout(s.Ctx(), 1)
+		Expect(rollout.Stage).To(Equal(pooltypes.UPGRADE_ROLLOUT_STAGE_EVALUATING))
+
+		// Act
+		s.App().PoolKeeper.IncrementBundleInformation(s.Ctx(), 1, 200, "199", "value")
+		s.CommitAfterSeconds(1)
+
+		// Assert
+		_, found := s.App().PoolKeeper.GetUpgradeRollout(s.Ctx(), 1)
+		Expect(found).To(BeFalse())
+
+		for _, poolId := range []uint64{0, 2} {
+			pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), poolId)
+			Expect(pool.UpgradePlan.Version).To(Equal("new version"))
+			Expect(pool.UpgradePlan.Duration).To(Equal(uint64(60)))
+		}
+
+		// pools of other runtimes are untouched
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 3)
+		Expect(pool.UpgradePlan.ScheduledAt).To(BeZero())
+
+		// the remaining pools finish their upgrade
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		for _, poolId := range []uint64{0, 1, 2} {
+			pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), poolId)
+			Expect(pool.Protocol.Version).To(Equal("new version"))
+			Expect(pool.UpgradePlan.ScheduledAt).To(BeZero())
+		}
+	})
+
+	It("Roll back a staged rollout if the canary pool does not finalize enough bundles", func() {
+		// Arrange
+		scheduleRollout()
+
+		// Act
+		s.App().PoolKeeper.IncrementBundleInformation(s.Ctx(), 1, 100, "99", "value")
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		// Assert
+		_, found := s.App().PoolKeeper.GetUpgradeRollout(s.Ctx(), 1)
+		Expect(found).To(BeFalse())
+
+		canary, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(canary.UpgradePlan.Version).To(Equal("1"))
+		Expect(canary.UpgradePlan.Binaries).To(Equal("{\"b1\": \"https://example.com/b1\"}"))
+
+		// the canary pool returns to its previous protocol
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		for _, poolId := range []uint64{0, 1, 2} {
+			pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), poolId)
+			Expect(pool.Protocol.Version).To(Equal("1"))
+			Expect(pool.Protocol.Binaries).To(Equal("{\"b1\": \"https://example.com/b1\"}"))
+			Expect(pool.UpgradePlan.ScheduledAt).To(BeZero())
+		}
+	})
+
+	It("Cancel a staged rollout", func() {
+		// Arrange
+		scheduleRollout()
+
+		// Act
+		err := s.App().PoolKeeper.CancelPoolUpgrade(s.Ctx(), &pooltypes.CancelPoolUpgradeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Runtime:     "@kyve/evm",
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(s.App().PoolKeeper.GetAllUpgradeRollouts(s.Ctx())).To(BeEmpty())
+		Expect(getRolloutStages()).To(ContainElement(pooltypes.UPGRADE_ROLLOUT_STAGE_CANCELLED))
+
+		s.App().PoolKeeper.IncrementBundleInformation(s.Ctx(), 1, 100, "99", "value")
+		s.App().PoolKeeper.IncrementBundleInformation(s.Ctx(), 1, 200, "199", "value")
+		s.CommitAfterSeconds(1)
+
+		for poolId, version := range []string{"1", "new version", "1"} {
+			pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), uint64(poolId))
+			Expect(pool.Protocol.Version).To(Equal(version))
+			Expect(pool.UpgradePlan.ScheduledAt).To(BeZero())
+		}
+	})
+})
@@ -7,19 +7,29 @@ import (
 )
 
 // ChargeFundersOfPool equally splits the amount between all funders and removes
-// the appropriate amount from each funder.
+// the appropriate amount from each funder. The amount is denominated in units of
+// the pool costs and every funder pays its share with the funding denoms it holds,
+// converted with the weights of the funding denoms param.
 // All funders who can't afford the amount, are kicked out.
-// Their remaining amount is transferred to the Treasury
+// Their remaining amount is transferred to the Treasury, except for
+// funds in denoms which are no longer allowed, those are refunded.
+// Pools with the pro-rata charging mode are charged by chargeFundersOfPoolProRata.
 // Function throws an error if pool ran out of funds.
 // This method does not transfer any funds. The bundles
-// module is responsible for transferring the rewards out of the module
-func (k Keeper) ChargeFundersOfPool(ctx sdk.Context, poolId uint64, amount uint64) error {
+// module is responsible for transferring the returned coins out of the module
+func (k Keeper) ChargeFundersOfPool(ctx sdk.Context, poolId uint64, amount uint64) (payout sdk.Coins, err error) {
 
 	pool, poolErr := k.GetPoolWithError(ctx, poolId)
 	if poolErr != nil {
-		return poolErr
+		return nil, poolErr
 	}
 
+	if pool.ChargingMode == pooltypes.CHARGING_MODE_PRO_RATA {
+		return k.chargeFundersOfPoolProRata(ctx, pool, amount)
+	}
+
+	weights := k.GetFundingWeights(ctx)
+
 	// This is the amount every funder will be charged
 	var amountPerFunder uint64
 
@@ -29,52 +39,115 @@ func (k Keeper) ChargeFundersOfPool(ctx sdk.Context, poolId uint64, amount uint6
 
 	// When a funder is not able to pay, all the remaining funds will be moved
 	// to the treasury.
-	var slashedFunds uint64
+	slashedFunds := sdk.NewCoins()
 
 	// Remove all funders who can't afford amountPerFunder
 	for len(pool.Funders) > 0 {
 		amountPerFunder = amount / uint64(len(pool.Funders))
 		amountRemainder = amount - amountPerFunder*uint64(len(pool.Funders))
 
-		lowestFunder := pool.GetLowestFunder()
+		lowestFunder := pool.GetLowestFunder(weights)
 
-		if amountRemainder+amountPerFunder > lowestFunder.Amount {
+		if sdk.NewDecFromInt(sdk.NewIntFromUint64(amountRemainder + amountPerFunder)).GT(lowestFunder.GetValue(weights)) {
 			pool.RemoveFunder(lowestFunder)
 			if errEmit := ctx.EventManager().EmitTypedEvent(&pooltypes.EventDefundPool{
 				PoolId:  poolId,
 				Address: lowestFunder.Address,
 				Amount:  lowestFunder.Amount,
 			}); errEmit != nil {
-				return errEmit
+				return nil, errEmit
+			}
+
+			// Funds in delisted denoms have no value and are refunded
+			refund := lowestFunder.GetDelistedFunds(weights)
+			if !refund.IsZero() {
+				if err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, pooltypes.ModuleName, lowestFunder.Address, refund); err != nil {
+					util.PanicHalt(k.upgradeKeeper, ctx, "pool module out of funds")
+				}
 			}
 
-			slashedFunds += lowestFunder.Amount
+			slashedFunds = slashedFunds.Add(lowestFunder.Amount.Sub(refund)...)
 		} else {
 			break
 		}
 	}
 
-	if slashedFunds > 0 {
+	if !slashedFunds.IsZero() {
 		// send slash to treasury
-		if err := util.TransferFromModuleToTreasury(k.accountKeeper, k.distrkeeper, ctx, pooltypes.ModuleName, slashedFunds); err != nil {
+		if err := util.TransferCoinsFromModuleToTreasury(k.accountKeeper, k.distrkeeper, ctx, pooltypes.ModuleName, slashedFunds); err != nil {
 			util.PanicHalt(k.upgradeKeeper, ctx, "pool module out of funds")
 		}
 	}
 
 	if len(pool.Funders) == 0 {
 		k.SetPool(ctx, pool)
-		return pooltypes.ErrFundsTooLow
+		return nil, pooltypes.ErrFundsTooLow
 	}
 
+	// The lowest funder additionally pays the remainder
+	lowestFunder := pool.GetLowestFunder(weights)
+
 	// Remove amount from funders
-	for _, funder := range pool.Funders {
-		pool.SubFromFunder(funder.Address, amountPerFunder)
+	payout = sdk.NewCoins()
+	for _, funder := range append([]*pooltypes.Funder{}, pool.Funders...) {
+		if funder.Address == lowestFunder.Address {
+			payout = payout.Add(pool.ChargeFunder(funder.Address, amountPerFunder+amountRemainder, weights)...)
+		} else {
+			payout = payout.Add(pool.ChargeFunder(funder.Address, amountPerFunder, weights)...)
+		}
 	}
 
-	lowestFunder := pool.GetLowestFunder()
-	pool.SubFromFunder(lowestFunder.Address, amountRemainder)
+	k.SetPool(ctx, pool)
+
+	return payout, nil
+}
+
+// chargeFundersOfPoolProRata splits the amount between all funders in proportion
+// to the value of their funds. The remainder which can not be split is charged
+// to the funder with the highest value. Funders who can't afford their share or
+// whose funds have no value are removed one by one, starting with the lowest,
+// and get their remaining funds refunded.
+func (k Keeper) chargeFundersOfPoolProRata(ctx sdk.Context, pool pooltypes.Pool, amount uint64) (payout sdk.Coins, err error) {
+	weights := k.GetFundingWeights(ctx)
+
+	var charges []uint64
+
+	for len(pool.Funders) > 0 {
+		charges = pool.GetProRataCharges(amount, weights)
+
+		// Find the lowest funder who can't afford the share
+		funder, found := pool.GetInsolventProRataFunder(charges, weights)
+		if !found {
+			break
+		}
+
+		if err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, pooltypes.ModuleName, funder.Address, funder.Amount); err != nil {
+			util.PanicHalt(k.upgradeKeeper, ctx, "pool module out of funds")
+		}
+
+		if errEmit := ctx.EventManager().EmitTypedEvent(&pooltypes.EventDefundPool{
+			PoolId:  pool.Id,
+			Address: funder.Address,
+			Amount:  funder.Amount,
+		}); errEmit != nil {
+			return nil, errEmit
+		}
+
+		pool.RemoveFunder(funder)
+	}
+
+	if len(pool.Funders) == 0 {
+		k.SetPool(ctx, pool)
+		return nil, pooltypes.ErrFundsTooLow
+	}
+
+	// Remove the shares from the funders
+	payout = sdk.NewCoins()
+	for index, funder := range append([]*pooltypes.Funder{}, pool.Funders...) {
+		payout = payout.Add(pool.ChargeFunder(funder.Address, charges[index], weights)...)
+	}
 
 	k.SetPool(ctx, pool)
 
-	return nil
+	return payout, nil
 }
@@ -0,0 +1,348 @@
+package keeper_test
+
+import (
+	"math/rand"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/util"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_funders.go (pro-rata)
+
+* Charge funders in proportion to their funds
+* Charge the remainder to the largest funder
+* Refund a funder who can't afford the remainder
+* Refund all funders if the pool can't afford the amount
+* Refund funders whose funds have no value
+* Switch a pool to pro-rata charging via governance
+* Charge random funders pro-rata (property based)
+
+*/
+
+var _ = Describe("logic_funders.go (pro-rata)", Ordered, func() {
+	s := i.NewCleanChain()
+
+	// appendProRataPool creates a new pool which charges its funders pro-rata
+	appendProRataPool := func() uint64 {
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:           "Moontest",
+			MaxBundleSize:  100,
+			StartKey:       "0",
+			MinStake:       100 * i.KYVE,
+			UploadInterval: 60,
+			OperatingCost:  10_000,
+			ChargingMode:   pooltypes.CHARGING_MODE_PRO_RATA,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		return s.App().PoolKeeper.GetPoolCount(s.Ctx()) - 1
+	}
+
+	// charge charges the funders of the pool and distributes the payout like the bundles module does
+	charge := func(poolId uint64, amount uint64) (sdk.Coins, error) {
+		payout, err := s.App().PoolKeeper.ChargeFundersOfPool(s.Ctx(), poolId, amount)
+		if err == nil {
+			Expect(util.TransferCoinsFromModuleToAddress(s.App().BankKeeper, s.Ctx(), pooltypes.ModuleName, i.CHARLIE, payout)).To(BeNil())
+		}
+
+		return payout, err
+	}
+
+	getFunderAmount := func(poolId uint64, address string) uint64 {
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), poolId)
+		funder, _ := pool.GetFunder(address)
+		return funder.Amount.AmountOf(i.KYVE_DENOM).Uint64()
+	}
+
+	fund := func(poolId uint64, address string, amount uint64) {
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: address,
+			Id:      poolId,
+			Amount:  i.KYVECoins(amount),
+		})
+	}
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Charge funders in proportion to their funds", func() {
+		// ARRANGE
+		poolId := appendProRataPool()
+
+		fund(poolId, i.ALICE, 100*i.KYVE)
+		fund(poolId, i.BOB, 300*i.KYVE)
+		fund(poolId, i.CHARLIE, 600*i.KYVE)
+
+		// ACT
+		payout, err := charge(poolId, 10*i.KYVE)
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(payout).To(Equal(i.KYVECoins(10 * i.KYVE)))
+
+		Expect(getFunderAmount(poolId, i.ALICE)).To(Equal(99 * i.KYVE))
+		Expect(getFunderAmount(poolId, i.BOB)).To(Equal(297 * i.KYVE))
+		Expect(getFunderAmount(poolId, i.CHARLIE)).To(Equal(594 * i.KYVE))
+	})
+
+	It("Charge the remainder to the largest funder", func() {
+		// ARRANGE
+		poolId := appendProRataPool()
+
+		fund(poolId, i.ALICE, 100)
+		fund(poolId, i.BOB, 200)
+		fund(poolId, i.CHARLIE, 100)
+
+		// ACT
+		payout, err := charge(poolId, 3)
+
+		// ASSERT
+		// the shares are 0.75, 1.5 and 0.75 of which only bob's full unit is charged
+		Expect(err).To(BeNil())
+		Expect(payout).To(Equal(i.KYVECoins(3)))
+
+		Expect(getFunderAmount(poolId, i.ALICE)).To(Equal(uint64(100)))
+		Expect(getFunderAmount(poolId, i.BOB)).To(Equal(uint64(197)))
+		Expect(getFunderAmount(poolId, i.CHARLIE)).To(Equal(uint64(100)))
+	})
+
+	It("Refund a funder who can't afford the remainder", func() {
+		// ARRANGE
+		poolId := appendProRataPool()
+
+		fund(poolId, i.ALICE, 5)
+		fund(poolId, i.BOB, 5)
+		fund(poolId, i.DUMMY[0], 6)
+
+		aliceBalance := s.GetBalanceFromAddress(i.ALICE)
+		bobBalance := s.GetBalanceFromAddress(i.BOB)
+		dummyBalance := s.GetBalanceFromAddress(i.DUMMY[0])
+
+		// ACT
+		// alice and bob are charged 4 and the largest funder 5 plus the remainder of 2 which exceeds its funds
+		payout, err := charge(poolId, 15)
+
+		// ASSERT
+		// the largest funder gets refunded and alice and bob alone can not afford the amount anymore
+		Expect(err).To(Equal(pooltypes.ErrFundsTooLow))
+		Expect(payout).To(BeEmpty())
+
+		Expect(s.GetBalanceFromAddress(i.ALICE)).To(Equal(aliceBalance + 5))
+		Expect(s.GetBalanceFromAddress(i.BOB)).To(Equal(bobBalance + 5))
+		Expect(s.GetBalanceFromAddress(i.DUMMY[0])).To(Equal(dummyBalance + 6))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), poolId)
+		Expect(pool.Funders).To(BeEmpty())
+		Expect(pool.TotalFunds.IsZero()).To(BeTrue())
+	})
+
+	It("Refund all funders if the pool can't afford the amount", func() {
+		// ARRANGE
+		poolId := appendProRataPool()
+
+		fund(poolId, i.ALICE, 1*i.KYVE)
+		fund(poolId, i.BOB, 2*i.KYVE)
+
+		aliceBalance := s.GetBalanceFromAddress(i.ALICE)
+		bobBalance := s.GetBalanceFromAddress(i.BOB)
+		communityPool := s.App().DistrKeeper.GetFeePoolCommunityCoins(s.Ctx())
+
+		// ACT
+		_, err := charge(poolId, 4*i.KYVE)
+
+		// ASSERT
+		Expect(err).To(Equal(pooltypes.ErrFundsTooLow))
+
+		Expect(s.GetBalanceFromAddress(i.ALICE)).To(Equal(aliceBalance + 1*i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.BOB)).To(Equal(bobBalance + 2*i.KYVE))
+		Expect(s.App().DistrKeeper.GetFeePoolCommunityCoins(s.Ctx())).To(Equal(communityPool))
+	})
+
+	It("Refund funders whose funds have no value", func() {
+		// ARRANGE
+		poolId := appendProRataPool()
+
+		otherDenom := "acoin"
+		Expect(s.MintCoins(i.ALICE, sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(100*i.KYVE))))).To(BeNil())
+
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.FundingDenoms = append(params.FundingDenoms, pooltypes.FundingDenom{Denom: otherDenom, Weight: sdk.OneDec()})
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      poolId,
+			Amount:  sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(100*i.KYVE))),
+		})
+		fund(poolId, i.BOB, 100*i.KYVE)
+
+		// the denom of alice is no longer accepted
+		params.FundingDenoms = params.FundingDenoms[:len(params.FundingDenoms)-1]
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		payout, err := charge(poolId, 10*i.KYVE)
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(payout).To(Equal(i.KYVECoins(10 * i.KYVE)))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), poolId)
+		_, found := pool.GetFunder(i.ALICE)
+		Expect(found).To(BeFalse())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sdk.MustAccAddressFromBech32(i.ALICE), otherDenom).Amount.Uint64()).To(Equal(100 * i.KYVE))
+
+		Expect(getFunderAmount(poolId, i.BOB)).To(Equal(90 * i.KYVE))
+	})
+
+	It("Switch a pool to pro-rata charging via governance", func() {
+		// ARRANGE
+		poolId := appendProRataPool()
+
+		// ACT
+		err := s.App().PoolKeeper.UpdatePool(s.Ctx(), pooltypes.NewUpdatePoolProposal(i.GOV, "desc", poolId,
+			[]string{pooltypes.PoolUpdateChargingMode}, pooltypes.PoolUpdate{ChargingMode: pooltypes.CHARGING_MODE_EQUAL},
+		).(*pooltypes.UpdatePoolProposal))
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), poolId)
+		Expect(pool.ChargingMode).To(Equal(pooltypes.CHARGING_MODE_EQUAL))
+
+		// ACT
+		err = s.App().PoolKeeper.UpdatePool(s.Ctx(), pooltypes.NewUpdatePoolProposal(i.GOV, "desc", poolId,
+			[]string{pooltypes.PoolUpdateChargingMode}, pooltypes.PoolUpdate{ChargingMode: 5},
+		).(*pooltypes.UpdatePoolProposal))
+
+		// ASSERT
+		Expect(err).NotTo(BeNil())
+
+		chargingMode, err := pooltypes.ChargingModeFromString("pro-rata")
+		Expect(err).To(BeNil())
+		Expect(chargingMode).To(Equal(pooltypes.CHARGING_MODE_PRO_RATA))
+	})
+
+	It("Charge random funders pro-rata (property based)", func() {
+		r := rand.New(rand.NewSource(1))
+
+		for run := 0; run < 100; run++ {
+			// ARRANGE
+			poolId := appendProRataPool()
+
+			funders := i.DUMMY[:1+r.Intn(8)]
+			before := make(map[string]uint64)
+			balances := make(map[string]uint64)
+
+			var totalFunds uint64
+			for _, funder := range funders {
+				// mix dust funders with large ones
+				amount := 1 + uint64(r.Int63n(int64(i.KYVE)))
+				if r.Intn(3) == 0 {
+					amount = 1 + uint64(r.Intn(10))
+				}
+
+				fund(poolId, funder, amount)
+
+				before[funder] = amount
+				balances[funder] = s.GetBalanceFromAddress(funder)
+				totalFunds += amount
+			}
+
+			amount := uint64(r.Int63n(int64(totalFunds + totalFunds/5 + 1)))
+			communityPool := s.App().DistrKeeper.GetFeePoolCommunityCoins(s.Ctx())
+
+			// ACT
+			payout, err := charge(poolId, amount)
+
+			// ASSERT
+			pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), poolId)
+
+			// nothing is sent to the treasury
+			Expect(s.App().DistrKeeper.GetFeePoolCommunityCoins(s.Ctx())).To(Equal(communityPool))
+
+			var charged, remainingValue, refunded uint64
+			chargedFunders := make(map[string]bool)
+
+			for _, funder := range funders {
+				refund := s.GetBalanceFromAddress(funder) - balances[funder]
+				remaining := getFunderAmount(poolId, funder)
+
+				if _, found := pool.GetFunder(funder); found {
+					// remaining funders are never refunded
+					Expect(refund).To(BeZero())
+					Expect(remaining).To(BeNumerically("<=", before[funder]))
+					charged += before[funder] - remaining
+					remainingValue += before[funder]
+					chargedFunders[funder] = true
+				} else {
+					// removed funders get all their funds back, unless they paid their full share
+					Expect(refund == before[funder] || refund == 0).To(BeTrue())
+					charged += before[funder] - refund
+					refunded += refund
+
+					if refund == 0 {
+						remainingValue += before[funder]
+						chargedFunders[funder] = true
+					}
+				}
+			}
+
+			// funds are conserved
+			Expect(charged).To(Equal(payout.AmountOf(i.KYVE_DENOM).Uint64()))
+			Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(totalFunds - charged - refunded))
+
+			if err != nil {
+				Expect(err).To(Equal(pooltypes.ErrFundsTooLow))
+				Expect(amount).To(BeNumerically(">", 0))
+				Expect(pool.Funders).To(BeEmpty())
+				Expect(refunded).To(Equal(totalFunds))
+				continue
+			}
+
+			// the exact amount is charged
+			Expect(charged).To(Equal(amount))
+
+			// every charged funder pays its share rounded down, only the largest one pays the remainder
+			var largest string
+			for _, funder := range funders {
+				if chargedFunders[funder] && (largest == "" || before[funder] > before[largest]) {
+					largest = funder
+				}
+			}
+
+			for _, funder := range funders {
+				if !chargedFunders[funder] {
+					continue
+				}
+
+				share := sdk.NewIntFromUint64(amount).Mul(sdk.NewIntFromUint64(before[funder])).Quo(sdk.NewIntFromUint64(remainingValue)).Uint64()
+				paid := before[funder] - getFunderAmount(poolId, funder)
+
+				// on a tie the pool order decides who pays the remainder
+				if before[funder] == before[largest] {
+					Expect(paid).To(BeNumerically(">=", share))
+					Expect(paid - share).To(BeNumerically("<", len(funders)))
+				} else {
+					Expect(paid).To(Equal(share))
+				}
+			}
+		}
+	})
+})
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"encoding/json"
+	"fmt"
+
 	"github.com/KYVENetwork/chain/x/pool/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,11 +15,31 @@ func (k Keeper) CreatePool(ctx sdk.Context, p *types.CreatePoolProposal) error {
 		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidJson.Error(), p.Config)
 	}
 
-	// Validate binaries json
-	if !json.Valid([]byte(p.Binaries)) {
+	// Validate binaries json, empty binaries are taken from the runtime
+	if p.Binaries != "" && !json.Valid([]byte(p.Binaries)) {
 		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidJson.Error(), p.Binaries)
 	}
 
+	// Validate version and binaries against the runtime
+	binaries, err := k.validateProtocol(ctx, p.Runtime, p.Version, p.Binaries)
+	if err != nil {
+		return err
+	}
+
+	// Validate config against the schema of the runtime
+	if err := k.validateConfig(ctx, p.Runtime, p.Config); err != nil {
+		return err
+	}
+
+	// Validate quorums
+	if _, _, err := types.ParseQuorums(p.ValidQuorum, p.InvalidQuorum); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidQuorum.Error(), err)
+	}
+
+	if err := types.ValidateChargingMode(p.ChargingMode); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, err.Error())
+	}
+
 	k.AppendPool(ctx, types.Pool{
 		Name:           p.Name,
 		Runtime:        p.Runtime,
@@ -28,9 +50,21 @@ func (k Keeper) CreatePool(ctx sdk.Context, p *types.CreatePoolProposal) error {
 		OperatingCost:  p.OperatingCost,
 		MinStake:       p.MinStake,
 		MaxBundleSize:  p.MaxBundleSize,
+		ValidQuorum:    p.ValidQuorum,
+		InvalidQuorum:  p.InvalidQuorum,
+		UploadTimeout:  p.UploadTimeout,
+		MaxPoints:      p.MaxPoints,
+		ChargingMode:   p.ChargingMode,
+
+		Admin:                 p.Admin,
+		FunderAllowList:       p.FunderAllowList,
+		MaxFunders:            p.MaxFunders,
+		DisableFunderEviction: p.DisableFunderEviction,
+		CommitRevealVoting:    p.CommitRevealVoting,
+
 		Protocol: &types.Protocol{
 			Version:     p.Version,
-			Binaries:    p.Binaries,
+			Binaries:    binaries,
 			LastUpgrade: uint64(ctx.BlockTime().Unix()),
 		},
 		UpgradePlan: &types.UpgradePlan{},
@@ -48,7 +82,18 @@ func (k Keeper) CreatePool(ctx sdk.Context, p *types.CreatePoolProposal) error {
 		MinStake:       p.MinStake,
 		MaxBundleSize:  p.MaxBundleSize,
 		Version:        p.Version,
-		Binaries:       p.Binaries,
+		Binaries:       binaries,
+		ValidQuorum:    p.ValidQuorum,
+		InvalidQuorum:  p.InvalidQuorum,
+		UploadTimeout:  p.UploadTimeout,
+		MaxPoints:      p.MaxPoints,
+		ChargingMode:   p.ChargingMode,
+
+		Admin:                 p.Admin,
+		FunderAllowList:       p.FunderAllowList,
+		MaxFunders:            p.MaxFunders,
+		DisableFunderEviction: p.DisableFunderEviction,
+		CommitRevealVoting:    p.CommitRevealVoting,
 	}); errEmit != nil {
 		return errEmit
 	}
@@ -62,108 +107,77 @@ func (k Keeper) UpdatePool(ctx sdk.Context, p *types.UpdatePoolProposal) error {
 		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), p.Id)
 	}
 
-	type Update struct {
-		Name           *string
-		Runtime        *string
-		Logo           *string
-		Config         *string
-		UploadInterval *uint64
-		OperatingCost  *uint64
-		MinStake       *uint64
-		MaxBundleSize  *uint64
+	mask, update, err := p.GetPoolUpdate()
+	if err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrInvalidPoolUpdate.Error(), err)
 	}
 
-	var update Update
-
-	if err := json.Unmarshal([]byte(p.Payload), &update); err != nil {
-		return err
-	}
+	before := types.GetPoolUpdateValues(pool, mask)
+	types.ApplyPoolUpdate(&pool, mask, update)
+	after := types.GetPoolUpdateValues(pool, mask)
 
-	if update.Name != nil {
-		pool.Name = *update.Name
-	}
+	runtimeChanged := before.Runtime != after.Runtime
+	configChanged := before.Config != after.Config
 
-	if update.Runtime != nil {
-		pool.Runtime = *update.Runtime
+	// Quorums are validated together as they must not overlap
+	if _, _, err := types.ParseQuorums(pool.ValidQuorum, pool.InvalidQuorum); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidQuorum.Error(), err)
 	}
 
-	if update.Logo != nil {
-		pool.Logo = *update.Logo
+	// Existing funders are never removed by lowering the maximum
+	if len(pool.Funders) > pool.GetMaxFundersOrDefault() {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrInvalidPoolUpdate.Error(),
+			fmt.Sprintf("pool has %v funders which is more than %v", len(pool.Funders), pool.GetMaxFundersOrDefault()))
 	}
 
-	if update.Config != nil {
-		if json.Valid([]byte(*update.Config)) {
-			pool.Config = *update.Config
-		} else {
-			return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidJson.Error(), *update.Config)
+	// A new runtime has to support the current protocol of the pool
+	if runtimeChanged {
+		if _, err := k.validateProtocol(ctx, pool.Runtime, pool.Protocol.Version, pool.Protocol.Binaries); err != nil {
+			return err
 		}
 	}
 
-	if update.UploadInterval != nil {
-		pool.UploadInterval = *update.UploadInterval
-	}
-
-	if update.OperatingCost != nil {
-		pool.OperatingCost = *update.OperatingCost
+	// The config has to match the schema of the runtime
+	if runtimeChanged || configChanged {
+		if err := k.validateConfig(ctx, pool.Runtime, pool.Config); err != nil {
+			return err
+		}
 	}
 
-	if update.MinStake != nil {
-		pool.MinStake = *update.MinStake
-	}
+	k.SetPool(ctx, pool)
 
-	if update.MaxBundleSize != nil {
-		pool.MaxBundleSize = *update.MaxBundleSize
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventPoolUpdated{
+		PoolId:     pool.Id,
+		UpdateMask: mask,
+		Before:     before,
+		After:      after,
+	}); errEmit != nil {
+		return errEmit
 	}
 
-	k.SetPool(ctx, pool)
-
 	return nil
 }
 
 func (k Keeper) PausePool(ctx sdk.Context, p *types.PausePoolProposal) error {
-	// Attempt to fetch the pool, throw an error if not found.
-	pool, found := k.GetPool(ctx, p.Id)
-	if !found {
-		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), p.Id)
-	}
-
-	// Throw an error if the pool is already paused.
-	if pool.Paused {
-		return sdkErrors.Wrapf(sdkErrors.ErrLogic, "Pool is already paused.")
-	}
-
-	// Pause the pool and return.
-	pool.Paused = true
-	k.SetPool(ctx, pool)
-
-	return nil
+	return k.pausePool(ctx, p.Id, "")
 }
 
 func (k Keeper) UnpausePool(ctx sdk.Context, p *types.UnpausePoolProposal) error {
-	// Attempt to fetch the pool, throw an error if not found.
-	pool, found := k.GetPool(ctx, p.Id)
-	if !found {
-		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), p.Id)
-	}
-
-	// Throw an error if the pool is already unpaused.
-	if !pool.Paused {
-		return sdkErrors.Wrapf(sdkErrors.ErrLogic, "Pool is already unpaused.")
-	}
-
-	// Unpause the pool and return.
-	pool.Paused = false
-	k.SetPool(ctx, pool)
-
-	return nil
+	return k.unpausePool(ctx, p.Id, "")
 }
 
 func (k Keeper) UpgradePool(ctx sdk.Context, p *types.SchedulePoolUpgradeProposal) error {
-	// Check if upgrade version and binaries are not empty
-	if p.Version == "" || p.Binaries == "" {
+	// Check if upgrade version is not empty
+	if p.Version == "" {
 		return types.ErrInvalidArgs
 	}
 
+	// Validate version and binaries against the runtime
+	binaries, err := k.validateUpgradeProtocol(ctx, p.Runtime, p.Version, p.Binaries)
+	if err != nil {
+		return err
+	}
+
 	var scheduledAt uint64
 
 	// If upgrade time was already surpassed we upgrade immediately
@@ -173,28 +187,71 @@ func (k Keeper) UpgradePool(ctx sdk.Context, p *types.SchedulePoolUpgradeProposa
 		scheduledAt = p.ScheduledAt
 	}
 
-	// go through every pool and schedule the upgrade
-	for _, pool := range k.GetAllPools(ctx) {
-		// Skip if runtime does not match
-		if pool.Runtime != p.Runtime {
-			continue
+	var pools []types.Pool
+
+	if len(p.PoolIds) > 0 {
+		// Explicitly targeted pools have to be upgradable
+		for _, poolId := range p.PoolIds {
+			pool, err := k.getUpgradablePool(ctx, poolId, p.Runtime)
+			if err != nil {
+				return err
+			}
+
+			pools = append(pools, pool)
 		}
+	} else {
+		// Otherwise target every pool of the runtime which is not upgrading
+		for _, pool := range k.GetAllPools(ctx) {
+			if pool.Runtime != p.Runtime || k.isPoolUpgrading(ctx, pool) {
+				continue
+			}
 
-		// Skip if pool is currently upgrading
-		if pool.UpgradePlan.ScheduledAt > 0 {
-			continue
+			if p.CanaryBundles > 0 && pool.Id == p.CanaryPoolId {
+				continue
+			}
+
+			pools = append(pools, pool)
 		}
+	}
 
-		// register upgrade plan
-		pool.UpgradePlan = &types.UpgradePlan{
-			Version:     p.Version,
-			Binaries:    p.Binaries,
-			ScheduledAt: scheduledAt,
-			Duration:    p.Duration,
+	// Without a canary the upgrade gets scheduled for all pools at once
+	if p.CanaryBundles == 0 {
+		for _, pool := range pools {
+			k.schedulePoolUpgrade(ctx, pool, p.Version, binaries, scheduledAt, p.Duration)
 		}
 
-		// Update the pool
-		k.SetPool(ctx, pool)
+		return nil
+	}
+
+	canary, err := k.getUpgradablePool(ctx, p.CanaryPoolId, p.Runtime)
+	if err != nil {
+		return err
+	}
+
+	rollout := types.UpgradeRollout{
+		CanaryPoolId:     canary.Id,
+		Version:          p.Version,
+		Binaries:         binaries,
+		Duration:         p.Duration,
+		CanaryBundles:    p.CanaryBundles,
+		Stage:            types.UPGRADE_ROLLOUT_STAGE_SCHEDULED,
+		PreviousProtocol: *canary.Protocol,
+	}
+
+	for _, pool := range pools {
+		rollout.PoolIds = append(rollout.PoolIds, pool.Id)
+	}
+
+	k.SetUpgradeRollout(ctx, rollout)
+	k.schedulePoolUpgrade(ctx, canary, p.Version, binaries, scheduledAt, p.Duration)
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventPoolUpgradeRollout{
+		CanaryPoolId: rollout.CanaryPoolId,
+		Stage:        rollout.Stage,
+		Version:      rollout.Version,
+		PoolIds:      rollout.PoolIds,
+	}); errEmit != nil {
+		return errEmit
 	}
 
 	return nil
@@ -220,5 +277,127 @@ func (k Keeper) CancelPoolUpgrade(ctx sdk.Context, p *types.CancelPoolUpgradePro
 		k.SetPool(ctx, pool)
 	}
 
+	// Stop all rollouts of the runtime. A canary pool which already
+	// runs the new version keeps it.
+	for _, rollout := range k.GetAllUpgradeRollouts(ctx) {
+		canary, found := k.GetPool(ctx, rollout.CanaryPoolId)
+		if found && canary.Runtime != p.Runtime {
+			continue
+		}
+
+		k.RemoveUpgradeRollout(ctx, rollout.CanaryPoolId)
+
+		if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventPoolUpgradeRollout{
+			CanaryPoolId: rollout.CanaryPoolId,
+			Stage:        types.UPGRADE_ROLLOUT_STAGE_CANCELLED,
+			Version:      rollout.Version,
+			PoolIds:      rollout.PoolIds,
+		}); errEmit != nil {
+			return errEmit
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) ResetPool(ctx sdk.Context, p *types.ResetPoolProposal) error {
+	// Attempt to fetch the pool, throw an error if not found.
+	pool, found := k.GetPool(ctx, p.Id)
+	if !found {
+		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), p.Id)
+	}
+
+	// Only finalized bundles of the pool can be targeted
+	if p.BundleId >= pool.TotalBundles {
+		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrFinalizedBundleNotFound.Error(), p.BundleId)
+	}
+
+	// Remove all bundles after the target bundle and reset the current proposal
+	currentHeight, currentKey, currentValue, found := k.bundlesKeeper.ResetToFinalizedBundle(ctx, p.Id, p.BundleId)
+	if !found {
+		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrFinalizedBundleNotFound.Error(), p.BundleId)
+	}
+
+	removedBundles := pool.TotalBundles - (p.BundleId + 1)
+
+	// Restore the pool state from the target bundle
+	pool.CurrentHeight = currentHeight
+	pool.CurrentKey = currentKey
+	pool.CurrentValue = currentValue
+	pool.TotalBundles = p.BundleId + 1
+
+	k.SetPool(ctx, pool)
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventResetPool{
+		PoolId:         pool.Id,
+		BundleId:       p.BundleId,
+		RemovedBundles: removedBundles,
+		CurrentHeight:  pool.CurrentHeight,
+		CurrentKey:     pool.CurrentKey,
+		CurrentValue:   pool.CurrentValue,
+	}); errEmit != nil {
+		return errEmit
+	}
+
+	return nil
+}
+
+func (k Keeper) CreateRuntime(ctx sdk.Context, p *types.CreateRuntimeProposal) error {
+	// Throw an error if the runtime is already registered.
+	if k.DoesRuntimeExist(ctx, p.Name) {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrRuntimeAlreadyExists.Error(), p.Name)
+	}
+
+	runtime := types.Runtime{
+		Name:         p.Name,
+		ConfigSchema: p.ConfigSchema,
+		Versions:     p.Versions,
+	}
+
+	if err := runtime.Validate(); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidRuntime.Error(), err)
+	}
+
+	k.SetRuntime(ctx, runtime)
+
+	return nil
+}
+
+func (k Keeper) UpdateRuntime(ctx sdk.Context, p *types.UpdateRuntimeProposal) error {
+	// Attempt to fetch the runtime, throw an error if not found.
+	runtime, found := k.GetRuntime(ctx, p.Name)
+	if !found {
+		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrRuntimeNotFound.Error(), p.Name)
+	}
+
+	// Replace the config schema and all versions
+	runtime.ConfigSchema = p.ConfigSchema
+	runtime.Versions = p.Versions
+
+	if err := runtime.Validate(); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidRuntime.Error(), err)
+	}
+
+	k.SetRuntime(ctx, runtime)
+
+	return nil
+}
+
+func (k Keeper) DeprecateRuntime(ctx sdk.Context, p *types.DeprecateRuntimeProposal) error {
+	// Attempt to fetch the runtime, throw an error if not found.
+	runtime, found := k.GetRuntime(ctx, p.Name)
+	if !found {
+		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrRuntimeNotFound.Error(), p.Name)
+	}
+
+	// Throw an error if the runtime is already deprecated.
+	if runtime.Deprecated {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrRuntimeDeprecated.Error(), p.Name)
+	}
+
+	// Deprecate the runtime, pools which are already running it are not affected.
+	runtime.Deprecated = true
+	k.SetRuntime(ctx, runtime)
+
 	return nil
 }
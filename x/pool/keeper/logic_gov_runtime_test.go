@@ -0,0 +1,408 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_gov_test.go (runtimes)
+
+* Create Runtime
+* Create Runtime which already exists
+* Create Runtime with invalid checksum
+* Create Runtime with invalid url
+* Update Runtime
+* Deprecate Runtime
+* Create Pool with unknown runtime
+* Create Pool with unknown version
+* Create Pool with binaries which do not match the runtime
+* Create Pool without binaries takes the binaries of the runtime
+* Create Pool with deprecated runtime
+* Create Pool with config which does not match the schema
+* Update Pool config which does not match the schema
+* Update Pool runtime which does not support the protocol version
+* Create Upgrade Pool proposal with unknown version
+* Query pool returns the binaries with their checksums
+
+*/
+
+var _ = Describe("Gov Runtime", Ordered, func() {
+	s := i.NewCleanChain()
+
+	configSchema := `{"type": "object", "properties": {"rpc": {"type": "string"}}, "required": ["rpc"], "additionalProperties": false}`
+
+	createPoolProposal := func() *pooltypes.CreatePoolProposal {
+		return &pooltypes.CreatePoolProposal{
+			Title:          i.GOV,
+			Description:    "desc",
+			Name:           "Cosmos Hub",
+			Runtime:        "@kyve/tendermint",
+			Logo:           "https://arweave.net/9FJDam56yBbmvn8rlamEucATH5UcYqSBw468rlCXn8E",
+			Config:         "{\"rpc\": \"https://rpc.cosmos.network\"}",
+			StartKey:       "1",
+			UploadInterval: 60,
+			OperatingCost:  2_500_000_000,
+			MinStake:       100_000_000_000,
+			MaxBundleSize:  100,
+			Version:        "1.0.0",
+			Binaries:       "{\"linux-x64\": \"https://example.com/tendermint-linux-x64\"}",
+		}
+	}
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		err := s.App().PoolKeeper.CreateRuntime(s.Ctx(), &pooltypes.CreateRuntimeProposal{
+			Title:        i.GOV,
+			Description:  "desc",
+			Name:         "@kyve/tendermint",
+			ConfigSchema: configSchema,
+			Versions: []pooltypes.RuntimeVersion{
+				{
+					Version: "1.0.0",
+					Binaries: []pooltypes.Binary{
+						{Platform: "linux-x64", Url: "https://example.com/tendermint-linux-x64", Sha256: checksum},
+					},
+				},
+				{
+					Version: "1.1.0",
+					Binaries: []pooltypes.Binary{
+						{Platform: "linux-x64", Url: "https://example.com/tendermint-linux-x64-1.1.0", Sha256: checksum},
+						{Platform: "macos-x64", Url: "https://example.com/tendermint-macos-x64-1.1.0", Sha256: checksum},
+					},
+				},
+			},
+		})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Create Runtime", func() {
+		// Assert
+		runtime, found := s.App().PoolKeeper.GetRuntime(s.Ctx(), "@kyve/tendermint")
+		Expect(found).To(BeTrue())
+		Expect(runtime.ConfigSchema).To(Equal(configSchema))
+		Expect(runtime.Versions).To(HaveLen(2))
+		Expect(runtime.Deprecated).To(BeFalse())
+
+		res, err := s.App().PoolKeeper.Runtime(sdk.WrapSDKContext(s.Ctx()), &pooltypes.QueryRuntimeRequest{Name: "@kyve/tendermint"})
+		Expect(err).To(BeNil())
+		Expect(res.Runtime).To(Equal(runtime))
+	})
+
+	It("Create Runtime which already exists", func() {
+		// Act
+		err := s.App().PoolKeeper.CreateRuntime(s.Ctx(), &pooltypes.CreateRuntimeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Name:        "@kyve/tendermint",
+			Versions: []pooltypes.RuntimeVersion{
+				{Version: "2.0.0", Binaries: []pooltypes.Binary{{Platform: "linux-x64", Url: "https://example.com", Sha256: checksum}}},
+			},
+		})
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+
+		runtime, _ := s.App().PoolKeeper.GetRuntime(s.Ctx(), "@kyve/tendermint")
+		Expect(runtime.Versions).To(HaveLen(2))
+	})
+
+	It("Create Runtime with invalid checksum", func() {
+		// Arrange
+		proposal := &pooltypes.CreateRuntimeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Name:        "@kyve/evm",
+			Versions: []pooltypes.RuntimeVersion{
+				{Version: "1.0.0", Binaries: []pooltypes.Binary{{Platform: "linux-x64", Url: "https://example.com", Sha256: "1234"}}},
+			},
+		}
+
+		// Act
+		err := s.App().PoolKeeper.CreateRuntime(s.Ctx(), proposal)
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+		Expect(proposal.ValidateBasic()).NotTo(BeNil())
+		Expect(s.App().PoolKeeper.DoesRuntimeExist(s.Ctx(), "@kyve/evm")).To(BeFalse())
+	})
+
+	It("Create Runtime with invalid url", func() {
+		for _, url := range []string{"string", "example.com/tendermint", "/tendermint", "ftp://example.com/tendermint", "https://"} {
+			// Arrange
+			proposal := &pooltypes.CreateRuntimeProposal{
+				Title:       i.GOV,
+				Description: "desc",
+				Name:        "@kyve/evm",
+				Versions: []pooltypes.RuntimeVersion{
+					{Version: "1.0.0", Binaries: []pooltypes.Binary{{Platform: "linux-x64", Url: url, Sha256: checksum}}},
+				},
+			}
+
+			// Act
+			err := s.App().PoolKeeper.CreateRuntime(s.Ctx(), proposal)
+
+			// Assert
+			Expect(err).NotTo(BeNil())
+			Expect(proposal.ValidateBasic()).NotTo(BeNil())
+			Expect(s.App().PoolKeeper.DoesRuntimeExist(s.Ctx(), "@kyve/evm")).To(BeFalse())
+		}
+	})
+
+	It("Update Runtime", func() {
+		// Act
+		err := s.App().PoolKeeper.UpdateRuntime(s.Ctx(), &pooltypes.UpdateRuntimeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Name:        "@kyve/tendermint",
+			Versions: []pooltypes.RuntimeVersion{
+				{Version: "2.0.0", Binaries: []pooltypes.Binary{{Platform: "linux-x64", Url: "https://example.com", Sha256: checksum}}},
+			},
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		runtime, _ := s.App().PoolKeeper.GetRuntime(s.Ctx(), "@kyve/tendermint")
+		Expect(runtime.ConfigSchema).To(BeEmpty())
+		Expect(runtime.Versions).To(HaveLen(1))
+		Expect(runtime.Versions[0].Version).To(Equal("2.0.0"))
+	})
+
+	It("Deprecate Runtime", func() {
+		// Act
+		err := s.App().PoolKeeper.DeprecateRuntime(s.Ctx(), &pooltypes.DeprecateRuntimeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Name:        "@kyve/tendermint",
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		runtime, _ := s.App().PoolKeeper.GetRuntime(s.Ctx(), "@kyve/tendermint")
+		Expect(runtime.Deprecated).To(BeTrue())
+
+		// Act
+		err = s.App().PoolKeeper.DeprecateRuntime(s.Ctx(), &pooltypes.DeprecateRuntimeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Name:        "@kyve/tendermint",
+		})
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("Create Pool with unknown runtime", func() {
+		// Arrange
+		proposal := createPoolProposal()
+		proposal.Runtime = "@kyve/evm"
+
+		// Act
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), proposal)
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+		Expect(s.App().PoolKeeper.GetPoolCount(s.Ctx())).To(BeZero())
+	})
+
+	It("Create Pool with unknown version", func() {
+		// Arrange
+		proposal := createPoolProposal()
+		proposal.Version = "0.9.0"
+
+		// Act
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), proposal)
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+		Expect(s.App().PoolKeeper.GetPoolCount(s.Ctx())).To(BeZero())
+	})
+
+	It("Create Pool with binaries which do not match the runtime", func() {
+		// Arrange
+		proposal := createPoolProposal()
+		proposal.Binaries = "{\"linux-x64\": \"https://example.com/malicious\"}"
+
+		// Act
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), proposal)
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+		Expect(s.App().PoolKeeper.GetPoolCount(s.Ctx())).To(BeZero())
+	})
+
+	It("Create Pool without binaries takes the binaries of the runtime", func() {
+		// Arrange
+		proposal := createPoolProposal()
+		proposal.Version = "1.1.0"
+		proposal.Binaries = ""
+
+		// Act
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), proposal)
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Protocol.Version).To(Equal("1.1.0"))
+		Expect(pool.Protocol.Binaries).To(Equal("{\"linux-x64\":\"https://example.com/tendermint-linux-x64-1.1.0\",\"macos-x64\":\"https://example.com/tendermint-macos-x64-1.1.0\"}"))
+	})
+
+	It("Create Pool with deprecated runtime", func() {
+		// Arrange
+		err := s.App().PoolKeeper.DeprecateRuntime(s.Ctx(), &pooltypes.DeprecateRuntimeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Name:        "@kyve/tendermint",
+		})
+		Expect(err).To(BeNil())
+
+		// Act
+		err = s.App().PoolKeeper.CreatePool(s.Ctx(), createPoolProposal())
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+		Expect(s.App().PoolKeeper.GetPoolCount(s.Ctx())).To(BeZero())
+	})
+
+	It("Create Pool with config which does not match the schema", func() {
+		// Arrange
+		proposal := createPoolProposal()
+		proposal.Config = "{\"rpc\": \"https://rpc.cosmos.network\", \"unknown\": true}"
+
+		// Act
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), proposal)
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+		Expect(s.App().PoolKeeper.GetPoolCount(s.Ctx())).To(BeZero())
+	})
+
+	It("Update Pool config which does not match the schema", func() {
+		// Arrange
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), createPoolProposal())
+		Expect(err).To(BeNil())
+
+		// Act
+		err = s.App().PoolKeeper.UpdatePool(s.Ctx(), &pooltypes.UpdatePoolProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Id:          0,
+			Payload:     "{\"Config\": \"{\\\"rpc\\\": 1}\"}",
+		})
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Config).To(Equal("{\"rpc\": \"https://rpc.cosmos.network\"}"))
+
+		// Act
+		err = s.App().PoolKeeper.UpdatePool(s.Ctx(), &pooltypes.UpdatePoolProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Id:          0,
+			Payload:     "{\"Config\": \"{\\\"rpc\\\": \\\"https://rpc.cosmos.directory\\\"}\"}",
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Config).To(Equal("{\"rpc\": \"https://rpc.cosmos.directory\"}"))
+	})
+
+	It("Update Pool runtime which does not support the protocol version", func() {
+		// Arrange
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), createPoolProposal())
+		Expect(err).To(BeNil())
+
+		err = s.App().PoolKeeper.CreateRuntime(s.Ctx(), &pooltypes.CreateRuntimeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Name:        "@kyve/evm",
+			Versions: []pooltypes.RuntimeVersion{
+				{Version: "2.0.0", Binaries: []pooltypes.Binary{{Platform: "linux-x64", Url: "https://example.com", Sha256: checksum}}},
+			},
+		})
+		Expect(err).To(BeNil())
+
+		// Act
+		err = s.App().PoolKeeper.UpdatePool(s.Ctx(), &pooltypes.UpdatePoolProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Id:          0,
+			Payload:     "{\"Runtime\": \"@kyve/evm\"}",
+		})
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Runtime).To(Equal("@kyve/tendermint"))
+	})
+
+	It("Create Upgrade Pool proposal with unknown version", func() {
+		// Arrange
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), createPoolProposal())
+		Expect(err).To(BeNil())
+
+		// Act
+		err = s.App().PoolKeeper.UpgradePool(s.Ctx(), &pooltypes.SchedulePoolUpgradeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Runtime:     "@kyve/tendermint",
+			Version:     "2.0.0",
+			ScheduledAt: uint64(s.Ctx().BlockTime().Unix() + 1000),
+			Duration:    60,
+		})
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.UpgradePlan.ScheduledAt).To(BeZero())
+	})
+
+	It("Query pool returns the binaries with their checksums", func() {
+		// Arrange
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), createPoolProposal())
+		Expect(err).To(BeNil())
+
+		err = s.App().PoolKeeper.UpgradePool(s.Ctx(), &pooltypes.SchedulePoolUpgradeProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Runtime:     "@kyve/tendermint",
+			Version:     "1.1.0",
+			ScheduledAt: uint64(s.Ctx().BlockTime().Unix() + 1000),
+			Duration:    60,
+		})
+		Expect(err).To(BeNil())
+
+		// Act
+		res, err := s.App().QueryKeeper.Pool(sdk.WrapSDKContext(s.Ctx()), &querytypes.QueryPoolRequest{Id: 0})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		Expect(res.Pool.ProtocolBinaries).To(Equal([]pooltypes.Binary{
+			{Platform: "linux-x64", Url: "https://example.com/tendermint-linux-x64", Sha256: checksum},
+		}))
+		Expect(res.Pool.UpgradeBinaries).To(Equal([]pooltypes.Binary{
+			{Platform: "linux-x64", Url: "https://example.com/tendermint-linux-x64-1.1.0", Sha256: checksum},
+			{Platform: "macos-x64", Url: "https://example.com/tendermint-macos-x64-1.1.0", Sha256: checksum},
+		}))
+	})
+})
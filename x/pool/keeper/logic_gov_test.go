@@ -3,8 +3,10 @@ package keeper_test
 import (
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 /*
@@ -12,7 +14,17 @@ import (
 TEST CASES - logic_gov_test.go
 
 * Create Pool
+* Create Pool with commit-reveal voting
 * Update Pool
+* Create Pool with overlapping quorums
+* Update Pool quorums
+* Create Pool with upload timeout and max points overrides
+* Update Pool upload timeout and max points overrides
+* Update Pool with typed fields
+* Update Pool with invalid typed fields
+* Update Pool with payload containing unknown keys
+* Create Pool with funder settings
+* Update Pool funder settings
 * Pause Pool
 * Pause Pool when already paused
 * Unpause Pool
@@ -21,12 +33,41 @@ TEST CASES - logic_gov_test.go
 
 */
 
+// checksum is the SHA-256 checksum which is used for all test binaries
+const checksum = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// createRuntimes registers the runtimes which are used by the gov tests
+func createRuntimes(s *i.KeeperTestSuite) {
+	err := s.App().PoolKeeper.CreateRuntime(s.Ctx(), &pooltypes.CreateRuntimeProposal{
+		Title:       i.GOV,
+		Description: "desc",
+		Name:        "@kyve/evm",
+		Versions: []pooltypes.RuntimeVersion{
+			{Version: "1", Binaries: []pooltypes.Binary{{Platform: "b1", Url: "https://example.com/b1", Sha256: checksum}}},
+			{Version: "new version", Binaries: []pooltypes.Binary{{Platform: "test", Url: "https://example.com/test", Sha256: checksum}}},
+		},
+	})
+	Expect(err).To(BeNil())
+
+	err = s.App().PoolKeeper.CreateRuntime(s.Ctx(), &pooltypes.CreateRuntimeProposal{
+		Title:       i.GOV,
+		Description: "desc",
+		Name:        "@kyve/bitcoin",
+		Versions: []pooltypes.RuntimeVersion{
+			{Version: "1", Binaries: []pooltypes.Binary{{Platform: "b1", Url: "https://example.com/b1", Sha256: checksum}}},
+		},
+	})
+	Expect(err).To(BeNil())
+}
+
 var _ = Describe("Gov Pool", Ordered, func() {
 	s := i.NewCleanChain()
 
 	BeforeEach(func() {
 		s = i.NewCleanChain()
 
+		createRuntimes(&s)
+
 		err := s.App().PoolKeeper.CreatePool(s.Ctx(), &pooltypes.CreatePoolProposal{
 			Title:          i.GOV,
 			Description:    "desc",
@@ -40,7 +81,7 @@ var _ = Describe("Gov Pool", Ordered, func() {
 			MinStake:       100_000_000_000,
 			MaxBundleSize:  100,
 			Version:        "1",
-			Binaries:       "{\"b1\": \"string\"}",
+			Binaries:       "{\"b1\": \"https://example.com/b1\"}",
 		})
 		Expect(err).To(BeNil())
 
@@ -54,6 +95,7 @@ var _ = Describe("Gov Pool", Ordered, func() {
 
 		// Overwrite BeforeEach
 		s = i.NewCleanChain()
+		createRuntimes(&s)
 
 		// Arrange
 		_, found := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
@@ -73,7 +115,7 @@ var _ = Describe("Gov Pool", Ordered, func() {
 			MinStake:       100_000_000_000,
 			MaxBundleSize:  100,
 			Version:        "1",
-			Binaries:       "{\"b1\": \"string\"}",
+			Binaries:       "{\"b1\": \"https://example.com/b1\"}",
 		})
 		Expect(err).To(BeNil())
 
@@ -90,8 +132,26 @@ var _ = Describe("Gov Pool", Ordered, func() {
 		Expect(pool.MinStake).To(Equal(uint64(100_000_000_000)))
 		Expect(pool.MaxBundleSize).To(Equal(uint64(100)))
 		Expect(pool.Protocol.Version).To(Equal("1"))
-		Expect(pool.Protocol.Binaries).To(Equal("{\"b1\": \"string\"}"))
+		Expect(pool.Protocol.Binaries).To(Equal("{\"b1\": \"https://example.com/b1\"}"))
+		Expect(pool.CommitRevealVoting).To(BeFalse())
+
+	})
+
+	It("Create Pool with commit-reveal voting", func() {
+		// Arrange
+		proposal := pooltypes.NewCreatePoolProposal(i.GOV, "desc", "Bitcoin", "@kyve/bitcoin", "logo", "{}", "0", 600, 2_500_000_000, 100_000_000_000, 100, "1", "", "", "", 0, 0, pooltypes.CHARGING_MODE_EQUAL,
+			"", []string{}, 0, false, true).(*pooltypes.CreatePoolProposal)
+		Expect(proposal.ValidateBasic()).To(BeNil())
+
+		// Act
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), proposal)
+
+		// Assert
+		Expect(err).To(BeNil())
 
+		pool, found := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(found).To(BeTrue())
+		Expect(pool.CommitRevealVoting).To(BeTrue())
 	})
 
 	It("Update Pool", func() {
@@ -119,7 +179,355 @@ var _ = Describe("Gov Pool", Ordered, func() {
 		Expect(pool.MinStake).To(Equal(uint64(100_000_000_000)))
 		Expect(pool.MaxBundleSize).To(Equal(uint64(100)))
 		Expect(pool.Protocol.Version).To(Equal("1"))
-		Expect(pool.Protocol.Binaries).To(Equal("{\"b1\": \"string\"}"))
+		Expect(pool.Protocol.Binaries).To(Equal("{\"b1\": \"https://example.com/b1\"}"))
+	})
+
+	It("Create Pool with overlapping quorums", func() {
+		// Act
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), &pooltypes.CreatePoolProposal{
+			Title:          i.GOV,
+			Description:    "desc",
+			Name:           "Moonbeam",
+			Runtime:        "@kyve/evm",
+			Logo:           "https://arweave.net/9FJDam56yBbmvn8rlamEucATH5UcYqSBw468rlCXn8E",
+			Config:         "{\"config\": \"test\"}",
+			StartKey:       "0",
+			UploadInterval: 60,
+			OperatingCost:  2_500_000_000,
+			MinStake:       100_000_000_000,
+			MaxBundleSize:  100,
+			Version:        "1",
+			Binaries:       "{\"b1\": \"https://example.com/b1\"}",
+			ValidQuorum:    "0.3",
+			InvalidQuorum:  "0.3",
+		})
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+
+		_, found := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(found).To(BeFalse())
+
+		Expect((&pooltypes.CreatePoolProposal{Title: i.GOV, Description: "desc", ValidQuorum: "0.3", InvalidQuorum: "0.3"}).ValidateBasic()).NotTo(BeNil())
+		Expect((&pooltypes.CreatePoolProposal{Title: i.GOV, Description: "desc", ValidQuorum: "1", InvalidQuorum: "0.5"}).ValidateBasic()).NotTo(BeNil())
+		Expect((&pooltypes.CreatePoolProposal{Title: i.GOV, Description: "desc", ValidQuorum: "0.3", InvalidQuorum: "0.7"}).ValidateBasic()).To(BeNil())
+		Expect((&pooltypes.CreatePoolProposal{Title: i.GOV, Description: "desc"}).ValidateBasic()).To(BeNil())
+	})
+
+	It("Update Pool quorums", func() {
+		// Act
+		err := s.App().PoolKeeper.UpdatePool(s.Ctx(), &pooltypes.UpdatePoolProposal{
+			Title:       "gov",
+			Description: "desc",
+			Id:          0,
+			Payload:     "{\"ValidQuorum\": \"0.66\", \"InvalidQuorum\": \"0.34\"}",
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.ValidQuorum).To(Equal("0.66"))
+		Expect(pool.InvalidQuorum).To(Equal("0.34"))
+
+		// Act
+		err = s.App().PoolKeeper.UpdatePool(s.Ctx(), &pooltypes.UpdatePoolProposal{
+			Title:       "gov",
+			Description: "desc",
+			Id:          0,
+			Payload:     "{\"InvalidQuorum\": \"0.2\"}",
+		})
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.InvalidQuorum).To(Equal("0.34"))
+	})
+
+	It("Create Pool with upload timeout and max points overrides", func() {
+		// Act
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), &pooltypes.CreatePoolProposal{
+			Title:          i.GOV,
+			Description:    "desc",
+			Name:           "Bitcoin",
+			Runtime:        "@kyve/bitcoin",
+			Logo:           "https://arweave.net/9FJDam56yBbmvn8rlamEucATH5UcYqSBw468rlCXn8E",
+			Config:         "{\"config\": \"test\"}",
+			StartKey:       "0",
+			UploadInterval: 600,
+			OperatingCost:  2_500_000_000,
+			MinStake:       100_000_000_000,
+			MaxBundleSize:  100,
+			Version:        "1",
+			Binaries:       "{\"b1\": \"https://example.com/b1\"}",
+			UploadTimeout:  1200,
+			MaxPoints:      2,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		pool, found := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(found).To(BeTrue())
+		Expect(pool.UploadTimeout).To(Equal(uint64(1200)))
+		Expect(pool.MaxPoints).To(Equal(uint64(2)))
+
+		Expect(s.App().BundlesKeeper.PoolUploadTimeout(s.Ctx(), pool)).To(Equal(uint64(1200)))
+		Expect(s.App().BundlesKeeper.PoolMaxPoints(s.Ctx(), pool)).To(Equal(uint64(2)))
+
+		// pools without overrides use the module params
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(s.App().BundlesKeeper.PoolUploadTimeout(s.Ctx(), pool)).To(Equal(s.App().BundlesKeeper.UploadTimeout(s.Ctx())))
+		Expect(s.App().BundlesKeeper.PoolMaxPoints(s.Ctx(), pool)).To(Equal(s.App().BundlesKeeper.MaxPoints(s.Ctx())))
+	})
+
+	It("Update Pool upload timeout and max points overrides", func() {
+		// Act
+		err := s.App().PoolKeeper.UpdatePool(s.Ctx(), &pooltypes.UpdatePoolProposal{
+			Title:       "gov",
+			Description: "desc",
+			Id:          0,
+			Payload:     "{\"UploadTimeout\": 300, \"MaxPoints\": 10}",
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.UploadTimeout).To(Equal(uint64(300)))
+		Expect(pool.MaxPoints).To(Equal(uint64(10)))
+
+		// Act
+		err = s.App().PoolKeeper.UpdatePool(s.Ctx(), &pooltypes.UpdatePoolProposal{
+			Title:       "gov",
+			Description: "desc",
+			Id:          0,
+			Payload:     "{\"UploadTimeout\": 0}",
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.UploadTimeout).To(BeZero())
+		Expect(pool.MaxPoints).To(Equal(uint64(10)))
+		Expect(s.App().BundlesKeeper.PoolUploadTimeout(s.Ctx(), pool)).To(Equal(s.App().BundlesKeeper.UploadTimeout(s.Ctx())))
+	})
+
+	It("Update Pool with typed fields", func() {
+		// Arrange
+		proposal := pooltypes.NewUpdatePoolProposal(i.GOV, "desc", 0, []string{
+			pooltypes.PoolUpdateUploadInterval,
+			pooltypes.PoolUpdateMaxBundleSize,
+			pooltypes.PoolUpdateCommitRevealVoting,
+		}, pooltypes.PoolUpdate{
+			Name:               "ignored as it is not in the mask",
+			UploadInterval:     120,
+			MaxBundleSize:      50,
+			CommitRevealVoting: true,
+		}).(*pooltypes.UpdatePoolProposal)
+		Expect(proposal.ValidateBasic()).To(BeNil())
+
+		// Act
+		err := s.App().PoolKeeper.UpdatePool(s.Ctx(), proposal)
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Name).To(Equal("Moonbeam"))
+		Expect(pool.UploadInterval).To(Equal(uint64(120)))
+		Expect(pool.MaxBundleSize).To(Equal(uint64(50)))
+		Expect(pool.CommitRevealVoting).To(BeTrue())
+		Expect(pool.MinStake).To(Equal(uint64(100_000_000_000)))
+
+		var updatedEvent *sdk.Event
+		for _, event := range s.Ctx().EventManager().Events() {
+			if event.Type == "kyve.pool.v1beta1.EventPoolUpdated" {
+				e := event
+				updatedEvent = &e
+			}
+		}
+		Expect(updatedEvent).NotTo(BeNil())
+
+		parsedEvent, err := sdk.ParseTypedEvent(abci.Event(*updatedEvent))
+		Expect(err).To(BeNil())
+		Expect(parsedEvent).To(Equal(&pooltypes.EventPoolUpdated{
+			PoolId:     0,
+			UpdateMask: []string{"upload_interval", "max_bundle_size", "commit_reveal_voting"},
+			Before:     pooltypes.PoolUpdate{UploadInterval: 60, MaxBundleSize: 100, FunderAllowList: []string{}},
+			After:      pooltypes.PoolUpdate{UploadInterval: 120, MaxBundleSize: 50, CommitRevealVoting: true, FunderAllowList: []string{}},
+		}))
+	})
+
+	It("Update Pool with invalid typed fields", func() {
+		// Arrange
+		invalidUpdates := []struct {
+			mask   []string
+			update pooltypes.PoolUpdate
+		}{
+			{nil, pooltypes.PoolUpdate{}},
+			{[]string{"unknown"}, pooltypes.PoolUpdate{}},
+			{[]string{"UploadInterval"}, pooltypes.PoolUpdate{UploadInterval: 120}},
+			{[]string{"upload_interval"}, pooltypes.PoolUpdate{}},
+			{[]string{"max_bundle_size"}, pooltypes.PoolUpdate{}},
+			{[]string{"name"}, pooltypes.PoolUpdate{}},
+			{[]string{"runtime"}, pooltypes.PoolUpdate{}},
+			{[]string{"config"}, pooltypes.PoolUpdate{Config: "{"}},
+			{[]string{"valid_quorum"}, pooltypes.PoolUpdate{ValidQuorum: "1"}},
+			{[]string{"invalid_quorum"}, pooltypes.PoolUpdate{InvalidQuorum: "0"}},
+			{[]string{"logo", "logo"}, pooltypes.PoolUpdate{Logo: "logo"}},
+			{[]string{"admin"}, pooltypes.PoolUpdate{Admin: "invalid"}},
+			{[]string{"funder_allow_list"}, pooltypes.PoolUpdate{FunderAllowList: []string{i.ALICE, i.ALICE}}},
+			{[]string{"max_funders"}, pooltypes.PoolUpdate{MaxFunders: pooltypes.MaxFundersLimit + 1}},
+		}
+
+		for _, invalid := range invalidUpdates {
+			proposal := pooltypes.NewUpdatePoolProposal(i.GOV, "desc", 0, invalid.mask, invalid.update).(*pooltypes.UpdatePoolProposal)
+
+			// Act
+			err := s.App().PoolKeeper.UpdatePool(s.Ctx(), proposal)
+
+			// Assert
+			Expect(err).NotTo(BeNil(), "%v", invalid.mask)
+			Expect(proposal.ValidateBasic()).NotTo(BeNil(), "%v", invalid.mask)
+		}
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.UploadInterval).To(Equal(uint64(60)))
+		Expect(pool.MaxBundleSize).To(Equal(uint64(100)))
+
+		// a payload can not be combined with typed fields
+		proposal := &pooltypes.UpdatePoolProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Id:          0,
+			Payload:     "{\"Logo\": \"logo\"}",
+			UpdateMask:  []string{"logo"},
+			Update:      pooltypes.PoolUpdate{Logo: "logo"},
+		}
+		Expect(proposal.ValidateBasic()).NotTo(BeNil())
+		Expect(s.App().PoolKeeper.UpdatePool(s.Ctx(), proposal)).NotTo(BeNil())
+	})
+
+	It("Update Pool with payload containing unknown keys", func() {
+		// Act
+		err := s.App().PoolKeeper.UpdatePool(s.Ctx(), &pooltypes.UpdatePoolProposal{
+			Title:       "gov",
+			Description: "desc",
+			Id:          0,
+			Payload:     "{\"Name\": \"Bitcoin\", \"UploadIntervall\": 120}",
+		})
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Name).To(Equal("Moonbeam"))
+		Expect(pool.UploadInterval).To(Equal(uint64(60)))
+
+		Expect(pooltypes.NewLegacyUpdatePoolProposal(i.GOV, "desc", 0, "{\"UploadInterval\": 0}").ValidateBasic()).NotTo(BeNil())
+		Expect(pooltypes.NewLegacyUpdatePoolProposal(i.GOV, "desc", 0, "{}").ValidateBasic()).NotTo(BeNil())
+		Expect(pooltypes.NewLegacyUpdatePoolProposal(i.GOV, "desc", 0, "{\"UploadInterval\": 120}").ValidateBasic()).To(BeNil())
+	})
+
+	It("Create Pool with funder settings", func() {
+		// Arrange
+		proposal := pooltypes.NewCreatePoolProposal(i.GOV, "desc", "Bitcoin", "@kyve/bitcoin", "logo", "{}", "0", 600, 2_500_000_000, 100_000_000_000, 100, "1", "", "", "", 0, 0, pooltypes.CHARGING_MODE_EQUAL,
+			i.ALICE, []string{i.BOB, i.CHARLIE}, 10, true, false).(*pooltypes.CreatePoolProposal)
+		Expect(proposal.ValidateBasic()).To(BeNil())
+
+		// Act
+		err := s.App().PoolKeeper.CreatePool(s.Ctx(), proposal)
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		pool, found := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(found).To(BeTrue())
+		Expect(pool.Admin).To(Equal(i.ALICE))
+		Expect(pool.FunderAllowList).To(Equal([]string{i.BOB, i.CHARLIE}))
+		Expect(pool.MaxFunders).To(Equal(uint64(10)))
+		Expect(pool.DisableFunderEviction).To(BeTrue())
+
+		// pools without a maximum use the default
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.GetMaxFundersOrDefault()).To(Equal(pooltypes.DefaultMaxFunders))
+
+		// invalid funder settings are rejected
+		proposal.Admin = "invalid"
+		Expect(proposal.ValidateBasic()).NotTo(BeNil())
+
+		proposal.Admin = i.ALICE
+		proposal.MaxFunders = pooltypes.MaxFundersLimit + 1
+		Expect(proposal.ValidateBasic()).NotTo(BeNil())
+	})
+
+	It("Update Pool funder settings", func() {
+		// Arrange
+		for _, funder := range []string{i.ALICE, i.BOB, i.CHARLIE} {
+			s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+				Creator: funder,
+				Id:      0,
+				Amount:  i.KYVECoins(100 * i.KYVE),
+			})
+		}
+
+		// Act
+		err := s.App().PoolKeeper.UpdatePool(s.Ctx(), pooltypes.NewUpdatePoolProposal(i.GOV, "desc", 0, []string{
+			pooltypes.PoolUpdateAdmin,
+			pooltypes.PoolUpdateFunderAllowList,
+			pooltypes.PoolUpdateMaxFunders,
+			pooltypes.PoolUpdateDisableFunderEviction,
+		}, pooltypes.PoolUpdate{
+			Admin:                 i.ALICE,
+			FunderAllowList:       []string{i.ALICE, i.DUMMY[0]},
+			MaxFunders:            3,
+			DisableFunderEviction: true,
+		}).(*pooltypes.UpdatePoolProposal))
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Admin).To(Equal(i.ALICE))
+		Expect(pool.FunderAllowList).To(Equal([]string{i.ALICE, i.DUMMY[0]}))
+		Expect(pool.MaxFunders).To(Equal(uint64(3)))
+		Expect(pool.DisableFunderEviction).To(BeTrue())
+
+		// the pool is full and the allowed funder can not evict the lowest funder
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator: i.DUMMY[0],
+			Id:      0,
+			Amount:  i.KYVECoins(500 * i.KYVE),
+		})
+
+		// Act
+		// the maximum can not be lowered below the current number of funders
+		err = s.App().PoolKeeper.UpdatePool(s.Ctx(), pooltypes.NewUpdatePoolProposal(i.GOV, "desc", 0, []string{
+			pooltypes.PoolUpdateMaxFunders,
+		}, pooltypes.PoolUpdate{
+			MaxFunders: 2,
+		}).(*pooltypes.UpdatePoolProposal))
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.MaxFunders).To(Equal(uint64(3)))
+
+		// Act
+		// governance can remove the admin
+		err = s.App().PoolKeeper.UpdatePool(s.Ctx(), pooltypes.NewUpdatePoolProposal(i.GOV, "desc", 0, []string{
+			pooltypes.PoolUpdateAdmin,
+		}, pooltypes.PoolUpdate{}).(*pooltypes.UpdatePoolProposal))
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Admin).To(BeEmpty())
 	})
 
 	It("Pause Pool", func() {
@@ -143,6 +551,19 @@ var _ = Describe("Gov Pool", Ordered, func() {
 
 		poolAfter.Paused = false
 		Expect(pool).To(Equal(poolAfter))
+
+		var pausedEvent *sdk.Event
+		for _, event := range s.Ctx().EventManager().Events() {
+			if event.Type == "kyve.pool.v1beta1.EventPoolPaused" {
+				e := event
+				pausedEvent = &e
+			}
+		}
+		Expect(pausedEvent).NotTo(BeNil())
+
+		parsedEvent, err := sdk.ParseTypedEvent(abci.Event(*pausedEvent))
+		Expect(err).To(BeNil())
+		Expect(parsedEvent).To(Equal(&pooltypes.EventPoolPaused{PoolId: 0}))
 	})
 
 	It("Pause Pool when already paused", func() {
@@ -226,7 +647,7 @@ var _ = Describe("Gov Pool", Ordered, func() {
 			Version:     "new version",
 			ScheduledAt: uint64(s.Ctx().BlockTime().Unix() + 1000),
 			Duration:    60,
-			Binaries:    "{\"test\": \"link.com\"}",
+			Binaries:    "{\"test\": \"https://example.com/test\"}",
 		})
 
 		// Assert
@@ -236,7 +657,7 @@ var _ = Describe("Gov Pool", Ordered, func() {
 		Expect(poolAfter.UpgradePlan.Version).To(Equal("new version"))
 		Expect(poolAfter.UpgradePlan.ScheduledAt).To(Equal(uint64(s.Ctx().BlockTime().Unix() + 1000)))
 		Expect(poolAfter.UpgradePlan.Duration).To(Equal(uint64(60)))
-		Expect(poolAfter.UpgradePlan.Binaries).To(Equal("{\"test\": \"link.com\"}"))
+		Expect(poolAfter.UpgradePlan.Binaries).To(Equal("{\"test\": \"https://example.com/test\"}"))
 
 		// Fast-forward
 		s.CommitAfterSeconds(2000)
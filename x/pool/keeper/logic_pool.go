@@ -25,6 +25,75 @@ func (k Keeper) AssertPoolExists(ctx sdk.Context, poolId uint64) error {
 	return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), poolId)
 }
 
+// getPoolOfAdmin returns the pool if the given address is its admin.
+// Pools without an admin can only be managed by governance.
+func (k Keeper) getPoolOfAdmin(ctx sdk.Context, poolId uint64, address string) (types.Pool, error) {
+	pool, err := k.GetPoolWithError(ctx, poolId)
+	if err != nil {
+		return types.Pool{}, err
+	}
+
+	if pool.Admin == "" || pool.Admin != address {
+		return types.Pool{}, sdkErrors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrNotPoolAdmin.Error(), address, poolId)
+	}
+
+	return pool, nil
+}
+
+// pausePool pauses the pool and emits an event. The admin is empty
+// if the pool gets paused by governance.
+func (k Keeper) pausePool(ctx sdk.Context, poolId uint64, admin string) error {
+	pool, err := k.GetPoolWithError(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	// Throw an error if the pool is already paused.
+	if pool.Paused {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, "Pool is already paused.")
+	}
+
+	pool.Paused = true
+	pool.PausedBy = admin
+	k.SetPool(ctx, pool)
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventPoolPaused{
+		PoolId: poolId,
+		Admin:  admin,
+	}); errEmit != nil {
+		return errEmit
+	}
+
+	return nil
+}
+
+// unpausePool unpauses the pool and emits an event. The admin is empty
+// if the pool gets unpaused by governance.
+func (k Keeper) unpausePool(ctx sdk.Context, poolId uint64, admin string) error {
+	pool, err := k.GetPoolWithError(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	// Throw an error if the pool is already unpaused.
+	if !pool.Paused {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, "Pool is already unpaused.")
+	}
+
+	pool.Paused = false
+	pool.PausedBy = ""
+	k.SetPool(ctx, pool)
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventPoolUnpaused{
+		PoolId: poolId,
+		Admin:  admin,
+	}); errEmit != nil {
+		return errEmit
+	}
+
+	return nil
+}
+
 func (k Keeper) IncrementBundleInformation(
 	ctx sdk.Context,
 	poolId uint64,
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// validateProtocol checks that pools can run the given version of the runtime
+// and that the binaries are the registered ones. It returns the binaries
+// which are stored in the pool. Empty binaries are taken from the runtime.
+func (k Keeper) validateProtocol(ctx sdk.Context, runtimeName string, version string, binaries string) (string, error) {
+	runtime, found := k.GetRuntime(ctx, runtimeName)
+	if !found {
+		return "", sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrRuntimeNotFound.Error(), runtimeName)
+	}
+
+	if runtime.Deprecated {
+		return "", sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrRuntimeDeprecated.Error(), runtimeName)
+	}
+
+	runtimeVersion, found := runtime.GetVersion(version)
+	if !found {
+		return "", sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrRuntimeVersionNotFound.Error(), version, runtimeName)
+	}
+
+	if binaries == "" {
+		return runtimeVersion.BinariesJson(), nil
+	}
+
+	if err := runtimeVersion.MatchBinaries(binaries); err != nil {
+		return "", sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidBinaries.Error(), err)
+	}
+
+	return binaries, nil
+}
+
+// validateUpgradeProtocol checks the protocol of a pool upgrade. Pools which
+// were migrated from the registry module can run a runtime which is not
+// registered yet. Their binaries can't be checked against the runtime and
+// therefore have to be given explicitly.
+func (k Keeper) validateUpgradeProtocol(ctx sdk.Context, runtimeName string, version string, binaries string) (string, error) {
+	if k.DoesRuntimeExist(ctx, runtimeName) {
+		return k.validateProtocol(ctx, runtimeName, version, binaries)
+	}
+
+	if !json.Valid([]byte(binaries)) {
+		return "", sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidJson.Error(), binaries)
+	}
+
+	return binaries, nil
+}
+
+// validateConfig checks the given pool config against the schema of the runtime.
+// The config of pools whose runtime is not registered yet is not checked,
+// new pools and runtime changes are already rejected by validateProtocol.
+func (k Keeper) validateConfig(ctx sdk.Context, runtimeName string, config string) error {
+	runtime, found := k.GetRuntime(ctx, runtimeName)
+	if !found {
+		return nil
+	}
+
+	schema, err := types.ParseConfigSchema(runtime.ConfigSchema)
+	if err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidRuntime.Error(), err)
+	}
+
+	if err := schema.ValidateConfig(config); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidConfig.Error(), err)
+	}
+
+	return nil
+}
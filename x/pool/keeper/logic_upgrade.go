@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// isPoolUpgrading returns true if the pool has an upgrade scheduled or is
+// the canary pool of a rollout.
+func (k Keeper) isPoolUpgrading(ctx sdk.Context, pool types.Pool) bool {
+	if pool.UpgradePlan.ScheduledAt > 0 {
+		return true
+	}
+
+	_, found := k.GetUpgradeRollout(ctx, pool.Id)
+	return found
+}
+
+// getUpgradablePool returns the pool with the given id if it uses the runtime
+// and is not upgrading yet.
+func (k Keeper) getUpgradablePool(ctx sdk.Context, poolId uint64, runtime string) (types.Pool, error) {
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return types.Pool{}, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), poolId)
+	}
+
+	if pool.Runtime != runtime {
+		return types.Pool{}, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidPoolUpgrade.Error(),
+			fmt.Sprintf("pool %v does not use runtime %v", poolId, runtime))
+	}
+
+	if k.isPoolUpgrading(ctx, pool) {
+		return types.Pool{}, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidPoolUpgrade.Error(),
+			fmt.Sprintf("pool %v is already upgrading", poolId))
+	}
+
+	return pool, nil
+}
+
+// schedulePoolUpgrade registers the upgrade plan of the pool
+func (k Keeper) schedulePoolUpgrade(ctx sdk.Context, pool types.Pool, version string, binaries string, scheduledAt uint64, duration uint64) {
+	pool.UpgradePlan = &types.UpgradePlan{
+		Version:     version,
+		Binaries:    binaries,
+		ScheduledAt: scheduledAt,
+		Duration:    duration,
+	}
+
+	k.SetPool(ctx, pool)
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the pool funds from plain $KYVE amounts to coins
+// and initialises the funding denom allow-list with $KYVE only.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+
+	for _, pool := range m.keeper.GetAllPools(ctx) {
+		pool.TotalFunds = util.KyveCoins(pool.LegacyTotalFunds)
+		pool.LegacyTotalFunds = 0
+
+		for i := range pool.Funders {
+			pool.Funders[i].Amount = util.KyveCoins(pool.Funders[i].LegacyAmount)
+			pool.Funders[i].LegacyAmount = 0
+		}
+
+		m.keeper.SetPool(ctx, pool)
+	}
+
+	return nil
+}
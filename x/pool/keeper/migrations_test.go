@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/pool/keeper"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+)
+
+/*
+
+TEST CASES - migrations.go
+
+* Migrate legacy $KYVE funds to coins
+
+*/
+
+var _ = Describe("migrations.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name: "Moontest",
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Migrate legacy $KYVE funds to coins", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  i.KYVECoins(50 * i.KYVE),
+		})
+
+		expectedPool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		// write the state in the format of the previous version
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		pool.LegacyTotalFunds = pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()
+		pool.TotalFunds = nil
+
+		for _, funder := range pool.Funders {
+			funder.LegacyAmount = funder.Amount.AmountOf(i.KYVE_DENOM).Uint64()
+			funder.Amount = nil
+		}
+
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		// ACT
+		err := keeper.NewMigrator(s.App().PoolKeeper).Migrate2to3(s.Ctx())
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		migratedPool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(migratedPool).To(Equal(expectedPool))
+
+		Expect(s.App().PoolKeeper.GetParams(s.Ctx())).To(Equal(pooltypes.DefaultParams()))
+	})
+})
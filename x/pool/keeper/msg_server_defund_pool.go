@@ -31,15 +31,15 @@ func (k msgServer) DefundPool(goCtx context.Context, msg *types.MsgDefundPool) (
 	}
 
 	// Check if the sender is trying to defund more than they have funded.
-	if msg.Amount > funder.Amount {
-		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrDefundTooHigh.Error(), funder.Address)
+	if !funder.Amount.IsAllGTE(msg.Amount) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrDefundTooHigh.Error(), funder.Amount)
 	}
 
 	// Update state variables (or completely remove if fully defunding).
 	pool.SubFromFunder(funder.Address, msg.Amount)
 
 	// Transfer tokens from this module to sender.
-	if err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, msg.Creator, msg.Amount); err != nil {
+	if err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, msg.Creator, msg.Amount); err != nil {
 		return nil, err
 	}
 
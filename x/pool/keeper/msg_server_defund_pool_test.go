@@ -43,7 +43,7 @@ var _ = Describe("msg_server_defund_pool.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 	})
 
@@ -56,7 +56,7 @@ var _ = Describe("msg_server_defund_pool.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgDefundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  50 * i.KYVE,
+			Amount:  i.KYVECoins(50 * i.KYVE),
 		})
 
 		// ASSERT
@@ -67,16 +67,16 @@ var _ = Describe("msg_server_defund_pool.go", Ordered, func() {
 		Expect(initialBalance - balanceAfter).To(Equal(50 * i.KYVE))
 
 		Expect(pool.Funders).To(HaveLen(1))
-		Expect(pool.TotalFunds).To(Equal(50 * i.KYVE))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(50 * i.KYVE))
 
 		funder, funderFound := pool.GetFunder(i.ALICE)
 
 		Expect(funderFound).To(BeTrue())
 		Expect(funder).To(Equal(pooltypes.Funder{
 			Address: i.ALICE,
-			Amount:  50 * i.KYVE,
+			Amount:  i.KYVECoins(50 * i.KYVE),
 		}))
-		Expect(pool.GetLowestFunder()).To(Equal(funder))
+		Expect(pool.GetLowestFunder(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(funder))
 	})
 
 	It("Try to defund more than actually funded", func() {
@@ -84,7 +84,7 @@ var _ = Describe("msg_server_defund_pool.go", Ordered, func() {
 		s.RunTxPoolError(&pooltypes.MsgDefundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  101 * i.KYVE,
+			Amount:  i.KYVECoins(101 * i.KYVE),
 		})
 
 		// ASSERT
@@ -95,16 +95,16 @@ var _ = Describe("msg_server_defund_pool.go", Ordered, func() {
 		Expect(initialBalance - balanceAfter).To(Equal(100 * i.KYVE))
 
 		Expect(pool.Funders).To(HaveLen(1))
-		Expect(pool.TotalFunds).To(Equal(100 * i.KYVE))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100 * i.KYVE))
 
 		funder, funderFound := pool.GetFunder(i.ALICE)
 
 		Expect(funderFound).To(BeTrue())
 		Expect(funder).To(Equal(pooltypes.Funder{
 			Address: i.ALICE,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		}))
-		Expect(pool.GetLowestFunder()).To(Equal(funder))
+		Expect(pool.GetLowestFunder(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(funder))
 	})
 
 	It("Defund full funding amount from a funder who has previously funder 100 KYVE", func() {
@@ -112,7 +112,7 @@ var _ = Describe("msg_server_defund_pool.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgDefundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		// ASSERT
@@ -123,13 +123,13 @@ var _ = Describe("msg_server_defund_pool.go", Ordered, func() {
 		Expect(initialBalance - balanceAfter).To(BeZero())
 
 		Expect(pool.Funders).To(BeEmpty())
-		Expect(pool.TotalFunds).To(BeZero())
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
 
 		funder, funderFound := pool.GetFunder(i.ALICE)
 
 		Expect(funderFound).To(BeFalse())
 		Expect(funder).To(Equal(pooltypes.Funder{}))
-		Expect(pool.GetLowestFunder()).To(Equal(funder))
+		Expect(pool.GetLowestFunder(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(funder))
 	})
 
 	It("Defund as highest funder 75 KYVE in order to be the lowest funder afterwards", func() {
@@ -137,23 +137,23 @@ var _ = Describe("msg_server_defund_pool.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.BOB,
 			Id:      0,
-			Amount:  50 * i.KYVE,
+			Amount:  i.KYVECoins(50 * i.KYVE),
 		})
 
 		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 		funderBob, _ := pool.GetFunder(i.BOB)
-		Expect(pool.GetLowestFunder()).To(Equal(funderBob))
+		Expect(pool.GetLowestFunder(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(funderBob))
 
 		// ACT
 		s.RunTxPoolSuccess(&pooltypes.MsgDefundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  75 * i.KYVE,
+			Amount:  i.KYVECoins(75 * i.KYVE),
 		})
 
 		// ASSERT
 		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 		funderAlice, _ := pool.GetFunder(i.ALICE)
-		Expect(pool.GetLowestFunder()).To(Equal(funderAlice))
+		Expect(pool.GetLowestFunder(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(funderAlice))
 	})
 })
@@ -14,6 +14,10 @@ import (
 // If the funders list is full, it checks if the funder wants to fund
 // more than the current lowest funder. If so, the current lowest funder
 // will get their tokens back and removed form the funders list.
+// Pools can disable this eviction, then new funders are rejected instead.
+// Only denoms of the funding denoms param are accepted and the value of
+// fundings is compared using the weights of these denoms.
+// If the pool has a funder allow list, only those addresses can fund it.
 func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*types.MsgFundPoolResponse, error) {
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -23,20 +27,41 @@ func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*typ
 		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), msg.Id)
 	}
 
+	if !pool.IsFunderAllowed(msg.Creator) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrFunderNotAllowed.Error(), msg.Creator, msg.Id)
+	}
+
+	weights := k.GetFundingWeights(ctx)
+
+	for _, coin := range msg.Amount {
+		if _, allowed := weights[coin.Denom]; !allowed {
+			return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, types.ErrDenomNotAllowed.Error(), coin.Denom)
+		}
+	}
+
 	// Check if funder already exists
 	funder, found := pool.GetFunder(msg.Creator)
 
 	if found {
 		pool.AddToFunder(funder.Address, msg.Amount)
 	} else {
+		newFunder := types.Funder{
+			Address: msg.Creator,
+			Amount:  msg.Amount,
+		}
+
 		// If funder does not exist, check if limit is already exceeded.
-		if len(pool.Funders) >= types.MaxFunders {
+		if len(pool.Funders) >= pool.GetMaxFundersOrDefault() {
+			if pool.DisableFunderEviction {
+				return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrMaxFundersReached.Error(), msg.Id, pool.GetMaxFundersOrDefault())
+			}
+
 			// If so, check if funder wants to fund more than current lowest funder.
-			lowestFunder := pool.Funders[0]
-			if msg.Amount > lowestFunder.Amount {
+			lowestFunder := pool.GetLowestFunder(weights)
+			if newFunder.GetValue(weights).GT(lowestFunder.GetValue(weights)) {
 				// Unstake lowest Funder
 
-				err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, lowestFunder.Address, lowestFunder.Amount)
+				err := util.TransferCoinsFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, lowestFunder.Address, lowestFunder.Amount)
 				if err != nil {
 					return nil, err
 				}
@@ -51,19 +76,16 @@ func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*typ
 				}
 
 				// Remove from pool
-				pool.RemoveFunder(*pool.Funders[0])
+				pool.RemoveFunder(lowestFunder)
 			} else {
 				return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrFundsTooLow.Error(), lowestFunder.Amount)
 			}
 		}
 
-		pool.InsertFunder(types.Funder{
-			Address: msg.Creator,
-			Amount:  msg.Amount,
-		})
+		pool.InsertFunder(newFunder)
 	}
 
-	err := util.TransferFromAddressToModule(k.bankKeeper, ctx, msg.Creator, types.ModuleName, msg.Amount)
+	err := util.TransferCoinsFromAddressToModule(k.bankKeeper, ctx, msg.Creator, types.ModuleName, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
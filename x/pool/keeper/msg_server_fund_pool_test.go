@@ -6,6 +6,7 @@ import (
 
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 /*
@@ -19,6 +20,10 @@ TEST CASES - msg_server_fund_pool.go
 * Fund with a new funder more $KYVE than the existing one
 * Try to fund less $KYVE than the lowest funder with full funding slots
 * Try to fund more $KYVE than the lowest funder with full funding slots
+* Fund a pool with a funder allow list
+* Try to fund a pool with a funder allow list without being on it
+* Try to fund more $KYVE than the lowest funder with a per-pool maximum of funders
+* Try to fund more $KYVE than the lowest funder with funder eviction disabled
 
 */
 
@@ -52,7 +57,7 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		// ASSERT
@@ -63,16 +68,16 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		Expect(initialBalance - balanceAfter).To(Equal(100 * i.KYVE))
 
 		Expect(pool.Funders).To(HaveLen(1))
-		Expect(pool.TotalFunds).To(Equal(100 * i.KYVE))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100 * i.KYVE))
 
 		funder, funderFound := pool.GetFunder(i.ALICE)
 
 		Expect(funderFound).To(BeTrue())
 		Expect(funder).To(Equal(pooltypes.Funder{
 			Address: i.ALICE,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		}))
-		Expect(pool.GetLowestFunder()).To(Equal(funder))
+		Expect(pool.GetLowestFunder(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(funder))
 	})
 
 	It("Fund additional 50 $KYVE to an existing funder with 100 $KYVE", func() {
@@ -80,14 +85,14 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		// ACT
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  50 * i.KYVE,
+			Amount:  i.KYVECoins(50 * i.KYVE),
 		})
 
 		// ASSERT
@@ -98,16 +103,16 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		Expect(initialBalance - balanceAfter).To(Equal(150 * i.KYVE))
 
 		Expect(pool.Funders).To(HaveLen(1))
-		Expect(pool.TotalFunds).To(Equal(150 * i.KYVE))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(150 * i.KYVE))
 
 		funder, funderFound := pool.GetFunder(i.ALICE)
 
 		Expect(funderFound).To(BeTrue())
 		Expect(funder).To(Equal(pooltypes.Funder{
 			Address: i.ALICE,
-			Amount:  150 * i.KYVE,
+			Amount:  i.KYVECoins(150 * i.KYVE),
 		}))
-		Expect(pool.GetLowestFunder()).To(Equal(funder))
+		Expect(pool.GetLowestFunder(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(funder))
 	})
 
 	It("Try to fund more $KYVE than available in balance", func() {
@@ -117,7 +122,7 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		s.RunTxPoolError(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  currentBalance + 1,
+			Amount:  i.KYVECoins(currentBalance + 1),
 		})
 
 		// ASSERT
@@ -128,12 +133,12 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		Expect(initialBalance - balanceAfter).To(BeZero())
 
 		Expect(pool.Funders).To(BeEmpty())
-		Expect(pool.TotalFunds).To(BeZero())
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(BeZero())
 
 		_, funderFound := pool.GetFunder(i.ALICE)
 
 		Expect(funderFound).To(BeFalse())
-		Expect(pool.GetLowestFunder()).To(Equal(pooltypes.Funder{}))
+		Expect(pool.GetLowestFunder(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(pooltypes.Funder{}))
 	})
 
 	It("Fund with a new funder less $KYVE than the existing one", func() {
@@ -141,14 +146,14 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		// ACT
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.BOB,
 			Id:      0,
-			Amount:  50 * i.KYVE,
+			Amount:  i.KYVECoins(50 * i.KYVE),
 		})
 
 		// ASSERT
@@ -159,16 +164,16 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		Expect(initialBalance - balanceAfter).To(Equal(50 * i.KYVE))
 
 		Expect(pool.Funders).To(HaveLen(2))
-		Expect(pool.TotalFunds).To(Equal(150 * i.KYVE))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(150 * i.KYVE))
 
 		funder, funderFound := pool.GetFunder(i.BOB)
 
 		Expect(funderFound).To(BeTrue())
 		Expect(funder).To(Equal(pooltypes.Funder{
 			Address: i.BOB,
-			Amount:  50 * i.KYVE,
+			Amount:  i.KYVECoins(50 * i.KYVE),
 		}))
-		Expect(pool.GetLowestFunder()).To(Equal(funder))
+		Expect(pool.GetLowestFunder(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(funder))
 	})
 
 	It("Fund with a new funder more $KYVE than the existing one", func() {
@@ -176,14 +181,14 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		// ACT
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.BOB,
 			Id:      0,
-			Amount:  200 * i.KYVE,
+			Amount:  i.KYVECoins(200 * i.KYVE),
 		})
 
 		// ASSERT
@@ -194,7 +199,7 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		Expect(initialBalance - balanceAfter).To(Equal(200 * i.KYVE))
 
 		Expect(pool.Funders).To(HaveLen(2))
-		Expect(pool.TotalFunds).To(Equal(300 * i.KYVE))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(300 * i.KYVE))
 
 		funderBob, funderFound := pool.GetFunder(i.BOB)
 		funderAlice, _ := pool.GetFunder(i.ALICE)
@@ -202,9 +207,9 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		Expect(funderFound).To(BeTrue())
 		Expect(funderBob).To(Equal(pooltypes.Funder{
 			Address: i.BOB,
-			Amount:  200 * i.KYVE,
+			Amount:  i.KYVECoins(200 * i.KYVE),
 		}))
-		Expect(pool.GetLowestFunder()).To(Equal(funderAlice))
+		Expect(pool.GetLowestFunder(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(funderAlice))
 	})
 
 	It("Try to fund less $KYVE than the lowest funder with full funding slots", func() {
@@ -212,7 +217,7 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		for a := 0; a < 49; a++ {
@@ -220,17 +225,17 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 			s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 				Creator: i.DUMMY[a],
 				Id:      0,
-				Amount:  1000 * i.KYVE,
+				Amount:  i.KYVECoins(1000 * i.KYVE),
 			})
 		}
 
 		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 
 		Expect(pool.Funders).To(HaveLen(50))
-		Expect(pool.TotalFunds).To(Equal(49_100 * i.KYVE))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(49_100 * i.KYVE))
 
 		funderAlice, _ := pool.GetFunder(i.ALICE)
-		Expect(pool.GetLowestFunder()).To(Equal(funderAlice))
+		Expect(pool.GetLowestFunder(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(funderAlice))
 
 		balanceAfter := s.GetBalanceFromAddress(i.ALICE)
 
@@ -240,12 +245,12 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		s.RunTxPoolError(&pooltypes.MsgFundPool{
 			Creator: i.DUMMY[49],
 			Id:      0,
-			Amount:  50 * i.KYVE,
+			Amount:  i.KYVECoins(50 * i.KYVE),
 		})
 
 		// ASSERT
 		Expect(pool.Funders).To(HaveLen(50))
-		Expect(pool.TotalFunds).To(Equal(49_100 * i.KYVE))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(49_100 * i.KYVE))
 
 		_, funderFound := pool.GetFunder(i.DUMMY[49])
 		Expect(funderFound).To(BeFalse())
@@ -256,7 +261,7 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		for a := 0; a < 49; a++ {
@@ -264,7 +269,7 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 			s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 				Creator: i.DUMMY[a],
 				Id:      0,
-				Amount:  1000 * i.KYVE,
+				Amount:  i.KYVECoins(1000 * i.KYVE),
 			})
 		}
 
@@ -272,26 +277,178 @@ var _ = Describe("msg_server_fund_pool.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.DUMMY[49],
 			Id:      0,
-			Amount:  200 * i.KYVE,
+			Amount:  i.KYVECoins(200 * i.KYVE),
 		})
 
 		// ASSERT
 		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
 
 		Expect(pool.Funders).To(HaveLen(50))
-		Expect(pool.TotalFunds).To(Equal(49_200 * i.KYVE))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(49_200 * i.KYVE))
 
 		funderDummy, funderFound := pool.GetFunder(i.DUMMY[49])
 
 		Expect(funderFound).To(BeTrue())
 		Expect(funderDummy).To(Equal(pooltypes.Funder{
 			Address: i.DUMMY[49],
-			Amount:  200 * i.KYVE,
+			Amount:  i.KYVECoins(200 * i.KYVE),
 		}))
-		Expect(pool.GetLowestFunder()).To(Equal(funderDummy))
+		Expect(pool.GetLowestFunder(s.App().PoolKeeper.GetFundingWeights(s.Ctx()))).To(Equal(funderDummy))
 
 		balanceAfter := s.GetBalanceFromAddress(i.ALICE)
 
 		Expect(initialBalance - balanceAfter).To(BeZero())
 	})
+
+	It("Fund a pool with a funder allow list", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.FunderAllowList = []string{i.ALICE}
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		// ASSERT
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(HaveLen(1))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100 * i.KYVE))
+	})
+
+	It("Try to fund a pool with a funder allow list without being on it", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.FunderAllowList = []string{i.ALICE}
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		// ACT
+		_, errCharlie := s.RunTxPool(&pooltypes.MsgFundPool{
+			Creator: i.CHARLIE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		// bob was removed from the allow list and can not fund more
+		_, errBob := s.RunTxPool(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		// ASSERT
+		Expect(errCharlie).NotTo(BeNil())
+		Expect(errCharlie.Error()).To(Equal(sdkErrors.Wrapf(sdkErrors.ErrUnauthorized, pooltypes.ErrFunderNotAllowed.Error(), i.CHARLIE, 0).Error()))
+		Expect(errBob).NotTo(BeNil())
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(HaveLen(1))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(100 * i.KYVE))
+
+		// bob can still defund
+		s.RunTxPoolSuccess(&pooltypes.MsgDefundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+	})
+
+	It("Try to fund more $KYVE than the lowest funder with a per-pool maximum of funders", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.MaxFunders = 2
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  i.KYVECoins(200 * i.KYVE),
+		})
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgFundPool{
+			Creator: i.CHARLIE,
+			Id:      0,
+			Amount:  i.KYVECoins(50 * i.KYVE),
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.CHARLIE,
+			Id:      0,
+			Amount:  i.KYVECoins(150 * i.KYVE),
+		})
+
+		// ASSERT
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(HaveLen(2))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(350 * i.KYVE))
+
+		_, aliceFound := pool.GetFunder(i.ALICE)
+		Expect(aliceFound).To(BeFalse())
+
+		Expect(initialBalance - s.GetBalanceFromAddress(i.ALICE)).To(BeZero())
+	})
+
+	It("Try to fund more $KYVE than the lowest funder with funder eviction disabled", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.MaxFunders = 2
+		pool.DisableFunderEviction = true
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.BOB,
+			Id:      0,
+			Amount:  i.KYVECoins(200 * i.KYVE),
+		})
+
+		// ACT
+		_, err := s.RunTxPool(&pooltypes.MsgFundPool{
+			Creator: i.CHARLIE,
+			Id:      0,
+			Amount:  i.KYVECoins(500 * i.KYVE),
+		})
+
+		// existing funders can still fund more
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  i.KYVECoins(50 * i.KYVE),
+		})
+
+		// ASSERT
+		Expect(err).NotTo(BeNil())
+
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.Funders).To(HaveLen(2))
+		Expect(pool.TotalFunds.AmountOf(i.KYVE_DENOM).Uint64()).To(Equal(350 * i.KYVE))
+
+		_, charlieFound := pool.GetFunder(i.CHARLIE)
+		Expect(charlieFound).To(BeFalse())
+	})
 })
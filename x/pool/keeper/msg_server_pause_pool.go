@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PausePool pauses a pool on behalf of its admin. While paused, no bundles
+// can be proposed on the pool.
+func (k msgServer) PausePool(goCtx context.Context, msg *types.MsgPausePool) (*types.MsgPausePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.getPoolOfAdmin(ctx, msg.Id, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if err := k.pausePool(ctx, msg.Id, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPausePoolResponse{}, nil
+}
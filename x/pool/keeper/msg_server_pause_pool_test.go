@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - msg_server_pause_pool.go
+
+* Pause a pool as the pool admin
+* Try to pause a pool which is already paused
+* Try to pause a pool without being the pool admin
+* Try to pause a pool without admin
+* Pause a pool as the new admin after governance rotated it
+
+*/
+
+var _ = Describe("msg_server_pause_pool.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:  "Moontest",
+			Admin: i.ALICE,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Pause a pool as the pool admin", func() {
+		// ACT
+		res, err := s.RunTxPool(&pooltypes.MsgPausePool{
+			Creator: i.ALICE,
+			Id:      0,
+		})
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Paused).To(BeTrue())
+		Expect(pool.PausedBy).To(Equal(i.ALICE))
+
+		var event *pooltypes.EventPoolPaused
+		for _, e := range res.Events {
+			if parsed, err := sdk.ParseTypedEvent(e); err == nil {
+				if paused, ok := parsed.(*pooltypes.EventPoolPaused); ok {
+					event = paused
+				}
+			}
+		}
+
+		Expect(event).To(Equal(&pooltypes.EventPoolPaused{
+			PoolId: 0,
+			Admin:  i.ALICE,
+		}))
+	})
+
+	It("Try to pause a pool which is already paused", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgPausePool{
+			Creator: i.ALICE,
+			Id:      0,
+		})
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgPausePool{
+			Creator: i.ALICE,
+			Id:      0,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Paused).To(BeTrue())
+	})
+
+	It("Try to pause a pool without being the pool admin", func() {
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgPausePool{
+			Creator: i.BOB,
+			Id:      0,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Paused).To(BeFalse())
+	})
+
+	It("Try to pause a pool without admin", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.Admin = ""
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgPausePool{
+			Creator: i.ALICE,
+			Id:      0,
+		})
+
+		// ASSERT
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Paused).To(BeFalse())
+	})
+
+	It("Pause a pool as the new admin after governance rotated it", func() {
+		// ARRANGE
+		err := s.App().PoolKeeper.UpdatePool(s.Ctx(), pooltypes.NewUpdatePoolProposal(i.GOV, "desc", 0, []string{
+			pooltypes.PoolUpdateAdmin,
+		}, pooltypes.PoolUpdate{Admin: i.BOB}).(*pooltypes.UpdatePoolProposal))
+		Expect(err).To(BeNil())
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgPausePool{
+			Creator: i.ALICE,
+			Id:      0,
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgPausePool{
+			Creator: i.BOB,
+			Id:      0,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Paused).To(BeTrue())
+	})
+})
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UnpausePool unpauses a pool on behalf of its admin. Pools which got
+// paused by governance can only be unpaused by governance.
+func (k msgServer) UnpausePool(goCtx context.Context, msg *types.MsgUnpausePool) (*types.MsgUnpausePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, err := k.getPoolOfAdmin(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if pool.Paused && pool.PausedBy == "" {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrPausedByGov.Error(), msg.Id)
+	}
+
+	if err := k.unpausePool(ctx, msg.Id, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnpausePoolResponse{}, nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - msg_server_unpause_pool.go
+
+* Unpause a pool as the pool admin
+* Try to unpause a pool paused by governance as the pool admin
+* Try to unpause a pool which is not paused
+* Try to unpause a pool without being the pool admin
+
+*/
+
+var _ = Describe("msg_server_unpause_pool.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:     "Moontest",
+			Admin:    i.ALICE,
+			Paused:   true,
+			PausedBy: i.ALICE,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Unpause a pool as the pool admin", func() {
+		// ACT
+		res, err := s.RunTxPool(&pooltypes.MsgUnpausePool{
+			Creator: i.ALICE,
+			Id:      0,
+		})
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Paused).To(BeFalse())
+		Expect(pool.PausedBy).To(BeEmpty())
+
+		var event *pooltypes.EventPoolUnpaused
+		for _, e := range res.Events {
+			if parsed, err := sdk.ParseTypedEvent(e); err == nil {
+				if unpaused, ok := parsed.(*pooltypes.EventPoolUnpaused); ok {
+					event = unpaused
+				}
+			}
+		}
+
+		Expect(event).To(Equal(&pooltypes.EventPoolUnpaused{
+			PoolId: 0,
+			Admin:  i.ALICE,
+		}))
+	})
+
+	It("Try to unpause a pool paused by governance as the pool admin", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.Paused = false
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		err := s.App().PoolKeeper.PausePool(s.Ctx(), &pooltypes.PausePoolProposal{
+			Title:       i.GOV,
+			Description: "desc",
+			Id:          0,
+		})
+		Expect(err).To(BeNil())
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgUnpausePool{
+			Creator: i.ALICE,
+			Id:      0,
+		})
+
+		// ASSERT
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Paused).To(BeTrue())
+		Expect(pool.PausedBy).To(BeEmpty())
+	})
+
+	It("Try to unpause a pool which is not paused", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgUnpausePool{
+			Creator: i.ALICE,
+			Id:      0,
+		})
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgUnpausePool{
+			Creator: i.ALICE,
+			Id:      0,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Paused).To(BeFalse())
+	})
+
+	It("Try to unpause a pool without being the pool admin", func() {
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgUnpausePool{
+			Creator: i.BOB,
+			Id:      0,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Paused).To(BeTrue())
+	})
+})
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateFunderAllowList replaces the funder allow list of a pool. Only the
+// admin of the pool is allowed to do so. Funders who are removed from the
+// list keep their funds in the pool, but can not fund it anymore.
+func (k msgServer) UpdateFunderAllowList(goCtx context.Context, msg *types.MsgUpdateFunderAllowList) (*types.MsgUpdateFunderAllowListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, err := k.getPoolOfAdmin(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.FunderAllowList = msg.FunderAllowList
+	k.SetPool(ctx, pool)
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventFunderAllowListUpdated{
+		PoolId:          msg.Id,
+		Admin:           msg.Creator,
+		FunderAllowList: msg.FunderAllowList,
+	}); errEmit != nil {
+		return nil, errEmit
+	}
+
+	return &types.MsgUpdateFunderAllowListResponse{}, nil
+}
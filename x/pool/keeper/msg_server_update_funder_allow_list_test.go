@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - msg_server_update_funder_allow_list.go
+
+* Update the funder allow list as the pool admin
+* Remove the funder allow list as the pool admin
+* Try to update the funder allow list without being the pool admin
+* Try to update the funder allow list of a pool without admin
+* Try to update the funder allow list with invalid addresses
+* Try to update the funder allow list with too many addresses
+
+*/
+
+var _ = Describe("msg_server_update_funder_allow_list.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:  "Moontest",
+			Admin: i.ALICE,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Update the funder allow list as the pool admin", func() {
+		// ACT
+		res, err := s.RunTxPool(&pooltypes.MsgUpdateFunderAllowList{
+			Creator:         i.ALICE,
+			Id:              0,
+			FunderAllowList: []string{i.BOB, i.CHARLIE},
+		})
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.FunderAllowList).To(Equal([]string{i.BOB, i.CHARLIE}))
+
+		Expect(pool.IsFunderAllowed(i.BOB)).To(BeTrue())
+		Expect(pool.IsFunderAllowed(i.ALICE)).To(BeFalse())
+
+		var event *pooltypes.EventFunderAllowListUpdated
+		for _, e := range res.Events {
+			if parsed, err := sdk.ParseTypedEvent(e); err == nil {
+				if updated, ok := parsed.(*pooltypes.EventFunderAllowListUpdated); ok {
+					event = updated
+				}
+			}
+		}
+
+		Expect(event).NotTo(BeNil())
+		Expect(event.PoolId).To(Equal(uint64(0)))
+		Expect(event.Admin).To(Equal(i.ALICE))
+		Expect(event.FunderAllowList).To(Equal([]string{i.BOB, i.CHARLIE}))
+	})
+
+	It("Remove the funder allow list as the pool admin", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgUpdateFunderAllowList{
+			Creator:         i.ALICE,
+			Id:              0,
+			FunderAllowList: []string{i.BOB},
+		})
+
+		// ACT
+		s.RunTxPoolSuccess(&pooltypes.MsgUpdateFunderAllowList{
+			Creator: i.ALICE,
+			Id:      0,
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.FunderAllowList).To(BeEmpty())
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.CHARLIE,
+			Id:      0,
+			Amount:  i.KYVECoins(100 * i.KYVE),
+		})
+	})
+
+	It("Try to update the funder allow list without being the pool admin", func() {
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgUpdateFunderAllowList{
+			Creator:         i.BOB,
+			Id:              0,
+			FunderAllowList: []string{i.BOB},
+		})
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.FunderAllowList).To(BeEmpty())
+	})
+
+	It("Try to update the funder allow list of a pool without admin", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.Admin = ""
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		// ACT
+		s.RunTxPoolError(&pooltypes.MsgUpdateFunderAllowList{
+			Creator:         i.ALICE,
+			Id:              0,
+			FunderAllowList: []string{i.BOB},
+		})
+
+		// ASSERT
+		pool, _ = s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.FunderAllowList).To(BeEmpty())
+	})
+
+	It("Try to update the funder allow list with invalid addresses", func() {
+		// ACT
+		invalid := pooltypes.NewMsgUpdateFunderAllowList(i.ALICE, 0, []string{"invalid"})
+		duplicate := pooltypes.NewMsgUpdateFunderAllowList(i.ALICE, 0, []string{i.BOB, i.BOB})
+
+		// ASSERT
+		Expect(invalid.ValidateBasic()).NotTo(BeNil())
+		Expect(duplicate.ValidateBasic()).NotTo(BeNil())
+	})
+
+	It("Try to update the funder allow list with too many addresses", func() {
+		// ARRANGE
+		funderAllowList := make([]string, 0)
+		for n := 0; n <= pooltypes.MaxFundersLimit; n++ {
+			funderAllowList = append(funderAllowList, sdk.AccAddress(fmt.Sprintf("funder_allow_list%03d", n)).String())
+		}
+
+		// ACT
+		tooMany := pooltypes.NewMsgUpdateFunderAllowList(i.ALICE, 0, funderAllowList)
+		limit := pooltypes.NewMsgUpdateFunderAllowList(i.ALICE, 0, funderAllowList[:pooltypes.MaxFundersLimit])
+
+		// ASSERT
+		Expect(tooMany.ValidateBasic()).NotTo(BeNil())
+		Expect(limit.ValidateBasic()).To(BeNil())
+	})
+})
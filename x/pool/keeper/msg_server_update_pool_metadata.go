@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdatePoolMetadata updates the name, logo or config of a pool on behalf of
+// its admin. All other fields can only be changed by governance.
+func (k msgServer) UpdatePoolMetadata(goCtx context.Context, msg *types.MsgUpdatePoolMetadata) (*types.MsgUpdatePoolMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, err := k.getPoolOfAdmin(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	mask, update := msg.GetPoolUpdate()
+
+	before := types.GetPoolUpdateValues(pool, mask)
+	types.ApplyPoolUpdate(&pool, mask, update)
+	after := types.GetPoolUpdateValues(pool, mask)
+
+	// The config has to match the schema of the runtime
+	if before.Config != after.Config {
+		if err := k.validateConfig(ctx, pool.Runtime, pool.Config); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetPool(ctx, pool)
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventPoolMetadataUpdated{
+		PoolId:     msg.Id,
+		Admin:      msg.Creator,
+		UpdateMask: mask,
+		Before:     before,
+		After:      after,
+	}); errEmit != nil {
+		return nil, errEmit
+	}
+
+	return &types.MsgUpdatePoolMetadataResponse{}, nil
+}
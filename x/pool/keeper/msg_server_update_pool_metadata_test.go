@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - msg_server_update_pool_metadata.go
+
+* Update the name and logo of a pool as the pool admin
+* Update the config of a pool as the pool admin
+* Try to update the config of a pool with a config which does not match the runtime
+* Try to update the metadata of a pool without being the pool admin
+* Try to update an economic field of a pool as the pool admin
+
+*/
+
+var _ = Describe("msg_server_update_pool_metadata.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	configSchema := `{"type": "object", "properties": {"rpc": {"type": "string"}}, "required": ["rpc"], "additionalProperties": false}`
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		err := s.App().PoolKeeper.CreateRuntime(s.Ctx(), &pooltypes.CreateRuntimeProposal{
+			Title:        i.GOV,
+			Description:  "desc",
+			Name:         "@kyve/tendermint",
+			ConfigSchema: configSchema,
+			Versions: []pooltypes.RuntimeVersion{
+				{Version: "0.0.0", Binaries: []pooltypes.Binary{{Platform: "linux-x64", Url: "https://example.com", Sha256: checksum}}},
+			},
+		})
+		Expect(err).To(BeNil())
+
+		// create clean pool for every test case
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:          "Moontest",
+			Runtime:       "@kyve/tendermint",
+			Logo:          "ar://logo",
+			Config:        `{"rpc": "https://rpc.example.com"}`,
+			OperatingCost: 1 * i.KYVE,
+			Admin:         i.ALICE,
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Update the name and logo of a pool as the pool admin", func() {
+		// ACT
+		res, err := s.RunTxPool(pooltypes.NewMsgUpdatePoolMetadata(i.ALICE, 0, []string{
+			pooltypes.PoolUpdateName,
+			pooltypes.PoolUpdateLogo,
+		}, "Moonbeam", "ar://new-logo", ""))
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Name).To(Equal("Moonbeam"))
+		Expect(pool.Logo).To(Equal("ar://new-logo"))
+		Expect(pool.Config).To(Equal(`{"rpc": "https://rpc.example.com"}`))
+
+		var event *pooltypes.EventPoolMetadataUpdated
+		for _, e := range res.Events {
+			if parsed, err := sdk.ParseTypedEvent(e); err == nil {
+				if updated, ok := parsed.(*pooltypes.EventPoolMetadataUpdated); ok {
+					event = updated
+				}
+			}
+		}
+
+		Expect(event).To(Equal(&pooltypes.EventPoolMetadataUpdated{
+			PoolId:     0,
+			Admin:      i.ALICE,
+			UpdateMask: []string{pooltypes.PoolUpdateName, pooltypes.PoolUpdateLogo},
+			Before:     pooltypes.PoolUpdate{Name: "Moontest", Logo: "ar://logo", FunderAllowList: []string{}},
+			After:      pooltypes.PoolUpdate{Name: "Moonbeam", Logo: "ar://new-logo", FunderAllowList: []string{}},
+		}))
+	})
+
+	It("Update the config of a pool as the pool admin", func() {
+		// ACT
+		s.RunTxPoolSuccess(pooltypes.NewMsgUpdatePoolMetadata(i.ALICE, 0, []string{
+			pooltypes.PoolUpdateConfig,
+		}, "", "", `{"rpc": "https://other.example.com"}`))
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Name).To(Equal("Moontest"))
+		Expect(pool.Config).To(Equal(`{"rpc": "https://other.example.com"}`))
+	})
+
+	It("Try to update the config of a pool with a config which does not match the runtime", func() {
+		// ACT
+		s.RunTxPoolError(pooltypes.NewMsgUpdatePoolMetadata(i.ALICE, 0, []string{
+			pooltypes.PoolUpdateConfig,
+		}, "", "", `{"url": "https://other.example.com"}`))
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Config).To(Equal(`{"rpc": "https://rpc.example.com"}`))
+	})
+
+	It("Try to update the metadata of a pool without being the pool admin", func() {
+		// ACT
+		s.RunTxPoolError(pooltypes.NewMsgUpdatePoolMetadata(i.BOB, 0, []string{
+			pooltypes.PoolUpdateName,
+		}, "Moonbeam", "", ""))
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		Expect(pool.Name).To(Equal("Moontest"))
+	})
+
+	It("Try to update an economic field of a pool as the pool admin", func() {
+		// ACT
+		operatingCost := pooltypes.NewMsgUpdatePoolMetadata(i.ALICE, 0, []string{
+			pooltypes.PoolUpdateOperatingCost,
+		}, "", "", "")
+		admin := pooltypes.NewMsgUpdatePoolMetadata(i.ALICE, 0, []string{
+			pooltypes.PoolUpdateAdmin,
+		}, "", "", "")
+		empty := pooltypes.NewMsgUpdatePoolMetadata(i.ALICE, 0, []string{}, "", "", "")
+
+		// ASSERT
+		Expect(operatingCost.ValidateBasic()).NotTo(BeNil())
+		Expect(admin.ValidateBasic()).NotTo(BeNil())
+		Expect(empty.ValidateBasic()).NotTo(BeNil())
+	})
+})
@@ -7,10 +7,23 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.FundingDenoms(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// FundingDenoms returns the FundingDenoms param
+func (k Keeper) FundingDenoms(ctx sdk.Context) (res []types.FundingDenom) {
+	k.paramstore.Get(ctx, types.KeyFundingDenoms, &res)
+	return
+}
+
+// GetFundingWeights returns the weight of every denom pools can be funded with
+func (k Keeper) GetFundingWeights(ctx sdk.Context) map[string]sdk.Dec {
+	return k.GetParams(ctx).GetFundingWeights()
+}
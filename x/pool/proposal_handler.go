@@ -23,6 +23,14 @@ func NewPoolProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return k.UpgradePool(ctx, c)
 		case *types.CancelPoolUpgradeProposal:
 			return k.CancelPoolUpgrade(ctx, c)
+		case *types.ResetPoolProposal:
+			return k.ResetPool(ctx, c)
+		case *types.CreateRuntimeProposal:
+			return k.CreateRuntime(ctx, c)
+		case *types.UpdateRuntimeProposal:
+			return k.UpdateRuntime(ctx, c)
+		case *types.DeprecateRuntimeProposal:
+			return k.DeprecateRuntime(ctx, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized proposal content type: %T", c)
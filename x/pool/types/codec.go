@@ -12,6 +12,10 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFundPool{}, "registry/FundPool", nil)
 	cdc.RegisterConcrete(&MsgDefundPool{}, "registry/DefundPool", nil)
+	cdc.RegisterConcrete(&MsgUpdateFunderAllowList{}, "pool/UpdateFunderAllowList", nil)
+	cdc.RegisterConcrete(&MsgPausePool{}, "pool/PausePool", nil)
+	cdc.RegisterConcrete(&MsgUnpausePool{}, "pool/UnpausePool", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolMetadata{}, "pool/UpdatePoolMetadata", nil)
 	// this line is used by starport scaffolding # 2
 
 	cdc.RegisterConcrete(&CreatePoolProposal{}, "kyve/CreatePoolProposal", nil)
@@ -20,6 +24,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&UnpausePoolProposal{}, "kyve/UnpausePoolProposal", nil)
 	cdc.RegisterConcrete(&SchedulePoolUpgradeProposal{}, "kyve/SchedulePoolUpgradeProposal", nil)
 	cdc.RegisterConcrete(&CancelPoolUpgradeProposal{}, "kyve/CancelPoolUpgradeProposal", nil)
+	cdc.RegisterConcrete(&ResetPoolProposal{}, "kyve/ResetPoolProposal", nil)
+	cdc.RegisterConcrete(&CreateRuntimeProposal{}, "kyve/CreateRuntimeProposal", nil)
+	cdc.RegisterConcrete(&UpdateRuntimeProposal{}, "kyve/UpdateRuntimeProposal", nil)
+	cdc.RegisterConcrete(&DeprecateRuntimeProposal{}, "kyve/DeprecateRuntimeProposal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -29,6 +37,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDefundPool{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateFunderAllowList{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPausePool{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUnpausePool{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdatePoolMetadata{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations(
@@ -39,6 +59,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&UnpausePoolProposal{},
 		&SchedulePoolUpgradeProposal{},
 		&CancelPoolUpgradeProposal{},
+		&ResetPoolProposal{},
+		&CreateRuntimeProposal{},
+		&UpdateRuntimeProposal{},
+		&DeprecateRuntimeProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,196 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ConfigSchema is the subset of JSON schema which can be used to describe
+// the config of the pools of a runtime. Schemas with other keywords
+// are rejected, so that governance does not rely on checks which are
+// never performed.
+type ConfigSchema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	Type                 string                   `json:"type,omitempty"`
+	Enum                 []interface{}            `json:"enum,omitempty"`
+	Properties           map[string]*ConfigSchema `json:"properties,omitempty"`
+	Required             []string                 `json:"required,omitempty"`
+	AdditionalProperties *bool                    `json:"additionalProperties,omitempty"`
+	Items                *ConfigSchema            `json:"items,omitempty"`
+}
+
+var configSchemaTypes = map[string]struct{}{
+	"":        {},
+	"object":  {},
+	"array":   {},
+	"string":  {},
+	"number":  {},
+	"integer": {},
+	"boolean": {},
+	"null":    {},
+}
+
+// ParseConfigSchema parses the given schema. An empty schema allows every config.
+func ParseConfigSchema(schema string) (*ConfigSchema, error) {
+	parsed := &ConfigSchema{}
+
+	if schema == "" {
+		return parsed, nil
+	}
+
+	if err := decodeStrict(schema, parsed); err != nil {
+		return nil, err
+	}
+
+	if err := parsed.check(); err != nil {
+		return nil, err
+	}
+
+	return parsed, nil
+}
+
+// ValidateConfig checks the given config against the schema
+func (s *ConfigSchema) ValidateConfig(config string) error {
+	var value interface{}
+	if err := decodeStrict(config, &value); err != nil {
+		return err
+	}
+
+	return s.validate("config", value)
+}
+
+func (s *ConfigSchema) check() error {
+	if _, ok := configSchemaTypes[s.Type]; !ok {
+		return fmt.Errorf("unsupported type %v", s.Type)
+	}
+
+	for _, property := range s.Properties {
+		if property == nil {
+			return fmt.Errorf("empty property schema")
+		}
+		if err := property.check(); err != nil {
+			return err
+		}
+	}
+
+	if s.Items != nil {
+		return s.Items.check()
+	}
+
+	return nil
+}
+
+func (s *ConfigSchema) validate(path string, value interface{}) error {
+	if len(s.Enum) > 0 {
+		allowed := false
+		for _, option := range s.Enum {
+			if reflect.DeepEqual(option, value) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("%v is not one of the allowed values", path)
+		}
+	}
+
+	switch s.Type {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%v has to be an object", path)
+		}
+		return s.validateObject(path, object)
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%v has to be an array", path)
+		}
+		if s.Items != nil {
+			for i, item := range array {
+				if err := s.Items.validate(fmt.Sprintf("%v[%v]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%v has to be a string", path)
+		}
+	case "number":
+		if _, ok := value.(json.Number); !ok {
+			return fmt.Errorf("%v has to be a number", path)
+		}
+	case "integer":
+		number, ok := value.(json.Number)
+		if !ok || strings.ContainsAny(number.String(), ".eE") {
+			return fmt.Errorf("%v has to be an integer", path)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%v has to be a boolean", path)
+		}
+	case "null":
+		if value != nil {
+			return fmt.Errorf("%v has to be null", path)
+		}
+	}
+
+	return nil
+}
+
+func (s *ConfigSchema) validateObject(path string, object map[string]interface{}) error {
+	for _, key := range s.Required {
+		if _, ok := object[key]; !ok {
+			return fmt.Errorf("%v.%v is required", path, key)
+		}
+	}
+
+	// Iterate in a deterministic order to always return the same error
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		property, ok := s.Properties[key]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				return fmt.Errorf("%v.%v is not allowed", path, key)
+			}
+			continue
+		}
+
+		if err := property.validate(path+"."+key, object[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeStrict decodes the given json into v, rejects unknown fields
+// and keeps numbers as json.Number.
+func decodeStrict(data string, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.DisallowUnknownFields()
+	decoder.UseNumber()
+
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+
+	if decoder.More() {
+		return fmt.Errorf("unexpected data after json value")
+	}
+
+	return nil
+}
@@ -10,8 +10,33 @@ var (
 
 // funding errors
 var (
-	ErrFundsTooLow   = sdkerrors.Register(ModuleName, 1101, "minimum funding amount of %vkyve not reached")
-	ErrDefundTooHigh = sdkerrors.Register(ModuleName, 1102, "maximum defunding amount of %vkyve surpassed")
-	ErrInvalidJson   = sdkerrors.Register(ModuleName, 1103, "invalid json object: %v")
-	ErrInvalidArgs   = sdkerrors.Register(ModuleName, 1104, "invalid args")
+	ErrFundsTooLow     = sdkerrors.Register(ModuleName, 1101, "minimum funding amount of %v not reached")
+	ErrDefundTooHigh   = sdkerrors.Register(ModuleName, 1102, "maximum defunding amount of %v surpassed")
+	ErrInvalidJson     = sdkerrors.Register(ModuleName, 1103, "invalid json object: %v")
+	ErrInvalidArgs     = sdkerrors.Register(ModuleName, 1104, "invalid args")
+	ErrDenomNotAllowed = sdkerrors.Register(ModuleName, 1107, "denom %v is not allowed for funding")
+
+	ErrFunderNotAllowed  = sdkerrors.Register(ModuleName, 1117, "%v is not allowed to fund pool %v")
+	ErrMaxFundersReached = sdkerrors.Register(ModuleName, 1118, "pool %v has reached the maximum of %v funders")
+	ErrNotPoolAdmin      = sdkerrors.Register(ModuleName, 1119, "%v is not the admin of pool %v")
+	ErrPausedByGov       = sdkerrors.Register(ModuleName, 1120, "pool %v got paused by governance and can only be unpaused by governance")
+)
+
+// gov errors
+var (
+	ErrFinalizedBundleNotFound = sdkerrors.Register(ModuleName, 1105, "finalized bundle with id %v does not exist")
+	ErrInvalidQuorum           = sdkerrors.Register(ModuleName, 1106, "invalid quorum: %v")
+	ErrInvalidPoolUpdate       = sdkerrors.Register(ModuleName, 1115, "invalid pool update: %v")
+	ErrInvalidPoolUpgrade      = sdkerrors.Register(ModuleName, 1116, "invalid pool upgrade: %v")
+)
+
+// runtime errors
+var (
+	ErrInvalidRuntime         = sdkerrors.Register(ModuleName, 1108, "invalid runtime: %v")
+	ErrRuntimeNotFound        = sdkerrors.Register(ModuleName, 1109, "runtime %v does not exist")
+	ErrRuntimeAlreadyExists   = sdkerrors.Register(ModuleName, 1110, "runtime %v already exists")
+	ErrRuntimeDeprecated      = sdkerrors.Register(ModuleName, 1111, "runtime %v is deprecated")
+	ErrRuntimeVersionNotFound = sdkerrors.Register(ModuleName, 1112, "version %v of runtime %v does not exist")
+	ErrInvalidBinaries        = sdkerrors.Register(ModuleName, 1113, "binaries do not match the runtime: %v")
+	ErrInvalidConfig          = sdkerrors.Register(ModuleName, 1114, "config does not match the schema of the runtime: %v")
 )
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -48,6 +51,26 @@ type EventCreatePool struct {
 	Version string `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`
 	// binaries ...
 	Binaries string `protobuf:"bytes,12,opt,name=binaries,proto3" json:"binaries,omitempty"`
+	// valid_quorum ...
+	ValidQuorum string `protobuf:"bytes,13,opt,name=valid_quorum,json=validQuorum,proto3" json:"valid_quorum,omitempty"`
+	// invalid_quorum ...
+	InvalidQuorum string `protobuf:"bytes,14,opt,name=invalid_quorum,json=invalidQuorum,proto3" json:"invalid_quorum,omitempty"`
+	// upload_timeout ...
+	UploadTimeout uint64 `protobuf:"varint,15,opt,name=upload_timeout,json=uploadTimeout,proto3" json:"upload_timeout,omitempty"`
+	// max_points ...
+	MaxPoints uint64 `protobuf:"varint,16,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	// charging_mode ...
+	ChargingMode ChargingMode `protobuf:"varint,17,opt,name=charging_mode,json=chargingMode,proto3,enum=kyve.pool.v1beta1.ChargingMode" json:"charging_mode,omitempty"`
+	// admin ...
+	Admin string `protobuf:"bytes,18,opt,name=admin,proto3" json:"admin,omitempty"`
+	// funder_allow_list ...
+	FunderAllowList []string `protobuf:"bytes,19,rep,name=funder_allow_list,json=funderAllowList,proto3" json:"funder_allow_list,omitempty"`
+	// max_funders ...
+	MaxFunders uint64 `protobuf:"varint,20,opt,name=max_funders,json=maxFunders,proto3" json:"max_funders,omitempty"`
+	// disable_funder_eviction ...
+	DisableFunderEviction bool `protobuf:"varint,21,opt,name=disable_funder_eviction,json=disableFunderEviction,proto3" json:"disable_funder_eviction,omitempty"`
+	// commit_reveal_voting ...
+	CommitRevealVoting bool `protobuf:"varint,22,opt,name=commit_reveal_voting,json=commitRevealVoting,proto3" json:"commit_reveal_voting,omitempty"`
 }
 
 func (m *EventCreatePool) Reset()         { *m = EventCreatePool{} }
@@ -167,6 +190,76 @@ func (m *EventCreatePool) GetBinaries() string {
 	return ""
 }
 
+func (m *EventCreatePool) GetValidQuorum() string {
+	if m != nil {
+		return m.ValidQuorum
+	}
+	return ""
+}
+
+func (m *EventCreatePool) GetInvalidQuorum() string {
+	if m != nil {
+		return m.InvalidQuorum
+	}
+	return ""
+}
+
+func (m *EventCreatePool) GetUploadTimeout() uint64 {
+	if m != nil {
+		return m.UploadTimeout
+	}
+	return 0
+}
+
+func (m *EventCreatePool) GetMaxPoints() uint64 {
+	if m != nil {
+		return m.MaxPoints
+	}
+	return 0
+}
+
+func (m *EventCreatePool) GetChargingMode() ChargingMode {
+	if m != nil {
+		return m.ChargingMode
+	}
+	return CHARGING_MODE_EQUAL
+}
+
+func (m *EventCreatePool) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *EventCreatePool) GetFunderAllowList() []string {
+	if m != nil {
+		return m.FunderAllowList
+	}
+	return nil
+}
+
+func (m *EventCreatePool) GetMaxFunders() uint64 {
+	if m != nil {
+		return m.MaxFunders
+	}
+	return 0
+}
+
+func (m *EventCreatePool) GetDisableFunderEviction() bool {
+	if m != nil {
+		return m.DisableFunderEviction
+	}
+	return false
+}
+
+func (m *EventCreatePool) GetCommitRevealVoting() bool {
+	if m != nil {
+		return m.CommitRevealVoting
+	}
+	return false
+}
+
 // EventFundPool is an event emitted when a pool is funded.
 type EventFundPool struct {
 	// pool_id is the unique ID of the pool.
@@ -174,7 +267,7 @@ type EventFundPool struct {
 	// address is the account address of the pool funder.
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
 func (m *EventFundPool) Reset()         { *m = EventFundPool{} }
@@ -224,11 +317,11 @@ func (m *EventFundPool) GetAddress() string {
 	return ""
 }
 
-func (m *EventFundPool) GetAmount() uint64 {
+func (m *EventFundPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Amount
 	}
-	return 0
+	return nil
 }
 
 // EventDefundPool is an event emitted when a pool is defunded.
@@ -238,7 +331,7 @@ type EventDefundPool struct {
 	// address is the account address of the pool funder.
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
 func (m *EventDefundPool) Reset()         { *m = EventDefundPool{} }
@@ -288,13 +381,273 @@ func (m *EventDefundPool) GetAddress() string {
 	return ""
 }
 
-func (m *EventDefundPool) GetAmount() uint64 {
+func (m *EventDefundPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Amount
 	}
+	return nil
+}
+
+// EventFunderAllowListUpdated is an event emitted when the admin
+// of a pool updates the funder allow list.
+type EventFunderAllowListUpdated struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// admin is the address of the pool admin.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// funder_allow_list is the new funder allow list.
+	FunderAllowList []string `protobuf:"bytes,3,rep,name=funder_allow_list,json=funderAllowList,proto3" json:"funder_allow_list,omitempty"`
+}
+
+func (m *EventFunderAllowListUpdated) Reset()         { *m = EventFunderAllowListUpdated{} }
+func (m *EventFunderAllowListUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventFunderAllowListUpdated) ProtoMessage()    {}
+func (*EventFunderAllowListUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{3}
+}
+func (m *EventFunderAllowListUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFunderAllowListUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFunderAllowListUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFunderAllowListUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFunderAllowListUpdated.Merge(m, src)
+}
+func (m *EventFunderAllowListUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFunderAllowListUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFunderAllowListUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFunderAllowListUpdated proto.InternalMessageInfo
+
+func (m *EventFunderAllowListUpdated) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventFunderAllowListUpdated) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *EventFunderAllowListUpdated) GetFunderAllowList() []string {
+	if m != nil {
+		return m.FunderAllowList
+	}
+	return nil
+}
+
+// EventPoolPaused is an event emitted when a pool gets paused.
+type EventPoolPaused struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// admin is the address of the pool admin who paused the pool,
+	// it is empty if the pool got paused by governance.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *EventPoolPaused) Reset()         { *m = EventPoolPaused{} }
+func (m *EventPoolPaused) String() string { return proto.CompactTextString(m) }
+func (*EventPoolPaused) ProtoMessage()    {}
+func (*EventPoolPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{4}
+}
+func (m *EventPoolPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPoolPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolPaused.Merge(m, src)
+}
+func (m *EventPoolPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPoolPaused proto.InternalMessageInfo
+
+func (m *EventPoolPaused) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventPoolPaused) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// EventPoolUnpaused is an event emitted when a pool gets unpaused.
+type EventPoolUnpaused struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// admin is the address of the pool admin who unpaused the pool,
+	// it is empty if the pool got unpaused by governance.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *EventPoolUnpaused) Reset()         { *m = EventPoolUnpaused{} }
+func (m *EventPoolUnpaused) String() string { return proto.CompactTextString(m) }
+func (*EventPoolUnpaused) ProtoMessage()    {}
+func (*EventPoolUnpaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{5}
+}
+func (m *EventPoolUnpaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolUnpaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolUnpaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPoolUnpaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolUnpaused.Merge(m, src)
+}
+func (m *EventPoolUnpaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolUnpaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolUnpaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPoolUnpaused proto.InternalMessageInfo
+
+func (m *EventPoolUnpaused) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventPoolUnpaused) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// EventPoolMetadataUpdated is an event emitted when the admin
+// of a pool updates its name, logo or config.
+type EventPoolMetadataUpdated struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// admin is the address of the pool admin.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// update_mask contains the updated fields.
+	UpdateMask []string `protobuf:"bytes,3,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// before contains the previous values of the updated fields.
+	Before PoolUpdate `protobuf:"bytes,4,opt,name=before,proto3" json:"before"`
+	// after contains the new values of the updated fields.
+	After PoolUpdate `protobuf:"bytes,5,opt,name=after,proto3" json:"after"`
+}
+
+func (m *EventPoolMetadataUpdated) Reset()         { *m = EventPoolMetadataUpdated{} }
+func (m *EventPoolMetadataUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPoolMetadataUpdated) ProtoMessage()    {}
+func (*EventPoolMetadataUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{6}
+}
+func (m *EventPoolMetadataUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolMetadataUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolMetadataUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPoolMetadataUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolMetadataUpdated.Merge(m, src)
+}
+func (m *EventPoolMetadataUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolMetadataUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolMetadataUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPoolMetadataUpdated proto.InternalMessageInfo
+
+func (m *EventPoolMetadataUpdated) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
 	return 0
 }
 
+func (m *EventPoolMetadataUpdated) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *EventPoolMetadataUpdated) GetUpdateMask() []string {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+func (m *EventPoolMetadataUpdated) GetBefore() PoolUpdate {
+	if m != nil {
+		return m.Before
+	}
+	return PoolUpdate{}
+}
+
+func (m *EventPoolMetadataUpdated) GetAfter() PoolUpdate {
+	if m != nil {
+		return m.After
+	}
+	return PoolUpdate{}
+}
+
 // EventPoolOutOfFunds is an event emitted when a pool has run out of funds
 type EventPoolOutOfFunds struct {
 	// pool_id is the unique ID of the pool.
@@ -305,7 +658,7 @@ func (m *EventPoolOutOfFunds) Reset()         { *m = EventPoolOutOfFunds{} }
 func (m *EventPoolOutOfFunds) String() string { return proto.CompactTextString(m) }
 func (*EventPoolOutOfFunds) ProtoMessage()    {}
 func (*EventPoolOutOfFunds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1828a100d789238, []int{3}
+	return fileDescriptor_c1828a100d789238, []int{7}
 }
 func (m *EventPoolOutOfFunds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,67 +694,447 @@ func (m *EventPoolOutOfFunds) GetPoolId() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*EventCreatePool)(nil), "kyve.pool.v1beta1.EventCreatePool")
-	proto.RegisterType((*EventFundPool)(nil), "kyve.pool.v1beta1.EventFundPool")
-	proto.RegisterType((*EventDefundPool)(nil), "kyve.pool.v1beta1.EventDefundPool")
-	proto.RegisterType((*EventPoolOutOfFunds)(nil), "kyve.pool.v1beta1.EventPoolOutOfFunds")
+// EventResetPool is an event emitted when a pool is reset to an earlier finalized bundle.
+type EventResetPool struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// bundle_id is the id of the finalized bundle the pool was reset to.
+	BundleId uint64 `protobuf:"varint,2,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	// removed_bundles is the number of finalized bundles which got removed.
+	RemovedBundles uint64 `protobuf:"varint,3,opt,name=removed_bundles,json=removedBundles,proto3" json:"removed_bundles,omitempty"`
+	// current_height is the restored height of the pool.
+	CurrentHeight uint64 `protobuf:"varint,4,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	// current_key is the restored key of the pool.
+	CurrentKey string `protobuf:"bytes,5,opt,name=current_key,json=currentKey,proto3" json:"current_key,omitempty"`
+	// current_value is the restored value of the pool.
+	CurrentValue string `protobuf:"bytes,6,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
 }
 
-func init() { proto.RegisterFile("kyve/pool/v1beta1/events.proto", fileDescriptor_c1828a100d789238) }
+func (m *EventResetPool) Reset()         { *m = EventResetPool{} }
+func (m *EventResetPool) String() string { return proto.CompactTextString(m) }
+func (*EventResetPool) ProtoMessage()    {}
+func (*EventResetPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{8}
+}
+func (m *EventResetPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventResetPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventResetPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventResetPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventResetPool.Merge(m, src)
+}
+func (m *EventResetPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventResetPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventResetPool.DiscardUnknown(m)
+}
 
-var fileDescriptor_c1828a100d789238 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0x5d, 0x6b, 0x13, 0x41,
-	0x14, 0xcd, 0xc6, 0x98, 0x8f, 0xd1, 0x26, 0x38, 0x82, 0x0e, 0x0a, 0x4b, 0x09, 0xa8, 0xf5, 0x65,
-	0x97, 0xe2, 0x3f, 0x68, 0xac, 0x50, 0x0a, 0x56, 0x52, 0x10, 0x2c, 0xc2, 0x32, 0x9b, 0xbd, 0x49,
-	0x87, 0xec, 0xce, 0x5d, 0x66, 0xee, 0xae, 0x49, 0x7f, 0x85, 0x3f, 0xcb, 0xc7, 0x3e, 0xfa, 0x28,
-	0xc9, 0x1f, 0x91, 0x99, 0xdd, 0xf4, 0xcd, 0x47, 0xdf, 0xe6, 0x9c, 0x7b, 0x38, 0x33, 0xe7, 0xcc,
-	0x65, 0xe1, 0x7a, 0x5b, 0x43, 0x5c, 0x22, 0xe6, 0x71, 0x7d, 0x9a, 0x02, 0xc9, 0xd3, 0x18, 0x6a,
-	0xd0, 0x64, 0xa3, 0xd2, 0x20, 0x21, 0x7f, 0xe6, 0xe6, 0x91, 0x9b, 0x47, 0xed, 0x7c, 0xba, 0xef,
-	0xb2, 0xc9, 0xb9, 0xd3, 0xcc, 0x0c, 0x48, 0x82, 0x2f, 0x88, 0x39, 0x1f, 0xb3, 0xae, 0xca, 0x44,
-	0x70, 0x1c, 0x9c, 0xf4, 0xe6, 0x5d, 0x95, 0x71, 0xce, 0x7a, 0x5a, 0x16, 0x20, 0xba, 0xc7, 0xc1,
-	0xc9, 0x68, 0xee, 0xcf, 0x5c, 0xb0, 0x81, 0xa9, 0x34, 0xa9, 0x02, 0xc4, 0x23, 0x4f, 0x1f, 0xa0,
-	0x53, 0xe7, 0xb8, 0x42, 0xd1, 0x6b, 0xd4, 0xee, 0xcc, 0x5f, 0xb0, 0xfe, 0x02, 0xf5, 0x52, 0xad,
-	0xc4, 0x63, 0xcf, 0xb6, 0x88, 0xbf, 0x66, 0x23, 0x4b, 0xd2, 0x50, 0xb2, 0x86, 0xad, 0xe8, 0xfb,
-	0xd1, 0xd0, 0x13, 0x97, 0xb0, 0xe5, 0xef, 0xd8, 0xa4, 0x2a, 0x73, 0x94, 0x59, 0xa2, 0x34, 0x81,
-	0xa9, 0x65, 0x2e, 0x06, 0xfe, 0x4d, 0xe3, 0x86, 0xbe, 0x68, 0x59, 0xfe, 0x86, 0x8d, 0xb1, 0x04,
-	0x23, 0x49, 0xe9, 0x55, 0xb2, 0x40, 0x4b, 0x62, 0xe8, 0x75, 0x47, 0x0f, 0xec, 0x0c, 0x2d, 0xb9,
-	0xcb, 0x0a, 0xa5, 0x13, 0x4b, 0x72, 0x0d, 0x62, 0xe4, 0x15, 0xc3, 0x42, 0xe9, 0x6b, 0x87, 0xf9,
-	0x5b, 0x36, 0x29, 0xe4, 0x26, 0x49, 0x2b, 0x9d, 0xe5, 0x90, 0x58, 0x75, 0x07, 0x82, 0x35, 0x26,
-	0x85, 0xdc, 0x9c, 0x79, 0xf6, 0x5a, 0xdd, 0xf9, 0xdc, 0x35, 0x18, 0xab, 0x50, 0x8b, 0x27, 0x4d,
-	0xee, 0x16, 0xf2, 0x57, 0x6c, 0x98, 0x2a, 0x2d, 0x8d, 0x02, 0x2b, 0x9e, 0x36, 0x51, 0x0e, 0x78,
-	0x7a, 0xc3, 0x8e, 0x7c, 0xc9, 0x9f, 0x2a, 0x9d, 0xf9, 0x8a, 0x5f, 0xb2, 0x81, 0xfb, 0x86, 0xe4,
-	0xa1, 0xe7, 0xbe, 0x83, 0x17, 0x99, 0xf3, 0x97, 0x59, 0x66, 0xc0, 0xda, 0xb6, 0xee, 0x03, 0x74,
-	0x1d, 0xca, 0x02, 0x2b, 0x4d, 0xbe, 0xf0, 0xde, 0xbc, 0x45, 0xd3, 0xef, 0xed, 0x07, 0x7e, 0x84,
-	0xe5, 0x7f, 0x70, 0x8f, 0xd8, 0x73, 0xef, 0xee, 0x7c, 0xaf, 0x2a, 0xba, 0x5a, 0xba, 0x08, 0xf6,
-	0x9f, 0x37, 0x9c, 0xcd, 0x7e, 0xed, 0xc2, 0xe0, 0x7e, 0x17, 0x06, 0x7f, 0x76, 0x61, 0xf0, 0x73,
-	0x1f, 0x76, 0xee, 0xf7, 0x61, 0xe7, 0xf7, 0x3e, 0xec, 0xdc, 0xbc, 0x5f, 0x29, 0xba, 0xad, 0xd2,
-	0x68, 0x81, 0x45, 0x7c, 0xf9, 0xed, 0xeb, 0xf9, 0x67, 0xa0, 0x1f, 0x68, 0xd6, 0xf1, 0xe2, 0x56,
-	0x2a, 0x1d, 0x6f, 0x9a, 0xb5, 0xa5, 0x6d, 0x09, 0x36, 0xed, 0xfb, 0x75, 0xfd, 0xf0, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0x07, 0x71, 0xa1, 0x47, 0xd0, 0x02, 0x00, 0x00,
+var xxx_messageInfo_EventResetPool proto.InternalMessageInfo
+
+func (m *EventResetPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *EventCreatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventResetPool) GetBundleId() uint64 {
+	if m != nil {
+		return m.BundleId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventCreatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventResetPool) GetRemovedBundles() uint64 {
+	if m != nil {
+		return m.RemovedBundles
+	}
+	return 0
 }
 
-func (m *EventCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+func (m *EventResetPool) GetCurrentHeight() uint64 {
+	if m != nil {
+		return m.CurrentHeight
+	}
+	return 0
+}
+
+func (m *EventResetPool) GetCurrentKey() string {
+	if m != nil {
+		return m.CurrentKey
+	}
+	return ""
+}
+
+func (m *EventResetPool) GetCurrentValue() string {
+	if m != nil {
+		return m.CurrentValue
+	}
+	return ""
+}
+
+// EventPoolUpdated is an event emitted when a pool is updated by governance.
+type EventPoolUpdated struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// update_mask contains the names of the updated fields.
+	UpdateMask []string `protobuf:"bytes,2,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// before contains the values of the updated fields before the update.
+	Before PoolUpdate `protobuf:"bytes,3,opt,name=before,proto3" json:"before"`
+	// after contains the values of the updated fields after the update.
+	After PoolUpdate `protobuf:"bytes,4,opt,name=after,proto3" json:"after"`
+}
+
+func (m *EventPoolUpdated) Reset()         { *m = EventPoolUpdated{} }
+func (m *EventPoolUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPoolUpdated) ProtoMessage()    {}
+func (*EventPoolUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{9}
+}
+func (m *EventPoolUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPoolUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolUpdated.Merge(m, src)
+}
+func (m *EventPoolUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPoolUpdated proto.InternalMessageInfo
+
+func (m *EventPoolUpdated) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventPoolUpdated) GetUpdateMask() []string {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+func (m *EventPoolUpdated) GetBefore() PoolUpdate {
+	if m != nil {
+		return m.Before
+	}
+	return PoolUpdate{}
+}
+
+func (m *EventPoolUpdated) GetAfter() PoolUpdate {
+	if m != nil {
+		return m.After
+	}
+	return PoolUpdate{}
+}
+
+// EventPoolUpgradeRollout is an event emitted whenever a staged pool upgrade
+// enters a new stage.
+type EventPoolUpgradeRollout struct {
+	// canary_pool_id is the id of the canary pool of the rollout.
+	CanaryPoolId uint64 `protobuf:"varint,1,opt,name=canary_pool_id,json=canaryPoolId,proto3" json:"canary_pool_id,omitempty"`
+	// stage is the new stage of the rollout.
+	Stage UpgradeRolloutStage `protobuf:"varint,2,opt,name=stage,proto3,enum=kyve.pool.v1beta1.UpgradeRolloutStage" json:"stage,omitempty"`
+	// version is the version which gets rolled out.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// pool_ids are the pools which get upgraded once the canary pool succeeded.
+	PoolIds []uint64 `protobuf:"varint,4,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+	// finalized_bundles is the number of bundles the canary pool finalized
+	// with the new version.
+	FinalizedBundles uint64 `protobuf:"varint,5,opt,name=finalized_bundles,json=finalizedBundles,proto3" json:"finalized_bundles,omitempty"`
+}
+
+func (m *EventPoolUpgradeRollout) Reset()         { *m = EventPoolUpgradeRollout{} }
+func (m *EventPoolUpgradeRollout) String() string { return proto.CompactTextString(m) }
+func (*EventPoolUpgradeRollout) ProtoMessage()    {}
+func (*EventPoolUpgradeRollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1828a100d789238, []int{10}
+}
+func (m *EventPoolUpgradeRollout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolUpgradeRollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolUpgradeRollout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPoolUpgradeRollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolUpgradeRollout.Merge(m, src)
+}
+func (m *EventPoolUpgradeRollout) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolUpgradeRollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolUpgradeRollout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPoolUpgradeRollout proto.InternalMessageInfo
+
+func (m *EventPoolUpgradeRollout) GetCanaryPoolId() uint64 {
+	if m != nil {
+		return m.CanaryPoolId
+	}
+	return 0
+}
+
+func (m *EventPoolUpgradeRollout) GetStage() UpgradeRolloutStage {
+	if m != nil {
+		return m.Stage
+	}
+	return UPGRADE_ROLLOUT_STAGE_UNSPECIFIED
+}
+
+func (m *EventPoolUpgradeRollout) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *EventPoolUpgradeRollout) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+func (m *EventPoolUpgradeRollout) GetFinalizedBundles() uint64 {
+	if m != nil {
+		return m.FinalizedBundles
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventCreatePool)(nil), "kyve.pool.v1beta1.EventCreatePool")
+	proto.RegisterType((*EventFundPool)(nil), "kyve.pool.v1beta1.EventFundPool")
+	proto.RegisterType((*EventDefundPool)(nil), "kyve.pool.v1beta1.EventDefundPool")
+	proto.RegisterType((*EventFunderAllowListUpdated)(nil), "kyve.pool.v1beta1.EventFunderAllowListUpdated")
+	proto.RegisterType((*EventPoolPaused)(nil), "kyve.pool.v1beta1.EventPoolPaused")
+	proto.RegisterType((*EventPoolUnpaused)(nil), "kyve.pool.v1beta1.EventPoolUnpaused")
+	proto.RegisterType((*EventPoolMetadataUpdated)(nil), "kyve.pool.v1beta1.EventPoolMetadataUpdated")
+	proto.RegisterType((*EventPoolOutOfFunds)(nil), "kyve.pool.v1beta1.EventPoolOutOfFunds")
+	proto.RegisterType((*EventResetPool)(nil), "kyve.pool.v1beta1.EventResetPool")
+	proto.RegisterType((*EventPoolUpdated)(nil), "kyve.pool.v1beta1.EventPoolUpdated")
+	proto.RegisterType((*EventPoolUpgradeRollout)(nil), "kyve.pool.v1beta1.EventPoolUpgradeRollout")
+}
+
+func init() { proto.RegisterFile("kyve/pool/v1beta1/events.proto", fileDescriptor_c1828a100d789238) }
+
+var fileDescriptor_c1828a100d789238 = []byte{
+	// 1094 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x73, 0x1b, 0x35,
+	0x14, 0xcf, 0xc6, 0x7f, 0x62, 0xcb, 0xb1, 0xd3, 0xa8, 0x69, 0xa3, 0x26, 0xd4, 0x36, 0x06, 0x8a,
+	0x81, 0xc1, 0x6e, 0xc3, 0x0c, 0x33, 0x0c, 0x1c, 0x20, 0x69, 0x18, 0x32, 0x25, 0x34, 0x6c, 0x68,
+	0x66, 0xe0, 0xb2, 0x23, 0xef, 0xca, 0x1b, 0x8d, 0x77, 0x25, 0x23, 0x69, 0xb7, 0x49, 0x3e, 0x00,
+	0x67, 0xbe, 0x05, 0x03, 0x9f, 0x83, 0x43, 0x8f, 0x3d, 0x72, 0x60, 0x28, 0x93, 0x7c, 0x11, 0x46,
+	0x7f, 0xec, 0x3a, 0x4d, 0xda, 0xd2, 0x72, 0xe1, 0xb4, 0xfb, 0x7e, 0xbf, 0xf7, 0xa4, 0xf7, 0x9e,
+	0x9e, 0xde, 0x13, 0x68, 0x8e, 0x8e, 0x73, 0xd2, 0x1f, 0x73, 0x9e, 0xf4, 0xf3, 0x3b, 0x03, 0xa2,
+	0xf0, 0x9d, 0x3e, 0xc9, 0x09, 0x53, 0xb2, 0x37, 0x16, 0x5c, 0x71, 0xb8, 0xac, 0xf9, 0x9e, 0xe6,
+	0x7b, 0x8e, 0x5f, 0x6b, 0x86, 0x5c, 0xa6, 0x5c, 0xf6, 0x07, 0x58, 0x92, 0xa9, 0x51, 0xc8, 0x29,
+	0xb3, 0x26, 0x6b, 0x2b, 0x31, 0x8f, 0xb9, 0xf9, 0xed, 0xeb, 0x3f, 0x87, 0xae, 0x5f, 0xdc, 0x28,
+	0xe6, 0xb9, 0x23, 0xdf, 0xb8, 0x48, 0x9a, 0x2d, 0x2d, 0xdb, 0xba, 0xc8, 0x0a, 0x9e, 0x24, 0x3c,
+	0x53, 0x56, 0xa1, 0xf3, 0x53, 0x19, 0x2c, 0x6d, 0x6b, 0xaf, 0xb7, 0x04, 0xc1, 0x8a, 0xec, 0x71,
+	0x9e, 0xc0, 0x06, 0x98, 0xa7, 0x11, 0xf2, 0xda, 0x5e, 0xb7, 0xe8, 0xcf, 0xd3, 0x08, 0x42, 0x50,
+	0x64, 0x38, 0x25, 0x68, 0xbe, 0xed, 0x75, 0xab, 0xbe, 0xf9, 0x87, 0x08, 0x2c, 0x88, 0x8c, 0x29,
+	0x9a, 0x12, 0x54, 0x30, 0xf0, 0x44, 0xd4, 0xda, 0x09, 0x8f, 0x39, 0x2a, 0x5a, 0x6d, 0xfd, 0x0f,
+	0xaf, 0x83, 0x72, 0xc8, 0xd9, 0x90, 0xc6, 0xa8, 0x64, 0x50, 0x27, 0xc1, 0x75, 0x50, 0x95, 0x0a,
+	0x0b, 0x15, 0x8c, 0xc8, 0x31, 0x2a, 0x1b, 0xaa, 0x62, 0x80, 0x7b, 0xe4, 0x18, 0xbe, 0x0b, 0x96,
+	0xb2, 0x71, 0xc2, 0x71, 0x14, 0x50, 0xa6, 0x88, 0xc8, 0x71, 0x82, 0x16, 0x8c, 0x4f, 0x0d, 0x0b,
+	0xef, 0x38, 0x14, 0xbe, 0x03, 0x1a, 0x7c, 0x4c, 0x04, 0x56, 0x94, 0xc5, 0x41, 0xc8, 0xa5, 0x42,
+	0x15, 0xa3, 0x57, 0x9f, 0xa2, 0x5b, 0x5c, 0x2a, 0xbd, 0x59, 0x4a, 0x59, 0x20, 0x15, 0x1e, 0x11,
+	0x54, 0x35, 0x1a, 0x95, 0x94, 0xb2, 0x7d, 0x2d, 0xc3, 0x5b, 0x60, 0x29, 0xc5, 0x47, 0xc1, 0x20,
+	0x63, 0x51, 0x42, 0x02, 0x49, 0x4f, 0x08, 0x02, 0x76, 0x91, 0x14, 0x1f, 0x6d, 0x1a, 0x74, 0x9f,
+	0x9e, 0x98, 0xb8, 0x73, 0x22, 0x24, 0xe5, 0x0c, 0xd5, 0x6c, 0xdc, 0x4e, 0x84, 0x6b, 0xa0, 0x32,
+	0xa0, 0x0c, 0x0b, 0x4a, 0x24, 0x5a, 0xb4, 0xa1, 0x4c, 0x64, 0xf8, 0x26, 0x58, 0xcc, 0x71, 0x42,
+	0xa3, 0xe0, 0xc7, 0x8c, 0x8b, 0x2c, 0x45, 0x75, 0xc3, 0xd7, 0x0c, 0xf6, 0xad, 0x81, 0x74, 0x10,
+	0x94, 0x9d, 0x53, 0x6a, 0x18, 0xa5, 0x3a, 0x65, 0xcf, 0xa8, 0xb9, 0xa4, 0xe8, 0x64, 0xf3, 0x4c,
+	0xa1, 0x25, 0xeb, 0xa6, 0x45, 0xbf, 0xb3, 0x20, 0xbc, 0x09, 0x80, 0x0e, 0x67, 0xcc, 0x29, 0x53,
+	0x12, 0x5d, 0x31, 0x2a, 0xd5, 0x14, 0x1f, 0xed, 0x19, 0x00, 0xde, 0x05, 0xf5, 0xf0, 0x10, 0x8b,
+	0x58, 0x27, 0x2c, 0xe5, 0x11, 0x41, 0xcb, 0x6d, 0xaf, 0xdb, 0xd8, 0x68, 0xf5, 0x2e, 0x94, 0x6c,
+	0x6f, 0xcb, 0xe9, 0xed, 0xf2, 0x88, 0xf8, 0x8b, 0xe1, 0x8c, 0x04, 0x57, 0x40, 0x09, 0x47, 0x29,
+	0x65, 0x08, 0x1a, 0x4f, 0xad, 0x00, 0xdf, 0x07, 0xcb, 0xc3, 0x8c, 0x45, 0x44, 0x04, 0x38, 0x49,
+	0xf8, 0xc3, 0x20, 0xa1, 0x52, 0xa1, 0xab, 0xed, 0x42, 0xb7, 0xea, 0x2f, 0x59, 0xe2, 0x0b, 0x8d,
+	0x7f, 0x4d, 0xa5, 0x82, 0x2d, 0x50, 0xd3, 0x6e, 0x5a, 0x58, 0xa2, 0x15, 0xe3, 0xa7, 0xf6, 0xfc,
+	0x4b, 0x8b, 0xc0, 0x8f, 0xc1, 0x6a, 0x44, 0x25, 0x1e, 0x24, 0xc4, 0x29, 0x05, 0x24, 0xa7, 0xa1,
+	0xd2, 0xe9, 0xbf, 0xd6, 0xf6, 0xba, 0x15, 0xff, 0x9a, 0xa3, 0xad, 0xc1, 0xb6, 0x23, 0xe1, 0x6d,
+	0xb0, 0x12, 0xf2, 0x34, 0xa5, 0x2a, 0x10, 0x24, 0x27, 0x38, 0x09, 0x72, 0xae, 0xeb, 0x00, 0x5d,
+	0x37, 0x46, 0xd0, 0x72, 0xbe, 0xa1, 0x0e, 0x0c, 0xd3, 0xf9, 0xc5, 0x03, 0x75, 0x73, 0x11, 0xf4,
+	0x4a, 0xe6, 0x1a, 0xac, 0x82, 0x05, 0x9d, 0x89, 0x60, 0x7a, 0x17, 0xca, 0x5a, 0xdc, 0x89, 0x74,
+	0x0d, 0xe0, 0x28, 0x12, 0x44, 0x4a, 0x77, 0x25, 0x26, 0x22, 0x0c, 0x41, 0x19, 0xa7, 0x3c, 0x63,
+	0x0a, 0x15, 0xda, 0x85, 0x6e, 0x6d, 0xe3, 0x46, 0xcf, 0x5e, 0xf8, 0x9e, 0xbe, 0xf0, 0x4f, 0x53,
+	0xca, 0x29, 0xdb, 0xbc, 0xfd, 0xe8, 0xaf, 0xd6, 0xdc, 0x6f, 0x4f, 0x5a, 0xdd, 0x98, 0xaa, 0xc3,
+	0x6c, 0xd0, 0x0b, 0x79, 0xda, 0x77, 0xdd, 0xc1, 0x7e, 0x3e, 0x94, 0xd1, 0xa8, 0xaf, 0x8e, 0xc7,
+	0x44, 0x1a, 0x03, 0xe9, 0xbb, 0xa5, 0x3b, 0xbf, 0x7a, 0xee, 0xca, 0xde, 0x25, 0xc3, 0xff, 0xbb,
+	0xaf, 0x47, 0x60, 0x7d, 0x9a, 0xd4, 0x99, 0x83, 0x7f, 0x30, 0x8e, 0xb0, 0x22, 0xd1, 0xf3, 0xdd,
+	0x9e, 0x96, 0xd6, 0xfc, 0x4b, 0x4b, 0xab, 0x70, 0x69, 0x69, 0x75, 0x3e, 0x77, 0x49, 0xd2, 0xe9,
+	0xd9, 0xc3, 0x99, 0x7c, 0xe5, 0xdd, 0x3a, 0x9b, 0x60, 0x79, 0xba, 0xc2, 0x03, 0x36, 0x7e, 0xad,
+	0x35, 0x4e, 0x3d, 0x80, 0xa6, 0x8b, 0xec, 0x12, 0x85, 0x23, 0xac, 0xf0, 0x6b, 0x46, 0xdf, 0x02,
+	0xb5, 0xcc, 0x58, 0x06, 0x29, 0x96, 0x23, 0x17, 0x37, 0xb0, 0xd0, 0x2e, 0x96, 0x23, 0xf8, 0x29,
+	0x28, 0x0f, 0xc8, 0x90, 0x0b, 0x62, 0x7a, 0x6f, 0x6d, 0xe3, 0xe6, 0x25, 0xd7, 0xd9, 0x04, 0x63,
+	0x4c, 0x36, 0x8b, 0xfa, 0x54, 0x7d, 0x67, 0x02, 0x3f, 0x01, 0x25, 0x3c, 0x54, 0x44, 0xa0, 0xd2,
+	0xbf, 0xb7, 0xb5, 0x16, 0x9d, 0x1e, 0xb8, 0x3a, 0x8d, 0xf1, 0x7e, 0xa6, 0xee, 0x0f, 0xf5, 0x69,
+	0xcb, 0xe7, 0x86, 0xd7, 0xf9, 0xd3, 0x03, 0x0d, 0x63, 0xe0, 0x13, 0x49, 0xd4, 0x8b, 0xeb, 0x77,
+	0x1d, 0x54, 0x5d, 0x4f, 0xa6, 0x91, 0x49, 0x47, 0xd1, 0xaf, 0x58, 0x60, 0x27, 0xd2, 0x13, 0x42,
+	0x90, 0x94, 0xe7, 0x24, 0x72, 0x8d, 0x5b, 0x9a, 0x61, 0x54, 0xf4, 0x1b, 0x0e, 0xb6, 0x8d, 0x5b,
+	0xea, 0xae, 0x19, 0x66, 0x42, 0x10, 0xa6, 0x82, 0x43, 0x42, 0xe3, 0x43, 0x65, 0x32, 0x54, 0xf4,
+	0xeb, 0x0e, 0xfd, 0xca, 0x80, 0x3a, 0xc3, 0x13, 0x35, 0x3d, 0x90, 0xec, 0xac, 0x02, 0x0e, 0xd2,
+	0x23, 0xe9, 0x2d, 0x30, 0xb1, 0x08, 0x72, 0x9c, 0x64, 0xc4, 0xcd, 0xac, 0x45, 0x07, 0x1e, 0x68,
+	0xac, 0xf3, 0xbb, 0x07, 0xae, 0x3c, 0x2d, 0x9c, 0x97, 0x9d, 0xf5, 0x33, 0xa7, 0x3a, 0xff, 0x82,
+	0x53, 0x2d, 0xfc, 0x87, 0x53, 0x2d, 0xbe, 0xf2, 0xa9, 0x3e, 0xf1, 0xc0, 0xea, 0x4c, 0x18, 0xb1,
+	0xc0, 0x11, 0xf1, 0xed, 0xdb, 0x01, 0xbe, 0x0d, 0x1a, 0x21, 0x66, 0x58, 0x1c, 0x07, 0xe7, 0x83,
+	0x5a, 0xb4, 0xe8, 0x9e, 0x0d, 0xed, 0x33, 0x50, 0x92, 0x0a, 0xc7, 0xf6, 0xe1, 0xd0, 0xd8, 0xb8,
+	0x75, 0xc9, 0xe6, 0xe7, 0xd7, 0xdd, 0xd7, 0xda, 0xbe, 0x35, 0x9a, 0x9d, 0xb4, 0x85, 0xf3, 0x93,
+	0xf6, 0x06, 0xa8, 0xb8, 0x6d, 0x25, 0x2a, 0xb6, 0x0b, 0xdd, 0xa2, 0xbf, 0x60, 0x93, 0x29, 0xe1,
+	0x07, 0x60, 0x79, 0x48, 0x19, 0x4e, 0xe8, 0xc9, 0x4c, 0x4d, 0x94, 0x8c, 0x6f, 0x57, 0xa6, 0x84,
+	0xab, 0x8a, 0xcd, 0xad, 0x47, 0xa7, 0x4d, 0xef, 0xf1, 0x69, 0xd3, 0xfb, 0xfb, 0xb4, 0xe9, 0xfd,
+	0x7c, 0xd6, 0x9c, 0x7b, 0x7c, 0xd6, 0x9c, 0xfb, 0xe3, 0xac, 0x39, 0xf7, 0xc3, 0x7b, 0x33, 0x8d,
+	0xee, 0xde, 0xf7, 0x07, 0xdb, 0xdf, 0x10, 0xf5, 0x90, 0x8b, 0x51, 0x3f, 0x3c, 0xc4, 0x94, 0xf5,
+	0x8f, 0xec, 0x83, 0xca, 0xf4, 0xbb, 0x41, 0xd9, 0xbc, 0xa3, 0x3e, 0xfa, 0x67, 0x00, 0xcf, 0xe6,
+	0x81, 0xf3, 0x0e, 0x0a, 0x00, 0x00,
+}
+
+func (m *EventCreatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommitRevealVoting {
+		i--
+		if m.CommitRevealVoting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.DisableFunderEviction {
+		i--
+		if m.DisableFunderEviction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MaxFunders != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxFunders))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.FunderAllowList) > 0 {
+		for iNdEx := len(m.FunderAllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FunderAllowList[iNdEx])
+			copy(dAtA[i:], m.FunderAllowList[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.FunderAllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ChargingMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChargingMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxPoints != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxPoints))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.UploadTimeout != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UploadTimeout))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.InvalidQuorum) > 0 {
+		i -= len(m.InvalidQuorum)
+		copy(dAtA[i:], m.InvalidQuorum)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InvalidQuorum)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ValidQuorum) > 0 {
+		i -= len(m.ValidQuorum)
+		copy(dAtA[i:], m.ValidQuorum)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidQuorum)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.Binaries) > 0 {
 		i -= len(m.Binaries)
 		copy(dAtA[i:], m.Binaries)
@@ -499,10 +1232,19 @@ func (m *EventFundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
@@ -539,10 +1281,19 @@ func (m *EventDefundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
@@ -559,7 +1310,7 @@ func (m *EventDefundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventPoolOutOfFunds) Marshal() (dAtA []byte, err error) {
+func (m *EventFunderAllowListUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -569,16 +1320,32 @@ func (m *EventPoolOutOfFunds) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventPoolOutOfFunds) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventFunderAllowListUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPoolOutOfFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventFunderAllowListUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.FunderAllowList) > 0 {
+		for iNdEx := len(m.FunderAllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FunderAllowList[iNdEx])
+			copy(dAtA[i:], m.FunderAllowList[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.FunderAllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
 		i--
@@ -587,44 +1354,383 @@ func (m *EventPoolOutOfFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventPoolPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventPoolPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolUnpaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Logo)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolUnpaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolUnpaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Config)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.StartKey)
-	if l > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolMetadataUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolMetadataUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolMetadataUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Before.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.UpdateMask) > 0 {
+		for iNdEx := len(m.UpdateMask) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpdateMask[iNdEx])
+			copy(dAtA[i:], m.UpdateMask[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdateMask[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolOutOfFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolOutOfFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolOutOfFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventResetPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventResetPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventResetPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentValue) > 0 {
+		i -= len(m.CurrentValue)
+		copy(dAtA[i:], m.CurrentValue)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentValue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CurrentKey) > 0 {
+		i -= len(m.CurrentKey)
+		copy(dAtA[i:], m.CurrentKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CurrentHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RemovedBundles != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RemovedBundles))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BundleId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BundleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Before.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UpdateMask) > 0 {
+		for iNdEx := len(m.UpdateMask) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpdateMask[iNdEx])
+			copy(dAtA[i:], m.UpdateMask[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdateMask[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolUpgradeRollout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolUpgradeRollout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolUpgradeRollout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalizedBundles != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FinalizedBundles))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PoolIds) > 0 {
+		dAtA6 := make([]byte, len(m.PoolIds)*10)
+		var j5 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintEvents(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Stage != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CanaryPoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CanaryPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	if m.UploadInterval != 0 {
@@ -647,6 +1753,42 @@ func (m *EventCreatePool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.ValidQuorum)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InvalidQuorum)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.UploadTimeout != 0 {
+		n += 1 + sovEvents(uint64(m.UploadTimeout))
+	}
+	if m.MaxPoints != 0 {
+		n += 2 + sovEvents(uint64(m.MaxPoints))
+	}
+	if m.ChargingMode != 0 {
+		n += 2 + sovEvents(uint64(m.ChargingMode))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	if len(m.FunderAllowList) > 0 {
+		for _, s := range m.FunderAllowList {
+			l = len(s)
+			n += 2 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.MaxFunders != 0 {
+		n += 2 + sovEvents(uint64(m.MaxFunders))
+	}
+	if m.DisableFunderEviction {
+		n += 3
+	}
+	if m.CommitRevealVoting {
+		n += 3
+	}
 	return n
 }
 
@@ -663,8 +1805,11 @@ func (m *EventFundPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
 	}
 	return n
 }
@@ -682,13 +1827,38 @@ func (m *EventDefundPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *EventPoolOutOfFunds) Size() (n int) {
+func (m *EventFunderAllowListUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.FunderAllowList) > 0 {
+		for _, s := range m.FunderAllowList {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventPoolPaused) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -697,16 +1867,1170 @@ func (m *EventPoolOutOfFunds) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovEvents(uint64(m.PoolId))
 	}
-	return n
-}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPoolUnpaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPoolMetadataUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.UpdateMask) > 0 {
+		for _, s := range m.UpdateMask {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Before.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.After.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventPoolOutOfFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *EventResetPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.BundleId != 0 {
+		n += 1 + sovEvents(uint64(m.BundleId))
+	}
+	if m.RemovedBundles != 0 {
+		n += 1 + sovEvents(uint64(m.RemovedBundles))
+	}
+	if m.CurrentHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentHeight))
+	}
+	l = len(m.CurrentKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CurrentValue)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPoolUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if len(m.UpdateMask) > 0 {
+		for _, s := range m.UpdateMask {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Before.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.After.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventPoolUpgradeRollout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CanaryPoolId != 0 {
+		n += 1 + sovEvents(uint64(m.CanaryPoolId))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovEvents(uint64(m.Stage))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if m.FinalizedBundles != 0 {
+		n += 1 + sovEvents(uint64(m.FinalizedBundles))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+			}
+			m.UploadInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatingCost", wireType)
+			}
+			m.OperatingCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatingCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			}
+			m.MaxBundleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTimeout", wireType)
+			}
+			m.UploadTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoints", wireType)
+			}
+			m.MaxPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargingMode", wireType)
+			}
+			m.ChargingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargingMode |= ChargingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAllowList = append(m.FunderAllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFunders", wireType)
+			}
+			m.MaxFunders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFunders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableFunderEviction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableFunderEviction = bool(v != 0)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealVoting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitRevealVoting = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFundPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDefundPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDefundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFunderAllowListUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFunderAllowListUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFunderAllowListUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAllowList = append(m.FunderAllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
+func (m *EventPoolPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -729,17 +3053,17 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPoolPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPoolPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -749,14 +3073,14 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -784,11 +3108,80 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolUnpaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolUnpaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolUnpaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -816,11 +3209,80 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolMetadataUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolMetadataUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolMetadataUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -848,11 +3310,11 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logo = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -877,16 +3339,132 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMask = append(m.UpdateMask, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolOutOfFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolOutOfFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolOutOfFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -896,29 +3474,66 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventResetPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventResetPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventResetPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.UploadInterval = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -928,16 +3543,16 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UploadInterval |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatingCost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
 			}
-			m.OperatingCost = 0
+			m.BundleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -947,16 +3562,16 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatingCost |= uint64(b&0x7F) << shift
+				m.BundleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedBundles", wireType)
 			}
-			m.MinStake = 0
+			m.RemovedBundles = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -966,16 +3581,16 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
+				m.RemovedBundles |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
 			}
-			m.MaxBundleSize = 0
+			m.CurrentHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -985,14 +3600,14 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				m.CurrentHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1020,11 +3635,11 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.CurrentKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1052,7 +3667,7 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
+			m.CurrentValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1075,7 +3690,7 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFundPool) Unmarshal(dAtA []byte) error {
+func (m *EventPoolUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1098,10 +3713,10 @@ func (m *EventFundPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFundPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPoolUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPoolUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1125,7 +3740,7 @@ func (m *EventFundPool) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1153,13 +3768,46 @@ func (m *EventFundPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.UpdateMask = append(m.UpdateMask, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Amount = 0
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1169,11 +3817,25 @@ func (m *EventFundPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1195,7 +3857,7 @@ func (m *EventFundPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
+func (m *EventPoolUpgradeRollout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1218,17 +3880,17 @@ func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDefundPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPoolUpgradeRollout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDefundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPoolUpgradeRollout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryPoolId", wireType)
 			}
-			m.PoolId = 0
+			m.CanaryPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1238,16 +3900,16 @@ func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.CanaryPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
-			var stringLen uint64
+			m.Stage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1257,29 +3919,16 @@ func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Stage |= UpgradeRolloutStage(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1289,66 +3938,105 @@ func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventPoolOutOfFunds) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventPoolOutOfFunds: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPoolOutOfFunds: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBundles", wireType)
 			}
-			m.PoolId = 0
+			m.FinalizedBundles = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1358,7 +4046,7 @@ func (m *EventPoolOutOfFunds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.FinalizedBundles |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11,3 +11,7 @@ type AccountKeeper interface {
 type UpgradeKeeper interface {
 	ScheduleUpgrade(ctx sdk.Context, plan upgradetypes.Plan) error
 }
+
+type BundlesKeeper interface {
+	ResetToFinalizedBundle(ctx sdk.Context, poolId uint64, bundleId uint64) (toHeight uint64, toKey string, toValue string, found bool)
+}
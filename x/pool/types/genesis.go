@@ -24,9 +24,51 @@ func (gs GenesisState) Validate() error {
 		if elem.Id >= gs.PoolCount {
 			return fmt.Errorf("pool id higher than pool count %v", elem)
 		}
-		if len(elem.Funders) > MaxFunders {
+		if err := ValidateMaxFunders(elem.MaxFunders); err != nil {
+			return fmt.Errorf("invalid max funders of pool %v: %w", elem.Id, err)
+		}
+		if len(elem.Funders) > elem.GetMaxFundersOrDefault() {
 			return fmt.Errorf("more funders than allowed %v", elem)
 		}
+		if err := ValidateAdmin(elem.Admin); err != nil {
+			return fmt.Errorf("invalid admin of pool %v: %w", elem.Id, err)
+		}
+		if err := ValidateFunderAllowList(elem.FunderAllowList); err != nil {
+			return fmt.Errorf("invalid funder allow list of pool %v: %w", elem.Id, err)
+		}
+		if _, _, err := ParseQuorums(elem.ValidQuorum, elem.InvalidQuorum); err != nil {
+			return fmt.Errorf("invalid quorums of pool %v: %w", elem.Id, err)
+		}
+		if err := ValidateChargingMode(elem.ChargingMode); err != nil {
+			return fmt.Errorf("invalid charging mode of pool %v: %w", elem.Id, err)
+		}
+	}
+
+	runtimeIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RuntimeList {
+		if _, ok := runtimeIndexMap[elem.Name]; ok {
+			return fmt.Errorf("duplicated runtime %v", elem.Name)
+		}
+		runtimeIndexMap[elem.Name] = struct{}{}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid runtime %v: %w", elem.Name, err)
+		}
+	}
+
+	rolloutIndexMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.UpgradeRolloutList {
+		if _, ok := rolloutIndexMap[elem.CanaryPoolId]; ok {
+			return fmt.Errorf("duplicated upgrade rollout of canary pool %v", elem.CanaryPoolId)
+		}
+		rolloutIndexMap[elem.CanaryPoolId] = struct{}{}
+		if _, ok := poolIndexMap[string(PoolKeyPrefix(elem.CanaryPoolId))]; !ok {
+			return fmt.Errorf("canary pool %v of upgrade rollout does not exist", elem.CanaryPoolId)
+		}
+		if elem.Stage != UPGRADE_ROLLOUT_STAGE_SCHEDULED && elem.Stage != UPGRADE_ROLLOUT_STAGE_EVALUATING {
+			return fmt.Errorf("upgrade rollout of canary pool %v is not active", elem.CanaryPoolId)
+		}
 	}
 
 	return gs.Params.Validate()
@@ -31,6 +31,10 @@ type GenesisState struct {
 	PoolList []Pool `protobuf:"bytes,2,rep,name=pool_list,json=poolList,proto3" json:"pool_list"`
 	// pool_count ...
 	PoolCount uint64 `protobuf:"varint,3,opt,name=pool_count,json=poolCount,proto3" json:"pool_count,omitempty"`
+	// runtime_list ...
+	RuntimeList []Runtime `protobuf:"bytes,4,rep,name=runtime_list,json=runtimeList,proto3" json:"runtime_list"`
+	// upgrade_rollout_list ...
+	UpgradeRolloutList []UpgradeRollout `protobuf:"bytes,5,rep,name=upgrade_rollout_list,json=upgradeRolloutList,proto3" json:"upgrade_rollout_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +91,20 @@ func (m *GenesisState) GetPoolCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetRuntimeList() []Runtime {
+	if m != nil {
+		return m.RuntimeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUpgradeRolloutList() []UpgradeRollout {
+	if m != nil {
+		return m.UpgradeRolloutList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.pool.v1beta1.GenesisState")
 }
@@ -94,24 +112,29 @@ func init() {
 func init() { proto.RegisterFile("kyve/pool/v1beta1/genesis.proto", fileDescriptor_ba827ab14a3de899) }
 
 var fileDescriptor_ba827ab14a3de899 = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0xae, 0x2c, 0x4b,
-	0xd5, 0x2f, 0xc8, 0xcf, 0xcf, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x72, 0x98, 0x26, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x92, 0x92, 0xc1,
-	0x22, 0x0f, 0x32, 0x15, 0x2c, 0xab, 0xb4, 0x88, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x71, 0x70, 0x49,
-	0x62, 0x49, 0xaa, 0x90, 0x39, 0x17, 0x1b, 0x44, 0xbb, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91,
-	0xa4, 0x1e, 0x86, 0x43, 0xf4, 0x02, 0xc0, 0x0a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82,
-	0x2a, 0x17, 0xb2, 0xe2, 0xe2, 0x04, 0x29, 0x8a, 0xcf, 0xc9, 0x2c, 0x2e, 0x91, 0x60, 0x52, 0x60,
-	0xd6, 0xe0, 0x36, 0x12, 0xc7, 0xa6, 0x37, 0x3f, 0x3f, 0x07, 0xaa, 0x93, 0x03, 0x24, 0xe1, 0x93,
-	0x59, 0x5c, 0x22, 0x24, 0xcb, 0xc5, 0x05, 0xd6, 0x9b, 0x9c, 0x5f, 0x9a, 0x57, 0x22, 0xc1, 0xac,
-	0xc0, 0xa8, 0xc1, 0x12, 0x04, 0x36, 0xcd, 0x19, 0x24, 0xe0, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3,
-	0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9,
-	0xb9, 0xfa, 0xde, 0x91, 0x61, 0xae, 0x7e, 0xa9, 0x25, 0xe5, 0xf9, 0x45, 0xd9, 0xfa, 0xc9, 0x19,
-	0x89, 0x99, 0x79, 0xfa, 0x15, 0x10, 0x6f, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x3d,
-	0x6c, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x79, 0x5b, 0xc5, 0x7a, 0x01, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcd, 0x4e, 0xf2, 0x40,
+	0x14, 0x86, 0x5b, 0xe0, 0x23, 0x9f, 0x03, 0x1b, 0x1b, 0x12, 0xb1, 0xd1, 0x01, 0x5d, 0xe1, 0xa6,
+	0x13, 0x70, 0x61, 0xe2, 0x12, 0x62, 0x5c, 0x68, 0x8c, 0xa9, 0xd1, 0x04, 0x37, 0xa4, 0xe0, 0xa4,
+	0x34, 0x94, 0x9e, 0xa6, 0x3d, 0x83, 0x72, 0x17, 0x5e, 0x93, 0x2b, 0x96, 0x2c, 0x5d, 0x19, 0x03,
+	0x37, 0x62, 0xe6, 0x67, 0x63, 0x68, 0xdc, 0x35, 0x73, 0x9e, 0xf7, 0x99, 0xb7, 0x73, 0x48, 0x6b,
+	0xb6, 0x5c, 0x70, 0x96, 0x02, 0xc4, 0x6c, 0xd1, 0x1d, 0x73, 0x0c, 0xba, 0x2c, 0xe4, 0x09, 0xcf,
+	0xa3, 0xdc, 0x4b, 0x33, 0x40, 0x70, 0xf6, 0x25, 0xe0, 0x49, 0xc0, 0x33, 0x80, 0xdb, 0x08, 0x21,
+	0x04, 0x35, 0x65, 0xf2, 0x4b, 0x83, 0x2e, 0xdd, 0x35, 0xa5, 0x41, 0x16, 0xcc, 0x8d, 0xc8, 0x3d,
+	0x2a, 0x98, 0x4b, 0xab, 0x9e, 0x16, 0xf4, 0xc8, 0x20, 0x8e, 0x41, 0xe0, 0x1f, 0x80, 0x48, 0x30,
+	0x9a, 0x73, 0x0d, 0x9c, 0x7e, 0x94, 0x48, 0xfd, 0x5a, 0x57, 0x7f, 0xc0, 0x00, 0xb9, 0x73, 0x41,
+	0xaa, 0xba, 0x40, 0xd3, 0x6e, 0xdb, 0x9d, 0x5a, 0xef, 0xd0, 0xdb, 0xf9, 0x15, 0xef, 0x5e, 0x01,
+	0xfd, 0xca, 0xea, 0xab, 0x65, 0xf9, 0x06, 0x77, 0x2e, 0xc9, 0x9e, 0x84, 0x46, 0x71, 0x94, 0x63,
+	0xb3, 0xd4, 0x2e, 0x77, 0x6a, 0xbd, 0x83, 0xa2, 0x2c, 0x40, 0x6c, 0x92, 0xff, 0xe5, 0xe0, 0x36,
+	0xca, 0xd1, 0x39, 0x26, 0x44, 0x65, 0x27, 0x20, 0x12, 0x6c, 0x96, 0xdb, 0x76, 0xa7, 0xe2, 0x2b,
+	0xdb, 0x40, 0x1e, 0x38, 0x03, 0x52, 0x37, 0xad, 0xb5, 0xbd, 0xa2, 0xec, 0x6e, 0x81, 0xdd, 0xd7,
+	0x98, 0xb9, 0xa0, 0x66, 0x52, 0xea, 0x8e, 0x21, 0x69, 0x88, 0x34, 0xcc, 0x82, 0x17, 0x3e, 0x32,
+	0x6f, 0xa4, 0x65, 0xff, 0x94, 0xec, 0xa4, 0x40, 0xf6, 0xa8, 0x71, 0x5f, 0xd3, 0xc6, 0xe9, 0x88,
+	0x5f, 0xa7, 0x52, 0xdd, 0x1f, 0xac, 0x36, 0xd4, 0x5e, 0x6f, 0xa8, 0xfd, 0xbd, 0xa1, 0xf6, 0xfb,
+	0x96, 0x5a, 0xeb, 0x2d, 0xb5, 0x3e, 0xb7, 0xd4, 0x7a, 0x3e, 0x0b, 0x23, 0x9c, 0x8a, 0xb1, 0x37,
+	0x81, 0x39, 0xbb, 0x19, 0x3e, 0x5d, 0xdd, 0x71, 0x7c, 0x85, 0x6c, 0xc6, 0x26, 0xd3, 0x20, 0x4a,
+	0xd8, 0x9b, 0xde, 0x0c, 0x2e, 0x53, 0x9e, 0x8f, 0xab, 0x6a, 0x21, 0xe7, 0x3f, 0x03, 0x00, 0xbf,
+	0xf3, 0xfa, 0xbc, 0x5c, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -134,6 +157,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UpgradeRolloutList) > 0 {
+		for iNdEx := len(m.UpgradeRolloutList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpgradeRolloutList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RuntimeList) > 0 {
+		for iNdEx := len(m.RuntimeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.PoolCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolCount))
 		i--
@@ -194,6 +245,18 @@ func (m *GenesisState) Size() (n int) {
 	if m.PoolCount != 0 {
 		n += 1 + sovGenesis(uint64(m.PoolCount))
 	}
+	if len(m.RuntimeList) > 0 {
+		for _, e := range m.RuntimeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UpgradeRolloutList) > 0 {
+		for _, e := range m.UpgradeRolloutList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -318,6 +381,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeList = append(m.RuntimeList, Runtime{})
+			if err := m.RuntimeList[len(m.RuntimeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeRolloutList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeRolloutList = append(m.UpgradeRolloutList, UpgradeRollout{})
+			if err := m.UpgradeRolloutList[len(m.UpgradeRolloutList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -11,6 +14,10 @@ const (
 	ProposalTypeUnpausePool         = "UnpausePool"
 	ProposalTypeSchedulePoolUpgrade = "SchedulePoolUpgrade"
 	ProposalTypeCancelPoolUpgrade   = "CancelPoolUpgrade"
+	ProposalTypeResetPool           = "ResetPool"
+	ProposalTypeCreateRuntime       = "CreateRuntime"
+	ProposalTypeUpdateRuntime       = "UpdateRuntime"
+	ProposalTypeDeprecateRuntime    = "DeprecateRuntime"
 )
 
 func init() {
@@ -26,6 +33,14 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(&SchedulePoolUpgradeProposal{}, "kyve/SchedulePoolUpgradeProposal")
 	govtypes.RegisterProposalType(ProposalTypeCancelPoolUpgrade)
 	govtypes.RegisterProposalTypeCodec(&CancelPoolUpgradeProposal{}, "kyve/CancelPoolUpgradeProposal")
+	govtypes.RegisterProposalType(ProposalTypeResetPool)
+	govtypes.RegisterProposalTypeCodec(&ResetPoolProposal{}, "kyve/ResetPoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeCreateRuntime)
+	govtypes.RegisterProposalTypeCodec(&CreateRuntimeProposal{}, "kyve/CreateRuntimeProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateRuntime)
+	govtypes.RegisterProposalTypeCodec(&UpdateRuntimeProposal{}, "kyve/UpdateRuntimeProposal")
+	govtypes.RegisterProposalType(ProposalTypeDeprecateRuntime)
+	govtypes.RegisterProposalTypeCodec(&DeprecateRuntimeProposal{}, "kyve/DeprecateRuntimeProposal")
 }
 
 var (
@@ -35,9 +50,13 @@ var (
 	_ govtypes.Content = &UnpausePoolProposal{}
 	_ govtypes.Content = &SchedulePoolUpgradeProposal{}
 	_ govtypes.Content = &CancelPoolUpgradeProposal{}
+	_ govtypes.Content = &ResetPoolProposal{}
+	_ govtypes.Content = &CreateRuntimeProposal{}
+	_ govtypes.Content = &UpdateRuntimeProposal{}
+	_ govtypes.Content = &DeprecateRuntimeProposal{}
 )
 
-func NewCreatePoolProposal(title string, description string, name string, runtime string, logo string, config string, startKey string, uploadInterval uint64, operatingCost uint64, minStake uint64, maxBundleSize uint64, version string, binaries string) govtypes.Content {
+func NewCreatePoolProposal(title string, description string, name string, runtime string, logo string, config string, startKey string, uploadInterval uint64, operatingCost uint64, minStake uint64, maxBundleSize uint64, version string, binaries string, validQuorum string, invalidQuorum string, uploadTimeout uint64, maxPoints uint64, chargingMode ChargingMode, admin string, funderAllowList []string, maxFunders uint64, disableFunderEviction bool, commitRevealVoting bool) govtypes.Content {
 	return &CreatePoolProposal{
 		Title:          title,
 		Description:    description,
@@ -52,6 +71,17 @@ func NewCreatePoolProposal(title string, description string, name string, runtim
 		MaxBundleSize:  maxBundleSize,
 		Version:        version,
 		Binaries:       binaries,
+		ValidQuorum:    validQuorum,
+		InvalidQuorum:  invalidQuorum,
+		UploadTimeout:  uploadTimeout,
+		MaxPoints:      maxPoints,
+		ChargingMode:   chargingMode,
+
+		Admin:                 admin,
+		FunderAllowList:       funderAllowList,
+		MaxFunders:            maxFunders,
+		DisableFunderEviction: disableFunderEviction,
+		CommitRevealVoting:    commitRevealVoting,
 	}
 }
 
@@ -67,10 +97,43 @@ func (p *CreatePoolProposal) ValidateBasic() error {
 		return err
 	}
 
+	if _, _, err := ParseQuorums(p.ValidQuorum, p.InvalidQuorum); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidQuorum.Error(), err)
+	}
+
+	if err := ValidateChargingMode(p.ChargingMode); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateAdmin(p.Admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := ValidateFunderAllowList(p.FunderAllowList); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateMaxFunders(p.MaxFunders); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
 
-func NewUpdatePoolProposal(title string, description string, id uint64, payload string) govtypes.Content {
+func NewUpdatePoolProposal(title string, description string, id uint64, updateMask []string, update PoolUpdate) govtypes.Content {
+	return &UpdatePoolProposal{
+		Title:       title,
+		Description: description,
+		Id:          id,
+		UpdateMask:  updateMask,
+		Update:      update,
+	}
+}
+
+// NewLegacyUpdatePoolProposal creates an UpdatePoolProposal from a JSON payload.
+//
+// Deprecated: use NewUpdatePoolProposal instead.
+func NewLegacyUpdatePoolProposal(title string, description string, id uint64, payload string) govtypes.Content {
 	return &UpdatePoolProposal{
 		Title:       title,
 		Description: description,
@@ -91,6 +154,10 @@ func (p *UpdatePoolProposal) ValidateBasic() error {
 		return err
 	}
 
+	if _, _, err := p.GetPoolUpdate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidPoolUpdate.Error(), err)
+	}
+
 	return nil
 }
 
@@ -140,15 +207,18 @@ func (p *UnpausePoolProposal) ValidateBasic() error {
 	return nil
 }
 
-func NewSchedulePoolUpgradeProposal(title string, description string, runtime string, version string, scheduledAt uint64, duration uint64, binaries string) govtypes.Content {
+func NewSchedulePoolUpgradeProposal(title string, description string, runtime string, version string, scheduledAt uint64, duration uint64, binaries string, poolIds []uint64, canaryPoolId uint64, canaryBundles uint64) govtypes.Content {
 	return &SchedulePoolUpgradeProposal{
-		Title:       title,
-		Description: description,
-		Runtime:     runtime,
-		Version:     version,
-		ScheduledAt: scheduledAt,
-		Duration:    duration,
-		Binaries:    binaries,
+		Title:         title,
+		Description:   description,
+		Runtime:       runtime,
+		Version:       version,
+		ScheduledAt:   scheduledAt,
+		Duration:      duration,
+		Binaries:      binaries,
+		PoolIds:       poolIds,
+		CanaryPoolId:  canaryPoolId,
+		CanaryBundles: canaryBundles,
 	}
 }
 
@@ -164,6 +234,25 @@ func (p *SchedulePoolUpgradeProposal) ValidateBasic() error {
 		return err
 	}
 
+	poolIds := make(map[uint64]bool)
+	for _, poolId := range p.PoolIds {
+		if poolIds[poolId] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidPoolUpgrade.Error(), fmt.Sprintf("pool %v is targeted more than once", poolId))
+		}
+		poolIds[poolId] = true
+	}
+
+	if p.CanaryBundles > 0 {
+		if poolIds[p.CanaryPoolId] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidPoolUpgrade.Error(), "canary pool must not be contained in the pool ids")
+		}
+
+		// The canary pool needs time to finalize bundles after its upgrade
+		if p.Duration == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidPoolUpgrade.Error(), "staged rollouts require a duration")
+		}
+	}
+
 	return nil
 }
 
@@ -189,3 +278,114 @@ func (p *CancelPoolUpgradeProposal) ValidateBasic() error {
 
 	return nil
 }
+
+func NewResetPoolProposal(title string, description string, id uint64, bundleId uint64) govtypes.Content {
+	return &ResetPoolProposal{
+		Title:       title,
+		Description: description,
+		Id:          id,
+		BundleId:    bundleId,
+	}
+}
+
+func (p *ResetPoolProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ResetPoolProposal) ProposalType() string {
+	return ProposalTypeResetPool
+}
+
+func (p *ResetPoolProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewCreateRuntimeProposal(title string, description string, name string, configSchema string, versions []RuntimeVersion) govtypes.Content {
+	return &CreateRuntimeProposal{
+		Title:        title,
+		Description:  description,
+		Name:         name,
+		ConfigSchema: configSchema,
+		Versions:     versions,
+	}
+}
+
+func (p *CreateRuntimeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *CreateRuntimeProposal) ProposalType() string {
+	return ProposalTypeCreateRuntime
+}
+
+func (p *CreateRuntimeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	runtime := Runtime{Name: p.Name, ConfigSchema: p.ConfigSchema, Versions: p.Versions}
+	if err := runtime.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidRuntime.Error(), err)
+	}
+
+	return nil
+}
+
+func NewUpdateRuntimeProposal(title string, description string, name string, configSchema string, versions []RuntimeVersion) govtypes.Content {
+	return &UpdateRuntimeProposal{
+		Title:        title,
+		Description:  description,
+		Name:         name,
+		ConfigSchema: configSchema,
+		Versions:     versions,
+	}
+}
+
+func (p *UpdateRuntimeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateRuntimeProposal) ProposalType() string {
+	return ProposalTypeUpdateRuntime
+}
+
+func (p *UpdateRuntimeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	runtime := Runtime{Name: p.Name, ConfigSchema: p.ConfigSchema, Versions: p.Versions}
+	if err := runtime.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidRuntime.Error(), err)
+	}
+
+	return nil
+}
+
+func NewDeprecateRuntimeProposal(title string, description string, name string) govtypes.Content {
+	return &DeprecateRuntimeProposal{
+		Title:       title,
+		Description: description,
+		Name:        name,
+	}
+}
+
+func (p *DeprecateRuntimeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *DeprecateRuntimeProposal) ProposalType() string {
+	return ProposalTypeDeprecateRuntime
+}
+
+func (p *DeprecateRuntimeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.Name == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidRuntime.Error(), "runtime name is empty")
+	}
+
+	return nil
+}
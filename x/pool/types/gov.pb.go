@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -50,6 +51,26 @@ type CreatePoolProposal struct {
 	Version string `protobuf:"bytes,12,opt,name=version,proto3" json:"version,omitempty"`
 	// binaries ...
 	Binaries string `protobuf:"bytes,13,opt,name=binaries,proto3" json:"binaries,omitempty"`
+	// valid_quorum ...
+	ValidQuorum string `protobuf:"bytes,14,opt,name=valid_quorum,json=validQuorum,proto3" json:"valid_quorum,omitempty"`
+	// invalid_quorum ...
+	InvalidQuorum string `protobuf:"bytes,15,opt,name=invalid_quorum,json=invalidQuorum,proto3" json:"invalid_quorum,omitempty"`
+	// upload_timeout ...
+	UploadTimeout uint64 `protobuf:"varint,16,opt,name=upload_timeout,json=uploadTimeout,proto3" json:"upload_timeout,omitempty"`
+	// max_points ...
+	MaxPoints uint64 `protobuf:"varint,17,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	// charging_mode ...
+	ChargingMode ChargingMode `protobuf:"varint,18,opt,name=charging_mode,json=chargingMode,proto3,enum=kyve.pool.v1beta1.ChargingMode" json:"charging_mode,omitempty"`
+	// admin ...
+	Admin string `protobuf:"bytes,19,opt,name=admin,proto3" json:"admin,omitempty"`
+	// funder_allow_list ...
+	FunderAllowList []string `protobuf:"bytes,20,rep,name=funder_allow_list,json=funderAllowList,proto3" json:"funder_allow_list,omitempty"`
+	// max_funders ...
+	MaxFunders uint64 `protobuf:"varint,21,opt,name=max_funders,json=maxFunders,proto3" json:"max_funders,omitempty"`
+	// disable_funder_eviction ...
+	DisableFunderEviction bool `protobuf:"varint,22,opt,name=disable_funder_eviction,json=disableFunderEviction,proto3" json:"disable_funder_eviction,omitempty"`
+	// commit_reveal_voting ...
+	CommitRevealVoting bool `protobuf:"varint,23,opt,name=commit_reveal_voting,json=commitRevealVoting,proto3" json:"commit_reveal_voting,omitempty"`
 }
 
 func (m *CreatePoolProposal) Reset()         { *m = CreatePoolProposal{} }
@@ -176,6 +197,76 @@ func (m *CreatePoolProposal) GetBinaries() string {
 	return ""
 }
 
+func (m *CreatePoolProposal) GetValidQuorum() string {
+	if m != nil {
+		return m.ValidQuorum
+	}
+	return ""
+}
+
+func (m *CreatePoolProposal) GetInvalidQuorum() string {
+	if m != nil {
+		return m.InvalidQuorum
+	}
+	return ""
+}
+
+func (m *CreatePoolProposal) GetUploadTimeout() uint64 {
+	if m != nil {
+		return m.UploadTimeout
+	}
+	return 0
+}
+
+func (m *CreatePoolProposal) GetMaxPoints() uint64 {
+	if m != nil {
+		return m.MaxPoints
+	}
+	return 0
+}
+
+func (m *CreatePoolProposal) GetChargingMode() ChargingMode {
+	if m != nil {
+		return m.ChargingMode
+	}
+	return CHARGING_MODE_EQUAL
+}
+
+func (m *CreatePoolProposal) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *CreatePoolProposal) GetFunderAllowList() []string {
+	if m != nil {
+		return m.FunderAllowList
+	}
+	return nil
+}
+
+func (m *CreatePoolProposal) GetMaxFunders() uint64 {
+	if m != nil {
+		return m.MaxFunders
+	}
+	return 0
+}
+
+func (m *CreatePoolProposal) GetDisableFunderEviction() bool {
+	if m != nil {
+		return m.DisableFunderEviction
+	}
+	return false
+}
+
+func (m *CreatePoolProposal) GetCommitRevealVoting() bool {
+	if m != nil {
+		return m.CommitRevealVoting
+	}
+	return false
+}
+
 // UpdatePoolProposal is a gov Content type for updating a pool.
 type UpdatePoolProposal struct {
 	// title ...
@@ -184,8 +275,14 @@ type UpdatePoolProposal struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// id ...
 	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
-	// payload
-	Payload string `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// payload is a JSON object with the fields to update. It is deprecated in
+	// favour of update_mask and update and can not be combined with them.
+	Payload string `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"` // Deprecated: Do not use.
+	// update_mask contains the names of the fields of update which are applied
+	// to the pool, e.g. "upload_interval".
+	UpdateMask []string `protobuf:"bytes,5,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// update contains the new values of the fields in update_mask.
+	Update PoolUpdate `protobuf:"bytes,6,opt,name=update,proto3" json:"update"`
 }
 
 func (m *UpdatePoolProposal) Reset()         { *m = UpdatePoolProposal{} }
@@ -242,6 +339,7 @@ func (m *UpdatePoolProposal) GetId() uint64 {
 	return 0
 }
 
+// Deprecated: Do not use.
 func (m *UpdatePoolProposal) GetPayload() string {
 	if m != nil {
 		return m.Payload
@@ -249,6 +347,219 @@ func (m *UpdatePoolProposal) GetPayload() string {
 	return ""
 }
 
+func (m *UpdatePoolProposal) GetUpdateMask() []string {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+func (m *UpdatePoolProposal) GetUpdate() PoolUpdate {
+	if m != nil {
+		return m.Update
+	}
+	return PoolUpdate{}
+}
+
+// PoolUpdate contains the fields of a pool which can be changed by governance.
+type PoolUpdate struct {
+	// name ...
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// runtime ...
+	Runtime string `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// logo ...
+	Logo string `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`
+	// config ...
+	Config string `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	// upload_interval ...
+	UploadInterval uint64 `protobuf:"varint,5,opt,name=upload_interval,json=uploadInterval,proto3" json:"upload_interval,omitempty"`
+	// operating_cost ...
+	OperatingCost uint64 `protobuf:"varint,6,opt,name=operating_cost,json=operatingCost,proto3" json:"operating_cost,omitempty"`
+	// min_stake ...
+	MinStake uint64 `protobuf:"varint,7,opt,name=min_stake,json=minStake,proto3" json:"min_stake,omitempty"`
+	// max_bundle_size ...
+	MaxBundleSize uint64 `protobuf:"varint,8,opt,name=max_bundle_size,json=maxBundleSize,proto3" json:"max_bundle_size,omitempty"`
+	// commit_reveal_voting ...
+	CommitRevealVoting bool `protobuf:"varint,9,opt,name=commit_reveal_voting,json=commitRevealVoting,proto3" json:"commit_reveal_voting,omitempty"`
+	// valid_quorum ...
+	ValidQuorum string `protobuf:"bytes,10,opt,name=valid_quorum,json=validQuorum,proto3" json:"valid_quorum,omitempty"`
+	// invalid_quorum ...
+	InvalidQuorum string `protobuf:"bytes,11,opt,name=invalid_quorum,json=invalidQuorum,proto3" json:"invalid_quorum,omitempty"`
+	// upload_timeout ...
+	UploadTimeout uint64 `protobuf:"varint,12,opt,name=upload_timeout,json=uploadTimeout,proto3" json:"upload_timeout,omitempty"`
+	// max_points ...
+	MaxPoints uint64 `protobuf:"varint,13,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	// charging_mode ...
+	ChargingMode ChargingMode `protobuf:"varint,14,opt,name=charging_mode,json=chargingMode,proto3,enum=kyve.pool.v1beta1.ChargingMode" json:"charging_mode,omitempty"`
+	// admin ...
+	Admin string `protobuf:"bytes,15,opt,name=admin,proto3" json:"admin,omitempty"`
+	// funder_allow_list ...
+	FunderAllowList []string `protobuf:"bytes,16,rep,name=funder_allow_list,json=funderAllowList,proto3" json:"funder_allow_list,omitempty"`
+	// max_funders ...
+	MaxFunders uint64 `protobuf:"varint,17,opt,name=max_funders,json=maxFunders,proto3" json:"max_funders,omitempty"`
+	// disable_funder_eviction ...
+	DisableFunderEviction bool `protobuf:"varint,18,opt,name=disable_funder_eviction,json=disableFunderEviction,proto3" json:"disable_funder_eviction,omitempty"`
+}
+
+func (m *PoolUpdate) Reset()         { *m = PoolUpdate{} }
+func (m *PoolUpdate) String() string { return proto.CompactTextString(m) }
+func (*PoolUpdate) ProtoMessage()    {}
+func (*PoolUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adce52e9478669ec, []int{2}
+}
+func (m *PoolUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolUpdate.Merge(m, src)
+}
+func (m *PoolUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolUpdate proto.InternalMessageInfo
+
+func (m *PoolUpdate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PoolUpdate) GetRuntime() string {
+	if m != nil {
+		return m.Runtime
+	}
+	return ""
+}
+
+func (m *PoolUpdate) GetLogo() string {
+	if m != nil {
+		return m.Logo
+	}
+	return ""
+}
+
+func (m *PoolUpdate) GetConfig() string {
+	if m != nil {
+		return m.Config
+	}
+	return ""
+}
+
+func (m *PoolUpdate) GetUploadInterval() uint64 {
+	if m != nil {
+		return m.UploadInterval
+	}
+	return 0
+}
+
+func (m *PoolUpdate) GetOperatingCost() uint64 {
+	if m != nil {
+		return m.OperatingCost
+	}
+	return 0
+}
+
+func (m *PoolUpdate) GetMinStake() uint64 {
+	if m != nil {
+		return m.MinStake
+	}
+	return 0
+}
+
+func (m *PoolUpdate) GetMaxBundleSize() uint64 {
+	if m != nil {
+		return m.MaxBundleSize
+	}
+	return 0
+}
+
+func (m *PoolUpdate) GetCommitRevealVoting() bool {
+	if m != nil {
+		return m.CommitRevealVoting
+	}
+	return false
+}
+
+func (m *PoolUpdate) GetValidQuorum() string {
+	if m != nil {
+		return m.ValidQuorum
+	}
+	return ""
+}
+
+func (m *PoolUpdate) GetInvalidQuorum() string {
+	if m != nil {
+		return m.InvalidQuorum
+	}
+	return ""
+}
+
+func (m *PoolUpdate) GetUploadTimeout() uint64 {
+	if m != nil {
+		return m.UploadTimeout
+	}
+	return 0
+}
+
+func (m *PoolUpdate) GetMaxPoints() uint64 {
+	if m != nil {
+		return m.MaxPoints
+	}
+	return 0
+}
+
+func (m *PoolUpdate) GetChargingMode() ChargingMode {
+	if m != nil {
+		return m.ChargingMode
+	}
+	return CHARGING_MODE_EQUAL
+}
+
+func (m *PoolUpdate) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *PoolUpdate) GetFunderAllowList() []string {
+	if m != nil {
+		return m.FunderAllowList
+	}
+	return nil
+}
+
+func (m *PoolUpdate) GetMaxFunders() uint64 {
+	if m != nil {
+		return m.MaxFunders
+	}
+	return 0
+}
+
+func (m *PoolUpdate) GetDisableFunderEviction() bool {
+	if m != nil {
+		return m.DisableFunderEviction
+	}
+	return false
+}
+
 // PausePoolProposal is a gov Content type for pausing a pool.
 type PausePoolProposal struct {
 	// title ...
@@ -263,7 +574,7 @@ func (m *PausePoolProposal) Reset()         { *m = PausePoolProposal{} }
 func (m *PausePoolProposal) String() string { return proto.CompactTextString(m) }
 func (*PausePoolProposal) ProtoMessage()    {}
 func (*PausePoolProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_adce52e9478669ec, []int{2}
+	return fileDescriptor_adce52e9478669ec, []int{3}
 }
 func (m *PausePoolProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,7 +638,7 @@ func (m *UnpausePoolProposal) Reset()         { *m = UnpausePoolProposal{} }
 func (m *UnpausePoolProposal) String() string { return proto.CompactTextString(m) }
 func (*UnpausePoolProposal) ProtoMessage()    {}
 func (*UnpausePoolProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_adce52e9478669ec, []int{3}
+	return fileDescriptor_adce52e9478669ec, []int{4}
 }
 func (m *UnpausePoolProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,13 +704,23 @@ type SchedulePoolUpgradeProposal struct {
 	Duration uint64 `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
 	// binaries ...
 	Binaries string `protobuf:"bytes,7,opt,name=binaries,proto3" json:"binaries,omitempty"`
+	// pool_ids are the pools which get upgraded. If empty, all pools of the
+	// runtime get upgraded.
+	PoolIds []uint64 `protobuf:"varint,8,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+	// canary_pool_id is the pool which gets upgraded first if canary_bundles
+	// is greater than zero. It must not be contained in pool_ids.
+	CanaryPoolId uint64 `protobuf:"varint,9,opt,name=canary_pool_id,json=canaryPoolId,proto3" json:"canary_pool_id,omitempty"`
+	// canary_bundles is the number of bundles the canary pool has to finalize
+	// within the upgrade duration before the upgrade is promoted to the other
+	// pools. Zero disables the staged rollout.
+	CanaryBundles uint64 `protobuf:"varint,10,opt,name=canary_bundles,json=canaryBundles,proto3" json:"canary_bundles,omitempty"`
 }
 
 func (m *SchedulePoolUpgradeProposal) Reset()         { *m = SchedulePoolUpgradeProposal{} }
 func (m *SchedulePoolUpgradeProposal) String() string { return proto.CompactTextString(m) }
 func (*SchedulePoolUpgradeProposal) ProtoMessage()    {}
 func (*SchedulePoolUpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_adce52e9478669ec, []int{4}
+	return fileDescriptor_adce52e9478669ec, []int{5}
 }
 func (m *SchedulePoolUpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -477,6 +798,27 @@ func (m *SchedulePoolUpgradeProposal) GetBinaries() string {
 	return ""
 }
 
+func (m *SchedulePoolUpgradeProposal) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+func (m *SchedulePoolUpgradeProposal) GetCanaryPoolId() uint64 {
+	if m != nil {
+		return m.CanaryPoolId
+	}
+	return 0
+}
+
+func (m *SchedulePoolUpgradeProposal) GetCanaryBundles() uint64 {
+	if m != nil {
+		return m.CanaryBundles
+	}
+	return 0
+}
+
 // CancelPoolUpgradeProposal is a gov Content type for cancelling a scheduled pool upgrade by the runtime.
 type CancelPoolUpgradeProposal struct {
 	// title ...
@@ -491,7 +833,7 @@ func (m *CancelPoolUpgradeProposal) Reset()         { *m = CancelPoolUpgradeProp
 func (m *CancelPoolUpgradeProposal) String() string { return proto.CompactTextString(m) }
 func (*CancelPoolUpgradeProposal) ProtoMessage()    {}
 func (*CancelPoolUpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_adce52e9478669ec, []int{5}
+	return fileDescriptor_adce52e9478669ec, []int{6}
 }
 func (m *CancelPoolUpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -541,7 +883,7 @@ func (m *CancelPoolUpgradeProposal) GetRuntime() string {
 	return ""
 }
 
-// ResetPoolProposal is a gov Content type for cancelling a scheduled pool upgrade by the runtime.
+// ResetPoolProposal is a gov Content type for resetting a pool to an earlier finalized bundle.
 type ResetPoolProposal struct {
 	// title ...
 	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -557,7 +899,7 @@ func (m *ResetPoolProposal) Reset()         { *m = ResetPoolProposal{} }
 func (m *ResetPoolProposal) String() string { return proto.CompactTextString(m) }
 func (*ResetPoolProposal) ProtoMessage()    {}
 func (*ResetPoolProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_adce52e9478669ec, []int{6}
+	return fileDescriptor_adce52e9478669ec, []int{7}
 }
 func (m *ResetPoolProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -614,69 +956,333 @@ func (m *ResetPoolProposal) GetBundleId() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*CreatePoolProposal)(nil), "kyve.pool.v1beta1.CreatePoolProposal")
-	proto.RegisterType((*UpdatePoolProposal)(nil), "kyve.pool.v1beta1.UpdatePoolProposal")
-	proto.RegisterType((*PausePoolProposal)(nil), "kyve.pool.v1beta1.PausePoolProposal")
-	proto.RegisterType((*UnpausePoolProposal)(nil), "kyve.pool.v1beta1.UnpausePoolProposal")
-	proto.RegisterType((*SchedulePoolUpgradeProposal)(nil), "kyve.pool.v1beta1.SchedulePoolUpgradeProposal")
-	proto.RegisterType((*CancelPoolUpgradeProposal)(nil), "kyve.pool.v1beta1.CancelPoolUpgradeProposal")
-	proto.RegisterType((*ResetPoolProposal)(nil), "kyve.pool.v1beta1.ResetPoolProposal")
+// CreateRuntimeProposal is a gov Content type for registering a runtime.
+type CreateRuntimeProposal struct {
+	// title ...
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description ...
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// name ...
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// config_schema ...
+	ConfigSchema string `protobuf:"bytes,4,opt,name=config_schema,json=configSchema,proto3" json:"config_schema,omitempty"`
+	// versions ...
+	Versions []RuntimeVersion `protobuf:"bytes,5,rep,name=versions,proto3" json:"versions"`
 }
 
-func init() { proto.RegisterFile("kyve/pool/v1beta1/gov.proto", fileDescriptor_adce52e9478669ec) }
-
-var fileDescriptor_adce52e9478669ec = []byte{
-	// 541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
-	0x18, 0x5d, 0xb6, 0xac, 0x3f, 0xee, 0xd6, 0xa9, 0x06, 0x21, 0x43, 0xa5, 0xa8, 0x54, 0x02, 0xc6,
-	0x4d, 0xa3, 0x89, 0x27, 0x60, 0x15, 0x17, 0xd3, 0x24, 0x54, 0xa5, 0x2a, 0x12, 0x20, 0x14, 0xb9,
-	0xc9, 0x47, 0x6a, 0x35, 0xb1, 0x23, 0xdb, 0x09, 0xed, 0x1e, 0x02, 0xf1, 0x58, 0x5c, 0xee, 0x92,
-	0x4b, 0xd4, 0xf2, 0x20, 0x28, 0x4e, 0x5a, 0xb5, 0xdc, 0x42, 0xb9, 0xf3, 0x39, 0xe7, 0x93, 0x3f,
-	0x7f, 0x3e, 0xc7, 0x46, 0xdd, 0xf9, 0x32, 0x07, 0x37, 0x15, 0x22, 0x76, 0xf3, 0xab, 0x29, 0x68,
-	0x7a, 0xe5, 0x46, 0x22, 0x1f, 0xa4, 0x52, 0x68, 0x81, 0x3b, 0x85, 0x38, 0x28, 0xc4, 0x41, 0x25,
-	0xf6, 0xbf, 0x9e, 0x20, 0x3c, 0x94, 0x40, 0x35, 0x8c, 0x84, 0x88, 0x47, 0x52, 0xa4, 0x42, 0xd1,
-	0x18, 0x3f, 0x44, 0xa7, 0x9a, 0xe9, 0x18, 0x88, 0xd5, 0xb3, 0x2e, 0x9b, 0x5e, 0x09, 0x70, 0x0f,
-	0xb5, 0x42, 0x50, 0x81, 0x64, 0xa9, 0x66, 0x82, 0x93, 0x63, 0xa3, 0xed, 0x52, 0x18, 0x23, 0x9b,
-	0xd3, 0x04, 0xc8, 0x89, 0x91, 0xcc, 0x1a, 0x13, 0x54, 0x97, 0x19, 0xd7, 0x2c, 0x01, 0x62, 0x1b,
-	0x7a, 0x03, 0x8b, 0xea, 0x58, 0x44, 0x82, 0x9c, 0x96, 0xd5, 0xc5, 0x1a, 0x3f, 0x42, 0xb5, 0x40,
-	0xf0, 0xcf, 0x2c, 0x22, 0x35, 0xc3, 0x56, 0x08, 0x77, 0x51, 0x53, 0x69, 0x2a, 0xb5, 0x3f, 0x87,
-	0x25, 0xa9, 0x1b, 0xa9, 0x61, 0x88, 0x5b, 0x58, 0xe2, 0x17, 0xe8, 0x22, 0x4b, 0x63, 0x41, 0x43,
-	0x9f, 0x71, 0x0d, 0x32, 0xa7, 0x31, 0x69, 0xf4, 0xac, 0x4b, 0xdb, 0x6b, 0x97, 0xf4, 0x4d, 0xc5,
-	0xe2, 0x67, 0xa8, 0x2d, 0x52, 0x90, 0x54, 0x33, 0x1e, 0xf9, 0x81, 0x50, 0x9a, 0x34, 0x4d, 0xdd,
-	0xf9, 0x96, 0x1d, 0x0a, 0xa5, 0x8b, 0x66, 0x09, 0xe3, 0xbe, 0xd2, 0x74, 0x0e, 0x04, 0x99, 0x8a,
-	0x46, 0xc2, 0xf8, 0xb8, 0xc0, 0xf8, 0x39, 0xba, 0x48, 0xe8, 0xc2, 0x9f, 0x66, 0x3c, 0x8c, 0xc1,
-	0x57, 0xec, 0x0e, 0x48, 0xab, 0xdc, 0x24, 0xa1, 0x8b, 0x6b, 0xc3, 0x8e, 0xd9, 0x9d, 0x99, 0x3b,
-	0x07, 0xa9, 0x8a, 0x9b, 0x3a, 0x2b, 0xe7, 0xae, 0x20, 0x7e, 0x82, 0x1a, 0x53, 0xc6, 0xa9, 0x64,
-	0xa0, 0xc8, 0x79, 0x39, 0xca, 0x06, 0xf7, 0x73, 0x84, 0x27, 0x69, 0xf8, 0xaf, 0xfc, 0x68, 0xa3,
-	0x63, 0x16, 0x1a, 0x37, 0x6c, 0xef, 0x98, 0x85, 0xc5, 0x99, 0x52, 0xba, 0x2c, 0xae, 0x64, 0xe3,
-	0x45, 0x05, 0xfb, 0x1f, 0x51, 0x67, 0x44, 0x33, 0x75, 0x90, 0xb6, 0xfd, 0x4f, 0xe8, 0xc1, 0x84,
-	0xa7, 0x07, 0xdb, 0xfe, 0x97, 0x85, 0xba, 0xe3, 0x60, 0x06, 0x61, 0x16, 0x9b, 0x06, 0x93, 0x34,
-	0x92, 0x34, 0x84, 0xbf, 0xee, 0xb3, 0x93, 0xdc, 0x93, 0xfd, 0xe4, 0xee, 0x78, 0x6b, 0xef, 0x7b,
-	0xfb, 0x14, 0x9d, 0xa9, 0xea, 0x28, 0xa1, 0x4f, 0xb5, 0xc9, 0xb6, 0xed, 0xb5, 0xb6, 0xdc, 0x6b,
-	0x5d, 0xd8, 0x1f, 0x66, 0x45, 0xda, 0x04, 0x37, 0x21, 0xb7, 0xbd, 0x2d, 0xde, 0x8b, 0x46, 0xfd,
-	0x8f, 0x68, 0x24, 0xe8, 0xf1, 0x90, 0xf2, 0x00, 0xe2, 0xff, 0x32, 0x63, 0x7f, 0x81, 0x3a, 0x1e,
-	0x28, 0xd0, 0x07, 0x09, 0x62, 0x17, 0x35, 0xab, 0x07, 0xc4, 0xca, 0x28, 0xda, 0x5e, 0xa3, 0x24,
-	0x6e, 0xc2, 0xeb, 0xe1, 0xf7, 0x95, 0x63, 0xdd, 0xaf, 0x1c, 0xeb, 0xe7, 0xca, 0xb1, 0xbe, 0xad,
-	0x9d, 0xa3, 0xfb, 0xb5, 0x73, 0xf4, 0x63, 0xed, 0x1c, 0x7d, 0x78, 0x19, 0x31, 0x3d, 0xcb, 0xa6,
-	0x83, 0x40, 0x24, 0xee, 0xed, 0xfb, 0x77, 0x6f, 0xde, 0x82, 0xfe, 0x22, 0xe4, 0xdc, 0x0d, 0x66,
-	0x94, 0x71, 0x77, 0x51, 0x7e, 0x7c, 0x7a, 0x99, 0x82, 0x9a, 0xd6, 0xcc, 0x9f, 0xf7, 0xea, 0x77,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x81, 0x08, 0x4a, 0x8f, 0x12, 0x05, 0x00, 0x00,
+func (m *CreateRuntimeProposal) Reset()         { *m = CreateRuntimeProposal{} }
+func (m *CreateRuntimeProposal) String() string { return proto.CompactTextString(m) }
+func (*CreateRuntimeProposal) ProtoMessage()    {}
+func (*CreateRuntimeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adce52e9478669ec, []int{8}
+}
+func (m *CreateRuntimeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateRuntimeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateRuntimeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateRuntimeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRuntimeProposal.Merge(m, src)
+}
+func (m *CreateRuntimeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateRuntimeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRuntimeProposal.DiscardUnknown(m)
 }
 
-func (m *CreatePoolProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateRuntimeProposal proto.InternalMessageInfo
+
+func (m *CreateRuntimeProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreatePoolProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CreateRuntimeProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateRuntimeProposal) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateRuntimeProposal) GetConfigSchema() string {
+	if m != nil {
+		return m.ConfigSchema
+	}
+	return ""
+}
+
+func (m *CreateRuntimeProposal) GetVersions() []RuntimeVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+// UpdateRuntimeProposal is a gov Content type for replacing the config schema
+// and the versions of a registered runtime.
+type UpdateRuntimeProposal struct {
+	// title ...
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description ...
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// name ...
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// config_schema ...
+	ConfigSchema string `protobuf:"bytes,4,opt,name=config_schema,json=configSchema,proto3" json:"config_schema,omitempty"`
+	// versions ...
+	Versions []RuntimeVersion `protobuf:"bytes,5,rep,name=versions,proto3" json:"versions"`
+}
+
+func (m *UpdateRuntimeProposal) Reset()         { *m = UpdateRuntimeProposal{} }
+func (m *UpdateRuntimeProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateRuntimeProposal) ProtoMessage()    {}
+func (*UpdateRuntimeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adce52e9478669ec, []int{9}
+}
+func (m *UpdateRuntimeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateRuntimeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateRuntimeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateRuntimeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRuntimeProposal.Merge(m, src)
+}
+func (m *UpdateRuntimeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateRuntimeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRuntimeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRuntimeProposal proto.InternalMessageInfo
+
+func (m *UpdateRuntimeProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdateRuntimeProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateRuntimeProposal) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateRuntimeProposal) GetConfigSchema() string {
+	if m != nil {
+		return m.ConfigSchema
+	}
+	return ""
+}
+
+func (m *UpdateRuntimeProposal) GetVersions() []RuntimeVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+// DeprecateRuntimeProposal is a gov Content type for deprecating a runtime.
+type DeprecateRuntimeProposal struct {
+	// title ...
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description ...
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// name ...
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *DeprecateRuntimeProposal) Reset()         { *m = DeprecateRuntimeProposal{} }
+func (m *DeprecateRuntimeProposal) String() string { return proto.CompactTextString(m) }
+func (*DeprecateRuntimeProposal) ProtoMessage()    {}
+func (*DeprecateRuntimeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adce52e9478669ec, []int{10}
+}
+func (m *DeprecateRuntimeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeprecateRuntimeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeprecateRuntimeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeprecateRuntimeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeprecateRuntimeProposal.Merge(m, src)
+}
+func (m *DeprecateRuntimeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeprecateRuntimeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeprecateRuntimeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeprecateRuntimeProposal proto.InternalMessageInfo
+
+func (m *DeprecateRuntimeProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *DeprecateRuntimeProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DeprecateRuntimeProposal) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CreatePoolProposal)(nil), "kyve.pool.v1beta1.CreatePoolProposal")
+	proto.RegisterType((*UpdatePoolProposal)(nil), "kyve.pool.v1beta1.UpdatePoolProposal")
+	proto.RegisterType((*PoolUpdate)(nil), "kyve.pool.v1beta1.PoolUpdate")
+	proto.RegisterType((*PausePoolProposal)(nil), "kyve.pool.v1beta1.PausePoolProposal")
+	proto.RegisterType((*UnpausePoolProposal)(nil), "kyve.pool.v1beta1.UnpausePoolProposal")
+	proto.RegisterType((*SchedulePoolUpgradeProposal)(nil), "kyve.pool.v1beta1.SchedulePoolUpgradeProposal")
+	proto.RegisterType((*CancelPoolUpgradeProposal)(nil), "kyve.pool.v1beta1.CancelPoolUpgradeProposal")
+	proto.RegisterType((*ResetPoolProposal)(nil), "kyve.pool.v1beta1.ResetPoolProposal")
+	proto.RegisterType((*CreateRuntimeProposal)(nil), "kyve.pool.v1beta1.CreateRuntimeProposal")
+	proto.RegisterType((*UpdateRuntimeProposal)(nil), "kyve.pool.v1beta1.UpdateRuntimeProposal")
+	proto.RegisterType((*DeprecateRuntimeProposal)(nil), "kyve.pool.v1beta1.DeprecateRuntimeProposal")
+}
+
+func init() { proto.RegisterFile("kyve/pool/v1beta1/gov.proto", fileDescriptor_adce52e9478669ec) }
+
+var fileDescriptor_adce52e9478669ec = []byte{
+	// 1039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x97, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0x4d, 0x59, 0x96, 0xa8, 0xd1, 0x87, 0xa3, 0x8d, 0x9d, 0x6c, 0xec, 0x44, 0x96, 0xd5,
+	0x2f, 0xb5, 0x07, 0xa9, 0x71, 0x81, 0x5e, 0x7a, 0x8a, 0x95, 0x14, 0x30, 0xd2, 0x14, 0x2e, 0xdd,
+	0x18, 0x68, 0x8b, 0x82, 0x58, 0x91, 0x6b, 0x79, 0x21, 0x92, 0xcb, 0x72, 0x97, 0x8a, 0x95, 0xa7,
+	0xe8, 0x13, 0xf4, 0x1d, 0xfa, 0x16, 0x39, 0xb4, 0x40, 0x8e, 0x39, 0x15, 0x85, 0xfd, 0x22, 0xc5,
+	0x7e, 0xd8, 0xb5, 0x63, 0xa5, 0x76, 0x91, 0xaa, 0x87, 0xdc, 0x38, 0xff, 0x99, 0xe5, 0xee, 0x8c,
+	0xe6, 0xb7, 0x43, 0xc1, 0xfa, 0x78, 0x3a, 0xa1, 0xfd, 0x94, 0xf3, 0xa8, 0x3f, 0xb9, 0x3f, 0xa4,
+	0x92, 0xdc, 0xef, 0x8f, 0xf8, 0xa4, 0x97, 0x66, 0x5c, 0x72, 0xd4, 0x54, 0xce, 0x9e, 0x72, 0xf6,
+	0xac, 0x73, 0x6d, 0x65, 0xc4, 0x47, 0x5c, 0x7b, 0xfb, 0xea, 0xc9, 0x04, 0xae, 0xdd, 0xbd, 0xfc,
+	0x16, 0xbd, 0xca, 0x78, 0x37, 0x2e, 0x7b, 0xb3, 0x3c, 0x91, 0x2c, 0xa6, 0x26, 0xa0, 0xf3, 0x6b,
+	0x09, 0xd0, 0x20, 0xa3, 0x44, 0xd2, 0x5d, 0xce, 0xa3, 0xdd, 0x8c, 0xa7, 0x5c, 0x90, 0x08, 0xad,
+	0xc0, 0x92, 0x64, 0x32, 0xa2, 0xd8, 0x69, 0x3b, 0xdd, 0x8a, 0x67, 0x0c, 0xd4, 0x86, 0x6a, 0x48,
+	0x45, 0x90, 0xb1, 0x54, 0x32, 0x9e, 0xe0, 0x82, 0xf6, 0x9d, 0x97, 0x10, 0x82, 0x62, 0x42, 0x62,
+	0x8a, 0x17, 0xb5, 0x4b, 0x3f, 0x23, 0x0c, 0x65, 0xbb, 0x27, 0x2e, 0x6a, 0xf9, 0xd4, 0x54, 0xd1,
+	0x11, 0x1f, 0x71, 0xbc, 0x64, 0xa2, 0xd5, 0x33, 0xba, 0x05, 0xa5, 0x80, 0x27, 0x07, 0x6c, 0x84,
+	0x4b, 0x5a, 0xb5, 0x16, 0x5a, 0x87, 0x8a, 0x90, 0x24, 0x93, 0xfe, 0x98, 0x4e, 0x71, 0x59, 0xbb,
+	0x5c, 0x2d, 0x3c, 0xa6, 0x53, 0xf4, 0x11, 0x2c, 0xe7, 0x69, 0xc4, 0x49, 0xe8, 0xb3, 0x44, 0xd2,
+	0x6c, 0x42, 0x22, 0xec, 0xb6, 0x9d, 0x6e, 0xd1, 0x6b, 0x18, 0x79, 0xc7, 0xaa, 0xe8, 0x03, 0x68,
+	0xf0, 0x94, 0x66, 0x44, 0xb2, 0x64, 0xe4, 0x07, 0x5c, 0x48, 0x5c, 0xd1, 0x71, 0xf5, 0x33, 0x75,
+	0xc0, 0x85, 0x54, 0x9b, 0xc5, 0x2c, 0xf1, 0x85, 0x24, 0x63, 0x8a, 0x41, 0x47, 0xb8, 0x31, 0x4b,
+	0xf6, 0x94, 0x8d, 0x3e, 0x84, 0xe5, 0x98, 0x1c, 0xf9, 0xc3, 0x3c, 0x09, 0x23, 0xea, 0x0b, 0xf6,
+	0x9c, 0xe2, 0xaa, 0x79, 0x49, 0x4c, 0x8e, 0xb6, 0xb5, 0xba, 0xc7, 0x9e, 0xeb, 0xbc, 0x27, 0x34,
+	0x13, 0xaa, 0x52, 0x35, 0x93, 0xb7, 0x35, 0xd1, 0x1a, 0xb8, 0x43, 0x96, 0x90, 0x8c, 0x51, 0x81,
+	0xeb, 0x26, 0x95, 0x53, 0x1b, 0x6d, 0x42, 0x6d, 0x42, 0x22, 0x16, 0xfa, 0x3f, 0xe5, 0x3c, 0xcb,
+	0x63, 0xdc, 0x30, 0x45, 0xd6, 0xda, 0x37, 0x5a, 0x52, 0x49, 0xb0, 0xe4, 0x42, 0xd0, 0xb2, 0x0e,
+	0xaa, 0xb3, 0xe4, 0xb5, 0x30, 0x5b, 0x14, 0x55, 0x6c, 0x9e, 0x4b, 0x7c, 0xc3, 0x1c, 0xd3, 0xa8,
+	0xdf, 0x1a, 0x11, 0xdd, 0x03, 0x50, 0xe9, 0xa4, 0x9c, 0x25, 0x52, 0xe0, 0xa6, 0x0e, 0xa9, 0xc4,
+	0xe4, 0x68, 0x57, 0x0b, 0xe8, 0x21, 0xd4, 0x83, 0x43, 0x92, 0x8d, 0x54, 0xc1, 0x62, 0x1e, 0x52,
+	0x8c, 0xda, 0x4e, 0xb7, 0xb1, 0xb5, 0xd1, 0xbb, 0xd4, 0xa0, 0xbd, 0x81, 0x8d, 0x7b, 0xc2, 0x43,
+	0xea, 0xd5, 0x82, 0x73, 0x96, 0xea, 0x27, 0x12, 0xc6, 0x2c, 0xc1, 0x37, 0x4d, 0x3f, 0x69, 0x03,
+	0x7d, 0x02, 0xcd, 0x83, 0x3c, 0x09, 0x69, 0xe6, 0x93, 0x28, 0xe2, 0xcf, 0xfc, 0x88, 0x09, 0x89,
+	0x57, 0xda, 0x8b, 0xdd, 0x8a, 0xb7, 0x6c, 0x1c, 0x0f, 0x94, 0xfe, 0x15, 0x13, 0x12, 0x6d, 0x40,
+	0x55, 0x1d, 0xd3, 0xc8, 0x02, 0xaf, 0xea, 0x73, 0xaa, 0x93, 0x7f, 0x69, 0x14, 0xf4, 0x39, 0xdc,
+	0x0e, 0x99, 0x20, 0xc3, 0x88, 0xda, 0x20, 0x9f, 0x4e, 0x58, 0xa0, 0x1b, 0xf5, 0x56, 0xdb, 0xe9,
+	0xba, 0xde, 0xaa, 0x75, 0x9b, 0x05, 0x8f, 0xac, 0x13, 0x7d, 0x0a, 0x2b, 0x01, 0x8f, 0x63, 0x26,
+	0xfd, 0x8c, 0x4e, 0x28, 0x89, 0xfc, 0x09, 0x57, 0x7d, 0x80, 0x6f, 0xeb, 0x45, 0xc8, 0xf8, 0x3c,
+	0xed, 0xda, 0xd7, 0x9e, 0xce, 0x2b, 0x07, 0xd0, 0xd3, 0x34, 0xfc, 0xaf, 0x98, 0x69, 0x40, 0x81,
+	0x85, 0x9a, 0x98, 0xa2, 0x57, 0x60, 0x21, 0xba, 0x0b, 0xe5, 0x94, 0x4c, 0xd5, 0x4f, 0x64, 0x78,
+	0xd9, 0x2e, 0x60, 0xc7, 0x3b, 0x95, 0x54, 0x1d, 0x72, 0xbd, 0xb7, 0x1f, 0x13, 0x31, 0xc6, 0x4b,
+	0xba, 0x5a, 0x60, 0xa4, 0x27, 0x44, 0x8c, 0xd1, 0x17, 0x50, 0x32, 0x96, 0x06, 0xa8, 0xba, 0x75,
+	0x6f, 0xc6, 0x2f, 0xa5, 0xce, 0x6d, 0x32, 0xd8, 0x2e, 0xbe, 0xf8, 0x63, 0x63, 0xc1, 0xb3, 0x4b,
+	0x3a, 0xbf, 0x2c, 0x01, 0xfc, 0xed, 0x3c, 0xc3, 0xd9, 0x99, 0x8d, 0x73, 0x61, 0x36, 0xce, 0x8b,
+	0x33, 0x71, 0x2e, 0x5e, 0xc0, 0x79, 0x06, 0xb1, 0x4b, 0xd7, 0x24, 0xb6, 0x74, 0x25, 0xb1, 0xe5,
+	0xab, 0x89, 0x75, 0x67, 0x11, 0xfb, 0xa6, 0x56, 0xa8, 0xbc, 0xa9, 0x15, 0x2e, 0xd1, 0x0a, 0xd7,
+	0xa1, 0xb5, 0x7a, 0x3d, 0x5a, 0x6b, 0x57, 0xd3, 0x5a, 0xbf, 0x92, 0xd6, 0xc6, 0x5b, 0xd1, 0xba,
+	0x7c, 0x25, 0xad, 0x37, 0xae, 0x45, 0x6b, 0xf3, 0xdf, 0xd0, 0x8a, 0xfe, 0x81, 0xd6, 0xce, 0x0f,
+	0xd0, 0xdc, 0x25, 0xb9, 0x98, 0x0b, 0x79, 0x9d, 0x1f, 0xe1, 0xe6, 0xd3, 0x24, 0x9d, 0xdb, 0xeb,
+	0x7f, 0x2b, 0xc0, 0xfa, 0x5e, 0x70, 0x48, 0xc3, 0x3c, 0xa2, 0x06, 0xb2, 0x51, 0x46, 0x42, 0xfa,
+	0xd6, 0xfb, 0x9c, 0x23, 0x72, 0xf1, 0x22, 0x91, 0xe7, 0x46, 0x50, 0xf1, 0xe2, 0x08, 0xda, 0x84,
+	0x9a, 0xb0, 0x47, 0x09, 0x7d, 0x22, 0x2d, 0x7c, 0xd5, 0x33, 0xed, 0x81, 0x54, 0x53, 0x2a, 0xcc,
+	0x15, 0x62, 0x3c, 0xb1, 0xcc, 0x9d, 0xd9, 0x17, 0x26, 0x58, 0xf9, 0xb5, 0x09, 0x76, 0x07, 0x5c,
+	0xd5, 0x68, 0x3e, 0x0b, 0x05, 0x76, 0xdb, 0x8b, 0xdd, 0xa2, 0x57, 0x56, 0xf6, 0x4e, 0x28, 0xd0,
+	0xfb, 0xd0, 0x08, 0x48, 0x42, 0xb2, 0xa9, 0x6f, 0x23, 0xec, 0xf8, 0xad, 0x19, 0x75, 0x57, 0x87,
+	0x29, 0x14, 0x6c, 0x94, 0x21, 0x56, 0xd8, 0x11, 0x5c, 0x37, 0xaa, 0x01, 0x56, 0x74, 0x62, 0xb8,
+	0x33, 0x20, 0x49, 0x40, 0xa3, 0xff, 0xa5, 0x96, 0x9d, 0x23, 0x68, 0x7a, 0x54, 0x50, 0x39, 0x97,
+	0x3b, 0x7f, 0x1d, 0x2a, 0xf6, 0x76, 0x62, 0xe6, 0xd6, 0x2f, 0x7a, 0xae, 0x11, 0x76, 0xc2, 0xce,
+	0xef, 0x0e, 0xac, 0x9a, 0x6f, 0x34, 0xcf, 0x9c, 0x65, 0x2e, 0x9f, 0x69, 0xef, 0x41, 0xdd, 0xdc,
+	0xcd, 0xbe, 0x6a, 0x82, 0x98, 0xd8, 0x8e, 0xa9, 0x19, 0x71, 0x4f, 0x6b, 0x68, 0x00, 0xae, 0xed,
+	0x20, 0xa1, 0x47, 0x4f, 0x75, 0x6b, 0x73, 0xc6, 0xd5, 0x62, 0x8f, 0xb9, 0x6f, 0x22, 0xed, 0x88,
+	0x39, 0x5b, 0xa8, 0xf3, 0x31, 0x03, 0xe6, 0xdd, 0xc8, 0xe7, 0x00, 0xf0, 0x43, 0x9a, 0x66, 0x34,
+	0x98, 0x6f, 0x46, 0xdb, 0x83, 0x17, 0xc7, 0x2d, 0xe7, 0xe5, 0x71, 0xcb, 0xf9, 0xf3, 0xb8, 0xe5,
+	0xfc, 0x7c, 0xd2, 0x5a, 0x78, 0x79, 0xd2, 0x5a, 0x78, 0x75, 0xd2, 0x5a, 0xf8, 0xfe, 0xe3, 0x11,
+	0x93, 0x87, 0xf9, 0xb0, 0x17, 0xf0, 0xb8, 0xff, 0xf8, 0xbb, 0xfd, 0x47, 0x5f, 0x53, 0xf9, 0x8c,
+	0x67, 0xe3, 0x7e, 0x70, 0x48, 0x58, 0xd2, 0x3f, 0x32, 0x7f, 0x00, 0xe4, 0x34, 0xa5, 0x62, 0x58,
+	0xd2, 0xdf, 0xfd, 0x9f, 0xfd, 0x35, 0x00, 0x69, 0x29, 0x08, 0xf8, 0x7e, 0x0c, 0x00, 0x00,
+}
+
+func (m *CreatePoolProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePoolProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *CreatePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -684,6 +1290,92 @@ func (m *CreatePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommitRevealVoting {
+		i--
+		if m.CommitRevealVoting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.DisableFunderEviction {
+		i--
+		if m.DisableFunderEviction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.MaxFunders != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxFunders))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.FunderAllowList) > 0 {
+		for iNdEx := len(m.FunderAllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FunderAllowList[iNdEx])
+			copy(dAtA[i:], m.FunderAllowList[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.FunderAllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ChargingMode != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ChargingMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxPoints != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxPoints))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.UploadTimeout != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.UploadTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.InvalidQuorum) > 0 {
+		i -= len(m.InvalidQuorum)
+		copy(dAtA[i:], m.InvalidQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.InvalidQuorum)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ValidQuorum) > 0 {
+		i -= len(m.ValidQuorum)
+		copy(dAtA[i:], m.ValidQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidQuorum)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.Binaries) > 0 {
 		i -= len(m.Binaries)
 		copy(dAtA[i:], m.Binaries)
@@ -790,6 +1482,25 @@ func (m *UpdatePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.UpdateMask) > 0 {
+		for iNdEx := len(m.UpdateMask) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpdateMask[iNdEx])
+			copy(dAtA[i:], m.UpdateMask[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.UpdateMask[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Payload) > 0 {
 		i -= len(m.Payload)
 		copy(dAtA[i:], m.Payload)
@@ -819,7 +1530,7 @@ func (m *UpdatePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PausePoolProposal) Marshal() (dAtA []byte, err error) {
+func (m *PoolUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -829,100 +1540,275 @@ func (m *PausePoolProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PausePoolProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PausePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+	if m.DisableFunderEviction {
 		i--
-		dAtA[i] = 0x18
+		if m.DisableFunderEviction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+	if m.MaxFunders != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxFunders))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.FunderAllowList) > 0 {
+		for iNdEx := len(m.FunderAllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FunderAllowList[iNdEx])
+			copy(dAtA[i:], m.FunderAllowList[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.FunderAllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Admin)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x7a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UnpausePoolProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ChargingMode != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ChargingMode))
+		i--
+		dAtA[i] = 0x70
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnpausePoolProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UnpausePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+	if m.MaxPoints != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxPoints))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x68
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+	if m.UploadTimeout != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.UploadTimeout))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x60
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+	if len(m.InvalidQuorum) > 0 {
+		i -= len(m.InvalidQuorum)
+		copy(dAtA[i:], m.InvalidQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.InvalidQuorum)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x5a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SchedulePoolUpgradeProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ValidQuorum) > 0 {
+		i -= len(m.ValidQuorum)
+		copy(dAtA[i:], m.ValidQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidQuorum)))
+		i--
+		dAtA[i] = 0x52
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SchedulePoolUpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SchedulePoolUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	if m.CommitRevealVoting {
+		i--
+		if m.CommitRevealVoting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxBundleSize != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxBundleSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinStake != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MinStake))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OperatingCost != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.OperatingCost))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UploadInterval != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.UploadInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Logo) > 0 {
+		i -= len(m.Logo)
+		copy(dAtA[i:], m.Logo)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Logo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PausePoolProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausePoolProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpausePoolProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpausePoolProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpausePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePoolUpgradeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePoolUpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePoolUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.CanaryBundles != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.CanaryBundles))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CanaryPoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.CanaryPoolId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PoolIds) > 0 {
+		dAtA3 := make([]byte, len(m.PoolIds)*10)
+		var j2 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGov(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Binaries) > 0 {
 		i -= len(m.Binaries)
 		copy(dAtA[i:], m.Binaries)
@@ -1062,50 +1948,224 @@ func (m *ResetPoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CreateRuntimeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreatePoolProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CreateRuntimeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateRuntimeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.ConfigSchema) > 0 {
+		i -= len(m.ConfigSchema)
+		copy(dAtA[i:], m.ConfigSchema)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConfigSchema)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Logo)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Config)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRuntimeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRuntimeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRuntimeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ConfigSchema) > 0 {
+		i -= len(m.ConfigSchema)
+		copy(dAtA[i:], m.ConfigSchema)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConfigSchema)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeprecateRuntimeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeprecateRuntimeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeprecateRuntimeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreatePoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
 	}
 	if m.UploadInterval != 0 {
 		n += 1 + sovGov(uint64(m.UploadInterval))
@@ -1127,6 +2187,42 @@ func (m *CreatePoolProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.ValidQuorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.InvalidQuorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.UploadTimeout != 0 {
+		n += 2 + sovGov(uint64(m.UploadTimeout))
+	}
+	if m.MaxPoints != 0 {
+		n += 2 + sovGov(uint64(m.MaxPoints))
+	}
+	if m.ChargingMode != 0 {
+		n += 2 + sovGov(uint64(m.ChargingMode))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.FunderAllowList) > 0 {
+		for _, s := range m.FunderAllowList {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxFunders != 0 {
+		n += 2 + sovGov(uint64(m.MaxFunders))
+	}
+	if m.DisableFunderEviction {
+		n += 3
+	}
+	if m.CommitRevealVoting {
+		n += 3
+	}
 	return n
 }
 
@@ -1151,6 +2247,87 @@ func (m *UpdatePoolProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.UpdateMask) > 0 {
+		for _, s := range m.UpdateMask {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = m.Update.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *PoolUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.UploadInterval != 0 {
+		n += 1 + sovGov(uint64(m.UploadInterval))
+	}
+	if m.OperatingCost != 0 {
+		n += 1 + sovGov(uint64(m.OperatingCost))
+	}
+	if m.MinStake != 0 {
+		n += 1 + sovGov(uint64(m.MinStake))
+	}
+	if m.MaxBundleSize != 0 {
+		n += 1 + sovGov(uint64(m.MaxBundleSize))
+	}
+	if m.CommitRevealVoting {
+		n += 2
+	}
+	l = len(m.ValidQuorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.InvalidQuorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.UploadTimeout != 0 {
+		n += 1 + sovGov(uint64(m.UploadTimeout))
+	}
+	if m.MaxPoints != 0 {
+		n += 1 + sovGov(uint64(m.MaxPoints))
+	}
+	if m.ChargingMode != 0 {
+		n += 1 + sovGov(uint64(m.ChargingMode))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.FunderAllowList) > 0 {
+		for _, s := range m.FunderAllowList {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxFunders != 0 {
+		n += 2 + sovGov(uint64(m.MaxFunders))
+	}
+	if m.DisableFunderEviction {
+		n += 3
+	}
 	return n
 }
 
@@ -1226,6 +2403,19 @@ func (m *SchedulePoolUpgradeProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	if m.CanaryPoolId != 0 {
+		n += 1 + sovGov(uint64(m.CanaryPoolId))
+	}
+	if m.CanaryBundles != 0 {
+		n += 1 + sovGov(uint64(m.CanaryBundles))
+	}
 	return n
 }
 
@@ -1273,31 +2463,114 @@ func (m *ResetPoolProposal) Size() (n int) {
 	return n
 }
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *CreateRuntimeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ConfigSchema)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
 }
-func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *UpdateRuntimeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ConfigSchema)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeprecateRuntimeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
@@ -1374,7 +2647,1462 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+			}
+			m.UploadInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatingCost", wireType)
+			}
+			m.OperatingCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatingCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			}
+			m.MaxBundleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTimeout", wireType)
+			}
+			m.UploadTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoints", wireType)
+			}
+			m.MaxPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargingMode", wireType)
+			}
+			m.ChargingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargingMode |= ChargingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAllowList = append(m.FunderAllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFunders", wireType)
+			}
+			m.MaxFunders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFunders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableFunderEviction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableFunderEviction = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealVoting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitRevealVoting = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMask = append(m.UpdateMask, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+			}
+			m.UploadInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatingCost", wireType)
+			}
+			m.OperatingCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatingCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			}
+			m.MaxBundleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealVoting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitRevealVoting = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTimeout", wireType)
+			}
+			m.UploadTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoints", wireType)
+			}
+			m.MaxPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargingMode", wireType)
+			}
+			m.ChargingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargingMode |= ChargingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAllowList = append(m.FunderAllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFunders", wireType)
+			}
+			m.MaxFunders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFunders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableFunderEviction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableFunderEviction = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausePoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausePoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpausePoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpausePoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpausePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1402,11 +4130,11 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1434,13 +4162,13 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1450,27 +4178,64 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGov
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePoolUpgradeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePoolUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1498,11 +4263,11 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1530,32 +4295,13 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
-			}
-			m.UploadInterval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UploadInterval |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatingCost", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
 			}
-			m.OperatingCost = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1565,50 +4311,25 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatingCost |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
-			}
-			m.MinStake = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
 			}
-			m.MaxBundleSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxBundleSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 12:
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
@@ -1640,11 +4361,11 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
 			}
-			var stringLen uint64
+			m.ScheduledAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1654,79 +4375,16 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ScheduledAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatePoolProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1736,27 +4394,14 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Duration |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				}
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1784,13 +4429,89 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Binaries = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryPoolId", wireType)
 			}
-			m.Id = 0
+			m.CanaryPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1800,16 +4521,16 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.CanaryPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryBundles", wireType)
 			}
-			var stringLen uint64
+			m.CanaryBundles = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1819,24 +4540,11 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CanaryBundles |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -1858,7 +4566,7 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PausePoolProposal) Unmarshal(dAtA []byte) error {
+func (m *CancelPoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1881,10 +4589,10 @@ func (m *PausePoolProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PausePoolProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelPoolUpgradeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PausePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelPoolUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1952,10 +4660,10 @@ func (m *PausePoolProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1965,11 +4673,24 @@ func (m *PausePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -1991,7 +4712,7 @@ func (m *PausePoolProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnpausePoolProposal) Unmarshal(dAtA []byte) error {
+func (m *ResetPoolProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2014,10 +4735,10 @@ func (m *UnpausePoolProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnpausePoolProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetPoolProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnpausePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetPoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2103,6 +4824,25 @@ func (m *UnpausePoolProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
+			}
+			m.BundleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2124,7 +4864,7 @@ func (m *UnpausePoolProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
+func (m *CreateRuntimeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2147,10 +4887,10 @@ func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchedulePoolUpgradeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateRuntimeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchedulePoolUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateRuntimeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2219,7 +4959,7 @@ func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2247,11 +4987,11 @@ func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigSchema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2279,51 +5019,13 @@ func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.ConfigSchema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
-			}
-			m.ScheduledAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			m.Duration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2333,23 +5035,25 @@ func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
+			m.Versions = append(m.Versions, RuntimeVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2372,7 +5076,7 @@ func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelPoolUpgradeProposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateRuntimeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2395,10 +5099,10 @@ func (m *CancelPoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelPoolUpgradeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRuntimeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelPoolUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRuntimeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2467,7 +5171,7 @@ func (m *CancelPoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2495,7 +5199,73 @@ func (m *CancelPoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, RuntimeVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2518,7 +5288,7 @@ func (m *CancelPoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetPoolProposal) Unmarshal(dAtA []byte) error {
+func (m *DeprecateRuntimeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2541,10 +5311,10 @@ func (m *ResetPoolProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetPoolProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeprecateRuntimeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetPoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeprecateRuntimeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2612,10 +5382,10 @@ func (m *ResetPoolProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2625,30 +5395,24 @@ func (m *ResetPoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
 			}
-			m.BundleId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BundleId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
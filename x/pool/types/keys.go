@@ -22,14 +22,42 @@ const (
 )
 
 const (
-	MaxFunders = 50 // maximum amount of funders which are allowed
+	DefaultMaxFunders = 50  // maximum amount of funders which are allowed if the pool does not override it
+	MaxFundersLimit   = 200 // upper bound for the maximum amount of funders of a single pool
 )
 
 var (
 	PoolKey      = []byte{1}
 	PoolCountKey = []byte{2}
+
+	// UploadDeadlineKeyPrefix is indexed by deadline and pool id
+	UploadDeadlineKeyPrefix = []byte{3}
+	// UploadDeadlineKeyPrefixIndex2 is indexed by pool id
+	UploadDeadlineKeyPrefixIndex2 = []byte{4}
+
+	// RuntimeKeyPrefix is indexed by the runtime name
+	RuntimeKeyPrefix = []byte{5}
+
+	// UpgradeRolloutKeyPrefix is indexed by the canary pool id
+	UpgradeRolloutKeyPrefix = []byte{6}
 )
 
 func PoolKeyPrefix(poolId uint64) []byte {
 	return util.GetByteKey(poolId)
 }
+
+func UploadDeadlineKey(deadline uint64, poolId uint64) []byte {
+	return util.GetByteKey(deadline, poolId)
+}
+
+func UploadDeadlineKeyIndex2(poolId uint64) []byte {
+	return util.GetByteKey(poolId)
+}
+
+func RuntimeKey(name string) []byte {
+	return util.GetByteKey(name)
+}
+
+func UpgradeRolloutKey(canaryPoolId uint64) []byte {
+	return util.GetByteKey(canaryPoolId)
+}
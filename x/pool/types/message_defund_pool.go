@@ -9,7 +9,7 @@ const TypeMsgDefundPool = "defund_pool"
 
 var _ sdk.Msg = &MsgDefundPool{}
 
-func NewMsgDefundPool(creator string, id uint64, amount uint64) *MsgDefundPool {
+func NewMsgDefundPool(creator string, id uint64, amount sdk.Coins) *MsgDefundPool {
 	return &MsgDefundPool{
 		Creator: creator,
 		Id:      id,
@@ -43,5 +43,10 @@ func (msg *MsgDefundPool) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount (%s)", msg.Amount)
+	}
+
 	return nil
 }
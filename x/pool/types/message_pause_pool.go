@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPausePool = "pause_pool"
+
+var _ sdk.Msg = &MsgPausePool{}
+
+func NewMsgPausePool(creator string, id uint64) *MsgPausePool {
+	return &MsgPausePool{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgPausePool) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPausePool) Type() string {
+	return TypeMsgPausePool
+}
+
+func (msg *MsgPausePool) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPausePool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPausePool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUnpausePool = "unpause_pool"
+
+var _ sdk.Msg = &MsgUnpausePool{}
+
+func NewMsgUnpausePool(creator string, id uint64) *MsgUnpausePool {
+	return &MsgUnpausePool{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgUnpausePool) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnpausePool) Type() string {
+	return TypeMsgUnpausePool
+}
+
+func (msg *MsgUnpausePool) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnpausePool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnpausePool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
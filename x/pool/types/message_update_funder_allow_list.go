@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateFunderAllowList = "update_funder_allow_list"
+
+var _ sdk.Msg = &MsgUpdateFunderAllowList{}
+
+func NewMsgUpdateFunderAllowList(creator string, id uint64, funderAllowList []string) *MsgUpdateFunderAllowList {
+	return &MsgUpdateFunderAllowList{
+		Creator:         creator,
+		Id:              id,
+		FunderAllowList: funderAllowList,
+	}
+}
+
+func (msg *MsgUpdateFunderAllowList) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateFunderAllowList) Type() string {
+	return TypeMsgUpdateFunderAllowList
+}
+
+func (msg *MsgUpdateFunderAllowList) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateFunderAllowList) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateFunderAllowList) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateFunderAllowList(msg.FunderAllowList); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdatePoolMetadata = "update_pool_metadata"
+
+var _ sdk.Msg = &MsgUpdatePoolMetadata{}
+
+func NewMsgUpdatePoolMetadata(creator string, id uint64, mask []string, name string, logo string, config string) *MsgUpdatePoolMetadata {
+	return &MsgUpdatePoolMetadata{
+		Creator:    creator,
+		Id:         id,
+		UpdateMask: mask,
+		Name:       name,
+		Logo:       logo,
+		Config:     config,
+	}
+}
+
+func (msg *MsgUpdatePoolMetadata) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdatePoolMetadata) Type() string {
+	return TypeMsgUpdatePoolMetadata
+}
+
+func (msg *MsgUpdatePoolMetadata) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdatePoolMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdatePoolMetadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// The admin of a pool is only allowed to update its metadata
+	for _, field := range msg.UpdateMask {
+		if field != PoolUpdateName && field != PoolUpdateLogo && field != PoolUpdateConfig {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidPoolUpdate.Error(),
+				fmt.Sprintf("field %v can only be updated by governance", field))
+		}
+	}
+
+	mask, update := msg.GetPoolUpdate()
+	if err := ValidatePoolUpdate(mask, update); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidPoolUpdate.Error(), err)
+	}
+
+	return nil
+}
+
+// GetPoolUpdate returns the update mask and the new values of the message.
+func (msg *MsgUpdatePoolMetadata) GetPoolUpdate() ([]string, PoolUpdate) {
+	return msg.UpdateMask, PoolUpdate{
+		Name:   msg.Name,
+		Logo:   msg.Logo,
+		Config: msg.Config,
+	}
+}
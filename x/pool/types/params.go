@@ -1,34 +1,54 @@
 package types
 
 import (
+	"fmt"
+
+	"github.com/KYVENetwork/chain/util"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyFundingDenoms     = []byte("FundingDenoms")
+	DefaultFundingDenoms = []FundingDenom{
+		{Denom: util.KyveDenom, Weight: sdk.OneDec()},
+	}
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(fundingDenoms []FundingDenom) Params {
+	return Params{
+		FundingDenoms: fundingDenoms,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultFundingDenoms)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFundingDenoms, &p.FundingDenoms, validateFundingDenoms),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+
+	if err := validateFundingDenoms(p.FundingDenoms); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -37,3 +57,37 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// GetFundingWeights returns the weight of every allowed funding denom.
+func (p Params) GetFundingWeights() map[string]sdk.Dec {
+	weights := make(map[string]sdk.Dec)
+	for _, fundingDenom := range p.FundingDenoms {
+		weights[fundingDenom.Denom] = fundingDenom.Weight
+	}
+	return weights
+}
+
+func validateFundingDenoms(v interface{}) error {
+	fundingDenoms, ok := v.([]FundingDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]bool)
+	for _, fundingDenom := range fundingDenoms {
+		if err := sdk.ValidateDenom(fundingDenom.Denom); err != nil {
+			return err
+		}
+
+		if seen[fundingDenom.Denom] {
+			return fmt.Errorf("duplicate funding denom %v", fundingDenom.Denom)
+		}
+		seen[fundingDenom.Denom] = true
+
+		if fundingDenom.Weight.IsNil() || !fundingDenom.Weight.IsPositive() {
+			return fmt.Errorf("weight of funding denom %v has to be positive", fundingDenom.Denom)
+		}
+	}
+
+	return nil
+}
@@ -1,60 +1,269 @@
 package types
 
 import (
-	"sort"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultValidQuorum   = "0.5"
+	DefaultInvalidQuorum = "0.5"
 )
 
-func (m *Pool) AddToFunder(funderAddress string, amount uint64) {
+// GetQuorums returns the valid and invalid quorum of the pool. Empty
+// quorums fall back to the defaults of a simple majority.
+func (m *Pool) GetQuorums() (validQuorum sdk.Dec, invalidQuorum sdk.Dec) {
+	validQuorum, invalidQuorum, err := ParseQuorums(m.ValidQuorum, m.InvalidQuorum)
+	if err != nil {
+		return sdk.MustNewDecFromStr(DefaultValidQuorum), sdk.MustNewDecFromStr(DefaultInvalidQuorum)
+	}
+
+	return validQuorum, invalidQuorum
+}
+
+// ParseQuorums parses and validates the given quorums. A bundle is valid if more than
+// valid_quorum of the delegation voted valid and invalid if at least invalid_quorum
+// voted invalid. To rule out that both outcomes are possible at the same time
+// the quorums have to add up to at least one.
+func ParseQuorums(validQuorum string, invalidQuorum string) (sdk.Dec, sdk.Dec, error) {
+	valid, err := ParseValidQuorum(validQuorum)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	invalid, err := ParseInvalidQuorum(invalidQuorum)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	if valid.Add(invalid).LT(sdk.OneDec()) {
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("valid quorum %v and invalid quorum %v have to add up to at least 1", valid, invalid)
+	}
+
+	return valid, invalid, nil
+}
+
+// ParseValidQuorum parses a single valid quorum, which has to be greater than
+// zero and less than one. An empty quorum falls back to the default.
+func ParseValidQuorum(validQuorum string) (sdk.Dec, error) {
+	if validQuorum == "" {
+		validQuorum = DefaultValidQuorum
+	}
+
+	valid, err := sdk.NewDecFromStr(validQuorum)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if !valid.IsPositive() || valid.GTE(sdk.OneDec()) {
+		return sdk.Dec{}, fmt.Errorf("valid quorum %v has to be greater than 0 and less than 1", valid)
+	}
+
+	return valid, nil
+}
+
+// ParseInvalidQuorum parses a single invalid quorum, which has to be greater
+// than zero and at most one. An empty quorum falls back to the default.
+func ParseInvalidQuorum(invalidQuorum string) (sdk.Dec, error) {
+	if invalidQuorum == "" {
+		invalidQuorum = DefaultInvalidQuorum
+	}
+
+	invalid, err := sdk.NewDecFromStr(invalidQuorum)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if !invalid.IsPositive() || invalid.GT(sdk.OneDec()) {
+		return sdk.Dec{}, fmt.Errorf("invalid quorum %v has to be greater than 0 and at most 1", invalid)
+	}
+
+	return invalid, nil
+}
+
+// GetValue returns the value of the funds in units of the pool costs. Funds in
+// denoms which are not allowed for funding have no value.
+func (m *Funder) GetValue(weights map[string]sdk.Dec) sdk.Dec {
+	value := sdk.ZeroDec()
+	for _, coin := range m.Amount {
+		if weight, allowed := weights[coin.Denom]; allowed {
+			value = value.Add(coin.Amount.ToDec().Mul(weight))
+		}
+	}
+	return value
+}
+
+// GetDelistedFunds returns the funds in denoms which are not allowed for funding.
+func (m *Funder) GetDelistedFunds(weights map[string]sdk.Dec) sdk.Coins {
+	delisted := sdk.NewCoins()
+	for _, coin := range m.Amount {
+		if _, allowed := weights[coin.Denom]; !allowed {
+			delisted = delisted.Add(coin)
+		}
+	}
+	return delisted
+}
+
+func (m *Pool) AddToFunder(funderAddress string, amount sdk.Coins) {
 	for _, v := range m.Funders {
 		if v.Address == funderAddress {
-			m.TotalFunds += amount
-			v.Amount += amount
+			m.TotalFunds = m.TotalFunds.Add(amount...)
+			v.Amount = v.Amount.Add(amount...)
 		}
 	}
-	sort.SliceStable(m.Funders, func(i, j int) bool {
-		return m.Funders[i].Amount < m.Funders[j].Amount
-	})
 }
 
-func (m *Pool) SubFromFunder(funderAddress string, amount uint64) {
+func (m *Pool) SubFromFunder(funderAddress string, amount sdk.Coins) {
 	for _, v := range m.Funders {
 		if v.Address == funderAddress {
-			if v.Amount > amount {
-				m.TotalFunds -= amount
-				v.Amount -= amount
-			} else if v.Amount == amount {
-				m.TotalFunds -= amount
-				v.Amount -= amount
-
-				m.RemoveFunder(*v)
+			if remaining, isNegative := v.Amount.SafeSub(amount); !isNegative {
+				m.TotalFunds = m.TotalFunds.Sub(amount)
+				v.Amount = remaining
+
+				if remaining.IsZero() {
+					m.RemoveFunder(*v)
+				}
 			}
+			return
 		}
 	}
-	sort.SliceStable(m.Funders, func(i, j int) bool {
-		return m.Funders[i].Amount < m.Funders[j].Amount
-	})
 }
 
-func (m *Pool) InsertFunder(funder Funder) {
+// ChargeFunder charges the given amount in units of the pool costs from the funder
+// and returns the charged coins. Every allowed coin of the funder is charged in
+// proportion to its share of the funder's value, rounded down. The remainder of
+// the rounding is charged in the denom with the highest value of the funder, also
+// rounded down, so that the funder is never charged more than the amount.
+func (m *Pool) ChargeFunder(funderAddress string, amount uint64, weights map[string]sdk.Dec) sdk.Coins {
+	charged := sdk.NewCoins()
+
+	funder, found := m.GetFunder(funderAddress)
+	if !found || amount == 0 {
+		return charged
+	}
+
+	value := funder.GetValue(weights)
+	if !value.IsPositive() {
+		return charged
+	}
+
+	decAmount := sdk.NewDecFromInt(sdk.NewIntFromUint64(amount))
+	if decAmount.GT(value) {
+		decAmount = value
+	}
+
+	// Truncate every share so that the charge never exceeds the amount
+	chargedValue := sdk.ZeroDec()
+	var highest sdk.Coin
+	highestValue := sdk.ZeroDec()
+
+	for _, coin := range funder.Amount {
+		weight, allowed := weights[coin.Denom]
+		if !allowed {
+			continue
+		}
+
+		chargedAmount := coin.Amount.ToDec().Mul(decAmount).QuoTruncate(value).TruncateInt()
+
+		charged = charged.Add(sdk.NewCoin(coin.Denom, chargedAmount))
+		chargedValue = chargedValue.Add(chargedAmount.ToDec().Mul(weight))
+
+		if coinValue := coin.Amount.ToDec().Mul(weight); coinValue.GT(highestValue) {
+			highest = coin
+			highestValue = coinValue
+		}
+	}
+
+	if remainder := decAmount.Sub(chargedValue); remainder.IsPositive() && highestValue.IsPositive() {
+		remainderAmount := remainder.Quo(weights[highest.Denom]).TruncateInt()
+		if available := highest.Amount.Sub(charged.AmountOf(highest.Denom)); remainderAmount.GT(available) {
+			remainderAmount = available
+		}
+
+		charged = charged.Add(sdk.NewCoin(highest.Denom, remainderAmount))
+	}
+
+	m.SubFromFunder(funderAddress, charged)
+
+	return charged
+}
+
+// GetProRataCharges splits the amount between the funders in proportion to the
+// value of their funds. The remainder of the division is added to the charge of
+// the funder with the highest value. The charges are returned in the order of
+// the funders.
+func (m *Pool) GetProRataCharges(amount uint64, weights map[string]sdk.Dec) []uint64 {
+	charges := make([]uint64, len(m.Funders))
+	if len(m.Funders) == 0 {
+		return charges
+	}
+
+	values := make([]sdk.Dec, len(m.Funders))
+	totalValue := sdk.ZeroDec()
+
+	for index, funder := range m.Funders {
+		values[index] = funder.GetValue(weights)
+		totalValue = totalValue.Add(values[index])
+	}
 
+	decAmount := sdk.NewDecFromInt(sdk.NewIntFromUint64(amount))
+
+	// Truncate every share so that the charges never exceed the amount
+	var charged uint64
+	highest := 0
+
+	for index := range m.Funders {
+		if totalValue.IsPositive() {
+			charges[index] = decAmount.Mul(values[index]).QuoTruncate(totalValue).TruncateInt().Uint64()
+		}
+		charged += charges[index]
+
+		if values[index].GT(values[highest]) {
+			highest = index
+		}
+	}
+
+	charges[highest] += amount - charged
+
+	return charges
+}
+
+// GetInsolventProRataFunder returns the funder with the lowest value among all
+// funders who can't afford their pro-rata charge or whose funds have no value.
+func (m *Pool) GetInsolventProRataFunder(charges []uint64, weights map[string]sdk.Dec) (Funder, bool) {
+	var insolventFunder *Funder
+	var insolventValue sdk.Dec
+
+	for index, funder := range m.Funders {
+		value := funder.GetValue(weights)
+		if !value.IsPositive() || sdk.NewDecFromInt(sdk.NewIntFromUint64(charges[index])).GT(value) {
+			if insolventFunder == nil || value.LT(insolventValue) {
+				insolventFunder = funder
+				insolventValue = value
+			}
+		}
+	}
+
+	if insolventFunder == nil {
+		return Funder{}, false
+	}
+
+	return *insolventFunder, true
+}
+
+func (m *Pool) InsertFunder(funder Funder) {
 	m.Funders = append(m.Funders, &funder)
-	m.TotalFunds += funder.Amount
-	sort.SliceStable(m.Funders, func(i, j int) bool {
-		return m.Funders[i].Amount < m.Funders[j].Amount
-	})
+	m.TotalFunds = m.TotalFunds.Add(funder.Amount...)
 }
 
 func (m *Pool) RemoveFunder(funder Funder) {
-	index := sort.Search(len(m.Funders), func(i int) bool {
-		if m.Funders[i].Amount == funder.Amount {
-			return m.Funders[i].Address >= funder.Address
-		}
-		return m.Funders[i].Amount >= funder.Amount
-	})
-	if index < len(m.Funders) {
-		if m.Funders[index].Address == funder.Address {
-			m.Funders = append(m.Funders[0:index], m.Funders[index+1:len(m.Funders)]...)
-			m.TotalFunds -= funder.Amount
+	for index, v := range m.Funders {
+		if v.Address == funder.Address {
+			m.Funders = append(m.Funders[0:index], m.Funders[index+1:]...)
+			m.TotalFunds = m.TotalFunds.Sub(v.Amount)
+			return
 		}
 	}
 }
@@ -68,9 +277,119 @@ func (m *Pool) GetFunder(address string) (Funder, bool) {
 	return Funder{}, false
 }
 
-func (m *Pool) GetLowestFunder() Funder {
+// GetLowestFunder returns the funder whose funds have the lowest value.
+// If multiple funders have the same value the earliest one is returned.
+func (m *Pool) GetLowestFunder(weights map[string]sdk.Dec) Funder {
 	if len(m.Funders) == 0 {
 		return Funder{}
 	}
-	return *m.Funders[0]
+
+	lowestFunder := m.Funders[0]
+	lowestValue := lowestFunder.GetValue(weights)
+
+	for _, v := range m.Funders[1:] {
+		if value := v.GetValue(weights); value.LT(lowestValue) {
+			lowestFunder = v
+			lowestValue = value
+		}
+	}
+
+	return *lowestFunder
+}
+
+// ChargingModeFromString parses a charging mode like "pro-rata" or "CHARGING_MODE_PRO_RATA".
+func ChargingModeFromString(mode string) (ChargingMode, error) {
+	name := strings.ToUpper(strings.ReplaceAll(mode, "-", "_"))
+	if !strings.HasPrefix(name, "CHARGING_MODE_") {
+		name = "CHARGING_MODE_" + name
+	}
+
+	value, found := ChargingMode_value[name]
+	if !found {
+		return CHARGING_MODE_EQUAL, fmt.Errorf("unknown charging mode %v", mode)
+	}
+
+	return ChargingMode(value), nil
+}
+
+// ValidateChargingMode returns an error if the charging mode is unknown.
+func ValidateChargingMode(mode ChargingMode) error {
+	if _, found := ChargingMode_name[int32(mode)]; !found {
+		return fmt.Errorf("unknown charging mode %v", int32(mode))
+	}
+
+	return nil
+}
+
+// GetMaxFundersOrDefault returns the maximum number of funders of the pool,
+// which falls back to DefaultMaxFunders if the pool does not override it.
+func (m *Pool) GetMaxFundersOrDefault() int {
+	if m.MaxFunders == 0 {
+		return DefaultMaxFunders
+	}
+
+	return int(m.MaxFunders)
+}
+
+// IsFunderAllowed returns true if the address is on the funder allow list
+// of the pool. If the list is empty everybody is allowed to fund the pool.
+func (m *Pool) IsFunderAllowed(address string) bool {
+	if len(m.FunderAllowList) == 0 {
+		return true
+	}
+
+	for _, allowed := range m.FunderAllowList {
+		if allowed == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateMaxFunders returns an error if the maximum number of funders
+// exceeds MaxFundersLimit. Zero is valid and selects the default.
+func ValidateMaxFunders(maxFunders uint64) error {
+	if maxFunders > MaxFundersLimit {
+		return fmt.Errorf("max funders %v must not exceed %v", maxFunders, MaxFundersLimit)
+	}
+
+	return nil
+}
+
+// ValidateAdmin returns an error if the admin is neither empty
+// nor a valid address.
+func ValidateAdmin(admin string) error {
+	if admin == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+		return fmt.Errorf("invalid admin address %v: %w", admin, err)
+	}
+
+	return nil
+}
+
+// ValidateFunderAllowList returns an error if the list contains more than
+// MaxFundersLimit addresses, an invalid address or the same address more than once.
+func ValidateFunderAllowList(funderAllowList []string) error {
+	if len(funderAllowList) > MaxFundersLimit {
+		return fmt.Errorf("funder allow list with %v addresses must not exceed %v", len(funderAllowList), MaxFundersLimit)
+	}
+
+	seen := make(map[string]bool)
+
+	for _, address := range funderAllowList {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid funder address %v: %w", address, err)
+		}
+
+		if seen[address] {
+			return fmt.Errorf("funder %v is allowed more than once", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -67,6 +69,38 @@ func (PoolStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_40c1730f47ff2ef8, []int{0}
 }
 
+// ChargingMode defines how the bundle costs are split between the funders of a pool.
+type ChargingMode int32
+
+const (
+	// CHARGING_MODE_EQUAL splits the costs equally between all funders. Funders
+	// who can't afford their share are removed and their funds are sent to
+	// the treasury. This is the default.
+	CHARGING_MODE_EQUAL ChargingMode = 0
+	// CHARGING_MODE_PRO_RATA splits the costs in proportion to the value of
+	// the funds of every funder. Funders who can't afford their share are
+	// removed and refunded.
+	CHARGING_MODE_PRO_RATA ChargingMode = 1
+)
+
+var ChargingMode_name = map[int32]string{
+	0: "CHARGING_MODE_EQUAL",
+	1: "CHARGING_MODE_PRO_RATA",
+}
+
+var ChargingMode_value = map[string]int32{
+	"CHARGING_MODE_EQUAL":    0,
+	"CHARGING_MODE_PRO_RATA": 1,
+}
+
+func (x ChargingMode) String() string {
+	return proto.EnumName(ChargingMode_name, int32(x))
+}
+
+func (ChargingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_40c1730f47ff2ef8, []int{1}
+}
+
 // Protocol ...
 type Protocol struct {
 	// version ...
@@ -208,8 +242,12 @@ func (m *UpgradePlan) GetDuration() uint64 {
 type Funder struct {
 	// address ...
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// legacy_amount is the funding amount in tkyve before pools
+	// could be funded with multiple denoms. It is only read by the
+	// store migration.
+	LegacyAmount uint64 `protobuf:"varint,3,opt,name=legacy_amount,json=legacyAmount,proto3" json:"legacy_amount,omitempty"` // Deprecated: Do not use.
 	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
 func (m *Funder) Reset()         { *m = Funder{} }
@@ -252,13 +290,21 @@ func (m *Funder) GetAddress() string {
 	return ""
 }
 
-func (m *Funder) GetAmount() uint64 {
+// Deprecated: Do not use.
+func (m *Funder) GetLegacyAmount() uint64 {
 	if m != nil {
-		return m.Amount
+		return m.LegacyAmount
 	}
 	return 0
 }
 
+func (m *Funder) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // Pool ...
 type Pool struct {
 	// id ...
@@ -293,12 +339,49 @@ type Pool struct {
 	Paused bool `protobuf:"varint,155,opt,name=paused,proto3" json:"paused,omitempty"`
 	// funders ...
 	Funders []*Funder `protobuf:"bytes,16,rep,name=funders,proto3" json:"funders,omitempty"`
-	// total_funds ...
-	TotalFunds uint64 `protobuf:"varint,17,opt,name=total_funds,json=totalFunds,proto3" json:"total_funds,omitempty"`
+	// legacy_total_funds is the total funding in tkyve before pools
+	// could be funded with multiple denoms. It is only read by the
+	// store migration.
+	LegacyTotalFunds uint64 `protobuf:"varint,17,opt,name=legacy_total_funds,json=legacyTotalFunds,proto3" json:"legacy_total_funds,omitempty"` // Deprecated: Do not use.
 	// protocol ...
 	Protocol *Protocol `protobuf:"bytes,18,opt,name=protocol,proto3" json:"protocol,omitempty"`
 	// upgrade_plan ...
 	UpgradePlan *UpgradePlan `protobuf:"bytes,19,opt,name=upgrade_plan,json=upgradePlan,proto3" json:"upgrade_plan,omitempty"`
+	// commit_reveal_voting enables the two phase commit-reveal
+	// voting scheme for bundle proposals of this pool
+	CommitRevealVoting bool `protobuf:"varint,20,opt,name=commit_reveal_voting,json=commitRevealVoting,proto3" json:"commit_reveal_voting,omitempty"`
+	// valid_quorum is the share of the pool delegation which has to vote
+	// valid so that a bundle gets finalized, defaults to 0.5 if empty
+	ValidQuorum string `protobuf:"bytes,21,opt,name=valid_quorum,json=validQuorum,proto3" json:"valid_quorum,omitempty"`
+	// invalid_quorum is the share of the pool delegation which has to vote
+	// invalid so that a bundle gets dropped, defaults to 0.5 if empty
+	InvalidQuorum string `protobuf:"bytes,22,opt,name=invalid_quorum,json=invalidQuorum,proto3" json:"invalid_quorum,omitempty"`
+	// total_funds ...
+	TotalFunds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,23,rep,name=total_funds,json=totalFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_funds"`
+	// upload_timeout overrides the upload_timeout param of the
+	// bundles module for this pool, the param is used if zero
+	UploadTimeout uint64 `protobuf:"varint,24,opt,name=upload_timeout,json=uploadTimeout,proto3" json:"upload_timeout,omitempty"`
+	// max_points overrides the max_points param of the
+	// bundles module for this pool, the param is used if zero
+	MaxPoints uint64 `protobuf:"varint,25,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	// charging_mode defines how the bundle costs are split between the funders.
+	ChargingMode ChargingMode `protobuf:"varint,26,opt,name=charging_mode,json=chargingMode,proto3,enum=kyve.pool.v1beta1.ChargingMode" json:"charging_mode,omitempty"`
+	// admin is an optional address which is allowed to manage
+	// the pool without a governance proposal.
+	Admin string `protobuf:"bytes,27,opt,name=admin,proto3" json:"admin,omitempty"`
+	// funder_allow_list contains the only addresses which are allowed
+	// to fund the pool. Everybody can fund the pool if it is empty.
+	// It can contain at most as many addresses as the maximum funders limit.
+	FunderAllowList []string `protobuf:"bytes,28,rep,name=funder_allow_list,json=funderAllowList,proto3" json:"funder_allow_list,omitempty"`
+	// max_funders overrides the default maximum number of
+	// funders of the pool, the default is used if zero
+	MaxFunders uint64 `protobuf:"varint,29,opt,name=max_funders,json=maxFunders,proto3" json:"max_funders,omitempty"`
+	// disable_funder_eviction prevents new funders from replacing
+	// the lowest funder once the pool has reached max_funders
+	DisableFunderEviction bool `protobuf:"varint,30,opt,name=disable_funder_eviction,json=disableFunderEviction,proto3" json:"disable_funder_eviction,omitempty"`
+	// paused_by is the admin who paused the pool. It is empty if the
+	// pool got paused by governance, then only governance can unpause it.
+	PausedBy string `protobuf:"bytes,31,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -446,9 +529,10 @@ func (m *Pool) GetFunders() []*Funder {
 	return nil
 }
 
-func (m *Pool) GetTotalFunds() uint64 {
+// Deprecated: Do not use.
+func (m *Pool) GetLegacyTotalFunds() uint64 {
 	if m != nil {
-		return m.TotalFunds
+		return m.LegacyTotalFunds
 	}
 	return 0
 }
@@ -467,8 +551,93 @@ func (m *Pool) GetUpgradePlan() *UpgradePlan {
 	return nil
 }
 
+func (m *Pool) GetCommitRevealVoting() bool {
+	if m != nil {
+		return m.CommitRevealVoting
+	}
+	return false
+}
+
+func (m *Pool) GetValidQuorum() string {
+	if m != nil {
+		return m.ValidQuorum
+	}
+	return ""
+}
+
+func (m *Pool) GetInvalidQuorum() string {
+	if m != nil {
+		return m.InvalidQuorum
+	}
+	return ""
+}
+
+func (m *Pool) GetTotalFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFunds
+	}
+	return nil
+}
+
+func (m *Pool) GetUploadTimeout() uint64 {
+	if m != nil {
+		return m.UploadTimeout
+	}
+	return 0
+}
+
+func (m *Pool) GetMaxPoints() uint64 {
+	if m != nil {
+		return m.MaxPoints
+	}
+	return 0
+}
+
+func (m *Pool) GetChargingMode() ChargingMode {
+	if m != nil {
+		return m.ChargingMode
+	}
+	return CHARGING_MODE_EQUAL
+}
+
+func (m *Pool) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *Pool) GetFunderAllowList() []string {
+	if m != nil {
+		return m.FunderAllowList
+	}
+	return nil
+}
+
+func (m *Pool) GetMaxFunders() uint64 {
+	if m != nil {
+		return m.MaxFunders
+	}
+	return 0
+}
+
+func (m *Pool) GetDisableFunderEviction() bool {
+	if m != nil {
+		return m.DisableFunderEviction
+	}
+	return false
+}
+
+func (m *Pool) GetPausedBy() string {
+	if m != nil {
+		return m.PausedBy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("kyve.pool.v1beta1.PoolStatus", PoolStatus_name, PoolStatus_value)
+	proto.RegisterEnum("kyve.pool.v1beta1.ChargingMode", ChargingMode_name, ChargingMode_value)
 	proto.RegisterType((*Protocol)(nil), "kyve.pool.v1beta1.Protocol")
 	proto.RegisterType((*UpgradePlan)(nil), "kyve.pool.v1beta1.UpgradePlan")
 	proto.RegisterType((*Funder)(nil), "kyve.pool.v1beta1.Funder")
@@ -478,54 +647,77 @@ func init() {
 func init() { proto.RegisterFile("kyve/pool/v1beta1/pool.proto", fileDescriptor_40c1730f47ff2ef8) }
 
 var fileDescriptor_40c1730f47ff2ef8 = []byte{
-	// 750 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x72, 0xea, 0x36,
-	0x14, 0xc6, 0x31, 0x21, 0x04, 0x04, 0x97, 0x4b, 0xd4, 0x34, 0x51, 0xfe, 0x8c, 0x4b, 0xe9, 0xb4,
-	0xa5, 0x5d, 0xc0, 0x24, 0x59, 0x74, 0xa6, 0x3b, 0x02, 0x24, 0x61, 0xd2, 0x01, 0xc6, 0x86, 0xcc,
-	0xb4, 0x1b, 0x8f, 0xb0, 0x15, 0xe3, 0xc1, 0x96, 0x3c, 0x96, 0x4c, 0x43, 0x96, 0x5d, 0x75, 0xd9,
-	0x7d, 0x17, 0x5d, 0xf4, 0x1d, 0xfa, 0x0c, 0x5d, 0x66, 0xd9, 0x65, 0x27, 0x79, 0x91, 0x8e, 0x64,
-	0x9b, 0x26, 0x6d, 0x56, 0x77, 0xa7, 0xef, 0x77, 0x3e, 0x9d, 0x23, 0x1f, 0xe9, 0x18, 0x9c, 0x2c,
-	0xd7, 0x2b, 0xd2, 0x09, 0x19, 0xf3, 0x3b, 0xab, 0xd3, 0x39, 0x11, 0xf8, 0x54, 0x89, 0x76, 0x18,
-	0x31, 0xc1, 0xe0, 0xae, 0x8c, 0xb6, 0x15, 0x48, 0xa3, 0x47, 0x7b, 0x2e, 0x73, 0x99, 0x8a, 0x76,
-	0xe4, 0x2a, 0x31, 0x36, 0x6d, 0x50, 0x9a, 0xc8, 0x85, 0xcd, 0x7c, 0x88, 0xc0, 0xce, 0x8a, 0x44,
-	0xdc, 0x63, 0x14, 0x69, 0x0d, 0xad, 0x55, 0x36, 0x32, 0x09, 0x8f, 0x40, 0x69, 0xee, 0x51, 0x1c,
-	0x79, 0x84, 0xa3, 0xbc, 0x0a, 0x6d, 0x34, 0xfc, 0x14, 0x54, 0x7d, 0xcc, 0x85, 0x15, 0x87, 0x6e,
-	0x84, 0x1d, 0x82, 0xb6, 0x1a, 0x5a, 0xab, 0x60, 0x54, 0x24, 0x9b, 0x25, 0xa8, 0xf9, 0x93, 0x06,
-	0x2a, 0xe9, 0x7a, 0xe2, 0x63, 0xfa, 0xe1, 0x85, 0xb8, 0xbd, 0x20, 0x4e, 0xec, 0x13, 0xc7, 0xc2,
-	0x22, 0x2b, 0xb4, 0x61, 0x5d, 0x21, 0xb7, 0x3b, 0x71, 0x84, 0x85, 0xcc, 0x5c, 0x50, 0xe1, 0x8d,
-	0x6e, 0x7e, 0x0b, 0x8a, 0x97, 0x31, 0x75, 0x48, 0x24, 0xcb, 0x63, 0xc7, 0x89, 0x08, 0xcf, 0x6a,
-	0x64, 0x12, 0xee, 0x83, 0x22, 0x0e, 0x58, 0x4c, 0xb3, 0xe4, 0xa9, 0x6a, 0xfe, 0xb6, 0x0d, 0x0a,
-	0x13, 0xc6, 0x7c, 0x58, 0x03, 0x79, 0xcf, 0x51, 0x87, 0x2e, 0x18, 0x79, 0xcf, 0x81, 0x10, 0x14,
-	0x28, 0x0e, 0x48, 0x9a, 0x47, 0xad, 0x65, 0xfa, 0x28, 0xa6, 0xc2, 0x0b, 0x92, 0x5e, 0x94, 0x8d,
-	0x4c, 0x4a, 0xb7, 0xcf, 0x5c, 0xa6, 0x8e, 0x56, 0x36, 0xd4, 0x5a, 0x96, 0xb4, 0x19, 0xbd, 0xf3,
-	0x5c, 0xb4, 0xad, 0x68, 0xaa, 0xe0, 0x31, 0x28, 0x73, 0x81, 0x23, 0x61, 0x2d, 0xc9, 0x1a, 0x15,
-	0x93, 0x56, 0x28, 0x70, 0x43, 0xd6, 0xf0, 0x13, 0x50, 0xb1, 0xe3, 0x28, 0x22, 0x34, 0x09, 0xef,
-	0xa8, 0x30, 0x48, 0x91, 0x34, 0x7c, 0x06, 0xde, 0x65, 0x86, 0x15, 0xf6, 0x63, 0x82, 0x4a, 0xca,
-	0x52, 0x4d, 0xe1, 0xad, 0x64, 0xf0, 0x73, 0x50, 0xcb, 0x4c, 0x0b, 0xe2, 0xb9, 0x0b, 0x81, 0xca,
-	0xea, 0xc3, 0xb2, 0xad, 0xd7, 0x0a, 0xca, 0x5c, 0x82, 0x09, 0xec, 0x5b, 0xf3, 0x98, 0x3a, 0x3e,
-	0xe1, 0x08, 0x28, 0x57, 0x55, 0xc1, 0x8b, 0x84, 0xc1, 0x2f, 0xc1, 0xfb, 0x38, 0xf4, 0x19, 0x76,
-	0x2c, 0x8f, 0x0a, 0x12, 0xad, 0xb0, 0x8f, 0x2a, 0xca, 0x56, 0x4b, 0xf0, 0x30, 0xa5, 0xb2, 0x28,
-	0x0b, 0x89, 0xbc, 0x13, 0xea, 0x5a, 0x36, 0xe3, 0x02, 0x55, 0x93, 0xa2, 0x1b, 0xda, 0x63, 0x5c,
-	0xc8, 0xcf, 0x0f, 0x3c, 0x6a, 0x71, 0x81, 0x97, 0x04, 0xbd, 0x4b, 0xae, 0x32, 0xf0, 0xa8, 0x29,
-	0x35, 0xfc, 0x02, 0xbc, 0x0f, 0xf0, 0x7d, 0x7a, 0x1e, 0x8b, 0x7b, 0x0f, 0x04, 0xd5, 0x92, 0x24,
-	0x01, 0xbe, 0x4f, 0x4e, 0x64, 0x7a, 0x0f, 0x04, 0x1e, 0x80, 0x62, 0x88, 0x63, 0x4e, 0x1c, 0xf4,
-	0xab, 0xbc, 0xb2, 0x92, 0x91, 0x4a, 0x78, 0x0e, 0x76, 0xee, 0xd4, 0x5b, 0xe0, 0xa8, 0xde, 0xd8,
-	0x6a, 0x55, 0xce, 0x0e, 0xdb, 0xff, 0x1b, 0x98, 0x76, 0xf2, 0x5a, 0x8c, 0xcc, 0x29, 0x9b, 0x9e,
-	0xf4, 0x41, 0x02, 0x8e, 0x76, 0x55, 0x45, 0xa0, 0x90, 0xb4, 0x72, 0xf8, 0x0d, 0x28, 0x85, 0xe9,
-	0x2c, 0x21, 0xd8, 0xd0, 0x5a, 0x95, 0xb3, 0xe3, 0x37, 0xd2, 0x66, 0xe3, 0x66, 0x6c, 0xcc, 0xb0,
-	0x0b, 0xaa, 0xe9, 0xf4, 0x58, 0xa1, 0x8f, 0x29, 0xfa, 0x48, 0x6d, 0xd6, 0xdf, 0xd8, 0xfc, 0x62,
-	0x8a, 0x8c, 0x4a, 0xfc, 0xaf, 0xf8, 0xfa, 0x0f, 0x0d, 0x00, 0xf9, 0x42, 0x4d, 0x81, 0x45, 0xcc,
-	0xe1, 0x31, 0x38, 0x98, 0x8c, 0xc7, 0xdf, 0x59, 0xe6, 0xb4, 0x3b, 0x9d, 0x99, 0xd6, 0x6c, 0x64,
-	0x4e, 0x06, 0xbd, 0xe1, 0xe5, 0x70, 0xd0, 0xaf, 0xe7, 0xe0, 0x3e, 0x80, 0x2f, 0x83, 0xdd, 0xde,
-	0x74, 0x78, 0x3b, 0xa8, 0x6b, 0xff, 0xe5, 0x93, 0xee, 0xcc, 0x1c, 0xf4, 0xeb, 0x79, 0x88, 0xc0,
-	0xde, 0x4b, 0x3e, 0x1a, 0x5b, 0x97, 0xb3, 0x51, 0xdf, 0xac, 0x6f, 0xc1, 0x06, 0x38, 0x79, 0x1d,
-	0x99, 0x5a, 0x83, 0xd1, 0x78, 0x76, 0x75, 0x2d, 0xc9, 0xcd, 0xa0, 0x5e, 0x80, 0x87, 0xe0, 0xe3,
-	0x57, 0x07, 0x99, 0x5c, 0x19, 0xdd, 0xfe, 0x70, 0x74, 0x55, 0xdf, 0x3e, 0x2a, 0xfc, 0xfc, 0xbb,
-	0x9e, 0xbb, 0xe8, 0xfd, 0xf9, 0xa4, 0x6b, 0x8f, 0x4f, 0xba, 0xf6, 0xf7, 0x93, 0xae, 0xfd, 0xf2,
-	0xac, 0xe7, 0x1e, 0x9f, 0xf5, 0xdc, 0x5f, 0xcf, 0x7a, 0xee, 0x87, 0xaf, 0x5c, 0x4f, 0x2c, 0xe2,
-	0x79, 0xdb, 0x66, 0x41, 0xe7, 0xe6, 0xfb, 0xdb, 0xc1, 0x88, 0x88, 0x1f, 0x59, 0xb4, 0xec, 0xd8,
-	0x0b, 0xec, 0xd1, 0xce, 0x7d, 0xf2, 0xef, 0x13, 0xeb, 0x90, 0xf0, 0x79, 0x51, 0xb5, 0xf2, 0xfc,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xa3, 0xbc, 0x7c, 0x15, 0x05, 0x00, 0x00,
+	// 1111 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x73, 0x1a, 0x37,
+	0x14, 0x66, 0x6d, 0x42, 0x8c, 0xc0, 0x0e, 0x51, 0x1c, 0x5b, 0xc1, 0x09, 0xa6, 0xee, 0xa4, 0xa1,
+	0x99, 0x29, 0xe4, 0xc7, 0x4c, 0x7b, 0x5e, 0x03, 0x71, 0x18, 0x3b, 0x40, 0x16, 0xf0, 0x4c, 0x7b,
+	0xd1, 0x88, 0x5d, 0x05, 0x34, 0xde, 0x5d, 0xd1, 0x95, 0x96, 0x98, 0x1c, 0x7b, 0xea, 0xb1, 0xf7,
+	0x1e, 0x7b, 0xeb, 0xa9, 0xa7, 0xfe, 0x0d, 0x39, 0xe6, 0xd8, 0x53, 0xda, 0xb1, 0xff, 0x91, 0x8e,
+	0xa4, 0x5d, 0x82, 0x5b, 0x9f, 0x3a, 0x3d, 0x59, 0xef, 0xfb, 0xbe, 0xa7, 0x27, 0xde, 0xfb, 0xde,
+	0x8e, 0xc1, 0xfd, 0xb3, 0xc5, 0x9c, 0x36, 0x66, 0x9c, 0xfb, 0x8d, 0xf9, 0xd3, 0x31, 0x95, 0xe4,
+	0xa9, 0x0e, 0xea, 0xb3, 0x88, 0x4b, 0x0e, 0x6f, 0x2b, 0xb6, 0xae, 0x81, 0x84, 0x2d, 0x57, 0x5c,
+	0x2e, 0x02, 0x2e, 0x1a, 0x63, 0x22, 0xe8, 0x32, 0xc5, 0xe5, 0x2c, 0x34, 0x29, 0xe5, 0xed, 0x09,
+	0x9f, 0x70, 0x7d, 0x6c, 0xa8, 0x93, 0x41, 0x0f, 0x5c, 0xb0, 0xd1, 0x57, 0x07, 0x97, 0xfb, 0x10,
+	0x81, 0x9b, 0x73, 0x1a, 0x09, 0xc6, 0x43, 0x64, 0x55, 0xad, 0x5a, 0xde, 0x49, 0x43, 0x58, 0x06,
+	0x1b, 0x63, 0x16, 0x92, 0x88, 0x51, 0x81, 0xd6, 0x34, 0xb5, 0x8c, 0xe1, 0x67, 0xa0, 0xe8, 0x13,
+	0x21, 0x71, 0x3c, 0x9b, 0x44, 0xc4, 0xa3, 0x68, 0xbd, 0x6a, 0xd5, 0xb2, 0x4e, 0x41, 0x61, 0x23,
+	0x03, 0x1d, 0xfc, 0x60, 0x81, 0x42, 0x72, 0xee, 0xfb, 0x24, 0xfc, 0xef, 0x85, 0x84, 0x3b, 0xa5,
+	0x5e, 0xec, 0x53, 0x0f, 0x13, 0x99, 0x16, 0x5a, 0x62, 0xb6, 0x54, 0xe9, 0x5e, 0x1c, 0x11, 0xa9,
+	0x6e, 0xce, 0x6a, 0x7a, 0x19, 0x1f, 0xfc, 0x66, 0x81, 0xdc, 0x8b, 0x38, 0xf4, 0x68, 0xa4, 0xea,
+	0x13, 0xcf, 0x8b, 0xa8, 0x48, 0x8b, 0xa4, 0x21, 0x7c, 0x04, 0x36, 0x7d, 0x3a, 0x21, 0xee, 0x02,
+	0x93, 0x80, 0xc7, 0x61, 0x52, 0xe4, 0x70, 0x0d, 0x59, 0x4e, 0xd1, 0x10, 0xb6, 0xc6, 0xa1, 0x0b,
+	0x72, 0x89, 0x22, 0x5b, 0x5d, 0xaf, 0x15, 0x9e, 0xdd, 0xab, 0x9b, 0xf6, 0xd7, 0x55, 0xfb, 0xd3,
+	0x99, 0xd4, 0x9b, 0x9c, 0x85, 0x87, 0x4f, 0xde, 0x7f, 0xdc, 0xcf, 0xfc, 0xfa, 0xe7, 0x7e, 0x6d,
+	0xc2, 0xe4, 0x34, 0x1e, 0xd7, 0x5d, 0x1e, 0x34, 0x92, 0x59, 0x99, 0x3f, 0x5f, 0x09, 0xef, 0xac,
+	0x21, 0x17, 0x33, 0x2a, 0x74, 0x82, 0x70, 0x92, 0xab, 0x0f, 0x3e, 0xe6, 0x41, 0xb6, 0xcf, 0xb9,
+	0x0f, 0xb7, 0xc0, 0x1a, 0xf3, 0x74, 0xaf, 0xb2, 0xce, 0x1a, 0xf3, 0x20, 0x04, 0xd9, 0x90, 0x04,
+	0x34, 0x79, 0xbd, 0x3e, 0xab, 0x1f, 0x15, 0xc5, 0xa1, 0x64, 0x81, 0x19, 0x41, 0xde, 0x49, 0x43,
+	0xa5, 0xf6, 0xf9, 0x84, 0xeb, 0x8e, 0xe4, 0x1d, 0x7d, 0x86, 0x3b, 0x20, 0xe7, 0xf2, 0xf0, 0x0d,
+	0x9b, 0xa0, 0x1b, 0x1a, 0x4d, 0x22, 0xb8, 0x07, 0xf2, 0x42, 0x92, 0x48, 0xe2, 0x33, 0xba, 0x40,
+	0x39, 0x33, 0x01, 0x0d, 0x1c, 0xd3, 0x05, 0xdc, 0x07, 0x05, 0x37, 0x8e, 0x22, 0x1a, 0x1a, 0xfa,
+	0xa6, 0xa6, 0x41, 0x02, 0x29, 0xc1, 0xe7, 0x60, 0x33, 0x15, 0xcc, 0x89, 0x1f, 0x53, 0xb4, 0xa1,
+	0x25, 0xc5, 0x04, 0x3c, 0x55, 0x18, 0x7c, 0x08, 0xb6, 0x52, 0xd1, 0x94, 0xb2, 0xc9, 0x54, 0xa2,
+	0xbc, 0xfe, 0x61, 0x69, 0xea, 0x4b, 0x0d, 0xaa, 0xbb, 0x24, 0x97, 0xc4, 0xc7, 0xe3, 0x38, 0xf4,
+	0x7c, 0x2a, 0x10, 0xd0, 0xaa, 0xa2, 0x06, 0x0f, 0x0d, 0x06, 0x1f, 0x81, 0x5b, 0xf1, 0xcc, 0xe7,
+	0xc4, 0xc3, 0x2c, 0x94, 0x34, 0x9a, 0x13, 0x1f, 0x15, 0xb4, 0x6c, 0xcb, 0xc0, 0x9d, 0x04, 0x55,
+	0x45, 0xf9, 0x8c, 0x2a, 0x2b, 0x84, 0x13, 0xec, 0x72, 0x21, 0x51, 0xd1, 0x14, 0x5d, 0xa2, 0x4d,
+	0x2e, 0xa4, 0xfa, 0xf9, 0x01, 0x0b, 0xb1, 0x90, 0xe4, 0x8c, 0xa2, 0x4d, 0xe3, 0xa0, 0x80, 0x85,
+	0x03, 0x15, 0xc3, 0x2f, 0xc0, 0xad, 0x80, 0x9c, 0x27, 0xef, 0xc1, 0x82, 0xbd, 0xa3, 0x68, 0xcb,
+	0x5c, 0x12, 0x90, 0x73, 0xf3, 0xa2, 0x01, 0x7b, 0x47, 0xe1, 0x2e, 0xc8, 0xcd, 0x48, 0x2c, 0xa8,
+	0x87, 0x7e, 0x56, 0x23, 0xdb, 0x70, 0x92, 0x10, 0x3e, 0x07, 0x37, 0xdf, 0x68, 0x07, 0x0a, 0x54,
+	0x4a, 0x5c, 0xf3, 0xaf, 0x3d, 0xae, 0x1b, 0x8f, 0x3a, 0xa9, 0x12, 0x3e, 0x01, 0x30, 0xb1, 0xa4,
+	0x69, 0x87, 0xc2, 0x05, 0xba, 0xbd, 0xf4, 0x65, 0xc9, 0xb0, 0x43, 0x45, 0xaa, 0x5c, 0x01, 0xbf,
+	0x01, 0x1b, 0xb3, 0x64, 0xa7, 0x11, 0xac, 0x5a, 0xb5, 0xc2, 0xb3, 0xbd, 0x6b, 0xea, 0xa4, 0x6b,
+	0xef, 0x2c, 0xc5, 0xd0, 0x06, 0xc5, 0x64, 0x8b, 0xf1, 0xcc, 0x27, 0x21, 0xba, 0xa3, 0x93, 0x2b,
+	0xd7, 0x24, 0xaf, 0x6c, 0xb3, 0x53, 0x88, 0x3f, 0x05, 0xf0, 0x09, 0xd8, 0x76, 0x79, 0x10, 0x30,
+	0x89, 0x23, 0x3a, 0xa7, 0xc4, 0xc7, 0x73, 0xae, 0x9a, 0x8b, 0xb6, 0x75, 0x23, 0xa0, 0xe1, 0x1c,
+	0x4d, 0x9d, 0x6a, 0x46, 0xad, 0xf5, 0x9c, 0xf8, 0xcc, 0xc3, 0xdf, 0xc7, 0x3c, 0x8a, 0x03, 0x74,
+	0x57, 0x5b, 0xa6, 0xa0, 0xb1, 0xd7, 0x1a, 0x52, 0xc3, 0x63, 0xe1, 0x15, 0xd1, 0x8e, 0x16, 0x6d,
+	0xb2, 0x70, 0x55, 0xe6, 0x83, 0xc2, 0x6a, 0x8b, 0x76, 0xff, 0xff, 0xc5, 0x04, 0xf2, 0x53, 0x97,
+	0x1f, 0x82, 0xc4, 0x63, 0x58, 0x2d, 0x19, 0x8f, 0x25, 0x42, 0xc6, 0x0c, 0x06, 0x1d, 0x1a, 0x10,
+	0x3e, 0x00, 0x40, 0x99, 0x66, 0xc6, 0x59, 0x28, 0x05, 0xba, 0xa7, 0x25, 0xf9, 0x80, 0x9c, 0xf7,
+	0x35, 0x00, 0x5b, 0x60, 0xd3, 0x9d, 0x92, 0x68, 0xa2, 0x6c, 0x19, 0x70, 0x8f, 0xa2, 0x72, 0xd5,
+	0xaa, 0x6d, 0x3d, 0xdb, 0xbf, 0xa6, 0xe7, 0xcd, 0x44, 0xf7, 0x8a, 0x7b, 0xd4, 0x29, 0xba, 0x2b,
+	0x11, 0xdc, 0x06, 0x37, 0x88, 0x17, 0xb0, 0x10, 0xed, 0xe9, 0xbe, 0x98, 0x00, 0x3e, 0x06, 0xb7,
+	0x8d, 0x89, 0x30, 0xf1, 0x7d, 0xfe, 0x16, 0xfb, 0x4c, 0x48, 0x74, 0xbf, 0xba, 0x5e, 0xcb, 0x3b,
+	0xb7, 0x0c, 0x61, 0x2b, 0xfc, 0x84, 0x09, 0xa9, 0x56, 0x5b, 0x3d, 0x33, 0xb5, 0xe7, 0x03, 0xfd,
+	0x4e, 0xf5, 0x72, 0x63, 0x47, 0x01, 0xbf, 0x06, 0xbb, 0x1e, 0x13, 0x64, 0xec, 0xd3, 0x44, 0x84,
+	0xe9, 0x9c, 0xb9, 0xfa, 0x4b, 0x5b, 0xd1, 0xb3, 0xbd, 0x9b, 0xd0, 0x26, 0xa1, 0x9d, 0x90, 0x6a,
+	0xa3, 0x8c, 0xfb, 0xf1, 0x78, 0x81, 0xf6, 0xcd, 0x07, 0xc5, 0x00, 0x87, 0x8b, 0xc7, 0xbf, 0x5b,
+	0x00, 0xa8, 0x0f, 0xdc, 0x40, 0x12, 0x19, 0x0b, 0xb8, 0x07, 0x76, 0xfb, 0xbd, 0xde, 0x09, 0x1e,
+	0x0c, 0xed, 0xe1, 0x68, 0x80, 0x47, 0xdd, 0x41, 0xbf, 0xdd, 0xec, 0xbc, 0xe8, 0xb4, 0x5b, 0xa5,
+	0x0c, 0xdc, 0x01, 0x70, 0x95, 0xb4, 0x9b, 0xc3, 0xce, 0x69, 0xbb, 0x64, 0xfd, 0x13, 0xef, 0xdb,
+	0xa3, 0x41, 0xbb, 0x55, 0x5a, 0x83, 0x08, 0x6c, 0xaf, 0xe2, 0xdd, 0x1e, 0x7e, 0x31, 0xea, 0xb6,
+	0x06, 0xa5, 0x75, 0x58, 0x05, 0xf7, 0xaf, 0x32, 0x43, 0xdc, 0xee, 0xf6, 0x46, 0x47, 0x2f, 0x15,
+	0x72, 0xdc, 0x2e, 0x65, 0xe1, 0x3d, 0x70, 0xf7, 0xca, 0x43, 0xfa, 0x47, 0x8e, 0xdd, 0xea, 0x74,
+	0x8f, 0x4a, 0x37, 0xca, 0xd9, 0x1f, 0x7f, 0xa9, 0x64, 0x1e, 0x77, 0x40, 0x71, 0x75, 0x1c, 0x70,
+	0x17, 0xdc, 0x69, 0xbe, 0xb4, 0x9d, 0xa3, 0x4e, 0xf7, 0x08, 0xbf, 0xea, 0xb5, 0xda, 0xb8, 0xfd,
+	0x7a, 0x64, 0x9f, 0x94, 0x32, 0xb0, 0x0c, 0x76, 0xae, 0x12, 0x7d, 0xa7, 0x87, 0x1d, 0x7b, 0x68,
+	0x97, 0x2c, 0x73, 0xd5, 0x61, 0xf3, 0xfd, 0x45, 0xc5, 0xfa, 0x70, 0x51, 0xb1, 0xfe, 0xba, 0xa8,
+	0x58, 0x3f, 0x5d, 0x56, 0x32, 0x1f, 0x2e, 0x2b, 0x99, 0x3f, 0x2e, 0x2b, 0x99, 0xef, 0xbe, 0x5c,
+	0xf1, 0xe5, 0xf1, 0xb7, 0xa7, 0xed, 0x2e, 0x95, 0x6f, 0x79, 0x74, 0xd6, 0x70, 0xa7, 0x84, 0x85,
+	0x8d, 0x73, 0xf3, 0xcf, 0x81, 0xb6, 0xe7, 0x38, 0xa7, 0x77, 0xf8, 0xf9, 0xdf, 0x03, 0x00, 0x0f,
+	0x98, 0x13, 0xa4, 0x36, 0x08, 0x00, 0x00,
 }
 
 func (m *Protocol) Marshal() (dAtA []byte, err error) {
@@ -637,8 +829,22 @@ func (m *Funder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.Amount))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LegacyAmount != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.LegacyAmount))
 		i--
 		dAtA[i] = 0x18
 	}
@@ -684,6 +890,121 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xd8
 	}
+	if len(m.PausedBy) > 0 {
+		i -= len(m.PausedBy)
+		copy(dAtA[i:], m.PausedBy)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.PausedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.DisableFunderEviction {
+		i--
+		if m.DisableFunderEviction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.MaxFunders != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.MaxFunders))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.FunderAllowList) > 0 {
+		for iNdEx := len(m.FunderAllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FunderAllowList[iNdEx])
+			copy(dAtA[i:], m.FunderAllowList[iNdEx])
+			i = encodeVarintPool(dAtA, i, uint64(len(m.FunderAllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.ChargingMode != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.ChargingMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.MaxPoints != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.MaxPoints))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.UploadTimeout != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.UploadTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.TotalFunds) > 0 {
+		for iNdEx := len(m.TotalFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.InvalidQuorum) > 0 {
+		i -= len(m.InvalidQuorum)
+		copy(dAtA[i:], m.InvalidQuorum)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.InvalidQuorum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.ValidQuorum) > 0 {
+		i -= len(m.ValidQuorum)
+		copy(dAtA[i:], m.ValidQuorum)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.ValidQuorum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.CommitRevealVoting {
+		i--
+		if m.CommitRevealVoting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.UpgradePlan != nil {
 		{
 			size, err := m.UpgradePlan.MarshalToSizedBuffer(dAtA[:i])
@@ -712,8 +1033,8 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x92
 	}
-	if m.TotalFunds != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.TotalFunds))
+	if m.LegacyTotalFunds != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.LegacyTotalFunds))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -886,8 +1207,14 @@ func (m *Funder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPool(uint64(l))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovPool(uint64(m.Amount))
+	if m.LegacyAmount != 0 {
+		n += 1 + sovPool(uint64(m.LegacyAmount))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
 	}
 	return n
 }
@@ -953,8 +1280,8 @@ func (m *Pool) Size() (n int) {
 			n += 2 + l + sovPool(uint64(l))
 		}
 	}
-	if m.TotalFunds != 0 {
-		n += 2 + sovPool(uint64(m.TotalFunds))
+	if m.LegacyTotalFunds != 0 {
+		n += 2 + sovPool(uint64(m.LegacyTotalFunds))
 	}
 	if m.Protocol != nil {
 		l = m.Protocol.Size()
@@ -964,6 +1291,52 @@ func (m *Pool) Size() (n int) {
 		l = m.UpgradePlan.Size()
 		n += 2 + l + sovPool(uint64(l))
 	}
+	if m.CommitRevealVoting {
+		n += 3
+	}
+	l = len(m.ValidQuorum)
+	if l > 0 {
+		n += 2 + l + sovPool(uint64(l))
+	}
+	l = len(m.InvalidQuorum)
+	if l > 0 {
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if len(m.TotalFunds) > 0 {
+		for _, e := range m.TotalFunds {
+			l = e.Size()
+			n += 2 + l + sovPool(uint64(l))
+		}
+	}
+	if m.UploadTimeout != 0 {
+		n += 2 + sovPool(uint64(m.UploadTimeout))
+	}
+	if m.MaxPoints != 0 {
+		n += 2 + sovPool(uint64(m.MaxPoints))
+	}
+	if m.ChargingMode != 0 {
+		n += 2 + sovPool(uint64(m.ChargingMode))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if len(m.FunderAllowList) > 0 {
+		for _, s := range m.FunderAllowList {
+			l = len(s)
+			n += 2 + l + sovPool(uint64(l))
+		}
+	}
+	if m.MaxFunders != 0 {
+		n += 2 + sovPool(uint64(m.MaxFunders))
+	}
+	if m.DisableFunderEviction {
+		n += 3
+	}
+	l = len(m.PausedBy)
+	if l > 0 {
+		n += 2 + l + sovPool(uint64(l))
+	}
 	if m.Paused {
 		n += 3
 	}
@@ -1324,9 +1697,28 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyAmount", wireType)
+			}
+			m.LegacyAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LegacyAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Amount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1336,11 +1728,26 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -1784,9 +2191,9 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyTotalFunds", wireType)
 			}
-			m.TotalFunds = 0
+			m.LegacyTotalFunds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -1796,7 +2203,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalFunds |= uint64(b&0x7F) << shift
+				m.LegacyTotalFunds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1873,6 +2280,316 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealVoting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitRevealVoting = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFunds = append(m.TotalFunds, types.Coin{})
+			if err := m.TotalFunds[len(m.TotalFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTimeout", wireType)
+			}
+			m.UploadTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoints", wireType)
+			}
+			m.MaxPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargingMode", wireType)
+			}
+			m.ChargingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargingMode |= ChargingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAllowList = append(m.FunderAllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFunders", wireType)
+			}
+			m.MaxFunders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFunders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableFunderEviction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableFunderEviction = bool(v != 0)
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 155:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
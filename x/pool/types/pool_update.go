@@ -0,0 +1,301 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Names of the pool fields which can be used in the update mask of an
+// UpdatePoolProposal.
+const (
+	PoolUpdateName               = "name"
+	PoolUpdateRuntime            = "runtime"
+	PoolUpdateLogo               = "logo"
+	PoolUpdateConfig             = "config"
+	PoolUpdateUploadInterval     = "upload_interval"
+	PoolUpdateOperatingCost      = "operating_cost"
+	PoolUpdateMinStake           = "min_stake"
+	PoolUpdateMaxBundleSize      = "max_bundle_size"
+	PoolUpdateCommitRevealVoting = "commit_reveal_voting"
+	PoolUpdateValidQuorum        = "valid_quorum"
+	PoolUpdateInvalidQuorum      = "invalid_quorum"
+	PoolUpdateUploadTimeout      = "upload_timeout"
+	PoolUpdateMaxPoints          = "max_points"
+	PoolUpdateChargingMode       = "charging_mode"
+
+	PoolUpdateAdmin                 = "admin"
+	PoolUpdateFunderAllowList       = "funder_allow_list"
+	PoolUpdateMaxFunders            = "max_funders"
+	PoolUpdateDisableFunderEviction = "disable_funder_eviction"
+)
+
+// legacyPoolUpdate is the format of the deprecated JSON payload of an
+// UpdatePoolProposal. Keys are matched case-insensitively.
+type legacyPoolUpdate struct {
+	Name           *string
+	Runtime        *string
+	Logo           *string
+	Config         *string
+	UploadInterval *uint64
+	OperatingCost  *uint64
+	MinStake       *uint64
+	MaxBundleSize  *uint64
+
+	CommitRevealVoting *bool
+
+	ValidQuorum   *string
+	InvalidQuorum *string
+
+	UploadTimeout *uint64
+	MaxPoints     *uint64
+}
+
+// GetPoolUpdate returns the validated update mask and the new values of the
+// proposal. The deprecated JSON payload is converted into the typed form, it
+// must not contain unknown keys.
+func (p *UpdatePoolProposal) GetPoolUpdate() ([]string, PoolUpdate, error) {
+	mask, update := p.UpdateMask, p.Update
+
+	if p.Payload != "" {
+		if len(p.UpdateMask) > 0 {
+			return nil, PoolUpdate{}, fmt.Errorf("payload can not be combined with an update mask")
+		}
+
+		var err error
+		if mask, update, err = parseLegacyPoolUpdate(p.Payload); err != nil {
+			return nil, PoolUpdate{}, err
+		}
+	}
+
+	if err := ValidatePoolUpdate(mask, update); err != nil {
+		return nil, PoolUpdate{}, err
+	}
+
+	return mask, update, nil
+}
+
+func parseLegacyPoolUpdate(payload string) ([]string, PoolUpdate, error) {
+	var legacy legacyPoolUpdate
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(payload)))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&legacy); err != nil {
+		return nil, PoolUpdate{}, fmt.Errorf("invalid payload: %w", err)
+	}
+
+	if decoder.More() {
+		return nil, PoolUpdate{}, fmt.Errorf("invalid payload: unexpected data after the JSON object")
+	}
+
+	var mask []string
+	var update PoolUpdate
+
+	if legacy.Name != nil {
+		mask, update.Name = append(mask, PoolUpdateName), *legacy.Name
+	}
+	if legacy.Runtime != nil {
+		mask, update.Runtime = append(mask, PoolUpdateRuntime), *legacy.Runtime
+	}
+	if legacy.Logo != nil {
+		mask, update.Logo = append(mask, PoolUpdateLogo), *legacy.Logo
+	}
+	if legacy.Config != nil {
+		mask, update.Config = append(mask, PoolUpdateConfig), *legacy.Config
+	}
+	if legacy.UploadInterval != nil {
+		mask, update.UploadInterval = append(mask, PoolUpdateUploadInterval), *legacy.UploadInterval
+	}
+	if legacy.OperatingCost != nil {
+		mask, update.OperatingCost = append(mask, PoolUpdateOperatingCost), *legacy.OperatingCost
+	}
+	if legacy.MinStake != nil {
+		mask, update.MinStake = append(mask, PoolUpdateMinStake), *legacy.MinStake
+	}
+	if legacy.MaxBundleSize != nil {
+		mask, update.MaxBundleSize = append(mask, PoolUpdateMaxBundleSize), *legacy.MaxBundleSize
+	}
+	if legacy.CommitRevealVoting != nil {
+		mask, update.CommitRevealVoting = append(mask, PoolUpdateCommitRevealVoting), *legacy.CommitRevealVoting
+	}
+	if legacy.ValidQuorum != nil {
+		mask, update.ValidQuorum = append(mask, PoolUpdateValidQuorum), *legacy.ValidQuorum
+	}
+	if legacy.InvalidQuorum != nil {
+		mask, update.InvalidQuorum = append(mask, PoolUpdateInvalidQuorum), *legacy.InvalidQuorum
+	}
+	if legacy.UploadTimeout != nil {
+		mask, update.UploadTimeout = append(mask, PoolUpdateUploadTimeout), *legacy.UploadTimeout
+	}
+	if legacy.MaxPoints != nil {
+		mask, update.MaxPoints = append(mask, PoolUpdateMaxPoints), *legacy.MaxPoints
+	}
+
+	return mask, update, nil
+}
+
+// ValidatePoolUpdate checks that the mask only contains known fields, each at
+// most once, and that the values of those fields are valid on their own.
+// Constraints between fields are checked after the update got applied.
+func ValidatePoolUpdate(mask []string, update PoolUpdate) error {
+	if len(mask) == 0 {
+		return fmt.Errorf("update mask is empty")
+	}
+
+	seen := make(map[string]bool)
+
+	for _, field := range mask {
+		if seen[field] {
+			return fmt.Errorf("field %v is updated more than once", field)
+		}
+		seen[field] = true
+
+		switch field {
+		case PoolUpdateName:
+			if update.Name == "" {
+				return fmt.Errorf("name must not be empty")
+			}
+		case PoolUpdateRuntime:
+			if update.Runtime == "" {
+				return fmt.Errorf("runtime must not be empty")
+			}
+		case PoolUpdateConfig:
+			if !json.Valid([]byte(update.Config)) {
+				return fmt.Errorf("config %v is not valid json", update.Config)
+			}
+		case PoolUpdateUploadInterval:
+			if update.UploadInterval == 0 {
+				return fmt.Errorf("upload interval must be greater than 0")
+			}
+		case PoolUpdateMaxBundleSize:
+			if update.MaxBundleSize == 0 {
+				return fmt.Errorf("max bundle size must be greater than 0")
+			}
+		case PoolUpdateValidQuorum:
+			if _, err := ParseValidQuorum(update.ValidQuorum); err != nil {
+				return err
+			}
+		case PoolUpdateInvalidQuorum:
+			if _, err := ParseInvalidQuorum(update.InvalidQuorum); err != nil {
+				return err
+			}
+		case PoolUpdateChargingMode:
+			if err := ValidateChargingMode(update.ChargingMode); err != nil {
+				return err
+			}
+		case PoolUpdateAdmin:
+			if err := ValidateAdmin(update.Admin); err != nil {
+				return err
+			}
+		case PoolUpdateFunderAllowList:
+			if err := ValidateFunderAllowList(update.FunderAllowList); err != nil {
+				return err
+			}
+		case PoolUpdateMaxFunders:
+			if err := ValidateMaxFunders(update.MaxFunders); err != nil {
+				return err
+			}
+		case PoolUpdateLogo, PoolUpdateOperatingCost, PoolUpdateMinStake, PoolUpdateCommitRevealVoting,
+			PoolUpdateUploadTimeout, PoolUpdateMaxPoints, PoolUpdateDisableFunderEviction:
+		default:
+			return fmt.Errorf("unknown field %v", field)
+		}
+	}
+
+	return nil
+}
+
+// ApplyPoolUpdate sets the fields of the pool which are contained in the mask
+// to their value in the update.
+func ApplyPoolUpdate(pool *Pool, mask []string, update PoolUpdate) {
+	for _, field := range mask {
+		switch field {
+		case PoolUpdateName:
+			pool.Name = update.Name
+		case PoolUpdateRuntime:
+			pool.Runtime = update.Runtime
+		case PoolUpdateLogo:
+			pool.Logo = update.Logo
+		case PoolUpdateConfig:
+			pool.Config = update.Config
+		case PoolUpdateUploadInterval:
+			pool.UploadInterval = update.UploadInterval
+		case PoolUpdateOperatingCost:
+			pool.OperatingCost = update.OperatingCost
+		case PoolUpdateMinStake:
+			pool.MinStake = update.MinStake
+		case PoolUpdateMaxBundleSize:
+			pool.MaxBundleSize = update.MaxBundleSize
+		case PoolUpdateCommitRevealVoting:
+			pool.CommitRevealVoting = update.CommitRevealVoting
+		case PoolUpdateValidQuorum:
+			pool.ValidQuorum = update.ValidQuorum
+		case PoolUpdateInvalidQuorum:
+			pool.InvalidQuorum = update.InvalidQuorum
+		case PoolUpdateUploadTimeout:
+			pool.UploadTimeout = update.UploadTimeout
+		case PoolUpdateMaxPoints:
+			pool.MaxPoints = update.MaxPoints
+		case PoolUpdateChargingMode:
+			pool.ChargingMode = update.ChargingMode
+		case PoolUpdateAdmin:
+			pool.Admin = update.Admin
+		case PoolUpdateFunderAllowList:
+			pool.FunderAllowList = update.FunderAllowList
+		case PoolUpdateMaxFunders:
+			pool.MaxFunders = update.MaxFunders
+		case PoolUpdateDisableFunderEviction:
+			pool.DisableFunderEviction = update.DisableFunderEviction
+		}
+	}
+}
+
+// GetPoolUpdateValues returns the current values of the pool for the fields
+// in the mask, all other fields are left empty.
+func GetPoolUpdateValues(pool Pool, mask []string) PoolUpdate {
+	var values PoolUpdate
+
+	for _, field := range mask {
+		switch field {
+		case PoolUpdateName:
+			values.Name = pool.Name
+		case PoolUpdateRuntime:
+			values.Runtime = pool.Runtime
+		case PoolUpdateLogo:
+			values.Logo = pool.Logo
+		case PoolUpdateConfig:
+			values.Config = pool.Config
+		case PoolUpdateUploadInterval:
+			values.UploadInterval = pool.UploadInterval
+		case PoolUpdateOperatingCost:
+			values.OperatingCost = pool.OperatingCost
+		case PoolUpdateMinStake:
+			values.MinStake = pool.MinStake
+		case PoolUpdateMaxBundleSize:
+			values.MaxBundleSize = pool.MaxBundleSize
+		case PoolUpdateCommitRevealVoting:
+			values.CommitRevealVoting = pool.CommitRevealVoting
+		case PoolUpdateValidQuorum:
+			values.ValidQuorum = pool.ValidQuorum
+		case PoolUpdateInvalidQuorum:
+			values.InvalidQuorum = pool.InvalidQuorum
+		case PoolUpdateUploadTimeout:
+			values.UploadTimeout = pool.UploadTimeout
+		case PoolUpdateMaxPoints:
+			values.MaxPoints = pool.MaxPoints
+		case PoolUpdateChargingMode:
+			values.ChargingMode = pool.ChargingMode
+		case PoolUpdateAdmin:
+			values.Admin = pool.Admin
+		case PoolUpdateFunderAllowList:
+			values.FunderAllowList = pool.FunderAllowList
+		case PoolUpdateMaxFunders:
+			values.MaxFunders = pool.MaxFunders
+		case PoolUpdateDisableFunderEviction:
+			values.DisableFunderEviction = pool.DisableFunderEviction
+		}
+	}
+
+	return values
+}
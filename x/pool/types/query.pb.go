@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,33 +113,244 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryRuntimesRequest is request type for the Query/Runtimes RPC method.
+type QueryRuntimesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRuntimesRequest) Reset()         { *m = QueryRuntimesRequest{} }
+func (m *QueryRuntimesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRuntimesRequest) ProtoMessage()    {}
+func (*QueryRuntimesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c2f559babbc8665, []int{2}
+}
+func (m *QueryRuntimesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRuntimesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRuntimesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRuntimesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuntimesRequest.Merge(m, src)
+}
+func (m *QueryRuntimesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRuntimesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuntimesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRuntimesRequest proto.InternalMessageInfo
+
+func (m *QueryRuntimesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRuntimesResponse is response type for the Query/Runtimes RPC method.
+type QueryRuntimesResponse struct {
+	// runtimes ...
+	Runtimes []Runtime `protobuf:"bytes,1,rep,name=runtimes,proto3" json:"runtimes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRuntimesResponse) Reset()         { *m = QueryRuntimesResponse{} }
+func (m *QueryRuntimesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRuntimesResponse) ProtoMessage()    {}
+func (*QueryRuntimesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c2f559babbc8665, []int{3}
+}
+func (m *QueryRuntimesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRuntimesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRuntimesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRuntimesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuntimesResponse.Merge(m, src)
+}
+func (m *QueryRuntimesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRuntimesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuntimesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRuntimesResponse proto.InternalMessageInfo
+
+func (m *QueryRuntimesResponse) GetRuntimes() []Runtime {
+	if m != nil {
+		return m.Runtimes
+	}
+	return nil
+}
+
+func (m *QueryRuntimesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRuntimeRequest is request type for the Query/Runtime RPC method.
+type QueryRuntimeRequest struct {
+	// name ...
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryRuntimeRequest) Reset()         { *m = QueryRuntimeRequest{} }
+func (m *QueryRuntimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRuntimeRequest) ProtoMessage()    {}
+func (*QueryRuntimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c2f559babbc8665, []int{4}
+}
+func (m *QueryRuntimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRuntimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRuntimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRuntimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuntimeRequest.Merge(m, src)
+}
+func (m *QueryRuntimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRuntimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuntimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRuntimeRequest proto.InternalMessageInfo
+
+func (m *QueryRuntimeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryRuntimeResponse is response type for the Query/Runtime RPC method.
+type QueryRuntimeResponse struct {
+	// runtime ...
+	Runtime Runtime `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime"`
+}
+
+func (m *QueryRuntimeResponse) Reset()         { *m = QueryRuntimeResponse{} }
+func (m *QueryRuntimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRuntimeResponse) ProtoMessage()    {}
+func (*QueryRuntimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c2f559babbc8665, []int{5}
+}
+func (m *QueryRuntimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRuntimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRuntimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRuntimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuntimeResponse.Merge(m, src)
+}
+func (m *QueryRuntimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRuntimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuntimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRuntimeResponse proto.InternalMessageInfo
+
+func (m *QueryRuntimeResponse) GetRuntime() Runtime {
+	if m != nil {
+		return m.Runtime
+	}
+	return Runtime{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kyve.pool.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kyve.pool.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryRuntimesRequest)(nil), "kyve.pool.v1beta1.QueryRuntimesRequest")
+	proto.RegisterType((*QueryRuntimesResponse)(nil), "kyve.pool.v1beta1.QueryRuntimesResponse")
+	proto.RegisterType((*QueryRuntimeRequest)(nil), "kyve.pool.v1beta1.QueryRuntimeRequest")
+	proto.RegisterType((*QueryRuntimeResponse)(nil), "kyve.pool.v1beta1.QueryRuntimeResponse")
 }
 
 func init() { proto.RegisterFile("kyve/pool/v1beta1/query.proto", fileDescriptor_9c2f559babbc8665) }
 
 var fileDescriptor_9c2f559babbc8665 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0xae, 0x2c, 0x4b,
-	0xd5, 0x2f, 0xc8, 0xcf, 0xcf, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x2c,
-	0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x49, 0xeb, 0x81, 0xa4,
-	0xf5, 0xa0, 0xd2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x59, 0x7d, 0x10, 0x0b, 0xa2, 0x50,
-	0x4a, 0x26, 0x3d, 0x3f, 0x3f, 0x3d, 0x27, 0x55, 0x3f, 0xb1, 0x20, 0x53, 0x3f, 0x31, 0x2f, 0x2f,
-	0xbf, 0x24, 0xb1, 0x24, 0x33, 0x3f, 0xaf, 0x18, 0x2a, 0x2b, 0x87, 0x69, 0x4b, 0x41, 0x62, 0x51,
-	0x62, 0x2e, 0x54, 0x5e, 0x49, 0x84, 0x4b, 0x28, 0x10, 0x64, 0x6b, 0x00, 0x58, 0x30, 0x28, 0xb5,
-	0xb0, 0x34, 0xb5, 0xb8, 0x44, 0xc9, 0x8f, 0x4b, 0x18, 0x45, 0xb4, 0xb8, 0x20, 0x3f, 0xaf, 0x38,
-	0x55, 0xc8, 0x9c, 0x8b, 0x0d, 0xa2, 0x59, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x52, 0x0f,
-	0xc3, 0x91, 0x7a, 0x10, 0x2d, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x95, 0x1b, 0x75,
-	0x30, 0x72, 0xb1, 0x82, 0x0d, 0x14, 0xaa, 0xe7, 0x62, 0x83, 0xa8, 0x10, 0x52, 0xc5, 0xa2, 0x19,
-	0xd3, 0x29, 0x52, 0x6a, 0x84, 0x94, 0x41, 0xdc, 0xa6, 0xa4, 0xd6, 0x74, 0xf9, 0xc9, 0x64, 0x26,
-	0x05, 0x21, 0x39, 0x7d, 0xef, 0xc8, 0x30, 0x57, 0xbf, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0xfd,
-	0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x88, 0xf7, 0x21, 0x4e, 0x71, 0x72, 0x3e, 0xf1, 0x48, 0x8e, 0xf1,
-	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xcd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc,
-	0x5c, 0x2c, 0x66, 0x54, 0x40, 0x4c, 0x29, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07, 0x9e,
-	0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xb7, 0xab, 0xa4, 0xc4, 0x01, 0x00, 0x00,
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6b, 0x13, 0x41,
+	0x18, 0xc5, 0x33, 0x6d, 0x4d, 0xeb, 0xf4, 0xe4, 0x18, 0x41, 0x17, 0x9d, 0x86, 0x05, 0x37, 0x5b,
+	0x0f, 0x33, 0x34, 0x1e, 0x04, 0xf1, 0x54, 0x51, 0x0f, 0x42, 0xd1, 0x3d, 0x08, 0x7a, 0x10, 0x26,
+	0x61, 0xd8, 0x2e, 0xed, 0xce, 0x6c, 0x77, 0x26, 0xd5, 0x20, 0x22, 0x78, 0x11, 0x3c, 0x09, 0xde,
+	0xf5, 0xdf, 0xe9, 0xb1, 0xe0, 0xc5, 0x93, 0x48, 0xe2, 0x1f, 0x22, 0x3b, 0xf3, 0x6d, 0xda, 0x34,
+	0x29, 0x7b, 0x5b, 0xf2, 0xbd, 0xf7, 0xbe, 0xdf, 0x9b, 0x99, 0xe0, 0x3b, 0x07, 0xe3, 0x63, 0xc9,
+	0x0b, 0xad, 0x0f, 0xf9, 0xf1, 0xce, 0x40, 0x5a, 0xb1, 0xc3, 0x8f, 0x46, 0xb2, 0x1c, 0xb3, 0xa2,
+	0xd4, 0x56, 0x93, 0x6b, 0xd5, 0x98, 0x55, 0x63, 0x06, 0xe3, 0xa0, 0x93, 0xea, 0x54, 0xbb, 0x29,
+	0xaf, 0xbe, 0xbc, 0x30, 0xb8, 0x9d, 0x6a, 0x9d, 0x1e, 0x4a, 0x2e, 0x8a, 0x8c, 0x0b, 0xa5, 0xb4,
+	0x15, 0x36, 0xd3, 0xca, 0xc0, 0xf4, 0xde, 0x50, 0x9b, 0x5c, 0x1b, 0x3e, 0x10, 0x46, 0xfa, 0xfc,
+	0xd9, 0xb6, 0x42, 0xa4, 0x99, 0x72, 0x62, 0xd0, 0xd2, 0x45, 0xa2, 0x42, 0x94, 0x22, 0xaf, 0xb3,
+	0xb6, 0x16, 0xe7, 0xe5, 0x48, 0xd9, 0x2c, 0x97, 0x5e, 0x10, 0x76, 0x30, 0x79, 0x59, 0xad, 0x78,
+	0xe1, 0x5c, 0x89, 0x3c, 0x1a, 0x49, 0x63, 0xc3, 0x3d, 0x7c, 0x7d, 0xee, 0x57, 0x53, 0x68, 0x65,
+	0x24, 0x79, 0x80, 0xdb, 0x3e, 0xfd, 0x26, 0xea, 0xa2, 0x78, 0xb3, 0x7f, 0x8b, 0x2d, 0x34, 0x66,
+	0xde, 0xb2, 0xbb, 0x76, 0xf2, 0x67, 0xab, 0x95, 0x80, 0x3c, 0x7c, 0x8b, 0x3b, 0x2e, 0x2f, 0xf1,
+	0xbb, 0xeb, 0x3d, 0xe4, 0x29, 0xc6, 0x67, 0x95, 0x20, 0x34, 0x62, 0xbe, 0x3f, 0xab, 0xfa, 0x33,
+	0x7f, 0xbe, 0x67, 0xe1, 0xa9, 0x04, 0x6f, 0x72, 0xce, 0x19, 0xfe, 0x40, 0xf8, 0xc6, 0x85, 0x05,
+	0x80, 0xfc, 0x08, 0x6f, 0x40, 0xe1, 0x0a, 0x7a, 0x35, 0xde, 0xec, 0x07, 0x4b, 0xa0, 0xc1, 0x06,
+	0xd4, 0x33, 0x07, 0x79, 0x36, 0xc7, 0xb7, 0xe2, 0xf8, 0x7a, 0x8d, 0x7c, 0x7e, 0xf5, 0x1c, 0xe0,
+	0x36, 0x1c, 0x28, 0x2c, 0xaa, 0xfb, 0x13, 0xbc, 0xa6, 0x44, 0x2e, 0x5d, 0xf3, 0xab, 0x89, 0xfb,
+	0x0e, 0x93, 0xf9, 0xb3, 0x9a, 0x35, 0x79, 0x88, 0xd7, 0x81, 0x0b, 0x0e, 0xaa, 0xb9, 0x48, 0x6d,
+	0xe8, 0xff, 0x5c, 0xc5, 0x57, 0x5c, 0x28, 0xf9, 0x84, 0xdb, 0xfe, 0x86, 0xc8, 0xdd, 0x25, 0xf6,
+	0xc5, 0xa7, 0x10, 0x44, 0x4d, 0x32, 0x8f, 0x17, 0x46, 0x9f, 0x7f, 0xfd, 0xfb, 0xbe, 0xd2, 0x25,
+	0x94, 0x3f, 0x7f, 0xfd, 0xea, 0xc9, 0x9e, 0xb4, 0xef, 0x74, 0x79, 0xc0, 0x87, 0xfb, 0x22, 0x53,
+	0xfe, 0xfd, 0xf9, 0xa7, 0x40, 0xbe, 0x20, 0xbc, 0x51, 0xdf, 0x12, 0xe9, 0x5d, 0x16, 0x7e, 0xe1,
+	0xa1, 0x04, 0x71, 0xb3, 0x10, 0x38, 0x62, 0xc7, 0x11, 0x92, 0xee, 0x65, 0x1c, 0xb3, 0xcb, 0xfd,
+	0x8a, 0xf0, 0x3a, 0xd8, 0x49, 0xd4, 0x90, 0x5f, 0x73, 0xf4, 0x1a, 0x75, 0x80, 0xc1, 0x1c, 0x46,
+	0x4c, 0xa2, 0x06, 0x0c, 0xfe, 0xa1, 0xba, 0xf4, 0x8f, 0xbb, 0x8f, 0x4f, 0x26, 0x14, 0x9d, 0x4e,
+	0x28, 0xfa, 0x3b, 0xa1, 0xe8, 0xdb, 0x94, 0xb6, 0x4e, 0xa7, 0xb4, 0xf5, 0x7b, 0x4a, 0x5b, 0x6f,
+	0xb6, 0xd3, 0xcc, 0xee, 0x8f, 0x06, 0x6c, 0xa8, 0xf3, 0x25, 0x59, 0xef, 0x7d, 0x9a, 0x1d, 0x17,
+	0xd2, 0x0c, 0xda, 0xee, 0x3f, 0x7d, 0xff, 0xff, 0x00, 0xbe, 0xb1, 0xe1, 0x13, 0xa8, 0x04, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +367,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Runtimes queries all registered runtimes.
+	Runtimes(ctx context.Context, in *QueryRuntimesRequest, opts ...grpc.CallOption) (*QueryRuntimesResponse, error)
+	// Runtime queries a registered runtime by its name.
+	Runtime(ctx context.Context, in *QueryRuntimeRequest, opts ...grpc.CallOption) (*QueryRuntimeResponse, error)
 }
 
 type queryClient struct {
@@ -174,10 +390,32 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Runtimes(ctx context.Context, in *QueryRuntimesRequest, opts ...grpc.CallOption) (*QueryRuntimesResponse, error) {
+	out := new(QueryRuntimesResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Query/Runtimes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Runtime(ctx context.Context, in *QueryRuntimeRequest, opts ...grpc.CallOption) (*QueryRuntimeResponse, error) {
+	out := new(QueryRuntimeResponse)
+	err := c.cc.Invoke(ctx, "/kyve.pool.v1beta1.Query/Runtime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Runtimes queries all registered runtimes.
+	Runtimes(context.Context, *QueryRuntimesRequest) (*QueryRuntimesResponse, error)
+	// Runtime queries a registered runtime by its name.
+	Runtime(context.Context, *QueryRuntimeRequest) (*QueryRuntimeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -187,6 +425,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Runtimes(ctx context.Context, req *QueryRuntimesRequest) (*QueryRuntimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Runtimes not implemented")
+}
+func (*UnimplementedQueryServer) Runtime(ctx context.Context, req *QueryRuntimeRequest) (*QueryRuntimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Runtime not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -210,6 +454,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Runtimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRuntimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Runtimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Query/Runtimes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Runtimes(ctx, req.(*QueryRuntimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Runtime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRuntimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Runtime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.pool.v1beta1.Query/Runtime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Runtime(ctx, req.(*QueryRuntimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kyve.pool.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -218,6 +498,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Runtimes",
+			Handler:    _Query_Runtimes_Handler,
+		},
+		{
+			MethodName: "Runtime",
+			Handler:    _Query_Runtime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kyve/pool/v1beta1/query.proto",
@@ -279,6 +567,153 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRuntimesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRuntimesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRuntimesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRuntimesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRuntimesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRuntimesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Runtimes) > 0 {
+		for iNdEx := len(m.Runtimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runtimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRuntimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRuntimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRuntimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRuntimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRuntimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRuntimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Runtime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -310,7 +745,63 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
+func (m *QueryRuntimesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRuntimesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Runtimes) > 0 {
+		for _, e := range m.Runtimes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRuntimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRuntimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Runtime.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
@@ -449,6 +940,377 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRuntimesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRuntimesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRuntimesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRuntimesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRuntimesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRuntimesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtimes = append(m.Runtimes, Runtime{})
+			if err := m.Runtimes[len(m.Runtimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRuntimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRuntimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRuntimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRuntimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRuntimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRuntimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Runtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
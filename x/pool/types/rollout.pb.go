@@ -0,0 +1,825 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kyve/pool/v1beta1/rollout.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpgradeRolloutStage is the stage of a staged pool upgrade.
+type UpgradeRolloutStage int32
+
+const (
+	// UPGRADE_ROLLOUT_STAGE_UNSPECIFIED ...
+	UPGRADE_ROLLOUT_STAGE_UNSPECIFIED UpgradeRolloutStage = 0
+	// UPGRADE_ROLLOUT_STAGE_SCHEDULED means the upgrade of the canary pool is
+	// scheduled or currently performed.
+	UPGRADE_ROLLOUT_STAGE_SCHEDULED UpgradeRolloutStage = 1
+	// UPGRADE_ROLLOUT_STAGE_EVALUATING means the canary pool runs the new
+	// version and has to finalize the required number of bundles.
+	UPGRADE_ROLLOUT_STAGE_EVALUATING UpgradeRolloutStage = 2
+	// UPGRADE_ROLLOUT_STAGE_PROMOTED means the canary pool succeeded and the
+	// upgrade got scheduled for the remaining pools.
+	UPGRADE_ROLLOUT_STAGE_PROMOTED UpgradeRolloutStage = 3
+	// UPGRADE_ROLLOUT_STAGE_ROLLED_BACK means the canary pool failed and got
+	// downgraded to its previous protocol.
+	UPGRADE_ROLLOUT_STAGE_ROLLED_BACK UpgradeRolloutStage = 4
+	// UPGRADE_ROLLOUT_STAGE_CANCELLED means the rollout got cancelled by governance.
+	UPGRADE_ROLLOUT_STAGE_CANCELLED UpgradeRolloutStage = 5
+)
+
+var UpgradeRolloutStage_name = map[int32]string{
+	0: "UPGRADE_ROLLOUT_STAGE_UNSPECIFIED",
+	1: "UPGRADE_ROLLOUT_STAGE_SCHEDULED",
+	2: "UPGRADE_ROLLOUT_STAGE_EVALUATING",
+	3: "UPGRADE_ROLLOUT_STAGE_PROMOTED",
+	4: "UPGRADE_ROLLOUT_STAGE_ROLLED_BACK",
+	5: "UPGRADE_ROLLOUT_STAGE_CANCELLED",
+}
+
+var UpgradeRolloutStage_value = map[string]int32{
+	"UPGRADE_ROLLOUT_STAGE_UNSPECIFIED": 0,
+	"UPGRADE_ROLLOUT_STAGE_SCHEDULED":   1,
+	"UPGRADE_ROLLOUT_STAGE_EVALUATING":  2,
+	"UPGRADE_ROLLOUT_STAGE_PROMOTED":    3,
+	"UPGRADE_ROLLOUT_STAGE_ROLLED_BACK": 4,
+	"UPGRADE_ROLLOUT_STAGE_CANCELLED":   5,
+}
+
+func (x UpgradeRolloutStage) String() string {
+	return proto.EnumName(UpgradeRolloutStage_name, int32(x))
+}
+
+func (UpgradeRolloutStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9d1554392ef1be34, []int{0}
+}
+
+// UpgradeRollout tracks a pool upgrade which is first applied to a canary
+// pool and only promoted to the remaining pools if the canary pool finalizes
+// enough bundles with the new version.
+type UpgradeRollout struct {
+	// canary_pool_id is the id of the pool which gets upgraded first.
+	CanaryPoolId uint64 `protobuf:"varint,1,opt,name=canary_pool_id,json=canaryPoolId,proto3" json:"canary_pool_id,omitempty"`
+	// pool_ids are the pools which get upgraded once the canary pool succeeded.
+	PoolIds []uint64 `protobuf:"varint,2,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+	// version ...
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// binaries ...
+	Binaries string `protobuf:"bytes,4,opt,name=binaries,proto3" json:"binaries,omitempty"`
+	// duration is the upgrade duration of every pool and the time the canary
+	// pool has to finalize canary_bundles after its upgrade finished.
+	Duration uint64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// canary_bundles is the number of bundles the canary pool has to finalize.
+	CanaryBundles uint64 `protobuf:"varint,6,opt,name=canary_bundles,json=canaryBundles,proto3" json:"canary_bundles,omitempty"`
+	// stage ...
+	Stage UpgradeRolloutStage `protobuf:"varint,7,opt,name=stage,proto3,enum=kyve.pool.v1beta1.UpgradeRolloutStage" json:"stage,omitempty"`
+	// previous_protocol is the protocol the canary pool gets rolled back to.
+	PreviousProtocol Protocol `protobuf:"bytes,8,opt,name=previous_protocol,json=previousProtocol,proto3" json:"previous_protocol"`
+	// start_bundles is the total number of bundles of the canary pool when the
+	// evaluation started.
+	StartBundles uint64 `protobuf:"varint,9,opt,name=start_bundles,json=startBundles,proto3" json:"start_bundles,omitempty"`
+	// deadline is the unix time until which the canary pool has to finalize
+	// canary_bundles.
+	Deadline uint64 `protobuf:"varint,10,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *UpgradeRollout) Reset()         { *m = UpgradeRollout{} }
+func (m *UpgradeRollout) String() string { return proto.CompactTextString(m) }
+func (*UpgradeRollout) ProtoMessage()    {}
+func (*UpgradeRollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1554392ef1be34, []int{0}
+}
+func (m *UpgradeRollout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeRollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeRollout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeRollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeRollout.Merge(m, src)
+}
+func (m *UpgradeRollout) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeRollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeRollout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeRollout proto.InternalMessageInfo
+
+func (m *UpgradeRollout) GetCanaryPoolId() uint64 {
+	if m != nil {
+		return m.CanaryPoolId
+	}
+	return 0
+}
+
+func (m *UpgradeRollout) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+func (m *UpgradeRollout) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *UpgradeRollout) GetBinaries() string {
+	if m != nil {
+		return m.Binaries
+	}
+	return ""
+}
+
+func (m *UpgradeRollout) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *UpgradeRollout) GetCanaryBundles() uint64 {
+	if m != nil {
+		return m.CanaryBundles
+	}
+	return 0
+}
+
+func (m *UpgradeRollout) GetStage() UpgradeRolloutStage {
+	if m != nil {
+		return m.Stage
+	}
+	return UPGRADE_ROLLOUT_STAGE_UNSPECIFIED
+}
+
+func (m *UpgradeRollout) GetPreviousProtocol() Protocol {
+	if m != nil {
+		return m.PreviousProtocol
+	}
+	return Protocol{}
+}
+
+func (m *UpgradeRollout) GetStartBundles() uint64 {
+	if m != nil {
+		return m.StartBundles
+	}
+	return 0
+}
+
+func (m *UpgradeRollout) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("kyve.pool.v1beta1.UpgradeRolloutStage", UpgradeRolloutStage_name, UpgradeRolloutStage_value)
+	proto.RegisterType((*UpgradeRollout)(nil), "kyve.pool.v1beta1.UpgradeRollout")
+}
+
+func init() { proto.RegisterFile("kyve/pool/v1beta1/rollout.proto", fileDescriptor_9d1554392ef1be34) }
+
+var fileDescriptor_9d1554392ef1be34 = []byte{
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xed, 0xc6, 0x69, 0xd2, 0xf9, 0xbe, 0x46, 0xee, 0xc0, 0xc2, 0x04, 0xe4, 0x98, 0xfe,
+	0x41, 0x81, 0x85, 0xad, 0x96, 0x2d, 0x1b, 0xc7, 0x1e, 0x42, 0xd4, 0x90, 0x44, 0x4e, 0x5c, 0x09,
+	0x36, 0x96, 0x13, 0x8f, 0x52, 0xab, 0xc6, 0x63, 0x8d, 0x27, 0x81, 0xbc, 0x01, 0x4b, 0xb6, 0xac,
+	0x79, 0x99, 0x2e, 0xbb, 0x64, 0x85, 0x50, 0xf2, 0x1c, 0x48, 0x68, 0xc6, 0x49, 0x24, 0xd4, 0x74,
+	0xe7, 0x7b, 0xce, 0xef, 0x5e, 0x9f, 0x7b, 0x6d, 0xd0, 0xb8, 0x59, 0xcc, 0xb1, 0x95, 0x11, 0x92,
+	0x58, 0xf3, 0xf3, 0x31, 0x66, 0xe1, 0xb9, 0x45, 0x49, 0x92, 0x90, 0x19, 0x33, 0x33, 0x4a, 0x18,
+	0x81, 0x47, 0x1c, 0x30, 0x39, 0x60, 0xae, 0x81, 0xfa, 0xe3, 0x29, 0x99, 0x12, 0xe1, 0x5a, 0xfc,
+	0xa9, 0x00, 0xeb, 0xcf, 0xee, 0x4f, 0x12, 0x5d, 0xc2, 0x3d, 0xfe, 0x5e, 0x02, 0x35, 0x3f, 0x9b,
+	0xd2, 0x30, 0xc2, 0x5e, 0x31, 0x1f, 0x9e, 0x82, 0xda, 0x24, 0x4c, 0x43, 0xba, 0x08, 0x38, 0x17,
+	0xc4, 0x91, 0x26, 0x1b, 0x72, 0x53, 0xf1, 0xfe, 0x2f, 0xd4, 0x01, 0x21, 0x49, 0x27, 0x82, 0x4f,
+	0x40, 0x75, 0x6d, 0xe7, 0xda, 0x9e, 0x51, 0x6a, 0x2a, 0x5e, 0x25, 0x13, 0x4e, 0x0e, 0x35, 0x50,
+	0x99, 0x63, 0x9a, 0xc7, 0x24, 0xd5, 0x4a, 0x86, 0xdc, 0x3c, 0xf0, 0x36, 0x25, 0xac, 0x83, 0xea,
+	0x38, 0x4e, 0x43, 0x1a, 0xe3, 0x5c, 0x53, 0x84, 0xb5, 0xad, 0xb9, 0x17, 0xcd, 0x68, 0xc8, 0x78,
+	0x5b, 0x59, 0xbc, 0x70, 0x5b, 0xc3, 0xb3, 0x6d, 0xa4, 0xf1, 0x2c, 0x8d, 0x12, 0x9c, 0x6b, 0xfb,
+	0x82, 0x38, 0x2c, 0xd4, 0x56, 0x21, 0xc2, 0x37, 0xa0, 0x9c, 0xb3, 0x70, 0x8a, 0xb5, 0x8a, 0x21,
+	0x37, 0x6b, 0x17, 0x2f, 0xcc, 0x7b, 0x37, 0x32, 0xff, 0xdd, 0x75, 0xc8, 0x69, 0xaf, 0x68, 0x82,
+	0x3d, 0x70, 0x94, 0x51, 0x3c, 0x8f, 0xc9, 0x2c, 0x0f, 0xc4, 0x71, 0x26, 0x24, 0xd1, 0xaa, 0x86,
+	0xdc, 0xfc, 0xef, 0xe2, 0xe9, 0x8e, 0x49, 0x83, 0x35, 0xd2, 0x52, 0x6e, 0x7f, 0x35, 0x24, 0x4f,
+	0xdd, 0xf4, 0x6e, 0x74, 0x78, 0x02, 0x0e, 0x73, 0x16, 0x52, 0xb6, 0xcd, 0x7c, 0x50, 0x9c, 0x51,
+	0x88, 0x9b, 0xc8, 0x7c, 0x6b, 0x1c, 0x46, 0x49, 0x9c, 0x62, 0x0d, 0xac, 0xb7, 0x5e, 0xd7, 0xaf,
+	0xfe, 0xc8, 0xe0, 0xd1, 0x8e, 0xbc, 0xf0, 0x0c, 0x3c, 0xf7, 0x07, 0x6d, 0xcf, 0x76, 0x51, 0xe0,
+	0xf5, 0xbb, 0xdd, 0xbe, 0x3f, 0x0a, 0x86, 0x23, 0xbb, 0x8d, 0x02, 0xbf, 0x37, 0x1c, 0x20, 0xa7,
+	0xf3, 0xb6, 0x83, 0x5c, 0x55, 0x82, 0x27, 0xa0, 0xb1, 0x1b, 0x1b, 0x3a, 0xef, 0x90, 0xeb, 0x77,
+	0x91, 0xab, 0xca, 0xf0, 0x14, 0x18, 0xbb, 0x21, 0x74, 0x65, 0x77, 0x7d, 0x7b, 0xd4, 0xe9, 0xb5,
+	0xd5, 0x3d, 0x78, 0x0c, 0xf4, 0xdd, 0xd4, 0xc0, 0xeb, 0xbf, 0xef, 0x8f, 0x90, 0xab, 0x96, 0x1e,
+	0x4e, 0xc5, 0x2b, 0xe4, 0x06, 0x2d, 0xdb, 0xb9, 0x54, 0x95, 0x87, 0x53, 0x39, 0x76, 0xcf, 0x41,
+	0x9c, 0x54, 0xcb, 0x75, 0xe5, 0xeb, 0x0f, 0x5d, 0x6a, 0x39, 0xb7, 0x4b, 0x5d, 0xbe, 0x5b, 0xea,
+	0xf2, 0xef, 0xa5, 0x2e, 0x7f, 0x5b, 0xe9, 0xd2, 0xdd, 0x4a, 0x97, 0x7e, 0xae, 0x74, 0xe9, 0xe3,
+	0xcb, 0x69, 0xcc, 0xae, 0x67, 0x63, 0x73, 0x42, 0x3e, 0x59, 0x97, 0x1f, 0xae, 0x50, 0x0f, 0xb3,
+	0xcf, 0x84, 0xde, 0x58, 0x93, 0xeb, 0x30, 0x4e, 0xad, 0x2f, 0xc5, 0xdf, 0xce, 0x16, 0x19, 0xce,
+	0xc7, 0xfb, 0xe2, 0x53, 0xbe, 0xfe, 0x3b, 0x00, 0x0f, 0xa6, 0x70, 0x83, 0x51, 0x03, 0x00, 0x00,
+}
+
+func (m *UpgradeRollout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeRollout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeRollout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StartBundles != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.StartBundles))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.PreviousProtocol.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRollout(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Stage != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CanaryBundles != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.CanaryBundles))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Duration != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Binaries) > 0 {
+		i -= len(m.Binaries)
+		copy(dAtA[i:], m.Binaries)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Binaries)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PoolIds) > 0 {
+		dAtA3 := make([]byte, len(m.PoolIds)*10)
+		var j2 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintRollout(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CanaryPoolId != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.CanaryPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRollout(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRollout(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpgradeRollout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CanaryPoolId != 0 {
+		n += 1 + sovRollout(uint64(m.CanaryPoolId))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovRollout(uint64(e))
+		}
+		n += 1 + sovRollout(uint64(l)) + l
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Binaries)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovRollout(uint64(m.Duration))
+	}
+	if m.CanaryBundles != 0 {
+		n += 1 + sovRollout(uint64(m.CanaryBundles))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovRollout(uint64(m.Stage))
+	}
+	l = m.PreviousProtocol.Size()
+	n += 1 + l + sovRollout(uint64(l))
+	if m.StartBundles != 0 {
+		n += 1 + sovRollout(uint64(m.StartBundles))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovRollout(uint64(m.Deadline))
+	}
+	return n
+}
+
+func sovRollout(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRollout(x uint64) (n int) {
+	return sovRollout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpgradeRollout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeRollout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeRollout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryPoolId", wireType)
+			}
+			m.CanaryPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanaryPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRollout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRollout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRollout
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRollout
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRollout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryBundles", wireType)
+			}
+			m.CanaryBundles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanaryBundles |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= UpgradeRolloutStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousProtocol", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousProtocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBundles", wireType)
+			}
+			m.StartBundles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBundles |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRollout(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRollout
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRollout
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRollout
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRollout        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRollout          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRollout = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// Validate checks that the runtime has a name, a supported config schema and
+// that every version lists its binaries with a download url and a SHA-256 checksum.
+func (m *Runtime) Validate() error {
+	if m.Name == "" {
+		return fmt.Errorf("runtime name is empty")
+	}
+
+	if _, err := ParseConfigSchema(m.ConfigSchema); err != nil {
+		return fmt.Errorf("invalid config schema: %w", err)
+	}
+
+	if len(m.Versions) == 0 {
+		return fmt.Errorf("runtime %v has no versions", m.Name)
+	}
+
+	versions := make(map[string]struct{})
+
+	for _, version := range m.Versions {
+		if version.Version == "" {
+			return fmt.Errorf("version of runtime %v is empty", m.Name)
+		}
+
+		if _, ok := versions[version.Version]; ok {
+			return fmt.Errorf("duplicated version %v", version.Version)
+		}
+		versions[version.Version] = struct{}{}
+
+		if err := version.Validate(); err != nil {
+			return fmt.Errorf("invalid version %v: %w", version.Version, err)
+		}
+	}
+
+	return nil
+}
+
+// GetVersion returns the given version of the runtime
+func (m *Runtime) GetVersion(version string) (RuntimeVersion, bool) {
+	for _, v := range m.Versions {
+		if v.Version == version {
+			return v, true
+		}
+	}
+
+	return RuntimeVersion{}, false
+}
+
+// Validate checks that the version has at least one binary, that every
+// platform has exactly one binary and that it can be downloaded over http(s).
+func (m *RuntimeVersion) Validate() error {
+	if len(m.Binaries) == 0 {
+		return fmt.Errorf("no binaries")
+	}
+
+	platforms := make(map[string]struct{})
+
+	for _, binary := range m.Binaries {
+		if binary.Platform == "" {
+			return fmt.Errorf("platform of binary is empty")
+		}
+
+		if _, ok := platforms[binary.Platform]; ok {
+			return fmt.Errorf("duplicated platform %v", binary.Platform)
+		}
+		platforms[binary.Platform] = struct{}{}
+
+		if binary.Url == "" {
+			return fmt.Errorf("url of platform %v is empty", binary.Platform)
+		}
+
+		u, err := url.Parse(binary.Url)
+		if err != nil {
+			return fmt.Errorf("invalid url of platform %v: %w", binary.Platform, err)
+		}
+
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("url of platform %v is not an absolute http(s) url", binary.Platform)
+		}
+
+		if checksum, err := hex.DecodeString(binary.Sha256); err != nil || len(checksum) != 32 {
+			return fmt.Errorf("invalid sha256 checksum of platform %v", binary.Platform)
+		}
+	}
+
+	return nil
+}
+
+// BinariesJson returns the binaries of the version in the format which
+// is stored in the protocol and the upgrade plan of a pool.
+func (m *RuntimeVersion) BinariesJson() string {
+	urls := make(map[string]string)
+	for _, binary := range m.Binaries {
+		urls[binary.Platform] = binary.Url
+	}
+
+	// Keys of maps are sorted, therefore the result is deterministic
+	bz, _ := json.Marshal(urls)
+	return string(bz)
+}
+
+// MatchBinaries checks that the given binaries of a pool
+// contain exactly the binaries of the version.
+func (m *RuntimeVersion) MatchBinaries(binaries string) error {
+	urls := make(map[string]string)
+	if err := json.Unmarshal([]byte(binaries), &urls); err != nil {
+		return err
+	}
+
+	if len(urls) != len(m.Binaries) {
+		return fmt.Errorf("expected %v binaries, got %v", len(m.Binaries), len(urls))
+	}
+
+	for _, binary := range m.Binaries {
+		if urls[binary.Platform] != binary.Url {
+			return fmt.Errorf("url of platform %v does not match", binary.Platform)
+		}
+	}
+
+	return nil
+}
+
+// ParseBinaries parses the binaries of a pool which are not registered
+// in a runtime. The binaries do not contain checksums.
+func ParseBinaries(binaries string) []Binary {
+	urls := make(map[string]string)
+	if err := json.Unmarshal([]byte(binaries), &urls); err != nil {
+		return []Binary{}
+	}
+
+	parsed := make([]Binary, 0, len(urls))
+	for platform, u := range urls {
+		parsed = append(parsed, Binary{
+			Platform: platform,
+			Url:      u,
+		})
+	}
+
+	sort.Slice(parsed, func(i, j int) bool {
+		return parsed[i].Platform < parsed[j].Platform
+	})
+
+	return parsed
+}
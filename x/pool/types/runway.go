@@ -0,0 +1,151 @@
+package types
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EstimateRunway estimates for every funder the amount it gets charged for the
+// next bundle and the number of bundles until it gets removed from the pool, if
+// every bundle costs the given amount. Both are returned in the order of the
+// funders. The estimate follows the charging mode of the pool but ignores that
+// charges are rounded down to whole coins. If a bundle has no cost the funds never
+// run out and all runways are zero. Runways which exceed the uint64 range are
+// capped at the maximum uint64 value.
+func (m *Pool) EstimateRunway(amount uint64, weights map[string]sdk.Dec) (charges []uint64, runways []uint64) {
+	charges = make([]uint64, len(m.Funders))
+	runways = make([]uint64, len(m.Funders))
+
+	if amount == 0 {
+		return charges, runways
+	}
+
+	if m.ChargingMode == CHARGING_MODE_PRO_RATA {
+		m.estimateRunwayProRata(amount, weights, charges, runways)
+	} else {
+		m.estimateRunwayEqual(amount, weights, charges, runways)
+	}
+
+	return charges, runways
+}
+
+// estimateRunwayEqual simulates the equal charging mode. As long as no funder
+// gets removed the lowest funder stays the lowest one, because it pays at least
+// as much as everybody else. Therefore, the bundles until the next removal can
+// be computed at once, which limits the simulation to one step per funder.
+func (m *Pool) estimateRunwayEqual(amount uint64, weights map[string]sdk.Dec, charges []uint64, runways []uint64) {
+	values := make([]sdk.Dec, len(m.Funders))
+	active := make([]int, 0, len(m.Funders))
+
+	for index, funder := range m.Funders {
+		values[index] = funder.GetValue(weights)
+		active = append(active, index)
+	}
+
+	elapsed := sdk.ZeroInt()
+	charged := false
+
+	for len(active) > 0 {
+		amountPerFunder := amount / uint64(len(active))
+		amountRemainder := amount - amountPerFunder*uint64(len(active))
+
+		lowest := 0
+		for position := range active {
+			if values[active[position]].LT(values[active[lowest]]) {
+				lowest = position
+			}
+		}
+
+		lowestCharge := sdk.NewDecFromInt(sdk.NewIntFromUint64(amountPerFunder + amountRemainder))
+
+		// The lowest funder can't afford its charge and gets removed
+		if lowestCharge.GT(values[active[lowest]]) {
+			runways[active[lowest]] = SaturatedUint64(elapsed)
+			active = append(active[:lowest], active[lowest+1:]...)
+			continue
+		}
+
+		if !charged {
+			for position, index := range active {
+				charges[index] = amountPerFunder
+				if position == lowest {
+					charges[index] += amountRemainder
+				}
+			}
+			charged = true
+		}
+
+		bundles := values[active[lowest]].Quo(lowestCharge).TruncateInt()
+		elapsed = elapsed.Add(bundles)
+
+		for position, index := range active {
+			charge := amountPerFunder
+			if position == lowest {
+				charge += amountRemainder
+			}
+			values[index] = values[index].Sub(sdk.NewDecFromInt(sdk.NewIntFromUint64(charge).Mul(bundles)))
+		}
+	}
+}
+
+// estimateRunwayProRata simulates the pro-rata charging mode. Funders who can't
+// afford their share are removed like in the actual charge. Since everybody else
+// pays in proportion to their value, all of them run out of funds together.
+func (m *Pool) estimateRunwayProRata(amount uint64, weights map[string]sdk.Dec, charges []uint64, runways []uint64) {
+	pool := Pool{
+		Funders:    append([]*Funder{}, m.Funders...),
+		TotalFunds: m.TotalFunds,
+	}
+
+	var proRataCharges []uint64
+
+	for len(pool.Funders) > 0 {
+		proRataCharges = pool.GetProRataCharges(amount, weights)
+
+		funder, found := pool.GetInsolventProRataFunder(proRataCharges, weights)
+		if !found {
+			break
+		}
+
+		pool.RemoveFunder(funder)
+	}
+
+	totalValue := sdk.ZeroDec()
+	for _, funder := range pool.Funders {
+		totalValue = totalValue.Add(funder.GetValue(weights))
+	}
+
+	bundles := SaturatedUint64(totalValue.QuoInt(sdk.NewIntFromUint64(amount)).TruncateInt())
+
+	for index, funder := range m.Funders {
+		for position, remaining := range pool.Funders {
+			if remaining.Address == funder.Address {
+				charges[index] = proRataCharges[position]
+				runways[index] = bundles
+			}
+		}
+	}
+}
+
+// SaturatedUint64 converts the given integer to an uint64. Values which
+// exceed the uint64 range are capped at the maximum uint64 value.
+func SaturatedUint64(value sdk.Int) uint64 {
+	if !value.IsUint64() {
+		return math.MaxUint64
+	}
+
+	return value.Uint64()
+}
+
+// SaturatedMul multiplies the given numbers. Products which exceed
+// the uint64 range are capped at the maximum uint64 value.
+func SaturatedMul(a uint64, b uint64) uint64 {
+	return SaturatedUint64(sdk.NewIntFromUint64(a).Mul(sdk.NewIntFromUint64(b)))
+}
+
+// SaturatedAdd adds the given numbers. Sums which exceed the
+// uint64 range are capped at the maximum uint64 value.
+func SaturatedAdd(a uint64, b uint64) uint64 {
+	return SaturatedUint64(sdk.NewIntFromUint64(a).Add(sdk.NewIntFromUint64(b)))
+}
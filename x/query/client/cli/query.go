@@ -13,6 +13,10 @@ import (
 	"github.com/KYVENetwork/chain/x/query/types"
 )
 
+const (
+	FlagBundles = "bundles"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group query queries under a subcommand
@@ -33,6 +37,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Pool
 	cmd.AddCommand(CmdShowPool())
 	cmd.AddCommand(CmdListPool())
+	cmd.AddCommand(CmdPoolRunway())
 
 	// Staking
 	cmd.AddCommand(CmdShowStaker())
@@ -43,10 +48,15 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdDelegator())
 	cmd.AddCommand(CmdStakersByPoolAndDelegator())
 	cmd.AddCommand(CmdDelegatorsByPoolAndStaker())
+	cmd.AddCommand(CmdSlashesByStaker())
+	cmd.AddCommand(CmdSlashesByPool())
+	cmd.AddCommand(CmdSlashesByDelegator())
 
 	// Bundles
 	cmd.AddCommand(CmdShowFinalizedBundle())
 	cmd.AddCommand(CmdListFinalizedBundles())
+	cmd.AddCommand(CmdShowConcludedBundleProposal())
+	cmd.AddCommand(CmdListConcludedBundleProposals())
 	cmd.AddCommand(CmdCanPropose())
 	cmd.AddCommand(CmdCanVote())
 	cmd.AddCommand(CmdCurrentVoteStatus())
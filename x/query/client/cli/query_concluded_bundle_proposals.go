@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"context"
+	"github.com/spf13/cast"
+
+	"github.com/KYVENetwork/chain/x/query/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdListConcludedBundleProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "concluded-bundle-proposals [pool_id]",
+		Short: "list the history of concluded bundle proposals of pool given by pool_id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			poolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryBundlesClient(clientCtx)
+
+			params := &types.QueryConcludedBundleProposalsRequest{
+				PoolId:     poolId,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ConcludedBundleProposals(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowConcludedBundleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "concluded-bundle-proposal [pool_id] [id]",
+		Short: "show the concluded bundle proposal given by pool_id and id",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			poolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			id, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryBundlesClient(clientCtx)
+
+			params := &types.QueryConcludedBundleProposalRequest{
+				PoolId: poolId,
+				Id:     id,
+			}
+
+			res, err := queryClient.ConcludedBundleProposal(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -94,3 +94,46 @@ func CmdShowPool() *cobra.Command {
 
 	return cmd
 }
+
+func CmdPoolRunway() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-runway [id]",
+		Short: "estimates how long the funds of a pool and its funders will last",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryPoolClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bundles, err := cmd.Flags().GetUint64(FlagBundles)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryPoolRunwayRequest{
+				Id:      id,
+				Bundles: bundles,
+			}
+
+			res, err := queryClient.PoolRunway(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBundles, types.DefaultRunwayBundles, "number of latest finalized bundles the cost per bundle is estimated from")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -27,7 +27,7 @@ func (k Keeper) AccountAssets(goCtx context.Context, req *types.QueryAccountAsse
 	// Balance
 	// =======
 	account, _ := sdk.AccAddressFromBech32(req.Address)
-	balance := k.bankKeeper.GetBalance(ctx, account, "tkyve")
+	balance := k.bankKeeper.GetBalance(ctx, account, util.KyveDenom)
 	response.Balance = balance.Amount.Uint64()
 
 	// ======================
@@ -52,7 +52,7 @@ func (k Keeper) AccountAssets(goCtx context.Context, req *types.QueryAccountAsse
 		staker := string(delegatorIterator.Key()[0:43])
 
 		response.ProtocolDelegation += k.delegationKeeper.GetDelegationAmountOfDelegator(ctx, staker, req.Address)
-		response.ProtocolRewards += k.delegationKeeper.GetOutstandingRewards(ctx, staker, req.Address)
+		response.ProtocolRewards = response.ProtocolRewards.Add(k.delegationKeeper.GetOutstandingRewards(ctx, staker, req.Address)...)
 	}
 
 	// ======================================================
@@ -74,7 +74,7 @@ func (k Keeper) AccountAssets(goCtx context.Context, req *types.QueryAccountAsse
 	// Iterate all pools and look if the user is funding
 	for _, pool := range k.poolKeeper.GetAllPools(ctx) {
 		funder, _ := pool.GetFunder(req.Address)
-		response.ProtocolFunding += funder.Amount
+		response.ProtocolFunding = response.ProtocolFunding.Add(funder.Amount...)
 	}
 
 	return &response, nil
@@ -17,17 +17,21 @@ func (k Keeper) CurrentVoteStatus(c context.Context, req *types.QueryCurrentVote
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	_, found := k.poolKeeper.GetPool(ctx, req.PoolId)
+	pool, found := k.poolKeeper.GetPool(ctx, req.PoolId)
 	if !found {
 		return nil, sdkErrors.ErrKeyNotFound
 	}
 
 	voteDistribution := k.bundleKeeper.GetVoteDistribution(ctx, req.PoolId)
+	validQuorum, invalidQuorum := pool.GetQuorums()
 
 	return &types.QueryCurrentVoteStatusResponse{
-		Valid:   voteDistribution.Valid,
-		Invalid: voteDistribution.Invalid,
-		Abstain: voteDistribution.Abstain,
-		Total:   voteDistribution.Total,
+		Valid:         voteDistribution.Valid,
+		Invalid:       voteDistribution.Invalid,
+		Abstain:       voteDistribution.Abstain,
+		Total:         voteDistribution.Total,
+		Status:        voteDistribution.Status,
+		ValidQuorum:   validQuorum.String(),
+		InvalidQuorum: invalidQuorum.String(),
 	}, nil
 }
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/query/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SlashesByStaker(goCtx context.Context, req *types.QuerySlashesByStakerRequest) (*types.QuerySlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	slashes, pageRes, err := k.delegationKeeper.GetPaginatedSlashRecordsByStaker(ctx, req.Pagination, req.Staker)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashesResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SlashesByPool(goCtx context.Context, req *types.QuerySlashesByPoolRequest) (*types.QuerySlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	slashes, pageRes, err := k.delegationKeeper.GetPaginatedSlashRecordsByPool(ctx, req.Pagination, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashesResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SlashesByDelegator(goCtx context.Context, req *types.QuerySlashesByDelegatorRequest) (*types.QuerySlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	slashes, pageRes, err := k.delegationKeeper.GetPaginatedSlashRecordsByDelegator(ctx, req.Pagination, req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashesResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
@@ -16,15 +16,24 @@ func (k Keeper) CanPropose(c context.Context, req *types.QueryCanProposeRequest)
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	// return the effective values of the pool so that nodes know their tolerances
+	pool, _ := k.poolKeeper.GetPool(ctx, req.PoolId)
+	uploadTimeout := k.bundleKeeper.PoolUploadTimeout(ctx, pool)
+	maxPoints := k.bundleKeeper.PoolMaxPoints(ctx, pool)
+
 	if err := k.bundleKeeper.AssertCanPropose(ctx, req.PoolId, req.Staker, req.Proposer, req.FromHeight); err != nil {
 		return &types.QueryCanProposeResponse{
-			Possible: false,
-			Reason:   err.Error(),
+			Possible:      false,
+			Reason:        err.Error(),
+			UploadTimeout: uploadTimeout,
+			MaxPoints:     maxPoints,
 		}, nil
 	}
 
 	return &types.QueryCanProposeResponse{
-		Possible: true,
-		Reason:   "",
+		Possible:      true,
+		Reason:        "",
+		UploadTimeout: uploadTimeout,
+		MaxPoints:     maxPoints,
 	}, nil
 }
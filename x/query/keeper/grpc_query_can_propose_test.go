@@ -28,6 +28,7 @@ TEST CASES - grpc_query_can_propose.go
 * Call can propose before the upload interval passed
 * Call can propose with an invalid from height
 * Call can propose on an active pool as the next uploader with valid args
+* Call can propose returns the upload timeout and max points of the pool
 * TODO: Call can propose when previous bundle was dropped
 
 */
@@ -50,7 +51,7 @@ var _ = Describe("grpc_query_can_propose.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		s.RunTxStakersSuccess(&stakertypes.MsgCreateStaker{
@@ -235,7 +236,7 @@ var _ = Describe("grpc_query_can_propose.go", Ordered, func() {
 		s.RunTxPoolSuccess(&pooltypes.MsgDefundPool{
 			Creator: i.ALICE,
 			Id:      0,
-			Amount:  100 * i.KYVE,
+			Amount:  i.KYVECoins(100 * i.KYVE),
 		})
 
 		// ACT
@@ -516,4 +517,41 @@ var _ = Describe("grpc_query_can_propose.go", Ordered, func() {
 
 		Expect(txErr).To(BeNil())
 	})
+
+	It("Call can propose returns the upload timeout and max points of the pool", func() {
+		// ACT
+		canPropose, err := s.App().QueryKeeper.CanPropose(sdk.WrapSDKContext(s.Ctx()), &querytypes.QueryCanProposeRequest{
+			PoolId:     0,
+			Staker:     i.STAKER_0,
+			Proposer:   i.VALADDRESS_0,
+			FromHeight: 100,
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		Expect(canPropose.UploadTimeout).To(Equal(s.App().BundlesKeeper.UploadTimeout(s.Ctx())))
+		Expect(canPropose.MaxPoints).To(Equal(s.App().BundlesKeeper.MaxPoints(s.Ctx())))
+
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.UploadTimeout = 1200
+		pool.MaxPoints = 2
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		// ACT
+		canPropose, err = s.App().QueryKeeper.CanPropose(sdk.WrapSDKContext(s.Ctx()), &querytypes.QueryCanProposeRequest{
+			PoolId:     0,
+			Staker:     i.STAKER_1,
+			Proposer:   i.VALADDRESS_1,
+			FromHeight: 100,
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		Expect(canPropose.Possible).To(BeFalse())
+		Expect(canPropose.UploadTimeout).To(Equal(uint64(1200)))
+		Expect(canPropose.MaxPoints).To(Equal(uint64(2)))
+	})
 })
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/query/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ConcludedBundleProposals(c context.Context, req *types.QueryConcludedBundleProposalsRequest) (*types.QueryConcludedBundleProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	concludedBundleProposals, pageRes, err := k.bundleKeeper.GetPaginatedConcludedBundleProposalQuery(ctx, req.Pagination, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConcludedBundleProposalsResponse{ConcludedBundleProposals: concludedBundleProposals, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ConcludedBundleProposal(c context.Context, req *types.QueryConcludedBundleProposalRequest) (*types.QueryConcludedBundleProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	concludedBundleProposal, found := k.bundleKeeper.GetConcludedBundleProposal(ctx, req.PoolId, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryConcludedBundleProposalResponse{ConcludedBundleProposal: concludedBundleProposal}, nil
+}
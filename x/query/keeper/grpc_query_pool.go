@@ -54,6 +54,13 @@ func (k Keeper) parsePoolResponse(ctx sdk.Context, pool *pooltypes.Pool) types.P
 
 	totalDelegation := k.delegationKeeper.GetDelegationOfPool(ctx, pool.Id)
 
+	protocolBinaries := k.poolKeeper.GetProtocolBinaries(ctx, pool.Runtime, pool.Protocol.Version, pool.Protocol.Binaries)
+
+	upgradeBinaries := []pooltypes.Binary{}
+	if pool.UpgradePlan.ScheduledAt > 0 {
+		upgradeBinaries = k.poolKeeper.GetProtocolBinaries(ctx, pool.Runtime, pool.UpgradePlan.Version, pool.UpgradePlan.Binaries)
+	}
+
 	return types.PoolResponse{
 		Id:                  pool.Id,
 		Data:                pool,
@@ -62,5 +69,7 @@ func (k Keeper) parsePoolResponse(ctx sdk.Context, pool *pooltypes.Pool) types.P
 		TotalSelfDelegation: totalSelfDelegation,
 		TotalDelegation:     totalDelegation,
 		Status:              k.GetPoolStatus(ctx, pool),
+		ProtocolBinaries:    protocolBinaries,
+		UpgradeBinaries:     upgradeBinaries,
 	}
 }
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/KYVENetwork/chain/x/query/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PoolRunway(c context.Context, req *types.QueryPoolRunwayRequest) (*types.QueryPoolRunwayResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, found := k.poolKeeper.GetPool(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	sampleSize := req.Bundles
+	if sampleSize == 0 {
+		sampleSize = types.DefaultRunwayBundles
+	}
+	if sampleSize > types.MaxRunwayBundles {
+		return nil, status.Errorf(codes.InvalidArgument, "bundles must not exceed %v", types.MaxRunwayBundles)
+	}
+
+	// Average the byte size over the latest finalized bundles. Bundles which got
+	// finalized before the byte size was recorded are skipped.
+	var sampledBundles, totalByteSize uint64
+	for id := pool.TotalBundles; id > 0 && pool.TotalBundles-id < sampleSize; id-- {
+		bundle, found := k.bundleKeeper.GetFinalizedBundle(ctx, req.Id, id-1)
+		if !found || bundle.ByteSize == 0 {
+			continue
+		}
+
+		sampledBundles++
+		totalByteSize = pooltypes.SaturatedAdd(totalByteSize, bundle.ByteSize)
+	}
+
+	var averageByteSize uint64
+	if sampledBundles > 0 {
+		averageByteSize = totalByteSize / sampledBundles
+	}
+
+	storageCost := k.bundleKeeper.StorageCost(ctx)
+	costPerBundle := pooltypes.SaturatedAdd(pool.OperatingCost, pooltypes.SaturatedMul(averageByteSize, storageCost))
+
+	weights := k.poolKeeper.GetFundingWeights(ctx)
+	charges, runways := pool.EstimateRunway(costPerBundle, weights)
+
+	totalValue := sdk.ZeroDec()
+	var bundlesRemaining uint64
+
+	funders := make([]types.FunderRunway, 0)
+	for index, funder := range pool.Funders {
+		value := funder.GetValue(weights)
+		totalValue = totalValue.Add(value)
+
+		if runways[index] > bundlesRemaining {
+			bundlesRemaining = runways[index]
+		}
+
+		funders = append(funders, types.FunderRunway{
+			Address:          funder.Address,
+			Value:            pooltypes.SaturatedUint64(value.TruncateInt()),
+			ChargePerBundle:  charges[index],
+			BundlesRemaining: runways[index],
+			TimeToEmpty:      pooltypes.SaturatedMul(runways[index], pool.UploadInterval),
+		})
+	}
+
+	return &types.QueryPoolRunwayResponse{
+		OperatingCost:    pool.OperatingCost,
+		StorageCost:      storageCost,
+		AverageByteSize:  averageByteSize,
+		SampledBundles:   sampledBundles,
+		CostPerBundle:    costPerBundle,
+		TotalValue:       pooltypes.SaturatedUint64(totalValue.TruncateInt()),
+		BundlesRemaining: bundlesRemaining,
+		TimeToEmpty:      pooltypes.SaturatedMul(bundlesRemaining, pool.UploadInterval),
+		Funders:          funders,
+	}, nil
+}
@@ -0,0 +1,332 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - grpc_query_pool_runway.go
+
+* Call pool runway if pool does not exist
+* Call pool runway with too many bundles
+* Call pool runway of a pool without funders
+* Call pool runway of a pool with the equal charging mode
+* Call pool runway of a pool with the pro-rata charging mode
+* Call pool runway with the average byte size of the latest bundles
+* Call pool runway of a pool whose bundles have no cost
+* Call pool runway of a pool whose funds exceed the uint64 range
+* Call pool runway of a pool whose runway exceeds the uint64 range with the equal charging mode
+* Call pool runway of a pool whose runway exceeds the uint64 range with the pro-rata charging mode
+
+*/
+
+var _ = Describe("grpc_query_pool_runway.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name:           "Moontest",
+			OperatingCost:  1 * i.KYVE,
+			MinStake:       200 * i.KYVE,
+			UploadInterval: 60,
+			MaxBundleSize:  100,
+			Protocol:       &pooltypes.Protocol{},
+			UpgradePlan:    &pooltypes.UpgradePlan{},
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	fund := func(address string, amount uint64) {
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: address,
+			Id:      0,
+			Amount:  i.KYVECoins(amount),
+		})
+	}
+
+	runway := func(bundles uint64) (*querytypes.QueryPoolRunwayResponse, error) {
+		return s.App().QueryKeeper.PoolRunway(sdk.WrapSDKContext(s.Ctx()), &querytypes.QueryPoolRunwayRequest{
+			Id:      0,
+			Bundles: bundles,
+		})
+	}
+
+	It("Call pool runway if pool does not exist", func() {
+		// ACT
+		_, err := s.App().QueryKeeper.PoolRunway(sdk.WrapSDKContext(s.Ctx()), &querytypes.QueryPoolRunwayRequest{
+			Id: 1,
+		})
+
+		// ASSERT
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("Call pool runway with too many bundles", func() {
+		// ACT
+		_, err := runway(querytypes.MaxRunwayBundles + 1)
+
+		// ASSERT
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("Call pool runway of a pool without funders", func() {
+		// ACT
+		res, err := runway(0)
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		Expect(res.OperatingCost).To(Equal(1 * i.KYVE))
+		Expect(res.CostPerBundle).To(Equal(1 * i.KYVE))
+		Expect(res.SampledBundles).To(BeZero())
+		Expect(res.TotalValue).To(BeZero())
+		Expect(res.BundlesRemaining).To(BeZero())
+		Expect(res.TimeToEmpty).To(BeZero())
+		Expect(res.Funders).To(BeEmpty())
+	})
+
+	It("Call pool runway of a pool with the equal charging mode", func() {
+		// ARRANGE
+		fund(i.ALICE, 10*i.KYVE)
+		fund(i.BOB, 30*i.KYVE)
+
+		// ACT
+		res, err := runway(0)
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		Expect(res.CostPerBundle).To(Equal(1 * i.KYVE))
+		Expect(res.TotalValue).To(Equal(40 * i.KYVE))
+
+		// alice and bob pay 0.5 $KYVE each until alice runs out after 20 bundles,
+		// afterwards bob pays the full cost with his remaining 20 $KYVE
+		Expect(res.BundlesRemaining).To(Equal(uint64(40)))
+		Expect(res.TimeToEmpty).To(Equal(uint64(40 * 60)))
+
+		Expect(res.Funders).To(HaveLen(2))
+
+		Expect(res.Funders[0].Address).To(Equal(i.ALICE))
+		Expect(res.Funders[0].Value).To(Equal(10 * i.KYVE))
+		Expect(res.Funders[0].ChargePerBundle).To(Equal(i.KYVE / 2))
+		Expect(res.Funders[0].BundlesRemaining).To(Equal(uint64(20)))
+		Expect(res.Funders[0].TimeToEmpty).To(Equal(uint64(20 * 60)))
+
+		Expect(res.Funders[1].Address).To(Equal(i.BOB))
+		Expect(res.Funders[1].Value).To(Equal(30 * i.KYVE))
+		Expect(res.Funders[1].ChargePerBundle).To(Equal(i.KYVE / 2))
+		Expect(res.Funders[1].BundlesRemaining).To(Equal(uint64(40)))
+		Expect(res.Funders[1].TimeToEmpty).To(Equal(uint64(40 * 60)))
+	})
+
+	It("Call pool runway of a pool with the pro-rata charging mode", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.ChargingMode = pooltypes.CHARGING_MODE_PRO_RATA
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		fund(i.ALICE, 10*i.KYVE)
+		fund(i.BOB, 30*i.KYVE)
+
+		// ACT
+		res, err := runway(0)
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		// both funders pay in proportion to their funds and run out together
+		Expect(res.BundlesRemaining).To(Equal(uint64(40)))
+		Expect(res.TimeToEmpty).To(Equal(uint64(40 * 60)))
+
+		Expect(res.Funders).To(HaveLen(2))
+
+		Expect(res.Funders[0].Address).To(Equal(i.ALICE))
+		Expect(res.Funders[0].ChargePerBundle).To(Equal(i.KYVE / 4))
+		Expect(res.Funders[0].BundlesRemaining).To(Equal(uint64(40)))
+
+		Expect(res.Funders[1].Address).To(Equal(i.BOB))
+		Expect(res.Funders[1].ChargePerBundle).To(Equal(i.KYVE * 3 / 4))
+		Expect(res.Funders[1].BundlesRemaining).To(Equal(uint64(40)))
+	})
+
+	It("Call pool runway with the average byte size of the latest bundles", func() {
+		// ARRANGE
+		fund(i.ALICE, 100*i.KYVE)
+
+		// the latest bundle got finalized before the byte size was recorded
+		for id, byteSize := range []uint64{1000, 100, 300, 0} {
+			s.App().BundlesKeeper.SetFinalizedBundle(s.Ctx(), bundletypes.FinalizedBundle{
+				PoolId:     0,
+				Id:         uint64(id),
+				StorageId:  fmt.Sprintf("storage_id_%v", id),
+				FromHeight: uint64(id) * 100,
+				ToHeight:   uint64(id+1) * 100,
+				ByteSize:   byteSize,
+			})
+		}
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.TotalBundles = 4
+		pool.CurrentHeight = 400
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		storageCost := s.App().BundlesKeeper.StorageCost(s.Ctx())
+
+		// ACT
+		res, err := runway(3)
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		Expect(res.StorageCost).To(Equal(storageCost))
+		Expect(res.SampledBundles).To(Equal(uint64(2)))
+		Expect(res.AverageByteSize).To(Equal(uint64(200)))
+		Expect(res.CostPerBundle).To(Equal(1*i.KYVE + 200*storageCost))
+		Expect(res.BundlesRemaining).To(Equal(100 * i.KYVE / res.CostPerBundle))
+
+		// ACT
+		res, err = runway(0)
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		Expect(res.SampledBundles).To(Equal(uint64(3)))
+		Expect(res.AverageByteSize).To(Equal(uint64(466)))
+	})
+
+	It("Call pool runway of a pool whose bundles have no cost", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.OperatingCost = 0
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		fund(i.ALICE, 10*i.KYVE)
+
+		// ACT
+		res, err := runway(0)
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		Expect(res.CostPerBundle).To(BeZero())
+		Expect(res.BundlesRemaining).To(BeZero())
+		Expect(res.Funders).To(HaveLen(1))
+		Expect(res.Funders[0].ChargePerBundle).To(BeZero())
+		Expect(res.Funders[0].BundlesRemaining).To(BeZero())
+	})
+
+	It("Call pool runway of a pool whose funds exceed the uint64 range", func() {
+		// ARRANGE
+		otherDenom := "acoin"
+		Expect(s.MintCoins(i.ALICE, sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(100*i.KYVE))))).To(BeNil())
+
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.FundingDenoms = append(params.FundingDenoms, pooltypes.FundingDenom{Denom: otherDenom, Weight: sdk.NewDec(1_000_000_000)})
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(100*i.KYVE))),
+		})
+		fund(i.BOB, 10*i.KYVE)
+
+		// ACT
+		res, err := runway(0)
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		Expect(res.TotalValue).To(Equal(uint64(math.MaxUint64)))
+		Expect(res.Funders).To(HaveLen(2))
+		Expect(res.Funders[0].Value).To(Equal(uint64(math.MaxUint64)))
+		Expect(res.Funders[1].Value).To(Equal(10 * i.KYVE))
+	})
+	It("Call pool runway of a pool whose runway exceeds the uint64 range with the equal charging mode", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.OperatingCost = 1
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		otherDenom := "acoin"
+		Expect(s.MintCoins(i.ALICE, sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(100*i.KYVE))))).To(BeNil())
+
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.FundingDenoms = append(params.FundingDenoms, pooltypes.FundingDenom{Denom: otherDenom, Weight: sdk.NewDec(1_000_000_000)})
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(100*i.KYVE))),
+		})
+		fund(i.BOB, 10*i.KYVE)
+
+		// ACT
+		res, err := runway(0)
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		Expect(res.CostPerBundle).To(Equal(uint64(1)))
+		Expect(res.BundlesRemaining).To(Equal(uint64(math.MaxUint64)))
+		Expect(res.TimeToEmpty).To(Equal(uint64(math.MaxUint64)))
+
+		Expect(res.Funders).To(HaveLen(2))
+		Expect(res.Funders[0].BundlesRemaining).To(Equal(uint64(math.MaxUint64)))
+		Expect(res.Funders[0].TimeToEmpty).To(Equal(uint64(math.MaxUint64)))
+		Expect(res.Funders[1].BundlesRemaining).To(BeNumerically("<", uint64(math.MaxUint64)))
+	})
+
+	It("Call pool runway of a pool whose runway exceeds the uint64 range with the pro-rata charging mode", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.OperatingCost = 1
+		pool.ChargingMode = pooltypes.CHARGING_MODE_PRO_RATA
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		otherDenom := "acoin"
+		Expect(s.MintCoins(i.ALICE, sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(100*i.KYVE))))).To(BeNil())
+
+		params := s.App().PoolKeeper.GetParams(s.Ctx())
+		params.FundingDenoms = append(params.FundingDenoms, pooltypes.FundingDenom{Denom: otherDenom, Weight: sdk.NewDec(1_000_000_000)})
+		s.App().PoolKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgFundPool{
+			Creator: i.ALICE,
+			Id:      0,
+			Amount:  sdk.NewCoins(sdk.NewInt64Coin(otherDenom, int64(100*i.KYVE))),
+		})
+		fund(i.BOB, 10*i.KYVE)
+
+		// ACT
+		res, err := runway(0)
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		Expect(res.BundlesRemaining).To(Equal(uint64(math.MaxUint64)))
+		Expect(res.TimeToEmpty).To(Equal(uint64(math.MaxUint64)))
+
+		Expect(res.Funders).To(HaveLen(2))
+		Expect(res.Funders[0].BundlesRemaining).To(Equal(uint64(math.MaxUint64)))
+		Expect(res.Funders[0].TimeToEmpty).To(Equal(uint64(math.MaxUint64)))
+		Expect(res.Funders[1].BundlesRemaining).To(Equal(uint64(math.MaxUint64)))
+		Expect(res.Funders[1].TimeToEmpty).To(Equal(uint64(math.MaxUint64)))
+	})
+})
@@ -18,12 +18,23 @@ func (k Keeper) GetFullStaker(ctx sdk.Context, stakerAddress string) *types.Full
 		}
 	}
 
+	retireStaker, found := k.stakerKeeper.GetRetireStakerEntryByIndex2(ctx, staker.Address)
+	var retireStakerEntry *types.RetireStakerEntry = nil
+	if found {
+		retireStakerEntry = &types.RetireStakerEntry{
+			CreationDate: retireStaker.CreationDate,
+		}
+	}
+
 	stakerMetadata := types.StakerMetadata{
 		Commission:              staker.Commission,
 		Moniker:                 staker.Moniker,
 		Website:                 staker.Website,
 		Logo:                    staker.Logo,
 		PendingCommissionChange: commissionChangeEntry,
+		PendingRetirement:       retireStakerEntry,
+		MaxCommission:           staker.MaxCommission,
+		MaxCommissionChangeRate: staker.MaxCommissionChangeRate,
 	}
 
 	delegationData, _ := k.delegationKeeper.GetDelegationData(ctx, staker.Address)
@@ -43,9 +54,11 @@ func (k Keeper) GetFullStaker(ctx sdk.Context, stakerAddress string) *types.Full
 				TotalFunds: pool.TotalFunds,
 				Status:     k.GetPoolStatus(ctx, &pool),
 			},
-			Points:     valaccount.Points,
-			IsLeaving:  valaccount.IsLeaving,
-			Valaddress: valaccount.Valaddress,
+			Points:      valaccount.Points,
+			IsLeaving:   valaccount.IsLeaving,
+			Valaddress:  valaccount.Valaddress,
+			JailedUntil: valaccount.JailedUntil,
+			JailCount:   valaccount.JailCount,
 		})
 	}
 
@@ -80,7 +93,7 @@ func (k Keeper) GetPoolStatus(ctx sdk.Context, pool *pooltypes.Pool) pooltypes.P
 		poolStatus = pooltypes.POOL_STATUS_PAUSED
 	} else if totalDelegation < pool.MinStake {
 		poolStatus = pooltypes.POOL_STATUS_NOT_ENOUGH_STAKE
-	} else if pool.TotalFunds == 0 {
+	} else if pool.TotalFunds.IsZero() {
 		poolStatus = pooltypes.POOL_STATUS_NO_FUNDS
 	} else {
 		poolStatus = pooltypes.POOL_STATUS_ACTIVE
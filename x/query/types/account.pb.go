@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -89,9 +91,9 @@ type QueryAccountAssetsResponse struct {
 	// protocol_delegation_unbonding
 	ProtocolDelegationUnbonding uint64 `protobuf:"varint,5,opt,name=protocol_delegation_unbonding,json=protocolDelegationUnbonding,proto3" json:"protocol_delegation_unbonding,omitempty"`
 	// protocol_rewards ...
-	ProtocolRewards uint64 `protobuf:"varint,6,opt,name=protocol_rewards,json=protocolRewards,proto3" json:"protocol_rewards,omitempty"`
+	ProtocolRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=protocol_rewards,json=protocolRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"protocol_rewards"`
 	// protocol_funding ...
-	ProtocolFunding uint64 `protobuf:"varint,7,opt,name=protocol_funding,json=protocolFunding,proto3" json:"protocol_funding,omitempty"`
+	ProtocolFunding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=protocol_funding,json=protocolFunding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"protocol_funding"`
 }
 
 func (m *QueryAccountAssetsResponse) Reset()         { *m = QueryAccountAssetsResponse{} }
@@ -162,18 +164,18 @@ func (m *QueryAccountAssetsResponse) GetProtocolDelegationUnbonding() uint64 {
 	return 0
 }
 
-func (m *QueryAccountAssetsResponse) GetProtocolRewards() uint64 {
+func (m *QueryAccountAssetsResponse) GetProtocolRewards() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.ProtocolRewards
 	}
-	return 0
+	return nil
 }
 
-func (m *QueryAccountAssetsResponse) GetProtocolFunding() uint64 {
+func (m *QueryAccountAssetsResponse) GetProtocolFunding() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.ProtocolFunding
 	}
-	return 0
+	return nil
 }
 
 // QueryAccountFundedListRequest ...
@@ -449,7 +451,7 @@ func (m *QueryAccountFundedListResponse) GetFunded() []Funded {
 // Funded ...
 type Funded struct {
 	// amount ...
-	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 	// pool ...
 	Pool *BasicPool `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
 }
@@ -487,11 +489,11 @@ func (m *Funded) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Funded proto.InternalMessageInfo
 
-func (m *Funded) GetAmount() uint64 {
+func (m *Funded) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Amount
 	}
-	return 0
+	return nil
 }
 
 func (m *Funded) GetPool() *BasicPool {
@@ -552,7 +554,7 @@ type QueryAccountRedelegationResponse struct {
 	// redelegation_cooldown_entries ...
 	RedelegationCooldownEntries []RedelegationEntry `protobuf:"bytes,1,rep,name=redelegation_cooldown_entries,json=redelegationCooldownEntries,proto3" json:"redelegation_cooldown_entries"`
 	// availableSlots ...
-	AvailableSlots uint64 `protobuf:"varint,2,opt,name=availableSlots,proto3" json:"availableSlots,omitempty"`
+	AvailableSlots uint64 `protobuf:"varint,2,opt,name=available_slots,json=availableSlots,proto3" json:"available_slots,omitempty"`
 }
 
 func (m *QueryAccountRedelegationResponse) Reset()         { *m = QueryAccountRedelegationResponse{} }
@@ -674,63 +676,67 @@ func init() {
 func init() { proto.RegisterFile("kyve/query/v1beta1/account.proto", fileDescriptor_51ca316755261aec) }
 
 var fileDescriptor_51ca316755261aec = []byte{
-	// 893 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x3a, 0xc6, 0x15, 0xcf, 0x2d, 0xd0, 0x09, 0xaa, 0xcc, 0x06, 0xaf, 0xa3, 0x45, 0x34,
-	0xa1, 0x82, 0x5d, 0xd9, 0x09, 0xa8, 0x25, 0x70, 0xa8, 0x9b, 0x84, 0x43, 0x01, 0x95, 0x35, 0x20,
-	0xb5, 0x17, 0x6b, 0xbc, 0x3b, 0xde, 0xac, 0xb2, 0xde, 0x71, 0x77, 0xc6, 0x09, 0x16, 0xe2, 0xc2,
-	0x09, 0x01, 0x07, 0x24, 0xfe, 0x02, 0x7f, 0x81, 0x13, 0x9c, 0x38, 0xf5, 0x58, 0x89, 0x0b, 0x17,
-	0x10, 0x4a, 0xf8, 0x21, 0x68, 0x67, 0x66, 0xed, 0x71, 0xbd, 0xb6, 0x09, 0xb7, 0xdd, 0x37, 0xef,
-	0x7b, 0xf3, 0x7d, 0xf3, 0xbe, 0x37, 0x03, 0x5b, 0x27, 0xe3, 0x53, 0xe2, 0x3e, 0x1e, 0x91, 0x74,
-	0xec, 0x9e, 0x36, 0x7b, 0x84, 0xe3, 0xa6, 0x8b, 0x7d, 0x9f, 0x8e, 0x12, 0xee, 0x0c, 0x53, 0xca,
-	0x29, 0x42, 0x59, 0x86, 0x23, 0x32, 0x1c, 0x95, 0x61, 0xde, 0xf2, 0x29, 0x1b, 0x50, 0xe6, 0xf6,
-	0x30, 0x7b, 0x16, 0x3c, 0xc4, 0x61, 0x94, 0x60, 0x1e, 0xd1, 0x44, 0xe2, 0xcd, 0x97, 0x43, 0x1a,
-	0x52, 0xf1, 0xe9, 0x66, 0x5f, 0x2a, 0xfa, 0x6a, 0x48, 0x69, 0x18, 0x13, 0x17, 0x0f, 0x23, 0x17,
-	0x27, 0x09, 0xe5, 0x02, 0xc2, 0xd4, 0xaa, 0x55, 0xc0, 0x4a, 0x32, 0x10, 0xeb, 0xf6, 0xdb, 0xf0,
-	0xca, 0x27, 0xd9, 0xef, 0x5d, 0xc9, 0xf4, 0x2e, 0x63, 0x84, 0x33, 0x8f, 0x3c, 0x1e, 0x11, 0xc6,
-	0x51, 0x0d, 0xae, 0xe0, 0x20, 0x48, 0x09, 0x63, 0x35, 0x63, 0xcb, 0xd8, 0x79, 0xde, 0xcb, 0x7f,
-	0xed, 0x6f, 0xd6, 0xc1, 0x2c, 0xc2, 0xb1, 0x21, 0x4d, 0x18, 0xc9, 0x80, 0x3d, 0x1c, 0xe3, 0xc4,
-	0x27, 0x02, 0x58, 0xf6, 0xf2, 0x5f, 0x74, 0x1b, 0x6a, 0x62, 0x63, 0x9f, 0xc6, 0x5d, 0x46, 0xe2,
-	0x7e, 0x37, 0x20, 0x31, 0x09, 0x05, 0xe5, 0x5a, 0x49, 0xa4, 0xde, 0xc8, 0xd7, 0x3b, 0x24, 0xee,
-	0x1f, 0x4c, 0x56, 0xd1, 0x7d, 0xb0, 0x17, 0x21, 0xbb, 0xa3, 0xa4, 0x47, 0x93, 0x20, 0x4a, 0xc2,
-	0xda, 0xba, 0xa8, 0xd1, 0x28, 0xae, 0xf1, 0x59, 0x9e, 0x86, 0x5c, 0xd8, 0x98, 0x14, 0xd3, 0x18,
-	0x94, 0x05, 0x1a, 0xe5, 0x4b, 0xda, 0xee, 0x6d, 0xa8, 0x17, 0x00, 0xb4, 0x8d, 0x9f, 0x13, 0xd0,
-	0xcd, 0x79, 0xe8, 0x74, 0xd3, 0x37, 0xe0, 0xa5, 0x49, 0x8d, 0x94, 0x9c, 0xe1, 0x34, 0x60, 0xb5,
-	0x8a, 0x80, 0xbd, 0x98, 0xc7, 0x3d, 0x19, 0x9e, 0x49, 0xed, 0x8f, 0xe4, 0x0e, 0x57, 0x66, 0x53,
-	0x8f, 0x64, 0xd8, 0xfe, 0xce, 0x80, 0x6d, 0xbd, 0x15, 0x05, 0x3b, 0x4f, 0x1a, 0x7a, 0x04, 0x30,
-	0x75, 0x95, 0x68, 0x4d, 0xb5, 0x75, 0xd3, 0x91, 0x16, 0x74, 0x32, 0x0b, 0xce, 0xba, 0xd3, 0x79,
-	0x80, 0x43, 0xa2, 0xb0, 0x9e, 0x86, 0xd4, 0x8d, 0x51, 0x9a, 0x35, 0xc6, 0x6f, 0x06, 0xec, 0xac,
-	0x66, 0xa3, 0x6c, 0xf2, 0x11, 0xc0, 0xe4, 0x00, 0x33, 0x8b, 0xad, 0xef, 0x54, 0x5b, 0xdb, 0xce,
-	0xfc, 0x94, 0x38, 0x05, 0x55, 0xda, 0xe5, 0x27, 0x7f, 0x35, 0xd6, 0x3c, 0xad, 0x00, 0xfa, 0x60,
-	0x46, 0x5d, 0x49, 0xa8, 0xdb, 0x5e, 0xa9, 0x4e, 0x72, 0xd1, 0xe5, 0xd9, 0xdf, 0x1a, 0xb0, 0x51,
-	0xd4, 0xc0, 0x1b, 0x50, 0xc1, 0x83, 0x4c, 0x95, 0x72, 0xb5, 0xfa, 0x43, 0xaf, 0xc1, 0x35, 0x3f,
-	0x25, 0xd2, 0x11, 0x3c, 0x1a, 0x10, 0xe5, 0xe4, 0xab, 0x79, 0xf0, 0xd3, 0x68, 0x40, 0xd0, 0x3b,
-	0x50, 0x61, 0x1c, 0x9f, 0x90, 0x54, 0x78, 0xb4, 0xda, 0xb2, 0x8a, 0x84, 0x1e, 0x8d, 0xe2, 0xb8,
-	0x23, 0xb2, 0x3c, 0x95, 0x6d, 0xdf, 0x81, 0xba, 0x7e, 0xa0, 0x59, 0xdb, 0x49, 0xf0, 0x61, 0xc4,
-	0xf8, 0xea, 0x29, 0x7d, 0x04, 0xd6, 0x22, 0xa8, 0xea, 0xc0, 0x6d, 0xa8, 0xf4, 0x45, 0x54, 0x9d,
-	0xbe, 0x59, 0x4c, 0x2a, 0xcb, 0x50, 0x07, 0xae, 0xf2, 0xed, 0x0e, 0x54, 0x64, 0x7c, 0xe1, 0xa9,
-	0x34, 0xa1, 0x3c, 0xa4, 0x34, 0x56, 0x8d, 0xa8, 0x17, 0x55, 0x6e, 0x63, 0x16, 0xf9, 0x0f, 0x28,
-	0x8d, 0x3d, 0x91, 0x6a, 0xef, 0x43, 0x43, 0x27, 0xec, 0x91, 0xe9, 0xa8, 0xad, 0x56, 0xfb, 0x8b,
-	0x01, 0x5b, 0x8b, 0xd1, 0x4a, 0x30, 0x85, 0x7a, 0xaa, 0xc5, 0xbb, 0x3e, 0xa5, 0x71, 0x40, 0xcf,
-	0x92, 0x2e, 0x49, 0x78, 0x1a, 0x91, 0xdc, 0x85, 0xaf, 0x17, 0xb1, 0xd5, 0x0b, 0x1e, 0x26, 0x3c,
-	0x1d, 0xab, 0x23, 0xd9, 0xd4, 0x2b, 0xde, 0x53, 0x05, 0x0f, 0x65, 0x3d, 0x74, 0x13, 0x5e, 0xc0,
-	0xa7, 0x38, 0x8a, 0x71, 0x2f, 0x26, 0x9d, 0x98, 0x72, 0xa6, 0xcc, 0xf1, 0x4c, 0xd4, 0x7e, 0x08,
-	0xd7, 0xe7, 0xea, 0xcf, 0x18, 0x2b, 0xc0, 0x3c, 0xbf, 0x4d, 0x27, 0xc6, 0x3a, 0xc0, 0x9c, 0xa0,
-	0x06, 0x54, 0xfb, 0x51, 0x12, 0xb1, 0x63, 0x99, 0x22, 0xcb, 0x83, 0x0c, 0x65, 0x09, 0xad, 0xef,
-	0x2b, 0x70, 0x55, 0x3f, 0x18, 0xf4, 0x93, 0x01, 0xd7, 0x66, 0x2e, 0x6e, 0xf4, 0x56, 0x91, 0xde,
-	0x85, 0x0f, 0x83, 0xe9, 0xfc, 0xd7, 0x74, 0x79, 0xea, 0xf6, 0xde, 0xd7, 0xbf, 0xff, 0xf3, 0x63,
-	0xc9, 0x41, 0x6f, 0xba, 0x8b, 0x1f, 0xc9, 0x2e, 0x16, 0x18, 0xf7, 0x4b, 0xd5, 0xcf, 0xaf, 0xd0,
-	0x9f, 0x06, 0x6c, 0x2e, 0xb9, 0x46, 0xd0, 0xfe, 0x2a, 0x16, 0x4b, 0xae, 0x42, 0xf3, 0xbd, 0xff,
-	0x07, 0x56, 0x82, 0xee, 0x09, 0x41, 0xef, 0xa3, 0xfd, 0x65, 0x82, 0x8a, 0xde, 0x09, 0x5d, 0xdf,
-	0xcf, 0x06, 0x5c, 0x9f, 0x1b, 0x4d, 0xd4, 0x5c, 0x45, 0x6c, 0xee, 0x06, 0x30, 0x5b, 0x97, 0x81,
-	0x28, 0x05, 0x77, 0x84, 0x82, 0x5d, 0xd4, 0x5c, 0xa6, 0x40, 0xce, 0x7a, 0x37, 0x8e, 0x18, 0xd7,
-	0x78, 0xff, 0x6a, 0xc0, 0x46, 0xc1, 0x8c, 0xa1, 0xdd, 0x55, 0x34, 0x0a, 0xe6, 0xd9, 0xdc, 0xbb,
-	0x1c, 0x48, 0xb1, 0x7f, 0x57, 0xb0, 0xdf, 0x43, 0xad, 0x65, 0xec, 0xf5, 0xb1, 0x9c, 0xd2, 0x6f,
-	0x1f, 0x3c, 0x39, 0xb7, 0x8c, 0xa7, 0xe7, 0x96, 0xf1, 0xf7, 0xb9, 0x65, 0xfc, 0x70, 0x61, 0xad,
-	0x3d, 0xbd, 0xb0, 0xd6, 0xfe, 0xb8, 0xb0, 0xd6, 0x1e, 0xdd, 0x0a, 0x23, 0x7e, 0x3c, 0xea, 0x39,
-	0x3e, 0x1d, 0xb8, 0xf7, 0x1f, 0x7e, 0x7e, 0xf8, 0x31, 0xe1, 0x67, 0x34, 0x3d, 0x71, 0xfd, 0x63,
-	0x1c, 0x25, 0xee, 0x17, 0x6a, 0x1b, 0x3e, 0x1e, 0x12, 0xd6, 0xab, 0x88, 0x77, 0x78, 0xf7, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xce, 0x91, 0x98, 0x17, 0xf7, 0x09, 0x00, 0x00,
+	// 951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xa6, 0xc6, 0x15, 0x2f, 0x2d, 0xa5, 0x13, 0x54, 0xb9, 0x0e, 0x59, 0x47, 0x8b, 0x20,
+	0x51, 0x45, 0x77, 0xb1, 0x13, 0x50, 0x4b, 0xe0, 0x50, 0xe7, 0x07, 0x87, 0x02, 0x2a, 0x1b, 0x40,
+	0x6a, 0x2f, 0xab, 0xf1, 0xee, 0x78, 0x33, 0xca, 0x7a, 0xc7, 0xdd, 0x19, 0x27, 0x44, 0x88, 0x0b,
+	0x47, 0xe0, 0x80, 0xc4, 0x3f, 0x80, 0x10, 0x27, 0xee, 0xdc, 0x7a, 0xe2, 0xd4, 0x63, 0x25, 0x2e,
+	0x5c, 0xf8, 0xa1, 0x84, 0x3f, 0x04, 0xed, 0xcc, 0xac, 0x3d, 0xc6, 0x6b, 0x9b, 0x22, 0x38, 0xc5,
+	0x3b, 0xf3, 0xbe, 0xf7, 0xbe, 0xef, 0xcd, 0xf7, 0x66, 0x02, 0x6b, 0x47, 0xa7, 0xc7, 0xc4, 0x7b,
+	0x38, 0x20, 0xd9, 0xa9, 0x77, 0xdc, 0xec, 0x10, 0x81, 0x9b, 0x1e, 0x0e, 0x43, 0x36, 0x48, 0x85,
+	0xdb, 0xcf, 0x98, 0x60, 0x08, 0xe5, 0x11, 0xae, 0x8c, 0x70, 0x75, 0x44, 0xfd, 0x46, 0xc8, 0x78,
+	0x8f, 0x71, 0xaf, 0x83, 0xf9, 0xdf, 0xc1, 0x7d, 0x1c, 0xd3, 0x14, 0x0b, 0xca, 0x52, 0x85, 0xaf,
+	0xdb, 0x66, 0x6c, 0x11, 0x15, 0x32, 0x5a, 0xec, 0xbf, 0x10, 0xb3, 0x98, 0xc9, 0x9f, 0x5e, 0xfe,
+	0x4b, 0xaf, 0xbe, 0x18, 0x33, 0x16, 0x27, 0xc4, 0xc3, 0x7d, 0xea, 0xe1, 0x34, 0x65, 0x42, 0xa6,
+	0xe4, 0x45, 0xce, 0x12, 0xd6, 0x8a, 0xa1, 0xdc, 0x77, 0x5e, 0x87, 0xeb, 0x1f, 0xe4, 0x9f, 0x77,
+	0x94, 0x92, 0x3b, 0x9c, 0x13, 0xc1, 0x7d, 0xf2, 0x70, 0x40, 0xb8, 0x40, 0x35, 0xb8, 0x88, 0xa3,
+	0x28, 0x23, 0x9c, 0xd7, 0xac, 0x35, 0x6b, 0xe3, 0x59, 0xbf, 0xf8, 0x74, 0xbe, 0xab, 0x40, 0xbd,
+	0x0c, 0xc7, 0xfb, 0x2c, 0xe5, 0x24, 0x07, 0x76, 0x70, 0x82, 0xd3, 0x90, 0x48, 0x60, 0xc5, 0x2f,
+	0x3e, 0xd1, 0x2d, 0xa8, 0xc9, 0xc2, 0x21, 0x4b, 0x02, 0x4e, 0x92, 0x6e, 0x10, 0x91, 0x84, 0xc4,
+	0x92, 0x72, 0x6d, 0x51, 0x86, 0x5e, 0x2b, 0xf6, 0x0f, 0x48, 0xd2, 0xdd, 0x1d, 0xee, 0xa2, 0xbb,
+	0xe0, 0x4c, 0x43, 0x06, 0x83, 0xb4, 0xc3, 0xd2, 0x88, 0xa6, 0x71, 0xed, 0x82, 0xcc, 0xd1, 0x28,
+	0xcf, 0xf1, 0x51, 0x11, 0x86, 0x3c, 0x58, 0x1e, 0x26, 0x33, 0x18, 0x54, 0x24, 0x1a, 0x15, 0x5b,
+	0x46, 0xf5, 0x36, 0xac, 0x96, 0x00, 0x8c, 0xc2, 0xcf, 0x48, 0xe8, 0xca, 0x24, 0x74, 0x54, 0xf4,
+	0x18, 0x9e, 0x1f, 0xe6, 0xc8, 0xc8, 0x09, 0xce, 0x22, 0x5e, 0xab, 0xae, 0x5d, 0xd8, 0x58, 0x6a,
+	0x5d, 0x77, 0xd5, 0xd1, 0xbb, 0xf9, 0xd1, 0x17, 0xde, 0x71, 0x77, 0x18, 0x4d, 0xdb, 0xaf, 0x3d,
+	0xfe, 0xad, 0xb1, 0xf0, 0xc3, 0xef, 0x8d, 0x8d, 0x98, 0x8a, 0xc3, 0x41, 0xc7, 0x0d, 0x59, 0xcf,
+	0xd3, 0x3e, 0x51, 0x7f, 0x6e, 0xf2, 0xe8, 0xc8, 0x13, 0xa7, 0x7d, 0xc2, 0x25, 0x80, 0xfb, 0x57,
+	0x8a, 0x22, 0xbe, 0xaa, 0x31, 0x56, 0xb7, 0x3b, 0x50, 0x74, 0x2f, 0xfe, 0x8f, 0x75, 0xf7, 0x55,
+	0x0d, 0xe7, 0x4b, 0x0b, 0xd6, 0x4d, 0x93, 0x94, 0xf4, 0x64, 0x68, 0xb5, 0x7d, 0x80, 0xd1, 0x3c,
+	0x48, 0xd3, 0x2c, 0xb5, 0x5e, 0x19, 0x63, 0x37, 0x36, 0x57, 0xee, 0x3d, 0x1c, 0x13, 0x8d, 0xf5,
+	0x0d, 0xa4, 0x69, 0xd9, 0xc5, 0x71, 0xcb, 0xfe, 0x64, 0xc1, 0xc6, 0x7c, 0x36, 0xda, 0xc0, 0xef,
+	0x01, 0x0c, 0x8f, 0x36, 0x37, 0x7f, 0xde, 0xac, 0x75, 0x77, 0x72, 0xbe, 0xdd, 0x92, 0x2c, 0xed,
+	0x4a, 0xde, 0x3a, 0xdf, 0x48, 0x80, 0xde, 0x19, 0x53, 0xb7, 0x28, 0xd5, 0xad, 0xcf, 0x55, 0xa7,
+	0xb8, 0x98, 0xf2, 0x9c, 0x2f, 0x2c, 0x58, 0x2e, 0xb3, 0xd6, 0x35, 0xa8, 0xe2, 0x5e, 0xae, 0x4a,
+	0xcf, 0x9b, 0xfe, 0x42, 0x2f, 0xc1, 0xe5, 0x30, 0x23, 0xca, 0xab, 0x82, 0xf6, 0x88, 0x9e, 0xb1,
+	0x4b, 0xc5, 0xe2, 0x87, 0xb4, 0x47, 0xd0, 0x1b, 0x50, 0xe5, 0x02, 0x1f, 0x91, 0x4c, 0x4e, 0xcf,
+	0x52, 0xcb, 0x2e, 0x13, 0xba, 0x3f, 0x48, 0x92, 0x03, 0x19, 0xe5, 0xeb, 0x68, 0xe7, 0x36, 0xac,
+	0x9a, 0x0d, 0xcd, 0x8f, 0x9d, 0x44, 0xef, 0x52, 0x2e, 0xe6, 0xdf, 0x1f, 0x0f, 0xc0, 0x9e, 0x06,
+	0xd5, 0x27, 0x70, 0x0b, 0xaa, 0x5d, 0xb9, 0xaa, 0xbb, 0x5f, 0x2f, 0x27, 0x95, 0x47, 0xe8, 0x86,
+	0xeb, 0x78, 0xe7, 0x5b, 0x0b, 0xaa, 0x6a, 0x03, 0x85, 0x46, 0x5b, 0xfe, 0x73, 0xbf, 0x17, 0x3d,
+	0x6e, 0x42, 0xa5, 0xcf, 0x58, 0xa2, 0x8f, 0x75, 0xb5, 0x8c, 0x67, 0x1b, 0x73, 0x1a, 0xde, 0x63,
+	0x2c, 0xf1, 0x65, 0xa8, 0xb3, 0x0d, 0x0d, 0x53, 0xbe, 0x4f, 0x46, 0x57, 0xca, 0xfc, 0xde, 0x3d,
+	0xb2, 0x60, 0x6d, 0x3a, 0x5a, 0xb7, 0x8f, 0xc1, 0x6a, 0x66, 0xac, 0x07, 0x21, 0x63, 0x49, 0xc4,
+	0x4e, 0xd2, 0x80, 0xa4, 0x22, 0xa3, 0xa4, 0xf0, 0xf4, 0xcb, 0x65, 0x6c, 0xcd, 0x84, 0x7b, 0xa9,
+	0xc8, 0x4e, 0x75, 0x83, 0x57, 0xcc, 0x8c, 0x3b, 0x3a, 0xe1, 0x9e, 0xca, 0x87, 0xd6, 0xe1, 0x0a,
+	0x3e, 0xc6, 0x34, 0xc1, 0x9d, 0x84, 0x04, 0x3c, 0x61, 0x82, 0x6b, 0xaf, 0x3d, 0x37, 0x5c, 0x3e,
+	0xc8, 0x57, 0x9d, 0xfb, 0x70, 0x75, 0xa2, 0xc0, 0x98, 0x4f, 0x23, 0x2c, 0x8a, 0x67, 0x63, 0xe8,
+	0xd3, 0x5d, 0x2c, 0x08, 0x6a, 0xc0, 0x52, 0x97, 0xa6, 0x94, 0x1f, 0xaa, 0x10, 0x95, 0x1e, 0xd4,
+	0x52, 0x1e, 0xd0, 0xfa, 0xaa, 0x0a, 0x97, 0xcc, 0xce, 0xa0, 0xef, 0x2d, 0xb8, 0x3c, 0xf6, 0x42,
+	0xa1, 0x9b, 0x65, 0x82, 0xa7, 0xbe, 0x80, 0x75, 0xf7, 0x9f, 0x86, 0xab, 0xb6, 0x3b, 0x5b, 0x9f,
+	0xff, 0xfc, 0xe7, 0x37, 0x8b, 0x2e, 0x7a, 0xd5, 0x9b, 0xfe, 0xdf, 0x42, 0x80, 0x25, 0xc6, 0xfb,
+	0x54, 0x1f, 0xe8, 0x67, 0xe8, 0x57, 0x0b, 0x56, 0x66, 0xdc, 0x4a, 0x68, 0x7b, 0x1e, 0x8b, 0x19,
+	0x37, 0x6b, 0xfd, 0xad, 0x7f, 0x07, 0xd6, 0x82, 0x76, 0xa4, 0xa0, 0xb7, 0xd1, 0xf6, 0x2c, 0x41,
+	0x65, 0x0f, 0xa2, 0xa9, 0xef, 0x47, 0x0b, 0xae, 0x4e, 0x4c, 0x3a, 0x6a, 0xce, 0x23, 0x36, 0x71,
+	0xa1, 0xd4, 0x5b, 0x4f, 0x03, 0xd1, 0x0a, 0x6e, 0x4b, 0x05, 0x9b, 0xa8, 0x39, 0x4b, 0x81, 0xba,
+	0x3a, 0x82, 0x84, 0x72, 0x61, 0xf0, 0x7e, 0x64, 0xc1, 0x72, 0xc9, 0x90, 0xa1, 0xcd, 0x79, 0x34,
+	0x4a, 0x06, 0xba, 0xbe, 0xf5, 0x74, 0x20, 0xcd, 0xfe, 0x4d, 0xc9, 0x7e, 0x0b, 0xb5, 0x66, 0xb1,
+	0x37, 0xe7, 0x72, 0x44, 0xbf, 0xbd, 0xfb, 0xf8, 0xcc, 0xb6, 0x9e, 0x9c, 0xd9, 0xd6, 0x1f, 0x67,
+	0xb6, 0xf5, 0xf5, 0xb9, 0xbd, 0xf0, 0xe4, 0xdc, 0x5e, 0xf8, 0xe5, 0xdc, 0x5e, 0x78, 0x70, 0xc3,
+	0xb8, 0xe4, 0xee, 0xde, 0xff, 0x78, 0xef, 0x7d, 0x22, 0x4e, 0x58, 0x76, 0xe4, 0x85, 0x87, 0x98,
+	0xa6, 0xde, 0x27, 0xba, 0x8c, 0xbc, 0xec, 0x3a, 0x55, 0xf9, 0xac, 0x6f, 0xfe, 0x35, 0x00, 0xec,
+	0xbe, 0x59, 0x99, 0x00, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -979,15 +985,33 @@ func (m *QueryAccountAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
-	if m.ProtocolFunding != 0 {
-		i = encodeVarintAccount(dAtA, i, uint64(m.ProtocolFunding))
-		i--
-		dAtA[i] = 0x38
+	if len(m.ProtocolFunding) > 0 {
+		for iNdEx := len(m.ProtocolFunding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFunding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.ProtocolRewards != 0 {
-		i = encodeVarintAccount(dAtA, i, uint64(m.ProtocolRewards))
-		i--
-		dAtA[i] = 0x30
+	if len(m.ProtocolRewards) > 0 {
+		for iNdEx := len(m.ProtocolRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
 	if m.ProtocolDelegationUnbonding != 0 {
 		i = encodeVarintAccount(dAtA, i, uint64(m.ProtocolDelegationUnbonding))
@@ -1252,10 +1276,19 @@ func (m *Funded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Amount != 0 {
-		i = encodeVarintAccount(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
@@ -1410,11 +1443,17 @@ func (m *QueryAccountAssetsResponse) Size() (n int) {
 	if m.ProtocolDelegationUnbonding != 0 {
 		n += 1 + sovAccount(uint64(m.ProtocolDelegationUnbonding))
 	}
-	if m.ProtocolRewards != 0 {
-		n += 1 + sovAccount(uint64(m.ProtocolRewards))
+	if len(m.ProtocolRewards) > 0 {
+		for _, e := range m.ProtocolRewards {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
 	}
-	if m.ProtocolFunding != 0 {
-		n += 1 + sovAccount(uint64(m.ProtocolFunding))
+	if len(m.ProtocolFunding) > 0 {
+		for _, e := range m.ProtocolFunding {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
 	}
 	return n
 }
@@ -1508,8 +1547,11 @@ func (m *Funded) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		n += 1 + sovAccount(uint64(m.Amount))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
 	}
 	if m.Pool != nil {
 		l = m.Pool.Size()
@@ -1777,10 +1819,10 @@ func (m *QueryAccountAssetsResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 6:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolRewards", wireType)
 			}
-			m.ProtocolRewards = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAccount
@@ -1790,16 +1832,31 @@ func (m *QueryAccountAssetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProtocolRewards |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolRewards = append(m.ProtocolRewards, types.Coin{})
+			if err := m.ProtocolRewards[len(m.ProtocolRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFunding", wireType)
 			}
-			m.ProtocolFunding = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAccount
@@ -1809,11 +1866,26 @@ func (m *QueryAccountAssetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProtocolFunding |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFunding = append(m.ProtocolFunding, types.Coin{})
+			if err := m.ProtocolFunding[len(m.ProtocolFunding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -2393,10 +2465,10 @@ func (m *Funded) Unmarshal(dAtA []byte) error {
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Amount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAccount
@@ -2406,11 +2478,26 @@ func (m *Funded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
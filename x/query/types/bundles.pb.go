@@ -439,6 +439,217 @@ func (m *QueryFinalizedBundlesByHeightResponse) GetFinalizedBundle() types.Final
 	return types.FinalizedBundle{}
 }
 
+// QueryConcludedBundleProposalsRequest is the request type for the Query/ConcludedBundleProposals RPC method.
+type QueryConcludedBundleProposalsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QueryConcludedBundleProposalsRequest) Reset()         { *m = QueryConcludedBundleProposalsRequest{} }
+func (m *QueryConcludedBundleProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConcludedBundleProposalsRequest) ProtoMessage()    {}
+func (*QueryConcludedBundleProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b49b126c38ac815c, []int{8}
+}
+func (m *QueryConcludedBundleProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConcludedBundleProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConcludedBundleProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConcludedBundleProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConcludedBundleProposalsRequest.Merge(m, src)
+}
+func (m *QueryConcludedBundleProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConcludedBundleProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConcludedBundleProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConcludedBundleProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryConcludedBundleProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryConcludedBundleProposalsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// QueryConcludedBundleProposalsResponse is the response type for the Query/ConcludedBundleProposals RPC method.
+type QueryConcludedBundleProposalsResponse struct {
+	// concluded_bundle_proposals ...
+	ConcludedBundleProposals []types.ConcludedBundleProposal `protobuf:"bytes,1,rep,name=concluded_bundle_proposals,json=concludedBundleProposals,proto3" json:"concluded_bundle_proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConcludedBundleProposalsResponse) Reset()         { *m = QueryConcludedBundleProposalsResponse{} }
+func (m *QueryConcludedBundleProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConcludedBundleProposalsResponse) ProtoMessage()    {}
+func (*QueryConcludedBundleProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b49b126c38ac815c, []int{9}
+}
+func (m *QueryConcludedBundleProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConcludedBundleProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConcludedBundleProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConcludedBundleProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConcludedBundleProposalsResponse.Merge(m, src)
+}
+func (m *QueryConcludedBundleProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConcludedBundleProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConcludedBundleProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConcludedBundleProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryConcludedBundleProposalsResponse) GetConcludedBundleProposals() []types.ConcludedBundleProposal {
+	if m != nil {
+		return m.ConcludedBundleProposals
+	}
+	return nil
+}
+
+func (m *QueryConcludedBundleProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConcludedBundleProposalRequest is the request type for the Query/ConcludedBundleProposal RPC method.
+type QueryConcludedBundleProposalRequest struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryConcludedBundleProposalRequest) Reset()         { *m = QueryConcludedBundleProposalRequest{} }
+func (m *QueryConcludedBundleProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConcludedBundleProposalRequest) ProtoMessage()    {}
+func (*QueryConcludedBundleProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b49b126c38ac815c, []int{10}
+}
+func (m *QueryConcludedBundleProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConcludedBundleProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConcludedBundleProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConcludedBundleProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConcludedBundleProposalRequest.Merge(m, src)
+}
+func (m *QueryConcludedBundleProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConcludedBundleProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConcludedBundleProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConcludedBundleProposalRequest proto.InternalMessageInfo
+
+func (m *QueryConcludedBundleProposalRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryConcludedBundleProposalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryConcludedBundleProposalResponse is the response type for the Query/ConcludedBundleProposal RPC method.
+type QueryConcludedBundleProposalResponse struct {
+	// concluded_bundle_proposal ...
+	ConcludedBundleProposal types.ConcludedBundleProposal `protobuf:"bytes,1,opt,name=concluded_bundle_proposal,json=concludedBundleProposal,proto3" json:"concluded_bundle_proposal"`
+}
+
+func (m *QueryConcludedBundleProposalResponse) Reset()         { *m = QueryConcludedBundleProposalResponse{} }
+func (m *QueryConcludedBundleProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConcludedBundleProposalResponse) ProtoMessage()    {}
+func (*QueryConcludedBundleProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b49b126c38ac815c, []int{11}
+}
+func (m *QueryConcludedBundleProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConcludedBundleProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConcludedBundleProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConcludedBundleProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConcludedBundleProposalResponse.Merge(m, src)
+}
+func (m *QueryConcludedBundleProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConcludedBundleProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConcludedBundleProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConcludedBundleProposalResponse proto.InternalMessageInfo
+
+func (m *QueryConcludedBundleProposalResponse) GetConcludedBundleProposal() types.ConcludedBundleProposal {
+	if m != nil {
+		return m.ConcludedBundleProposal
+	}
+	return types.ConcludedBundleProposal{}
+}
+
 // QueryCurrentVoteStatusRequest is the request type for the Query/Staker RPC method.
 type QueryCurrentVoteStatusRequest struct {
 	// pool_id ...
@@ -449,7 +660,7 @@ func (m *QueryCurrentVoteStatusRequest) Reset()         { *m = QueryCurrentVoteS
 func (m *QueryCurrentVoteStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentVoteStatusRequest) ProtoMessage()    {}
 func (*QueryCurrentVoteStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b49b126c38ac815c, []int{8}
+	return fileDescriptor_b49b126c38ac815c, []int{12}
 }
 func (m *QueryCurrentVoteStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,13 +706,19 @@ type QueryCurrentVoteStatusResponse struct {
 	Abstain uint64 `protobuf:"varint,3,opt,name=abstain,proto3" json:"abstain,omitempty"`
 	// total ...
 	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// status is the outcome of the votes under the quorums of the pool
+	Status types.BundleStatus `protobuf:"varint,5,opt,name=status,proto3,enum=kyve.bundles.v1beta1.BundleStatus" json:"status,omitempty"`
+	// valid_quorum is the effective valid quorum of the pool
+	ValidQuorum string `protobuf:"bytes,6,opt,name=valid_quorum,json=validQuorum,proto3" json:"valid_quorum,omitempty"`
+	// invalid_quorum is the effective invalid quorum of the pool
+	InvalidQuorum string `protobuf:"bytes,7,opt,name=invalid_quorum,json=invalidQuorum,proto3" json:"invalid_quorum,omitempty"`
 }
 
 func (m *QueryCurrentVoteStatusResponse) Reset()         { *m = QueryCurrentVoteStatusResponse{} }
 func (m *QueryCurrentVoteStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentVoteStatusResponse) ProtoMessage()    {}
 func (*QueryCurrentVoteStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b49b126c38ac815c, []int{9}
+	return fileDescriptor_b49b126c38ac815c, []int{13}
 }
 func (m *QueryCurrentVoteStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,6 +775,27 @@ func (m *QueryCurrentVoteStatusResponse) GetTotal() uint64 {
 	return 0
 }
 
+func (m *QueryCurrentVoteStatusResponse) GetStatus() types.BundleStatus {
+	if m != nil {
+		return m.Status
+	}
+	return types.BUNDLE_STATUS_UNSPECIFIED
+}
+
+func (m *QueryCurrentVoteStatusResponse) GetValidQuorum() string {
+	if m != nil {
+		return m.ValidQuorum
+	}
+	return ""
+}
+
+func (m *QueryCurrentVoteStatusResponse) GetInvalidQuorum() string {
+	if m != nil {
+		return m.InvalidQuorum
+	}
+	return ""
+}
+
 // QueryCanProposeRequest is the request type for the Query/CanPropose RPC method.
 type QueryCanValidateRequest struct {
 	// pool_id defines the unique ID of the pool.
@@ -570,7 +808,7 @@ func (m *QueryCanValidateRequest) Reset()         { *m = QueryCanValidateRequest
 func (m *QueryCanValidateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCanValidateRequest) ProtoMessage()    {}
 func (*QueryCanValidateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b49b126c38ac815c, []int{10}
+	return fileDescriptor_b49b126c38ac815c, []int{14}
 }
 func (m *QueryCanValidateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +863,7 @@ func (m *QueryCanValidateResponse) Reset()         { *m = QueryCanValidateRespon
 func (m *QueryCanValidateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCanValidateResponse) ProtoMessage()    {}
 func (*QueryCanValidateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b49b126c38ac815c, []int{11}
+	return fileDescriptor_b49b126c38ac815c, []int{15}
 }
 func (m *QueryCanValidateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +922,7 @@ func (m *QueryCanProposeRequest) Reset()         { *m = QueryCanProposeRequest{}
 func (m *QueryCanProposeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCanProposeRequest) ProtoMessage()    {}
 func (*QueryCanProposeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b49b126c38ac815c, []int{12}
+	return fileDescriptor_b49b126c38ac815c, []int{16}
 }
 func (m *QueryCanProposeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -747,13 +985,17 @@ type QueryCanProposeResponse struct {
 	Possible bool `protobuf:"varint,1,opt,name=possible,proto3" json:"possible,omitempty"`
 	// reason ...
 	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// upload_timeout is the effective upload timeout of the pool
+	UploadTimeout uint64 `protobuf:"varint,3,opt,name=upload_timeout,json=uploadTimeout,proto3" json:"upload_timeout,omitempty"`
+	// max_points is the effective max points of the pool
+	MaxPoints uint64 `protobuf:"varint,4,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
 }
 
 func (m *QueryCanProposeResponse) Reset()         { *m = QueryCanProposeResponse{} }
 func (m *QueryCanProposeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCanProposeResponse) ProtoMessage()    {}
 func (*QueryCanProposeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b49b126c38ac815c, []int{13}
+	return fileDescriptor_b49b126c38ac815c, []int{17}
 }
 func (m *QueryCanProposeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,6 +1038,20 @@ func (m *QueryCanProposeResponse) GetReason() string {
 	return ""
 }
 
+func (m *QueryCanProposeResponse) GetUploadTimeout() uint64 {
+	if m != nil {
+		return m.UploadTimeout
+	}
+	return 0
+}
+
+func (m *QueryCanProposeResponse) GetMaxPoints() uint64 {
+	if m != nil {
+		return m.MaxPoints
+	}
+	return 0
+}
+
 // QueryCanVoteRequest is the request type for the Query/CanVote RPC method.
 type QueryCanVoteRequest struct {
 	// pool_id defines the unique ID of the pool.
@@ -812,7 +1068,7 @@ func (m *QueryCanVoteRequest) Reset()         { *m = QueryCanVoteRequest{} }
 func (m *QueryCanVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCanVoteRequest) ProtoMessage()    {}
 func (*QueryCanVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b49b126c38ac815c, []int{14}
+	return fileDescriptor_b49b126c38ac815c, []int{18}
 }
 func (m *QueryCanVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,7 +1137,7 @@ func (m *QueryCanVoteResponse) Reset()         { *m = QueryCanVoteResponse{} }
 func (m *QueryCanVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCanVoteResponse) ProtoMessage()    {}
 func (*QueryCanVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b49b126c38ac815c, []int{15}
+	return fileDescriptor_b49b126c38ac815c, []int{19}
 }
 func (m *QueryCanVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,6 +1189,10 @@ func init() {
 	proto.RegisterType((*QueryFinalizedBundleByStorageIdResponse)(nil), "kyve.query.v1beta1.QueryFinalizedBundleByStorageIdResponse")
 	proto.RegisterType((*QueryFinalizedBundlesByHeightRequest)(nil), "kyve.query.v1beta1.QueryFinalizedBundlesByHeightRequest")
 	proto.RegisterType((*QueryFinalizedBundlesByHeightResponse)(nil), "kyve.query.v1beta1.QueryFinalizedBundlesByHeightResponse")
+	proto.RegisterType((*QueryConcludedBundleProposalsRequest)(nil), "kyve.query.v1beta1.QueryConcludedBundleProposalsRequest")
+	proto.RegisterType((*QueryConcludedBundleProposalsResponse)(nil), "kyve.query.v1beta1.QueryConcludedBundleProposalsResponse")
+	proto.RegisterType((*QueryConcludedBundleProposalRequest)(nil), "kyve.query.v1beta1.QueryConcludedBundleProposalRequest")
+	proto.RegisterType((*QueryConcludedBundleProposalResponse)(nil), "kyve.query.v1beta1.QueryConcludedBundleProposalResponse")
 	proto.RegisterType((*QueryCurrentVoteStatusRequest)(nil), "kyve.query.v1beta1.QueryCurrentVoteStatusRequest")
 	proto.RegisterType((*QueryCurrentVoteStatusResponse)(nil), "kyve.query.v1beta1.QueryCurrentVoteStatusResponse")
 	proto.RegisterType((*QueryCanValidateRequest)(nil), "kyve.query.v1beta1.QueryCanValidateRequest")
@@ -946,71 +1206,86 @@ func init() {
 func init() { proto.RegisterFile("kyve/query/v1beta1/bundles.proto", fileDescriptor_b49b126c38ac815c) }
 
 var fileDescriptor_b49b126c38ac815c = []byte{
-	// 1020 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xa4, 0x21, 0xa9, 0x5f, 0x2a, 0x9a, 0x0e, 0x51, 0x6b, 0x2d, 0xad, 0x1b, 0xad, 0x68,
-	0x13, 0xa5, 0x68, 0xa7, 0x4e, 0x84, 0xda, 0xd0, 0x43, 0x55, 0xb7, 0x24, 0x84, 0xd2, 0xaa, 0x6c,
-	0xa4, 0xf0, 0xe3, 0x62, 0x8d, 0xe3, 0x89, 0xb3, 0x8a, 0xb3, 0xe3, 0xee, 0x8c, 0x0d, 0xc6, 0xb2,
-	0x4a, 0x39, 0x70, 0x46, 0xe2, 0xaf, 0x80, 0x13, 0x1c, 0xb8, 0x70, 0xe4, 0xd4, 0x63, 0x25, 0x2e,
-	0x88, 0x43, 0x85, 0x12, 0xfe, 0x10, 0xb4, 0x33, 0xb3, 0xf6, 0x7a, 0xed, 0x8d, 0x37, 0xa9, 0x7a,
-	0xf3, 0x9b, 0x7d, 0x3f, 0xbe, 0xef, 0x9b, 0x37, 0xef, 0xc9, 0xb0, 0xb0, 0xdf, 0x6e, 0x31, 0xf2,
-	0xb4, 0xc9, 0x82, 0x36, 0x69, 0x15, 0x2b, 0x4c, 0xd2, 0x22, 0xa9, 0x34, 0xfd, 0x6a, 0x9d, 0x09,
-	0xa7, 0x11, 0x70, 0xc9, 0x31, 0x0e, 0x3d, 0x1c, 0xe5, 0xe1, 0x18, 0x0f, 0x6b, 0x79, 0x87, 0x8b,
-	0x03, 0x2e, 0x48, 0x85, 0x8a, 0x64, 0x70, 0x83, 0xd6, 0x3c, 0x9f, 0x4a, 0x8f, 0xfb, 0x3a, 0xde,
-	0x9a, 0xaf, 0xf1, 0x1a, 0x57, 0x3f, 0x49, 0xf8, 0xcb, 0x9c, 0x5e, 0xae, 0x71, 0x5e, 0xab, 0x33,
-	0x42, 0x1b, 0x1e, 0xa1, 0xbe, 0xcf, 0xa5, 0x0a, 0x31, 0x35, 0x2d, 0x5b, 0xa1, 0x32, 0x38, 0x46,
-	0xe3, 0xb2, 0x9f, 0xc1, 0xe5, 0xcf, 0xc2, 0xca, 0xeb, 0x9e, 0x4f, 0xeb, 0xde, 0xb7, 0xac, 0x5a,
-	0xd2, 0x9f, 0x5d, 0xf6, 0xb4, 0xc9, 0x84, 0xc4, 0xeb, 0x00, 0x7d, 0x2c, 0x79, 0xb4, 0x80, 0x96,
-	0x66, 0x57, 0xae, 0x3b, 0x1a, 0xb8, 0x13, 0x02, 0x1f, 0xe4, 0xe4, 0x3c, 0xa1, 0x35, 0x66, 0x62,
-	0xdd, 0x58, 0x24, 0xbe, 0x04, 0x33, 0x0d, 0xce, 0xeb, 0x65, 0xaf, 0x9a, 0x9f, 0x5c, 0x40, 0x4b,
-	0x53, 0xee, 0x74, 0x68, 0x6e, 0x56, 0xed, 0x3f, 0x11, 0x5c, 0x49, 0x41, 0x20, 0x1a, 0xdc, 0x17,
-	0x0c, 0x7f, 0x01, 0x17, 0x76, 0xa3, 0x6f, 0x65, 0x83, 0x3e, 0x8f, 0x16, 0xce, 0x2c, 0xcd, 0xae,
-	0x5c, 0x73, 0x94, 0xac, 0x11, 0xa5, 0x08, 0x44, 0x22, 0x55, 0x69, 0xea, 0xc5, 0xab, 0xab, 0x13,
-	0xee, 0xdc, 0x6e, 0xa2, 0x02, 0xde, 0x18, 0x20, 0x37, 0xa9, 0xc8, 0x2d, 0x8e, 0x25, 0xa7, 0x61,
-	0xc5, 0xd9, 0xd9, 0xeb, 0xf0, 0xee, 0x28, 0x0e, 0x91, 0x88, 0x31, 0xf2, 0x28, 0x4e, 0x1e, 0xbf,
-	0x0d, 0x93, 0x3d, 0x41, 0x26, 0xbd, 0xaa, 0xdd, 0x1a, 0x7d, 0x1b, 0x3d, 0x29, 0xb6, 0x61, 0x2e,
-	0x29, 0x85, 0xb9, 0x93, 0x13, 0x29, 0x71, 0x3e, 0xa1, 0x84, 0xbd, 0x01, 0xd7, 0x47, 0xd5, 0x2d,
-	0xb5, 0xb7, 0x24, 0x0f, 0x68, 0x8d, 0x6d, 0x56, 0x23, 0x2a, 0x57, 0x00, 0x84, 0x3e, 0x8b, 0xd8,
-	0xe4, 0xdc, 0x9c, 0x88, 0xbc, 0xec, 0xe7, 0x08, 0x16, 0xc7, 0x66, 0x7a, 0xc3, 0x64, 0x3e, 0x87,
-	0xf7, 0x46, 0x36, 0x54, 0xa9, 0xfd, 0x31, 0xf3, 0x6a, 0x7b, 0x72, 0xec, 0xad, 0x5c, 0x84, 0xe9,
-	0x3d, 0xe5, 0x19, 0xb5, 0xaa, 0xb6, 0xec, 0x67, 0x70, 0x6d, 0x4c, 0xe2, 0x37, 0xcc, 0xec, 0xb6,
-	0x79, 0x2a, 0xf7, 0x9b, 0x41, 0xc0, 0x7c, 0xb9, 0xcd, 0x25, 0xdb, 0x92, 0x54, 0x36, 0xc5, 0x38,
-	0x4a, 0xf6, 0x77, 0x08, 0x0a, 0x69, 0xa1, 0x06, 0xf4, 0x3c, 0xbc, 0xd5, 0xa2, 0xf5, 0x5e, 0xa4,
-	0x36, 0x70, 0x1e, 0x66, 0x3c, 0x5f, 0x9f, 0x6b, 0x31, 0x22, 0x33, 0xfc, 0x42, 0x2b, 0x42, 0x52,
-	0xcf, 0xcf, 0x9f, 0xd1, 0x5f, 0x8c, 0x19, 0x66, 0x92, 0x5c, 0xd2, 0x7a, 0x7e, 0x4a, 0x67, 0x52,
-	0x86, 0xed, 0xc2, 0x25, 0x8d, 0x80, 0xfa, 0xdb, 0x61, 0x02, 0x2a, 0xc7, 0xbf, 0x8f, 0x02, 0x40,
-	0x8b, 0xd6, 0x69, 0xb5, 0x1a, 0x30, 0x21, 0x14, 0x80, 0x9c, 0x1b, 0x3b, 0xb1, 0x1f, 0x43, 0x7e,
-	0x38, 0xa7, 0xe1, 0x63, 0xc1, 0xd9, 0x06, 0x17, 0xc2, 0xab, 0x18, 0xf1, 0xcf, 0xba, 0x3d, 0x3b,
-	0xbc, 0xe1, 0x80, 0x51, 0x61, 0x1e, 0x7d, 0xce, 0x35, 0x96, 0xfd, 0x03, 0x82, 0x8b, 0x51, 0xc2,
-	0x27, 0x01, 0x6f, 0x70, 0xc1, 0xb2, 0x74, 0x8b, 0x90, 0x74, 0x9f, 0x05, 0x51, 0x2e, 0x6d, 0xa9,
-	0xfa, 0x3a, 0x45, 0xa0, 0x04, 0xca, 0xb9, 0x3d, 0x1b, 0x5f, 0x85, 0xd9, 0xdd, 0x80, 0x1f, 0x94,
-	0x4d, 0x9b, 0x69, 0x9d, 0x20, 0x3c, 0xd2, 0x9d, 0x64, 0x3f, 0xea, 0x8b, 0xd5, 0xc3, 0xf1, 0x1a,
-	0xbc, 0x3a, 0xf0, 0x4e, 0x4f, 0x27, 0x2e, 0x4f, 0xcf, 0x29, 0xec, 0x11, 0x2e, 0x7b, 0x84, 0xb4,
-	0x91, 0x98, 0x09, 0x53, 0xc9, 0x99, 0xf0, 0x09, 0xcc, 0x0f, 0x16, 0x3f, 0x3d, 0x91, 0x95, 0xe7,
-	0xe7, 0xe0, 0x9c, 0x4a, 0x16, 0x8d, 0xf0, 0x5f, 0x11, 0xcc, 0x25, 0xdf, 0x23, 0xbe, 0xe9, 0x0c,
-	0x6f, 0x5b, 0xe7, 0xb8, 0x35, 0x67, 0x15, 0x4f, 0x10, 0xa1, 0xe1, 0xdb, 0xb7, 0xbe, 0xff, 0xeb,
-	0xbf, 0x9f, 0x26, 0x8b, 0x98, 0x90, 0x11, 0xcb, 0x7f, 0x68, 0x61, 0x91, 0x8e, 0x51, 0xba, 0x8b,
-	0x7f, 0x43, 0x70, 0x3e, 0x91, 0x15, 0x93, 0xac, 0xf5, 0x23, 0xc0, 0x37, 0xb3, 0x07, 0x18, 0xbc,
-	0x77, 0x14, 0xde, 0x0f, 0xf0, 0x6a, 0x16, 0xbc, 0x7d, 0xb8, 0xa4, 0x13, 0x62, 0x7e, 0x85, 0xc0,
-	0x4a, 0x1f, 0xe9, 0xf8, 0xc3, 0xac, 0x68, 0x86, 0x37, 0x8a, 0x75, 0xe7, 0x54, 0xb1, 0x86, 0xd4,
-	0x86, 0x22, 0x75, 0x0f, 0xdf, 0xcd, 0x42, 0xaa, 0x5c, 0x69, 0x97, 0xfb, 0x8d, 0x4a, 0x3a, 0xfd,
-	0xdf, 0x5d, 0xfc, 0x0f, 0x82, 0x7c, 0xda, 0x5c, 0xc7, 0xb7, 0x33, 0x77, 0x47, 0x62, 0xc7, 0x58,
-	0x6b, 0xa7, 0x88, 0x34, 0xd4, 0x36, 0x15, 0xb5, 0xfb, 0xf8, 0x5e, 0x56, 0x6a, 0x7a, 0x98, 0xc4,
-	0x6f, 0x4e, 0x9f, 0x74, 0xf1, 0xef, 0x08, 0x2e, 0x0c, 0x0d, 0x7e, 0x9c, 0xde, 0xf3, 0x69, 0xfb,
-	0xc5, 0x5a, 0x39, 0x49, 0x88, 0xe1, 0xb1, 0xa6, 0x78, 0xac, 0xe2, 0xe2, 0x28, 0x1e, 0x3b, 0x3a,
-	0xac, 0x1c, 0x0e, 0x92, 0xb2, 0x50, 0x81, 0xb1, 0x97, 0xf2, 0x33, 0x82, 0xd9, 0xd8, 0x68, 0xc7,
-	0x37, 0xd2, 0xcb, 0x0f, 0x2d, 0x15, 0xeb, 0xfd, 0x6c, 0xce, 0x06, 0xe5, 0x5d, 0x85, 0x72, 0x0d,
-	0xdf, 0x1a, 0x89, 0x92, 0xfa, 0xe5, 0x96, 0x89, 0x88, 0xeb, 0xdb, 0xdf, 0x44, 0x5d, 0xfc, 0x07,
-	0x02, 0xe8, 0x4f, 0x6b, 0xbc, 0x7c, 0x5c, 0xf5, 0xc1, 0xd5, 0x62, 0xdd, 0xc8, 0xe4, 0x6b, 0x80,
-	0x6e, 0x29, 0xa0, 0x8f, 0xf0, 0xc3, 0x34, 0xa0, 0x66, 0xc9, 0xc4, 0x71, 0xea, 0xe9, 0xdd, 0x25,
-	0x9d, 0x68, 0x01, 0x75, 0x49, 0x27, 0xb6, 0x7f, 0xba, 0xf8, 0x17, 0x04, 0x33, 0x66, 0x3c, 0xe3,
-	0xc5, 0x63, 0x75, 0xeb, 0x6f, 0x0f, 0x6b, 0x69, 0xbc, 0xa3, 0xc1, 0xfc, 0xa9, 0xc2, 0xbc, 0x8e,
-	0x1f, 0xa4, 0x8a, 0xcb, 0xe5, 0x68, 0xc0, 0x6a, 0xc1, 0x74, 0x07, 0x9e, 0x6a, 0xe9, 0xc1, 0x8b,
-	0xc3, 0x02, 0x7a, 0x79, 0x58, 0x40, 0xff, 0x1e, 0x16, 0xd0, 0x8f, 0x47, 0x85, 0x89, 0x97, 0x47,
-	0x85, 0x89, 0xbf, 0x8f, 0x0a, 0x13, 0x5f, 0x2d, 0xd7, 0x3c, 0xb9, 0xd7, 0xac, 0x38, 0x3b, 0xfc,
-	0x80, 0x3c, 0xfc, 0x72, 0xfb, 0xa3, 0xc7, 0x4c, 0x7e, 0xcd, 0x83, 0x7d, 0xb2, 0xb3, 0x47, 0x3d,
-	0x9f, 0x7c, 0x63, 0x0a, 0xcb, 0x76, 0x83, 0x89, 0xca, 0xb4, 0xfa, 0xff, 0xb3, 0xfa, 0x7f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x98, 0x99, 0x17, 0xba, 0xbb, 0x0d, 0x00, 0x00,
+	// 1258 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xb8, 0x69, 0x52, 0xbf, 0xe9, 0x47, 0x3a, 0xbf, 0xa8, 0xd9, 0xdf, 0xd2, 0xba, 0x61,
+	0x21, 0x4d, 0x94, 0x82, 0xb7, 0x49, 0x84, 0xf2, 0x51, 0x44, 0x69, 0x12, 0x12, 0x42, 0x21, 0x4a,
+	0x1d, 0x14, 0x3e, 0x2e, 0xab, 0xb1, 0xbd, 0x71, 0x56, 0xb1, 0x77, 0x9c, 0xdd, 0xb1, 0x89, 0x89,
+	0xac, 0x0a, 0x0e, 0xe5, 0x8a, 0xd4, 0x03, 0x7f, 0x03, 0x3d, 0xc1, 0x81, 0x0b, 0x47, 0x4e, 0x3d,
+	0x16, 0xb8, 0x20, 0x0e, 0x15, 0x4a, 0xf8, 0x43, 0xd0, 0xce, 0xcc, 0xda, 0xeb, 0xf5, 0x6e, 0xfc,
+	0x21, 0xca, 0xcd, 0xf3, 0xce, 0xfb, 0xf1, 0x3c, 0xcf, 0xcc, 0xbe, 0xf3, 0xca, 0x30, 0x71, 0x50,
+	0xab, 0x9a, 0xfa, 0x61, 0xc5, 0x74, 0x6a, 0x7a, 0x75, 0x36, 0x6b, 0x32, 0x32, 0xab, 0x67, 0x2b,
+	0x76, 0xbe, 0x68, 0xba, 0xe9, 0xb2, 0x43, 0x19, 0xc5, 0xd8, 0xf3, 0x48, 0x73, 0x8f, 0xb4, 0xf4,
+	0x50, 0x67, 0x72, 0xd4, 0x2d, 0x51, 0x57, 0xcf, 0x12, 0x37, 0x1c, 0x5c, 0x26, 0x05, 0xcb, 0x26,
+	0xcc, 0xa2, 0xb6, 0x88, 0x57, 0xc7, 0x0a, 0xb4, 0x40, 0xf9, 0x4f, 0xdd, 0xfb, 0x25, 0xad, 0xd7,
+	0x0b, 0x94, 0x16, 0x8a, 0xa6, 0x4e, 0xca, 0x96, 0x4e, 0x6c, 0x9b, 0x32, 0x1e, 0x22, 0x6b, 0xaa,
+	0x1a, 0x47, 0x25, 0x71, 0x44, 0xe3, 0xd2, 0x1e, 0xc1, 0xf5, 0x87, 0x5e, 0xe5, 0x75, 0xcb, 0x26,
+	0x45, 0xeb, 0x4b, 0x33, 0xbf, 0x22, 0xb6, 0x33, 0xe6, 0x61, 0xc5, 0x74, 0x19, 0x5e, 0x07, 0x68,
+	0x62, 0x51, 0xd0, 0x04, 0x9a, 0x1e, 0x99, 0xbb, 0x95, 0x16, 0xc0, 0xd3, 0x1e, 0xf0, 0x56, 0x4e,
+	0xe9, 0x6d, 0x52, 0x30, 0x65, 0x6c, 0x26, 0x10, 0x89, 0xc7, 0x61, 0xb8, 0x4c, 0x69, 0xd1, 0xb0,
+	0xf2, 0x4a, 0x62, 0x02, 0x4d, 0x0f, 0x66, 0x86, 0xbc, 0xe5, 0x66, 0x5e, 0xfb, 0x05, 0xc1, 0x8d,
+	0x18, 0x04, 0x6e, 0x99, 0xda, 0xae, 0x89, 0x3f, 0x85, 0xab, 0x7b, 0xfe, 0x9e, 0x21, 0xd1, 0x2b,
+	0x68, 0xe2, 0xdc, 0xf4, 0xc8, 0xdc, 0x64, 0x9a, 0xcb, 0xea, 0x53, 0xf2, 0x41, 0x84, 0x52, 0xad,
+	0x0c, 0x3e, 0x7b, 0x71, 0x73, 0x20, 0x33, 0xba, 0x17, 0xaa, 0x80, 0x37, 0x5a, 0xc8, 0x25, 0x38,
+	0xb9, 0xa9, 0x8e, 0xe4, 0x04, 0xac, 0x20, 0x3b, 0x6d, 0x1d, 0x5e, 0x89, 0xe2, 0xe0, 0x8b, 0x18,
+	0x20, 0x8f, 0x82, 0xe4, 0xf1, 0x65, 0x48, 0x34, 0x04, 0x49, 0x58, 0x79, 0xad, 0x1a, 0x7d, 0x1a,
+	0x0d, 0x29, 0x76, 0x61, 0x34, 0x2c, 0x85, 0x3c, 0x93, 0x9e, 0x94, 0xb8, 0x12, 0x52, 0x42, 0xdb,
+	0x80, 0x5b, 0x51, 0x75, 0x57, 0x6a, 0x3b, 0x8c, 0x3a, 0xa4, 0x60, 0x6e, 0xe6, 0x7d, 0x2a, 0x37,
+	0x00, 0x5c, 0x61, 0xf3, 0xd9, 0x24, 0x33, 0x49, 0xd7, 0xf7, 0xd2, 0xbe, 0x42, 0x30, 0xd5, 0x31,
+	0xd3, 0x4b, 0x26, 0xf3, 0x09, 0xbc, 0x1e, 0x79, 0xa1, 0x56, 0x6a, 0xef, 0x9b, 0x56, 0x61, 0x9f,
+	0x75, 0x3c, 0x95, 0x6b, 0x30, 0xb4, 0xcf, 0x3d, 0xfd, 0xab, 0x2a, 0x56, 0xda, 0x23, 0x98, 0xec,
+	0x90, 0xf8, 0x25, 0x33, 0xfb, 0x06, 0x49, 0x6a, 0xab, 0xd4, 0xce, 0x15, 0x2b, 0x79, 0x7f, 0x63,
+	0xdb, 0xa1, 0x65, 0xea, 0x92, 0xe2, 0x7f, 0xf7, 0xd5, 0x9e, 0x22, 0x98, 0xec, 0x80, 0x44, 0x6a,
+	0x71, 0x08, 0x6a, 0xce, 0xf7, 0x91, 0x5a, 0x18, 0x65, 0xdf, 0x4b, 0x7e, 0xc6, 0x6f, 0x46, 0xab,
+	0x12, 0x93, 0x5b, 0xaa, 0xa3, 0xe4, 0x62, 0x4a, 0xff, 0x7b, 0x9f, 0xf5, 0x16, 0xbc, 0x76, 0x16,
+	0xc9, 0x9e, 0x3f, 0xef, 0xef, 0x3a, 0x9c, 0x5f, 0x43, 0x34, 0x0a, 0xff, 0x8f, 0x15, 0x4d, 0x1e,
+	0x67, 0x5f, 0x9a, 0x8d, 0xc7, 0x68, 0xa6, 0x2d, 0xca, 0x26, 0xbc, 0x5a, 0x71, 0x1c, 0xd3, 0x66,
+	0xbb, 0x94, 0x99, 0x3b, 0x8c, 0xb0, 0x8a, 0xdb, 0x89, 0xa3, 0xf6, 0x38, 0x01, 0xa9, 0xb8, 0x50,
+	0xc9, 0x66, 0x0c, 0xce, 0x57, 0x49, 0xb1, 0x11, 0x29, 0x16, 0x58, 0x81, 0x61, 0xcb, 0x16, 0x76,
+	0xa1, 0x90, 0xbf, 0xf4, 0x76, 0x48, 0xd6, 0x65, 0xc4, 0xb2, 0x95, 0x73, 0x62, 0x47, 0x2e, 0xbd,
+	0x4c, 0x8c, 0x32, 0x52, 0x54, 0x06, 0x45, 0x26, 0xbe, 0xc0, 0xcb, 0x30, 0xe4, 0xf2, 0x8a, 0xca,
+	0xf9, 0x09, 0x34, 0x7d, 0x79, 0x4e, 0x8b, 0x96, 0x46, 0x50, 0x96, 0xd8, 0x64, 0x04, 0x7e, 0x15,
+	0x2e, 0xf2, 0xa2, 0xc6, 0x61, 0x85, 0x3a, 0x95, 0x92, 0x32, 0xc4, 0x3b, 0xda, 0x08, 0xb7, 0x3d,
+	0xe4, 0x26, 0x3c, 0x09, 0x97, 0x2d, 0xbb, 0xc5, 0x69, 0x98, 0x3b, 0x5d, 0xb2, 0xec, 0x80, 0x9b,
+	0x96, 0x81, 0x71, 0xa1, 0x03, 0xb1, 0x77, 0x3d, 0x33, 0x61, 0x9d, 0xfb, 0x7f, 0x0a, 0xa0, 0x4a,
+	0x8a, 0x24, 0x9f, 0x77, 0x4c, 0xd7, 0xe5, 0x32, 0x24, 0x33, 0x01, 0x8b, 0xb6, 0x05, 0x4a, 0x7b,
+	0x4e, 0xa9, 0xaa, 0x0a, 0x17, 0xca, 0xd4, 0x75, 0xad, 0xac, 0x6c, 0x2e, 0x17, 0x32, 0x8d, 0xb5,
+	0xd7, 0xc1, 0x1c, 0x93, 0xb8, 0xf2, 0xf6, 0x27, 0x33, 0x72, 0xa5, 0x3d, 0x46, 0x70, 0xcd, 0x4f,
+	0x28, 0xce, 0xde, 0xec, 0xa6, 0x1b, 0xba, 0x8c, 0x1c, 0x98, 0x8e, 0x9f, 0x4b, 0xac, 0x78, 0x7d,
+	0x91, 0xc2, 0xe1, 0xc7, 0x94, 0xcc, 0x34, 0xd6, 0xf8, 0x26, 0x8c, 0xec, 0x39, 0xb4, 0x64, 0xc8,
+	0x36, 0x2a, 0x4e, 0x0b, 0x3c, 0x93, 0xe8, 0x94, 0xda, 0x13, 0x04, 0xe3, 0x6d, 0x40, 0xfa, 0x27,
+	0xe6, 0x9d, 0x51, 0xa5, 0x5c, 0xa4, 0x24, 0x6f, 0x30, 0xab, 0x64, 0xd2, 0x0a, 0x93, 0x37, 0xe7,
+	0x92, 0xb0, 0x7e, 0x2c, 0x8c, 0xde, 0xeb, 0x55, 0x22, 0x47, 0x46, 0x99, 0x5a, 0x36, 0x73, 0x25,
+	0xac, 0x64, 0x89, 0x1c, 0x6d, 0x73, 0x83, 0x76, 0x0c, 0xff, 0x6b, 0xc8, 0x4d, 0x59, 0xff, 0xd2,
+	0x78, 0x17, 0x9e, 0xb2, 0x86, 0x2e, 0x62, 0x11, 0x7a, 0x3a, 0x07, 0xc3, 0x4f, 0xe7, 0x07, 0x30,
+	0xd6, 0x5a, 0xbc, 0x7f, 0x39, 0xe6, 0x9e, 0x5e, 0x81, 0x8b, 0x3c, 0x99, 0x3f, 0xe9, 0xfc, 0x80,
+	0x60, 0x34, 0xfc, 0x6c, 0xe1, 0x3b, 0xe9, 0xf6, 0xa1, 0x34, 0x7d, 0xd6, 0x34, 0xa8, 0xce, 0xf6,
+	0x10, 0x21, 0xe0, 0x6b, 0x0b, 0x5f, 0xff, 0xfe, 0xf7, 0x93, 0xc4, 0x2c, 0xd6, 0xf5, 0x88, 0x19,
+	0xb9, 0x6d, 0xae, 0xd3, 0x8f, 0xa5, 0xd2, 0x75, 0xfc, 0x23, 0x82, 0x2b, 0xa1, 0xac, 0x58, 0xef,
+	0xb6, 0xbe, 0x0f, 0xf8, 0x4e, 0xf7, 0x01, 0x12, 0xef, 0x5d, 0x8e, 0xf7, 0x2d, 0x3c, 0xdf, 0x0d,
+	0xde, 0x26, 0x5c, 0xfd, 0xd8, 0xc3, 0xfc, 0x02, 0x81, 0x1a, 0x3f, 0xf9, 0xe0, 0xe5, 0x6e, 0xd1,
+	0xb4, 0x0f, 0x5e, 0xea, 0xdd, 0xbe, 0x62, 0x25, 0xa9, 0x0d, 0x4e, 0xea, 0x3e, 0xbe, 0xd7, 0x0d,
+	0x29, 0x23, 0x5b, 0x33, 0x9a, 0x17, 0x55, 0x3f, 0x6e, 0xfe, 0xae, 0xe3, 0x3f, 0x11, 0x28, 0x71,
+	0xe3, 0x0f, 0x5e, 0xec, 0xfa, 0x76, 0x84, 0x46, 0x31, 0x75, 0xa9, 0x8f, 0x48, 0x49, 0x6d, 0x93,
+	0x53, 0x5b, 0xc5, 0xf7, 0xbb, 0xa5, 0x26, 0x7a, 0x52, 0xf0, 0xe4, 0x84, 0xa5, 0x8e, 0x7f, 0x45,
+	0xa0, 0xc4, 0xcd, 0x33, 0x67, 0x90, 0xeb, 0x30, 0x8c, 0xa9, 0x4b, 0x7d, 0x44, 0x4a, 0x72, 0xef,
+	0x72, 0x72, 0xcb, 0x78, 0x31, 0x8a, 0x5c, 0xfc, 0x58, 0x15, 0xf8, 0x8a, 0x7e, 0x43, 0x30, 0x1e,
+	0x53, 0x06, 0x2f, 0xf4, 0x0a, 0xcc, 0x67, 0xb4, 0xd8, 0x7b, 0xa0, 0x24, 0xb4, 0xc6, 0x09, 0xbd,
+	0x83, 0xdf, 0xee, 0x89, 0x50, 0xf8, 0x33, 0xfb, 0x09, 0xc1, 0xd5, 0xb6, 0x71, 0x03, 0xc7, 0x37,
+	0xa7, 0xb8, 0xa9, 0x46, 0x9d, 0xeb, 0x25, 0x44, 0x52, 0x58, 0xe2, 0x14, 0xe6, 0xf1, 0x6c, 0x24,
+	0x05, 0x11, 0x66, 0x78, 0x1d, 0xdf, 0x10, 0x23, 0x46, 0xe0, 0x30, 0xbe, 0x47, 0x30, 0x12, 0x78,
+	0xca, 0xf1, 0xed, 0xf8, 0xf2, 0x6d, 0x43, 0x84, 0xfa, 0x46, 0x77, 0xce, 0x12, 0xe5, 0x3d, 0x8e,
+	0x72, 0x09, 0x2f, 0x44, 0xa2, 0x24, 0xb6, 0x51, 0x95, 0x11, 0x41, 0x6d, 0x9b, 0x93, 0x47, 0x1d,
+	0xff, 0x8c, 0x00, 0x9a, 0x8f, 0x33, 0x9e, 0x39, 0xab, 0x7a, 0xeb, 0x28, 0xa1, 0xde, 0xee, 0xca,
+	0x57, 0x02, 0xdd, 0xe1, 0x40, 0x3f, 0xc2, 0x0f, 0xe2, 0x80, 0xca, 0xa1, 0x22, 0x88, 0x53, 0x3c,
+	0xb3, 0x75, 0xfd, 0x58, 0xee, 0x79, 0x3f, 0x03, 0xf3, 0x46, 0x1d, 0x3f, 0x45, 0x30, 0x2c, 0xdf,
+	0x51, 0x3c, 0x75, 0xa6, 0x6e, 0xcd, 0x67, 0x5e, 0x9d, 0xee, 0xec, 0x28, 0x31, 0x7f, 0xc8, 0x31,
+	0xaf, 0xe3, 0xb5, 0x58, 0x71, 0x29, 0x8b, 0x06, 0xec, 0x6d, 0x38, 0xf5, 0x96, 0x9e, 0xba, 0xb2,
+	0xf6, 0xec, 0x24, 0x85, 0x9e, 0x9f, 0xa4, 0xd0, 0x5f, 0x27, 0x29, 0xf4, 0xed, 0x69, 0x6a, 0xe0,
+	0xf9, 0x69, 0x6a, 0xe0, 0x8f, 0xd3, 0xd4, 0xc0, 0xe7, 0x33, 0x05, 0x8b, 0xed, 0x57, 0xb2, 0xe9,
+	0x1c, 0x2d, 0xe9, 0x0f, 0x3e, 0xdb, 0x7d, 0x6f, 0xcb, 0x64, 0x5f, 0x50, 0xe7, 0x40, 0xcf, 0xed,
+	0x13, 0xcb, 0xd6, 0x8f, 0x64, 0x61, 0x56, 0x2b, 0x9b, 0x6e, 0x76, 0x88, 0xff, 0x9f, 0x33, 0xff,
+	0xcf, 0x00, 0xc2, 0xfd, 0xba, 0x72, 0x8b, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1033,6 +1308,10 @@ type QueryBundlesClient interface {
 	FinalizedBundleByStorageId(ctx context.Context, in *QueryFinalizedBundleByStorageIdRequest, opts ...grpc.CallOption) (*QueryFinalizedBundleByStorageIdResponse, error)
 	// Queries the bundle which contains the data given height
 	FinalizedBundlesByHeight(ctx context.Context, in *QueryFinalizedBundlesByHeightRequest, opts ...grpc.CallOption) (*QueryFinalizedBundlesByHeightResponse, error)
+	// ConcludedBundleProposals returns the history of concluded bundle proposals of a pool
+	ConcludedBundleProposals(ctx context.Context, in *QueryConcludedBundleProposalsRequest, opts ...grpc.CallOption) (*QueryConcludedBundleProposalsResponse, error)
+	// ConcludedBundleProposal returns a single concluded bundle proposal of a pool
+	ConcludedBundleProposal(ctx context.Context, in *QueryConcludedBundleProposalRequest, opts ...grpc.CallOption) (*QueryConcludedBundleProposalResponse, error)
 	// CurrentVoteStatus ...
 	CurrentVoteStatus(ctx context.Context, in *QueryCurrentVoteStatusRequest, opts ...grpc.CallOption) (*QueryCurrentVoteStatusResponse, error)
 	// CanValidate ...
@@ -1087,6 +1366,24 @@ func (c *queryBundlesClient) FinalizedBundlesByHeight(ctx context.Context, in *Q
 	return out, nil
 }
 
+func (c *queryBundlesClient) ConcludedBundleProposals(ctx context.Context, in *QueryConcludedBundleProposalsRequest, opts ...grpc.CallOption) (*QueryConcludedBundleProposalsResponse, error) {
+	out := new(QueryConcludedBundleProposalsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryBundles/ConcludedBundleProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryBundlesClient) ConcludedBundleProposal(ctx context.Context, in *QueryConcludedBundleProposalRequest, opts ...grpc.CallOption) (*QueryConcludedBundleProposalResponse, error) {
+	out := new(QueryConcludedBundleProposalResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryBundles/ConcludedBundleProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryBundlesClient) CurrentVoteStatus(ctx context.Context, in *QueryCurrentVoteStatusRequest, opts ...grpc.CallOption) (*QueryCurrentVoteStatusResponse, error) {
 	out := new(QueryCurrentVoteStatusResponse)
 	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryBundles/CurrentVoteStatus", in, out, opts...)
@@ -1133,6 +1430,10 @@ type QueryBundlesServer interface {
 	FinalizedBundleByStorageId(context.Context, *QueryFinalizedBundleByStorageIdRequest) (*QueryFinalizedBundleByStorageIdResponse, error)
 	// Queries the bundle which contains the data given height
 	FinalizedBundlesByHeight(context.Context, *QueryFinalizedBundlesByHeightRequest) (*QueryFinalizedBundlesByHeightResponse, error)
+	// ConcludedBundleProposals returns the history of concluded bundle proposals of a pool
+	ConcludedBundleProposals(context.Context, *QueryConcludedBundleProposalsRequest) (*QueryConcludedBundleProposalsResponse, error)
+	// ConcludedBundleProposal returns a single concluded bundle proposal of a pool
+	ConcludedBundleProposal(context.Context, *QueryConcludedBundleProposalRequest) (*QueryConcludedBundleProposalResponse, error)
 	// CurrentVoteStatus ...
 	CurrentVoteStatus(context.Context, *QueryCurrentVoteStatusRequest) (*QueryCurrentVoteStatusResponse, error)
 	// CanValidate ...
@@ -1159,6 +1460,12 @@ func (*UnimplementedQueryBundlesServer) FinalizedBundleByStorageId(ctx context.C
 func (*UnimplementedQueryBundlesServer) FinalizedBundlesByHeight(ctx context.Context, req *QueryFinalizedBundlesByHeightRequest) (*QueryFinalizedBundlesByHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizedBundlesByHeight not implemented")
 }
+func (*UnimplementedQueryBundlesServer) ConcludedBundleProposals(ctx context.Context, req *QueryConcludedBundleProposalsRequest) (*QueryConcludedBundleProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConcludedBundleProposals not implemented")
+}
+func (*UnimplementedQueryBundlesServer) ConcludedBundleProposal(ctx context.Context, req *QueryConcludedBundleProposalRequest) (*QueryConcludedBundleProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConcludedBundleProposal not implemented")
+}
 func (*UnimplementedQueryBundlesServer) CurrentVoteStatus(ctx context.Context, req *QueryCurrentVoteStatusRequest) (*QueryCurrentVoteStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentVoteStatus not implemented")
 }
@@ -1248,6 +1555,42 @@ func _QueryBundles_FinalizedBundlesByHeight_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryBundles_ConcludedBundleProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConcludedBundleProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryBundlesServer).ConcludedBundleProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryBundles/ConcludedBundleProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryBundlesServer).ConcludedBundleProposals(ctx, req.(*QueryConcludedBundleProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryBundles_ConcludedBundleProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConcludedBundleProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryBundlesServer).ConcludedBundleProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryBundles/ConcludedBundleProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryBundlesServer).ConcludedBundleProposal(ctx, req.(*QueryConcludedBundleProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryBundles_CurrentVoteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCurrentVoteStatusRequest)
 	if err := dec(in); err != nil {
@@ -1340,6 +1683,14 @@ var _QueryBundles_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizedBundlesByHeight",
 			Handler:    _QueryBundles_FinalizedBundlesByHeight_Handler,
 		},
+		{
+			MethodName: "ConcludedBundleProposals",
+			Handler:    _QueryBundles_ConcludedBundleProposals_Handler,
+		},
+		{
+			MethodName: "ConcludedBundleProposal",
+			Handler:    _QueryBundles_ConcludedBundleProposal_Handler,
+		},
 		{
 			MethodName: "CurrentVoteStatus",
 			Handler:    _QueryBundles_CurrentVoteStatus_Handler,
@@ -1645,7 +1996,7 @@ func (m *QueryFinalizedBundlesByHeightResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCurrentVoteStatusRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryConcludedBundleProposalsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1655,12 +2006,12 @@ func (m *QueryCurrentVoteStatusRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCurrentVoteStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryConcludedBundleProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentVoteStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryConcludedBundleProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1668,12 +2019,24 @@ func (m *QueryCurrentVoteStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	if m.PoolId != 0 {
 		i = encodeVarintBundles(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBundles(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCurrentVoteStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryConcludedBundleProposalsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1683,27 +2046,189 @@ func (m *QueryCurrentVoteStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCurrentVoteStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryConcludedBundleProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentVoteStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryConcludedBundleProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Total != 0 {
-		i = encodeVarintBundles(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Abstain != 0 {
-		i = encodeVarintBundles(dAtA, i, uint64(m.Abstain))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBundles(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	if m.Invalid != 0 {
+	if len(m.ConcludedBundleProposals) > 0 {
+		for iNdEx := len(m.ConcludedBundleProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConcludedBundleProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundles(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConcludedBundleProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConcludedBundleProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConcludedBundleProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConcludedBundleProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConcludedBundleProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConcludedBundleProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ConcludedBundleProposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBundles(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentVoteStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentVoteStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentVoteStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentVoteStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentVoteStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentVoteStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvalidQuorum) > 0 {
+		i -= len(m.InvalidQuorum)
+		copy(dAtA[i:], m.InvalidQuorum)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.InvalidQuorum)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ValidQuorum) > 0 {
+		i -= len(m.ValidQuorum)
+		copy(dAtA[i:], m.ValidQuorum)
+		i = encodeVarintBundles(dAtA, i, uint64(len(m.ValidQuorum)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Total != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Abstain != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.Abstain))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Invalid != 0 {
 		i = encodeVarintBundles(dAtA, i, uint64(m.Invalid))
 		i--
 		dAtA[i] = 0x10
@@ -1858,6 +2383,16 @@ func (m *QueryCanProposeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPoints != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.MaxPoints))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UploadTimeout != 0 {
+		i = encodeVarintBundles(dAtA, i, uint64(m.UploadTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Reason) > 0 {
 		i -= len(m.Reason)
 		copy(dAtA[i:], m.Reason)
@@ -2089,6 +2624,67 @@ func (m *QueryFinalizedBundlesByHeightResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConcludedBundleProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovBundles(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryConcludedBundleProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConcludedBundleProposals) > 0 {
+		for _, e := range m.ConcludedBundleProposals {
+			l = e.Size()
+			n += 1 + l + sovBundles(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConcludedBundleProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovBundles(uint64(m.PoolId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovBundles(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryConcludedBundleProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConcludedBundleProposal.Size()
+	n += 1 + l + sovBundles(uint64(l))
+	return n
+}
+
 func (m *QueryCurrentVoteStatusRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2119,6 +2715,17 @@ func (m *QueryCurrentVoteStatusResponse) Size() (n int) {
 	if m.Total != 0 {
 		n += 1 + sovBundles(uint64(m.Total))
 	}
+	if m.Status != 0 {
+		n += 1 + sovBundles(uint64(m.Status))
+	}
+	l = len(m.ValidQuorum)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
+	l = len(m.InvalidQuorum)
+	if l > 0 {
+		n += 1 + l + sovBundles(uint64(l))
+	}
 	return n
 }
 
@@ -2190,6 +2797,12 @@ func (m *QueryCanProposeResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBundles(uint64(l))
 	}
+	if m.UploadTimeout != 0 {
+		n += 1 + sovBundles(uint64(m.UploadTimeout))
+	}
+	if m.MaxPoints != 0 {
+		n += 1 + sovBundles(uint64(m.MaxPoints))
+	}
 	return n
 }
 
@@ -2971,7 +3584,7 @@ func (m *QueryFinalizedBundlesByHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentVoteStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConcludedBundleProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2994,13 +3607,49 @@ func (m *QueryCurrentVoteStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentVoteStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConcludedBundleProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentVoteStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConcludedBundleProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
@@ -3040,7 +3689,7 @@ func (m *QueryCurrentVoteStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentVoteStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConcludedBundleProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3063,17 +3712,17 @@ func (m *QueryCurrentVoteStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentVoteStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConcludedBundleProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentVoteStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConcludedBundleProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcludedBundleProposals", wireType)
 			}
-			m.Valid = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBundles
@@ -3083,7 +3732,367 @@ func (m *QueryCurrentVoteStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Valid |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcludedBundleProposals = append(m.ConcludedBundleProposals, types.ConcludedBundleProposal{})
+			if err := m.ConcludedBundleProposals[len(m.ConcludedBundleProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConcludedBundleProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConcludedBundleProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConcludedBundleProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConcludedBundleProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConcludedBundleProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConcludedBundleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcludedBundleProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConcludedBundleProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentVoteStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentVoteStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentVoteStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentVoteStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentVoteStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentVoteStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			m.Valid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Valid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3145,6 +4154,89 @@ func (m *QueryCurrentVoteStatusResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.BundleStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBundles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBundles(dAtA[iNdEx:])
@@ -3602,6 +4694,44 @@ func (m *QueryCanProposeResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTimeout", wireType)
+			}
+			m.UploadTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoints", wireType)
+			}
+			m.MaxPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBundles(dAtA[iNdEx:])
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/KYVENetwork/chain/x/delegation/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -136,7 +139,7 @@ type StakerDelegatorResponse struct {
 	// delegator ...
 	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
 	// current_reward ...
-	CurrentReward uint64 `protobuf:"varint,2,opt,name=current_reward,json=currentReward,proto3" json:"current_reward,omitempty"`
+	CurrentReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=current_reward,json=currentReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_reward"`
 	// delegation_amount ...
 	DelegationAmount uint64 `protobuf:"varint,3,opt,name=delegation_amount,json=delegationAmount,proto3" json:"delegation_amount,omitempty"`
 	// staker ...
@@ -183,11 +186,11 @@ func (m *StakerDelegatorResponse) GetDelegator() string {
 	return ""
 }
 
-func (m *StakerDelegatorResponse) GetCurrentReward() uint64 {
+func (m *StakerDelegatorResponse) GetCurrentReward() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.CurrentReward
 	}
-	return 0
+	return nil
 }
 
 func (m *StakerDelegatorResponse) GetDelegationAmount() uint64 {
@@ -456,7 +459,7 @@ type DelegationForStakerResponse struct {
 	// staker ...
 	Staker *FullStaker `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
 	// current_reward ...
-	CurrentReward uint64 `protobuf:"varint,2,opt,name=current_reward,json=currentReward,proto3" json:"current_reward,omitempty"`
+	CurrentReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=current_reward,json=currentReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_reward"`
 	// delegation_amount ...
 	DelegationAmount uint64 `protobuf:"varint,3,opt,name=delegation_amount,json=delegationAmount,proto3" json:"delegation_amount,omitempty"`
 }
@@ -501,11 +504,11 @@ func (m *DelegationForStakerResponse) GetStaker() *FullStaker {
 	return nil
 }
 
-func (m *DelegationForStakerResponse) GetCurrentReward() uint64 {
+func (m *DelegationForStakerResponse) GetCurrentReward() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.CurrentReward
 	}
-	return 0
+	return nil
 }
 
 func (m *DelegationForStakerResponse) GetDelegationAmount() uint64 {
@@ -515,6 +518,226 @@ func (m *DelegationForStakerResponse) GetDelegationAmount() uint64 {
 	return 0
 }
 
+// QuerySlashesByStakerRequest ...
+type QuerySlashesByStakerRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+}
+
+func (m *QuerySlashesByStakerRequest) Reset()         { *m = QuerySlashesByStakerRequest{} }
+func (m *QuerySlashesByStakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesByStakerRequest) ProtoMessage()    {}
+func (*QuerySlashesByStakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1c28c162a0498a, []int{8}
+}
+func (m *QuerySlashesByStakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashesByStakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashesByStakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashesByStakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesByStakerRequest.Merge(m, src)
+}
+func (m *QuerySlashesByStakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashesByStakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesByStakerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashesByStakerRequest proto.InternalMessageInfo
+
+func (m *QuerySlashesByStakerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QuerySlashesByStakerRequest) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+// QuerySlashesResponse is the response type for all slash queries.
+type QuerySlashesResponse struct {
+	// slashes ...
+	Slashes []types1.SlashRecord `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashesResponse) Reset()         { *m = QuerySlashesResponse{} }
+func (m *QuerySlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesResponse) ProtoMessage()    {}
+func (*QuerySlashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1c28c162a0498a, []int{9}
+}
+func (m *QuerySlashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesResponse.Merge(m, src)
+}
+func (m *QuerySlashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashesResponse proto.InternalMessageInfo
+
+func (m *QuerySlashesResponse) GetSlashes() []types1.SlashRecord {
+	if m != nil {
+		return m.Slashes
+	}
+	return nil
+}
+
+func (m *QuerySlashesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySlashesByPoolRequest ...
+type QuerySlashesByPoolRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QuerySlashesByPoolRequest) Reset()         { *m = QuerySlashesByPoolRequest{} }
+func (m *QuerySlashesByPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesByPoolRequest) ProtoMessage()    {}
+func (*QuerySlashesByPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1c28c162a0498a, []int{10}
+}
+func (m *QuerySlashesByPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashesByPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashesByPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashesByPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesByPoolRequest.Merge(m, src)
+}
+func (m *QuerySlashesByPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashesByPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesByPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashesByPoolRequest proto.InternalMessageInfo
+
+func (m *QuerySlashesByPoolRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QuerySlashesByPoolRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// QuerySlashesByDelegatorRequest ...
+type QuerySlashesByDelegatorRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// delegator ...
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *QuerySlashesByDelegatorRequest) Reset()         { *m = QuerySlashesByDelegatorRequest{} }
+func (m *QuerySlashesByDelegatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesByDelegatorRequest) ProtoMessage()    {}
+func (*QuerySlashesByDelegatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1c28c162a0498a, []int{11}
+}
+func (m *QuerySlashesByDelegatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashesByDelegatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashesByDelegatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashesByDelegatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesByDelegatorRequest.Merge(m, src)
+}
+func (m *QuerySlashesByDelegatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashesByDelegatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesByDelegatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashesByDelegatorRequest proto.InternalMessageInfo
+
+func (m *QuerySlashesByDelegatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QuerySlashesByDelegatorRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryDelegatorRequest)(nil), "kyve.query.v1beta1.QueryDelegatorRequest")
 	proto.RegisterType((*QueryDelegatorResponse)(nil), "kyve.query.v1beta1.QueryDelegatorResponse")
@@ -524,6 +747,10 @@ func init() {
 	proto.RegisterType((*QueryStakersByDelegatorRequest)(nil), "kyve.query.v1beta1.QueryStakersByDelegatorRequest")
 	proto.RegisterType((*QueryStakersByDelegatorResponse)(nil), "kyve.query.v1beta1.QueryStakersByDelegatorResponse")
 	proto.RegisterType((*DelegationForStakerResponse)(nil), "kyve.query.v1beta1.DelegationForStakerResponse")
+	proto.RegisterType((*QuerySlashesByStakerRequest)(nil), "kyve.query.v1beta1.QuerySlashesByStakerRequest")
+	proto.RegisterType((*QuerySlashesResponse)(nil), "kyve.query.v1beta1.QuerySlashesResponse")
+	proto.RegisterType((*QuerySlashesByPoolRequest)(nil), "kyve.query.v1beta1.QuerySlashesByPoolRequest")
+	proto.RegisterType((*QuerySlashesByDelegatorRequest)(nil), "kyve.query.v1beta1.QuerySlashesByDelegatorRequest")
 }
 
 func init() {
@@ -531,51 +758,66 @@ func init() {
 }
 
 var fileDescriptor_5e1c28c162a0498a = []byte{
-	// 699 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0x69, 0xe8, 0x8f, 0x4e, 0xf9, 0xd9, 0x3a, 0xda, 0x1a, 0x62, 0xd9, 0x96, 0x88,
-	0x7f, 0xda, 0xc2, 0x0e, 0x4d, 0x4b, 0x41, 0xf1, 0x62, 0xac, 0x15, 0x11, 0xff, 0x34, 0x82, 0xa0,
-	0x97, 0x30, 0xd9, 0x0e, 0xdb, 0xd0, 0x74, 0x27, 0x9d, 0x99, 0x6d, 0x0d, 0xa5, 0x20, 0x1e, 0x3c,
-	0x0b, 0x5e, 0xc5, 0x8b, 0x27, 0xf1, 0x0d, 0x08, 0xbe, 0x81, 0x1e, 0x0b, 0x5e, 0xf4, 0x22, 0xd2,
-	0xf8, 0x42, 0x64, 0x67, 0x27, 0xd9, 0xd9, 0xcd, 0x26, 0x6d, 0x45, 0x6f, 0xc9, 0x33, 0xcf, 0x33,
-	0xf3, 0x9d, 0xcf, 0xf7, 0x79, 0x66, 0xe1, 0xa5, 0xcd, 0xd6, 0x0e, 0xc5, 0xdb, 0x3e, 0xe5, 0x2d,
-	0xbc, 0xb3, 0x50, 0xa3, 0x92, 0x2c, 0xe0, 0x75, 0xda, 0xa0, 0x2e, 0x91, 0x75, 0xe6, 0xd9, 0x4d,
-	0xce, 0x24, 0x43, 0x28, 0x48, 0xb2, 0x55, 0x92, 0xad, 0x93, 0x0a, 0x73, 0x0e, 0x13, 0x5b, 0x4c,
-	0xe0, 0x1a, 0x11, 0xc9, 0xfa, 0x26, 0x71, 0xeb, 0x9e, 0x51, 0x5f, 0x38, 0xef, 0x32, 0x97, 0xa9,
-	0x9f, 0x38, 0xf8, 0xa5, 0xa3, 0x53, 0x2e, 0x63, 0x6e, 0x83, 0x62, 0xd2, 0xac, 0x63, 0xe2, 0x79,
-	0x4c, 0xaa, 0x12, 0xa1, 0x57, 0xad, 0x14, 0x61, 0xa1, 0x02, 0xb5, 0x5e, 0x7c, 0x00, 0x27, 0xd6,
-	0x82, 0xbf, 0x2b, 0xa1, 0x58, 0xc6, 0x2b, 0x74, 0xdb, 0xa7, 0x42, 0xa2, 0x49, 0x38, 0x2c, 0x24,
-	0xd9, 0xa4, 0x3c, 0x0f, 0x66, 0xc0, 0xb5, 0x91, 0x8a, 0xfe, 0x87, 0xa6, 0xe0, 0xc8, 0x7a, 0x27,
-	0x37, 0x9f, 0x55, 0x4b, 0x51, 0xa0, 0xe8, 0xc0, 0xc9, 0xe4, 0x76, 0xa2, 0xc9, 0x3c, 0x41, 0xd1,
-	0x3d, 0xb3, 0x2e, 0xd8, 0x72, 0xb4, 0x34, 0x6f, 0xf7, 0x02, 0xb1, 0x9f, 0xa8, 0x63, 0x7a, 0xea,
-	0xcd, 0x43, 0x3e, 0x00, 0x78, 0xa1, 0x4f, 0x5a, 0x5c, 0x1e, 0x48, 0xc8, 0x43, 0x97, 0xe1, 0x19,
-	0xc7, 0xe7, 0x9c, 0x7a, 0xb2, 0xca, 0xe9, 0x2e, 0xe1, 0xeb, 0xea, 0x06, 0xb9, 0xca, 0xff, 0x3a,
-	0x5a, 0x51, 0x41, 0x34, 0x0f, 0xcf, 0x46, 0xe6, 0x55, 0xc9, 0x16, 0xf3, 0x3d, 0x99, 0x1f, 0x52,
-	0x99, 0xe3, 0xd1, 0xc2, 0x2d, 0x15, 0x37, 0x40, 0xe5, 0x4c, 0x50, 0xc5, 0x97, 0x00, 0x5a, 0x71,
-	0x16, 0xa2, 0xdc, 0x0a, 0x65, 0x77, 0x18, 0xaf, 0x42, 0x18, 0x99, 0xac, 0xa1, 0x5c, 0xb1, 0xc3,
-	0x8e, 0xb0, 0x83, 0x8e, 0x48, 0xb0, 0x79, 0x4c, 0x5c, 0xaa, 0x6b, 0x2b, 0x46, 0xa5, 0x21, 0x21,
-	0x1b, 0x93, 0xf0, 0x2e, 0x0b, 0xa7, 0xfb, 0x4a, 0xd0, 0xc0, 0xd6, 0x20, 0xec, 0xf2, 0x11, 0x79,
-	0x30, 0x33, 0x74, 0x4a, 0x63, 0xca, 0xb9, 0x83, 0x1f, 0xd3, 0x99, 0x8a, 0xb1, 0x09, 0x9a, 0x85,
-	0xe3, 0x92, 0x49, 0xd2, 0xa8, 0x46, 0xac, 0x34, 0xe7, 0x31, 0x15, 0x5f, 0xe9, 0x86, 0x51, 0x09,
-	0x4e, 0xc4, 0x52, 0x19, 0xaf, 0x3a, 0x06, 0xed, 0x73, 0x66, 0x3e, 0xe3, 0xb7, 0x15, 0xf0, 0xbb,
-	0x31, 0x6a, 0x39, 0x45, 0xed, 0xea, 0xb1, 0xd4, 0x74, 0x1b, 0x19, 0xa5, 0xc5, 0xd7, 0x1d, 0x87,
-	0xc2, 0xab, 0x89, 0x72, 0xef, 0x14, 0xfc, 0x2d, 0x87, 0x06, 0x4f, 0xcd, 0x77, 0xa0, 0x7d, 0x4a,
-	0x13, 0x72, 0xa2, 0xc6, 0x7e, 0x04, 0xff, 0x0b, 0x3d, 0x17, 0xf9, 0xac, 0xb2, 0x10, 0xa7, 0x59,
-	0x18, 0x81, 0x5f, 0x65, 0x3c, 0xde, 0x07, 0xda, 0xc6, 0xce, 0x2e, 0x09, 0xc8, 0x43, 0x7f, 0x0e,
-	0xf9, 0x23, 0x80, 0x17, 0x07, 0x9c, 0x8b, 0x96, 0x63, 0xef, 0xcc, 0x68, 0xc9, 0x4a, 0x13, 0xbe,
-	0xea, 0x37, 0x1a, 0xba, 0xae, 0xf3, 0x0e, 0xfd, 0x83, 0x51, 0x2e, 0x7d, 0xca, 0xc1, 0x31, 0x73,
-	0x5e, 0x02, 0xe7, 0xde, 0x03, 0x38, 0xd2, 0x75, 0x03, 0xcd, 0xa6, 0xa9, 0x4b, 0x7d, 0x40, 0x0b,
-	0x73, 0x27, 0x49, 0x0d, 0x21, 0x14, 0x6f, 0xbc, 0xfa, 0xfa, 0xeb, 0x6d, 0x76, 0x09, 0x95, 0x70,
-	0xff, 0xef, 0x08, 0xe3, 0x78, 0x2f, 0xbc, 0xfb, 0x3e, 0xde, 0xeb, 0xc6, 0xf6, 0xd1, 0x67, 0x00,
-	0x51, 0xef, 0x7c, 0xa3, 0xd2, 0xf1, 0xc7, 0x27, 0xdf, 0xa3, 0xc2, 0xe2, 0xa9, 0x6a, 0xb4, 0xf6,
-	0xeb, 0x4a, 0xfb, 0x22, 0x5a, 0x18, 0xa8, 0x5d, 0x54, 0x6b, 0xad, 0x6a, 0x28, 0xbf, 0x7b, 0x0d,
-	0xf4, 0x05, 0x40, 0xd4, 0xdb, 0xf2, 0x03, 0xa4, 0xf7, 0x1d, 0xd4, 0x01, 0xd2, 0xfb, 0xcf, 0x54,
-	0xf1, 0xa6, 0x92, 0xbe, 0x8c, 0x96, 0xd2, 0xa4, 0xeb, 0x49, 0x08, 0x74, 0x1b, 0x0e, 0x44, 0xe0,
-	0xcb, 0x2b, 0x07, 0x47, 0x16, 0x38, 0x3c, 0xb2, 0xc0, 0xcf, 0x23, 0x0b, 0xbc, 0x69, 0x5b, 0x99,
-	0xc3, 0xb6, 0x95, 0xf9, 0xd6, 0xb6, 0x32, 0xcf, 0xe7, 0xdc, 0xba, 0xdc, 0xf0, 0x6b, 0xb6, 0xc3,
-	0xb6, 0xf0, 0xfd, 0x67, 0x4f, 0xef, 0x3c, 0xa4, 0x72, 0x97, 0xf1, 0x4d, 0xec, 0x6c, 0x90, 0xba,
-	0x87, 0x5f, 0xe8, 0x83, 0x64, 0xab, 0x49, 0x45, 0x6d, 0x58, 0x7d, 0x87, 0x17, 0x7f, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0x39, 0x97, 0xee, 0x37, 0x42, 0x08, 0x00, 0x00,
+	// 930 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0x24, 0x51, 0x4b, 0xa7, 0x5a, 0xba, 0x0c, 0xfb, 0x23, 0x9b, 0xad, 0x9c, 0x28, 0x20,
+	0xc8, 0x76, 0x55, 0xcf, 0x36, 0x59, 0x2a, 0x81, 0xb8, 0x90, 0x0d, 0x45, 0x2b, 0x04, 0x6c, 0x8d,
+	0x84, 0x04, 0x97, 0xc8, 0x71, 0x46, 0xae, 0x15, 0xd7, 0x93, 0x7a, 0x9c, 0x96, 0xa8, 0x44, 0x42,
+	0x1c, 0x38, 0x23, 0x71, 0x45, 0x70, 0x01, 0x21, 0x71, 0xe2, 0x88, 0xc4, 0x3f, 0xd0, 0x63, 0x25,
+	0x2e, 0x70, 0x01, 0xd4, 0xf2, 0x07, 0x70, 0xe2, 0x8c, 0x3c, 0x1e, 0xdb, 0xe3, 0xc4, 0x4e, 0x9a,
+	0xaa, 0x82, 0x3d, 0xc5, 0x7e, 0xf3, 0xde, 0xbc, 0x6f, 0xbe, 0xef, 0xbd, 0x37, 0x0e, 0x7c, 0xa1,
+	0x3f, 0x3a, 0x24, 0xf8, 0x60, 0x48, 0xdc, 0x11, 0x3e, 0xdc, 0xea, 0x12, 0x4f, 0xdf, 0xc2, 0x3d,
+	0x62, 0x13, 0x53, 0xf7, 0x2c, 0xea, 0xa8, 0x03, 0x97, 0x7a, 0x14, 0x21, 0xdf, 0x49, 0xe5, 0x4e,
+	0xaa, 0x70, 0x2a, 0x6f, 0x18, 0x94, 0xed, 0x53, 0x86, 0xbb, 0x3a, 0x9b, 0x8c, 0x1f, 0xe8, 0xa6,
+	0xe5, 0x48, 0xf1, 0x65, 0x45, 0xf6, 0x0d, 0xbd, 0x0c, 0x6a, 0x85, 0xeb, 0x37, 0x4c, 0x6a, 0x52,
+	0xfe, 0x88, 0xfd, 0x27, 0x61, 0x5d, 0x37, 0x29, 0x35, 0x6d, 0x82, 0xf5, 0x81, 0x85, 0x75, 0xc7,
+	0xa1, 0x1e, 0xdf, 0x92, 0x89, 0xd5, 0x3a, 0x07, 0x1e, 0x43, 0xcd, 0x44, 0x5f, 0x56, 0x52, 0x8e,
+	0x18, 0x9c, 0x85, 0xaf, 0xd7, 0xde, 0x81, 0x37, 0x77, 0xfd, 0xd7, 0x76, 0x10, 0x48, 0x5d, 0x8d,
+	0x1c, 0x0c, 0x09, 0xf3, 0xd0, 0x2d, 0xb8, 0xc4, 0x3c, 0xbd, 0x4f, 0xdc, 0x12, 0xa8, 0x82, 0xfa,
+	0x8a, 0x26, 0xde, 0xd0, 0x3a, 0x5c, 0xe9, 0x85, 0xbe, 0xa5, 0x3c, 0x5f, 0x8a, 0x0d, 0x35, 0x03,
+	0xde, 0x9a, 0xdc, 0x8e, 0x0d, 0xa8, 0xc3, 0x08, 0x7a, 0x2c, 0xc7, 0xf9, 0x5b, 0xae, 0x36, 0xee,
+	0xab, 0xd3, 0xd4, 0xaa, 0xef, 0xf3, 0x34, 0x53, 0xf1, 0x72, 0x92, 0xbf, 0x01, 0xbc, 0x9d, 0xe1,
+	0x86, 0xd6, 0x27, 0xd3, 0xc8, 0xf0, 0x90, 0x0b, 0x9f, 0x35, 0x86, 0xae, 0x4b, 0x1c, 0xaf, 0xe3,
+	0x92, 0x23, 0xdd, 0xed, 0x95, 0xf2, 0xd5, 0x42, 0x7d, 0xb5, 0x71, 0x47, 0x0d, 0x44, 0x52, 0x7d,
+	0x91, 0x22, 0x28, 0x8f, 0xa8, 0xe5, 0xb4, 0x1e, 0x9c, 0xfc, 0x5e, 0xc9, 0xfd, 0xf0, 0x47, 0xa5,
+	0x6e, 0x5a, 0xde, 0xde, 0xb0, 0xab, 0x1a, 0x74, 0x1f, 0x0b, 0x45, 0x83, 0x9f, 0x4d, 0xd6, 0xeb,
+	0x63, 0x6f, 0x34, 0x20, 0x8c, 0x07, 0x30, 0xed, 0x9a, 0x48, 0xa1, 0xf1, 0x0c, 0xe8, 0x3e, 0x7c,
+	0x2e, 0x56, 0xa5, 0xa3, 0xef, 0xd3, 0xa1, 0xe3, 0x95, 0x0a, 0x55, 0x50, 0x2f, 0x6a, 0xd7, 0xe3,
+	0x85, 0x37, 0xb8, 0x5d, 0x62, 0xbd, 0x28, 0xb3, 0x5e, 0xfb, 0x14, 0x40, 0x25, 0x49, 0x2c, 0x6b,
+	0x8d, 0x02, 0x0e, 0x42, 0xc1, 0x76, 0x20, 0x8c, 0x6b, 0x4f, 0x30, 0xfc, 0x52, 0xe2, 0x5c, 0x49,
+	0xa2, 0x9f, 0xe8, 0x26, 0x11, 0xb1, 0x9a, 0x14, 0x29, 0x41, 0xc8, 0x27, 0x20, 0x7c, 0x95, 0x87,
+	0x95, 0x4c, 0x08, 0x82, 0xfd, 0x5d, 0x08, 0x23, 0xb2, 0x59, 0x09, 0x54, 0x0b, 0x0b, 0xaa, 0xdc,
+	0x2a, 0xfa, 0x6c, 0x6b, 0xd2, 0x26, 0xe8, 0x1e, 0xbc, 0xee, 0x51, 0x4f, 0xb7, 0x3b, 0x31, 0x57,
+	0x1c, 0x58, 0x51, 0x5b, 0xe3, 0xf6, 0x76, 0x64, 0x46, 0x0d, 0x78, 0x33, 0xe1, 0x4a, 0xdd, 0x8e,
+	0x21, 0xb1, 0xfd, 0xbc, 0xec, 0x4f, 0xdd, 0x47, 0x9c, 0xf0, 0xb7, 0x12, 0xac, 0x15, 0x39, 0x6b,
+	0x2f, 0xcf, 0x65, 0x4d, 0xd4, 0xa4, 0x14, 0x5a, 0xfb, 0x3c, 0x54, 0x28, 0x38, 0x1a, 0x6b, 0x4d,
+	0xb7, 0xd4, 0x55, 0x29, 0x34, 0xbb, 0x05, 0x7f, 0x03, 0xb0, 0x92, 0x09, 0xe4, 0x42, 0x5d, 0xf2,
+	0x1e, 0x5c, 0x0e, 0x34, 0x67, 0xa2, 0x3d, 0x70, 0x9a, 0x84, 0x31, 0xf1, 0x3b, 0xd4, 0x4d, 0xd6,
+	0x81, 0x90, 0x31, 0xdc, 0x65, 0x82, 0xe4, 0xc2, 0xe5, 0x49, 0xfe, 0x07, 0xc0, 0xbb, 0x33, 0xf2,
+	0xa2, 0xed, 0xc4, 0xd0, 0x5a, 0x6d, 0x28, 0x69, 0xc0, 0x77, 0x86, 0xb6, 0x2d, 0xe2, 0xc2, 0xa1,
+	0xf6, 0xb4, 0xcf, 0x85, 0xda, 0x18, 0xde, 0x0d, 0x34, 0xb5, 0x75, 0xb6, 0x47, 0xfe, 0xf3, 0xde,
+	0xff, 0x0e, 0xc0, 0x1b, 0x72, 0xfe, 0x88, 0xf0, 0x36, 0x5c, 0x66, 0x81, 0x49, 0x74, 0xfb, 0x8b,
+	0x01, 0xe3, 0xd2, 0x3d, 0x14, 0xb5, 0xbc, 0xef, 0xa7, 0x11, 0x83, 0xba, 0xbd, 0xa8, 0x3e, 0x82,
+	0xd0, 0x89, 0xfa, 0xc8, 0x5f, 0xbe, 0x3e, 0x3e, 0x81, 0x77, 0x92, 0x34, 0x3d, 0xa1, 0xd4, 0xbe,
+	0x6a, 0x92, 0x6e, 0xc3, 0xe5, 0x01, 0xa5, 0x76, 0xc7, 0xea, 0x89, 0x41, 0xb4, 0xe4, 0xbf, 0x3e,
+	0xee, 0x49, 0x23, 0x20, 0x4c, 0xff, 0xff, 0x8c, 0x80, 0xc6, 0xf7, 0xcf, 0xc0, 0x35, 0x79, 0x54,
+	0xfb, 0x11, 0x5f, 0x03, 0xb8, 0x12, 0xc1, 0x41, 0xf7, 0xd2, 0x1a, 0x23, 0xf5, 0x43, 0xa0, 0xbc,
+	0x71, 0x11, 0xd7, 0x40, 0x8b, 0xda, 0x6b, 0x9f, 0xfd, 0xf2, 0xd7, 0x97, 0xf9, 0x87, 0xa8, 0x81,
+	0xb3, 0xbf, 0xac, 0xa8, 0x8b, 0x8f, 0x83, 0xb2, 0x1a, 0xe3, 0xe3, 0xc8, 0x36, 0x46, 0x3f, 0x01,
+	0x88, 0xa6, 0xaf, 0x16, 0xd4, 0x98, 0x9f, 0x7e, 0xb2, 0x1d, 0xca, 0xcd, 0x85, 0x62, 0x04, 0xf6,
+	0x57, 0x39, 0xf6, 0x26, 0xda, 0x9a, 0x89, 0x9d, 0x75, 0xba, 0xa3, 0x4e, 0x00, 0x3f, 0x3a, 0x06,
+	0xfa, 0x19, 0x40, 0x34, 0x3d, 0x6d, 0x67, 0x40, 0xcf, 0xbc, 0x23, 0xca, 0xcd, 0x85, 0x62, 0x04,
+	0xf4, 0xd7, 0x39, 0xf4, 0x6d, 0xf4, 0x30, 0x0d, 0xba, 0x18, 0xc2, 0x3e, 0x6e, 0x49, 0x01, 0x89,
+	0xf8, 0x6f, 0x01, 0x5c, 0x9b, 0x98, 0x2b, 0x08, 0x67, 0xc3, 0x48, 0x9d, 0x40, 0xe5, 0xfa, 0xbc,
+	0x80, 0x08, 0xec, 0x36, 0x07, 0xfb, 0x00, 0xa9, 0xa9, 0x60, 0x03, 0xe7, 0x34, 0x92, 0xbf, 0x01,
+	0xf0, 0x5a, 0xa2, 0xaf, 0xd1, 0xe6, 0x7c, 0x90, 0x52, 0xff, 0x2f, 0x00, 0xf1, 0x15, 0x0e, 0x11,
+	0xa3, 0xcd, 0x39, 0x10, 0xfd, 0xbe, 0xc7, 0xc7, 0x62, 0x18, 0x8c, 0xd1, 0x8f, 0x7e, 0x19, 0x4c,
+	0xb5, 0xfe, 0xac, 0x32, 0xc8, 0x9a, 0x13, 0x0b, 0x60, 0x9d, 0xad, 0x7d, 0x8c, 0x35, 0x55, 0xfb,
+	0x56, 0xfb, 0xe4, 0x4c, 0x01, 0xa7, 0x67, 0x0a, 0xf8, 0xf3, 0x4c, 0x01, 0x5f, 0x9c, 0x2b, 0xb9,
+	0xd3, 0x73, 0x25, 0xf7, 0xeb, 0xb9, 0x92, 0xfb, 0x68, 0x43, 0xba, 0xd7, 0xde, 0xfe, 0xf0, 0x83,
+	0x37, 0xdf, 0x25, 0xde, 0x11, 0x75, 0xfb, 0xd8, 0xd8, 0xd3, 0x2d, 0x07, 0x7f, 0x2c, 0x12, 0xf1,
+	0xfb, 0xad, 0xbb, 0xc4, 0xff, 0x4b, 0x34, 0xff, 0x1d, 0x00, 0x52, 0x9d, 0x32, 0x37, 0x50, 0x0d,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -598,6 +840,16 @@ type QueryDelegationClient interface {
 	// StakersByPoolAndDelegator returns all stakers the given delegator has delegated to.
 	// This query is paginated.
 	StakersByDelegator(ctx context.Context, in *QueryStakersByDelegatorRequest, opts ...grpc.CallOption) (*QueryStakersByDelegatorResponse, error)
+	// SlashesByStaker returns all slashes of the given staker, oldest first.
+	// This query is paginated.
+	SlashesByStaker(ctx context.Context, in *QuerySlashesByStakerRequest, opts ...grpc.CallOption) (*QuerySlashesResponse, error)
+	// SlashesByPool returns all slashes which happened in the given pool, oldest first.
+	// This query is paginated.
+	SlashesByPool(ctx context.Context, in *QuerySlashesByPoolRequest, opts ...grpc.CallOption) (*QuerySlashesResponse, error)
+	// SlashesByDelegator returns all slashes which reduced the current delegations
+	// of the given delegator, oldest first.
+	// This query is paginated.
+	SlashesByDelegator(ctx context.Context, in *QuerySlashesByDelegatorRequest, opts ...grpc.CallOption) (*QuerySlashesResponse, error)
 }
 
 type queryDelegationClient struct {
@@ -635,6 +887,33 @@ func (c *queryDelegationClient) StakersByDelegator(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *queryDelegationClient) SlashesByStaker(ctx context.Context, in *QuerySlashesByStakerRequest, opts ...grpc.CallOption) (*QuerySlashesResponse, error) {
+	out := new(QuerySlashesResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryDelegation/SlashesByStaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryDelegationClient) SlashesByPool(ctx context.Context, in *QuerySlashesByPoolRequest, opts ...grpc.CallOption) (*QuerySlashesResponse, error) {
+	out := new(QuerySlashesResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryDelegation/SlashesByPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryDelegationClient) SlashesByDelegator(ctx context.Context, in *QuerySlashesByDelegatorRequest, opts ...grpc.CallOption) (*QuerySlashesResponse, error) {
+	out := new(QuerySlashesResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryDelegation/SlashesByDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryDelegationServer is the server API for QueryDelegation service.
 type QueryDelegationServer interface {
 	// Delegator returns delegation information for a specific delegator of a specific staker.
@@ -645,6 +924,16 @@ type QueryDelegationServer interface {
 	// StakersByPoolAndDelegator returns all stakers the given delegator has delegated to.
 	// This query is paginated.
 	StakersByDelegator(context.Context, *QueryStakersByDelegatorRequest) (*QueryStakersByDelegatorResponse, error)
+	// SlashesByStaker returns all slashes of the given staker, oldest first.
+	// This query is paginated.
+	SlashesByStaker(context.Context, *QuerySlashesByStakerRequest) (*QuerySlashesResponse, error)
+	// SlashesByPool returns all slashes which happened in the given pool, oldest first.
+	// This query is paginated.
+	SlashesByPool(context.Context, *QuerySlashesByPoolRequest) (*QuerySlashesResponse, error)
+	// SlashesByDelegator returns all slashes which reduced the current delegations
+	// of the given delegator, oldest first.
+	// This query is paginated.
+	SlashesByDelegator(context.Context, *QuerySlashesByDelegatorRequest) (*QuerySlashesResponse, error)
 }
 
 // UnimplementedQueryDelegationServer can be embedded to have forward compatible implementations.
@@ -660,6 +949,15 @@ func (*UnimplementedQueryDelegationServer) DelegatorsByStaker(ctx context.Contex
 func (*UnimplementedQueryDelegationServer) StakersByDelegator(ctx context.Context, req *QueryStakersByDelegatorRequest) (*QueryStakersByDelegatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StakersByDelegator not implemented")
 }
+func (*UnimplementedQueryDelegationServer) SlashesByStaker(ctx context.Context, req *QuerySlashesByStakerRequest) (*QuerySlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashesByStaker not implemented")
+}
+func (*UnimplementedQueryDelegationServer) SlashesByPool(ctx context.Context, req *QuerySlashesByPoolRequest) (*QuerySlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashesByPool not implemented")
+}
+func (*UnimplementedQueryDelegationServer) SlashesByDelegator(ctx context.Context, req *QuerySlashesByDelegatorRequest) (*QuerySlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashesByDelegator not implemented")
+}
 
 func RegisterQueryDelegationServer(s grpc1.Server, srv QueryDelegationServer) {
 	s.RegisterService(&_QueryDelegation_serviceDesc, srv)
@@ -719,6 +1017,60 @@ func _QueryDelegation_StakersByDelegator_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryDelegation_SlashesByStaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashesByStakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryDelegationServer).SlashesByStaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryDelegation/SlashesByStaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryDelegationServer).SlashesByStaker(ctx, req.(*QuerySlashesByStakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryDelegation_SlashesByPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashesByPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryDelegationServer).SlashesByPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryDelegation/SlashesByPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryDelegationServer).SlashesByPool(ctx, req.(*QuerySlashesByPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryDelegation_SlashesByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashesByDelegatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryDelegationServer).SlashesByDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryDelegation/SlashesByDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryDelegationServer).SlashesByDelegator(ctx, req.(*QuerySlashesByDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryDelegation_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kyve.query.v1beta1.QueryDelegation",
 	HandlerType: (*QueryDelegationServer)(nil),
@@ -735,6 +1087,18 @@ var _QueryDelegation_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StakersByDelegator",
 			Handler:    _QueryDelegation_StakersByDelegator_Handler,
 		},
+		{
+			MethodName: "SlashesByStaker",
+			Handler:    _QueryDelegation_SlashesByStaker_Handler,
+		},
+		{
+			MethodName: "SlashesByPool",
+			Handler:    _QueryDelegation_SlashesByPool_Handler,
+		},
+		{
+			MethodName: "SlashesByDelegator",
+			Handler:    _QueryDelegation_SlashesByDelegator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kyve/query/v1beta1/delegation.proto",
@@ -844,10 +1208,19 @@ func (m *StakerDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.CurrentReward != 0 {
-		i = encodeVarintDelegation(dAtA, i, uint64(m.CurrentReward))
-		i--
-		dAtA[i] = 0x10
+	if len(m.CurrentReward) > 0 {
+		for iNdEx := len(m.CurrentReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Delegator) > 0 {
 		i -= len(m.Delegator)
@@ -1083,10 +1456,19 @@ func (m *DelegationForStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.CurrentReward != 0 {
-		i = encodeVarintDelegation(dAtA, i, uint64(m.CurrentReward))
-		i--
-		dAtA[i] = 0x10
+	if len(m.CurrentReward) > 0 {
+		for iNdEx := len(m.CurrentReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if m.Staker != nil {
 		{
@@ -1103,16 +1485,189 @@ func (m *DelegationForStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDelegation(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySlashesByStakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesByStakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesByStakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDelegation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDelegation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashesByPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesByPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesByPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDelegation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashesByDelegatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDelegation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelegation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryDelegatorRequest) Size() (n int) {
 	if m == nil {
@@ -1154,8 +1709,11 @@ func (m *StakerDelegatorResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDelegation(uint64(l))
 	}
-	if m.CurrentReward != 0 {
-		n += 1 + sovDelegation(uint64(m.CurrentReward))
+	if len(m.CurrentReward) > 0 {
+		for _, e := range m.CurrentReward {
+			l = e.Size()
+			n += 1 + l + sovDelegation(uint64(l))
+		}
 	}
 	if m.DelegationAmount != 0 {
 		n += 1 + sovDelegation(uint64(m.DelegationAmount))
@@ -1259,8 +1817,11 @@ func (m *DelegationForStakerResponse) Size() (n int) {
 		l = m.Staker.Size()
 		n += 1 + l + sovDelegation(uint64(l))
 	}
-	if m.CurrentReward != 0 {
-		n += 1 + sovDelegation(uint64(m.CurrentReward))
+	if len(m.CurrentReward) > 0 {
+		for _, e := range m.CurrentReward {
+			l = e.Size()
+			n += 1 + l + sovDelegation(uint64(l))
+		}
 	}
 	if m.DelegationAmount != 0 {
 		n += 1 + sovDelegation(uint64(m.DelegationAmount))
@@ -1268,6 +1829,75 @@ func (m *DelegationForStakerResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySlashesByStakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashesByPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovDelegation(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QuerySlashesByDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
 func sovDelegation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1536,10 +2166,10 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReward", wireType)
 			}
-			m.CurrentReward = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -1549,11 +2179,26 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentReward |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentReward = append(m.CurrentReward, types.Coin{})
+			if err := m.CurrentReward[len(m.CurrentReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
@@ -2238,10 +2883,10 @@ func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReward", wireType)
 			}
-			m.CurrentReward = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -2251,19 +2896,34 @@ func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentReward |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
 			}
-			m.DelegationAmount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDelegation
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentReward = append(m.CurrentReward, types.Coin{})
+			if err := m.CurrentReward[len(m.CurrentReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+			}
+			m.DelegationAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
@@ -2296,6 +2956,467 @@ func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySlashesByStakerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashesByStakerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashesByStakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, types1.SlashRecord{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashesByPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashesByPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashesByPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashesByDelegatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashesByDelegatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashesByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDelegation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
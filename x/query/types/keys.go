@@ -20,3 +20,13 @@ const (
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+const (
+	// DefaultRunwayBundles is the number of finalized bundles the pool runway
+	// is estimated from if the request does not specify it
+	DefaultRunwayBundles = 10
+
+	// MaxRunwayBundles is the maximum number of finalized bundles the pool
+	// runway is estimated from
+	MaxRunwayBundles = 100
+)
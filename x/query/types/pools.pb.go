@@ -176,6 +176,10 @@ type PoolResponse struct {
 	TotalDelegation uint64 `protobuf:"varint,6,opt,name=total_delegation,json=totalDelegation,proto3" json:"total_delegation,omitempty"`
 	// status ...
 	Status types.PoolStatus `protobuf:"varint,7,opt,name=status,proto3,enum=kyve.pool.v1beta1.PoolStatus" json:"status,omitempty"`
+	// protocol_binaries are the parsed binaries of the current protocol version
+	ProtocolBinaries []types.Binary `protobuf:"bytes,8,rep,name=protocol_binaries,json=protocolBinaries,proto3" json:"protocol_binaries"`
+	// upgrade_binaries are the parsed binaries of the scheduled upgrade
+	UpgradeBinaries []types.Binary `protobuf:"bytes,9,rep,name=upgrade_binaries,json=upgradeBinaries,proto3" json:"upgrade_binaries"`
 }
 
 func (m *PoolResponse) Reset()         { *m = PoolResponse{} }
@@ -260,6 +264,20 @@ func (m *PoolResponse) GetStatus() types.PoolStatus {
 	return types.POOL_STATUS_UNSPECIFIED
 }
 
+func (m *PoolResponse) GetProtocolBinaries() []types.Binary {
+	if m != nil {
+		return m.ProtocolBinaries
+	}
+	return nil
+}
+
+func (m *PoolResponse) GetUpgradeBinaries() []types.Binary {
+	if m != nil {
+		return m.UpgradeBinaries
+	}
+	return nil
+}
+
 // QueryPoolRequest is the request type for the Query/Pool RPC method.
 type QueryPoolRequest struct {
 	// id defines the unique ID of the pool.
@@ -352,58 +370,348 @@ func (m *QueryPoolResponse) GetPool() PoolResponse {
 	return PoolResponse{}
 }
 
+// QueryPoolRunwayRequest is the request type for the Query/PoolRunway RPC method.
+type QueryPoolRunwayRequest struct {
+	// id defines the unique ID of the pool.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// bundles is the number of the latest finalized bundles the average
+	// byte size is computed from. Defaults to 10 if zero.
+	Bundles uint64 `protobuf:"varint,2,opt,name=bundles,proto3" json:"bundles,omitempty"`
+}
+
+func (m *QueryPoolRunwayRequest) Reset()         { *m = QueryPoolRunwayRequest{} }
+func (m *QueryPoolRunwayRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolRunwayRequest) ProtoMessage()    {}
+func (*QueryPoolRunwayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{5}
+}
+func (m *QueryPoolRunwayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolRunwayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolRunwayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolRunwayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolRunwayRequest.Merge(m, src)
+}
+func (m *QueryPoolRunwayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolRunwayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolRunwayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolRunwayRequest proto.InternalMessageInfo
+
+func (m *QueryPoolRunwayRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryPoolRunwayRequest) GetBundles() uint64 {
+	if m != nil {
+		return m.Bundles
+	}
+	return 0
+}
+
+// QueryPoolRunwayResponse is the response type for the Query/PoolRunway RPC method.
+type QueryPoolRunwayResponse struct {
+	// operating_cost is the fixed cost of a bundle
+	OperatingCost uint64 `protobuf:"varint,1,opt,name=operating_cost,json=operatingCost,proto3" json:"operating_cost,omitempty"`
+	// storage_cost is the cost of a single byte of a bundle
+	StorageCost uint64 `protobuf:"varint,2,opt,name=storage_cost,json=storageCost,proto3" json:"storage_cost,omitempty"`
+	// average_byte_size of the sampled bundles
+	AverageByteSize uint64 `protobuf:"varint,3,opt,name=average_byte_size,json=averageByteSize,proto3" json:"average_byte_size,omitempty"`
+	// sampled_bundles is the number of finalized bundles the average is based on
+	SampledBundles uint64 `protobuf:"varint,4,opt,name=sampled_bundles,json=sampledBundles,proto3" json:"sampled_bundles,omitempty"`
+	// cost_per_bundle is the expected amount the funders get charged per bundle,
+	// in units of the pool costs.
+	CostPerBundle uint64 `protobuf:"varint,5,opt,name=cost_per_bundle,json=costPerBundle,proto3" json:"cost_per_bundle,omitempty"`
+	// total_value of the funds of all funders in units of the pool costs,
+	// capped at the maximum uint64 value.
+	TotalValue uint64 `protobuf:"varint,6,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	// bundles_remaining is the estimated number of bundles until the pool
+	// runs out of funds. It is zero if a bundle has no cost.
+	BundlesRemaining uint64 `protobuf:"varint,7,opt,name=bundles_remaining,json=bundlesRemaining,proto3" json:"bundles_remaining,omitempty"`
+	// time_to_empty is the estimated time in seconds until the pool
+	// runs out of funds, based on the upload interval.
+	TimeToEmpty uint64 `protobuf:"varint,8,opt,name=time_to_empty,json=timeToEmpty,proto3" json:"time_to_empty,omitempty"`
+	// funders contains the runway of every funder under the
+	// charging mode of the pool.
+	Funders []FunderRunway `protobuf:"bytes,9,rep,name=funders,proto3" json:"funders"`
+}
+
+func (m *QueryPoolRunwayResponse) Reset()         { *m = QueryPoolRunwayResponse{} }
+func (m *QueryPoolRunwayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolRunwayResponse) ProtoMessage()    {}
+func (*QueryPoolRunwayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{6}
+}
+func (m *QueryPoolRunwayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolRunwayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolRunwayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolRunwayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolRunwayResponse.Merge(m, src)
+}
+func (m *QueryPoolRunwayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolRunwayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolRunwayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolRunwayResponse proto.InternalMessageInfo
+
+func (m *QueryPoolRunwayResponse) GetOperatingCost() uint64 {
+	if m != nil {
+		return m.OperatingCost
+	}
+	return 0
+}
+
+func (m *QueryPoolRunwayResponse) GetStorageCost() uint64 {
+	if m != nil {
+		return m.StorageCost
+	}
+	return 0
+}
+
+func (m *QueryPoolRunwayResponse) GetAverageByteSize() uint64 {
+	if m != nil {
+		return m.AverageByteSize
+	}
+	return 0
+}
+
+func (m *QueryPoolRunwayResponse) GetSampledBundles() uint64 {
+	if m != nil {
+		return m.SampledBundles
+	}
+	return 0
+}
+
+func (m *QueryPoolRunwayResponse) GetCostPerBundle() uint64 {
+	if m != nil {
+		return m.CostPerBundle
+	}
+	return 0
+}
+
+func (m *QueryPoolRunwayResponse) GetTotalValue() uint64 {
+	if m != nil {
+		return m.TotalValue
+	}
+	return 0
+}
+
+func (m *QueryPoolRunwayResponse) GetBundlesRemaining() uint64 {
+	if m != nil {
+		return m.BundlesRemaining
+	}
+	return 0
+}
+
+func (m *QueryPoolRunwayResponse) GetTimeToEmpty() uint64 {
+	if m != nil {
+		return m.TimeToEmpty
+	}
+	return 0
+}
+
+func (m *QueryPoolRunwayResponse) GetFunders() []FunderRunway {
+	if m != nil {
+		return m.Funders
+	}
+	return nil
+}
+
+// FunderRunway is the estimated runway of a single funder.
+type FunderRunway struct {
+	// address of the funder
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// value of the funds in units of the pool costs,
+	// capped at the maximum uint64 value.
+	Value uint64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// charge_per_bundle is the amount the funder currently gets
+	// charged per bundle, in units of the pool costs.
+	ChargePerBundle uint64 `protobuf:"varint,3,opt,name=charge_per_bundle,json=chargePerBundle,proto3" json:"charge_per_bundle,omitempty"`
+	// bundles_remaining is the estimated number of bundles
+	// until the funder gets removed from the pool.
+	BundlesRemaining uint64 `protobuf:"varint,4,opt,name=bundles_remaining,json=bundlesRemaining,proto3" json:"bundles_remaining,omitempty"`
+	// time_to_empty is the estimated time in seconds
+	// until the funder gets removed from the pool.
+	TimeToEmpty uint64 `protobuf:"varint,5,opt,name=time_to_empty,json=timeToEmpty,proto3" json:"time_to_empty,omitempty"`
+}
+
+func (m *FunderRunway) Reset()         { *m = FunderRunway{} }
+func (m *FunderRunway) String() string { return proto.CompactTextString(m) }
+func (*FunderRunway) ProtoMessage()    {}
+func (*FunderRunway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{7}
+}
+func (m *FunderRunway) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunderRunway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunderRunway.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunderRunway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunderRunway.Merge(m, src)
+}
+func (m *FunderRunway) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunderRunway) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunderRunway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunderRunway proto.InternalMessageInfo
+
+func (m *FunderRunway) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FunderRunway) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *FunderRunway) GetChargePerBundle() uint64 {
+	if m != nil {
+		return m.ChargePerBundle
+	}
+	return 0
+}
+
+func (m *FunderRunway) GetBundlesRemaining() uint64 {
+	if m != nil {
+		return m.BundlesRemaining
+	}
+	return 0
+}
+
+func (m *FunderRunway) GetTimeToEmpty() uint64 {
+	if m != nil {
+		return m.TimeToEmpty
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryPoolsRequest)(nil), "kyve.query.v1beta1.QueryPoolsRequest")
 	proto.RegisterType((*QueryPoolsResponse)(nil), "kyve.query.v1beta1.QueryPoolsResponse")
 	proto.RegisterType((*PoolResponse)(nil), "kyve.query.v1beta1.PoolResponse")
 	proto.RegisterType((*QueryPoolRequest)(nil), "kyve.query.v1beta1.QueryPoolRequest")
 	proto.RegisterType((*QueryPoolResponse)(nil), "kyve.query.v1beta1.QueryPoolResponse")
+	proto.RegisterType((*QueryPoolRunwayRequest)(nil), "kyve.query.v1beta1.QueryPoolRunwayRequest")
+	proto.RegisterType((*QueryPoolRunwayResponse)(nil), "kyve.query.v1beta1.QueryPoolRunwayResponse")
+	proto.RegisterType((*FunderRunway)(nil), "kyve.query.v1beta1.FunderRunway")
 }
 
 func init() { proto.RegisterFile("kyve/query/v1beta1/pools.proto", fileDescriptor_b627739c2d7723dc) }
 
 var fileDescriptor_b627739c2d7723dc = []byte{
-	// 628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0x8d, 0x5d, 0x37, 0xfd, 0x75, 0xfb, 0x53, 0x5b, 0x96, 0x7f, 0x26, 0x50, 0xd7, 0x58, 0x6d,
-	0x09, 0x45, 0xf2, 0xaa, 0x46, 0x5c, 0x10, 0xa7, 0xaa, 0xc0, 0x01, 0x01, 0xc5, 0x95, 0x90, 0xe0,
-	0x12, 0xad, 0xe3, 0xad, 0x63, 0xc5, 0xf1, 0xba, 0xde, 0x75, 0x21, 0x20, 0x2e, 0xfd, 0x04, 0x48,
-	0x1c, 0x39, 0xf3, 0x5d, 0x2a, 0x4e, 0x95, 0xb8, 0x70, 0x42, 0x28, 0xe1, 0x83, 0x20, 0xaf, 0xd7,
-	0xc1, 0x21, 0x84, 0x70, 0xf3, 0x78, 0xde, 0x9b, 0x79, 0x6f, 0x66, 0x6c, 0x60, 0x74, 0xfb, 0xc7,
-	0x04, 0x1d, 0x65, 0x24, 0xed, 0xa3, 0xe3, 0x1d, 0x8f, 0x70, 0xbc, 0x83, 0x12, 0x4a, 0x23, 0x66,
-	0x27, 0x29, 0xe5, 0x14, 0xc2, 0x3c, 0x6f, 0x8b, 0xbc, 0x2d, 0xf3, 0x8d, 0xed, 0x36, 0x65, 0x3d,
-	0xca, 0x90, 0x87, 0xd9, 0x04, 0x15, 0x07, 0x61, 0x8c, 0x79, 0x48, 0xe3, 0x82, 0xdf, 0xb8, 0x10,
-	0xd0, 0x80, 0x8a, 0x47, 0x94, 0x3f, 0xc9, 0xb7, 0xd7, 0x02, 0x4a, 0x83, 0x88, 0x20, 0x9c, 0x84,
-	0x08, 0xc7, 0x31, 0xe5, 0x82, 0x22, 0x7b, 0x36, 0x2c, 0xa1, 0xc9, 0xcb, 0x62, 0x3f, 0x22, 0x6c,
-	0x54, 0x5a, 0xc6, 0x65, 0x05, 0x81, 0xc9, 0x95, 0x8e, 0xc9, 0x2e, 0xb2, 0xd6, 0x27, 0x05, 0x9c,
-	0x7b, 0x96, 0x0b, 0xdb, 0xcf, 0xad, 0xb8, 0xe4, 0x28, 0x23, 0x8c, 0xc3, 0x07, 0x00, 0xfc, 0xd2,
-	0xa7, 0x2b, 0xa6, 0xd2, 0x5c, 0x72, 0xb6, 0xec, 0xc2, 0x8c, 0x9d, 0x9b, 0x19, 0xf7, 0x69, 0xef,
-	0xe3, 0x80, 0x48, 0xae, 0x5b, 0x61, 0xc2, 0x4b, 0xa0, 0xce, 0x08, 0x4e, 0xdb, 0x1d, 0x5d, 0x35,
-	0x95, 0xe6, 0xa2, 0x2b, 0x23, 0xa8, 0x83, 0x85, 0x34, 0x8b, 0x79, 0xd8, 0x23, 0xfa, 0x9c, 0x48,
-	0x94, 0x61, 0xce, 0x48, 0x70, 0xc6, 0x88, 0xaf, 0x6b, 0xa6, 0xd2, 0xfc, 0xcf, 0x95, 0x91, 0xf5,
-	0x51, 0x01, 0xb0, 0xaa, 0x93, 0x25, 0x34, 0x66, 0x04, 0xde, 0x03, 0xf3, 0x62, 0x07, 0xba, 0x62,
-	0xce, 0x35, 0x97, 0x1c, 0xd3, 0x9e, 0x5c, 0x82, 0x9d, 0x33, 0x4a, 0xc2, 0xae, 0x76, 0xfa, 0x6d,
-	0xbd, 0xe6, 0x16, 0x24, 0xf8, 0x70, 0xcc, 0xa6, 0x2a, 0x6c, 0xde, 0x98, 0x69, 0xb3, 0xa8, 0x54,
-	0xf5, 0x69, 0x7d, 0x56, 0xc1, 0xff, 0xd5, 0x36, 0x70, 0x19, 0xa8, 0xa1, 0x2f, 0x06, 0xa7, 0xb9,
-	0x6a, 0xe8, 0xc3, 0x5b, 0x40, 0xf3, 0x31, 0xc7, 0xb2, 0xc7, 0xe5, 0x42, 0xa6, 0x58, 0xc3, 0x98,
-	0x4a, 0x01, 0x82, 0x8f, 0xc1, 0x4a, 0xb1, 0xc2, 0x56, 0x92, 0xd2, 0x84, 0x32, 0x1c, 0x89, 0x29,
-	0x2d, 0x39, 0x1b, 0x05, 0xaf, 0xdc, 0x6f, 0x49, 0xdd, 0x15, 0xf1, 0xbe, 0xc4, 0xba, 0xcb, 0xde,
-	0x58, 0x9c, 0x0f, 0x9b, 0x71, 0xdc, 0x25, 0x29, 0xd3, 0x35, 0x73, 0x2e, 0x1f, 0xb6, 0x0c, 0xa1,
-	0x03, 0x2e, 0x72, 0xca, 0x71, 0xd4, 0x62, 0x24, 0x3a, 0x6c, 0xf9, 0x24, 0x22, 0x41, 0x31, 0x8a,
-	0x79, 0x21, 0xfc, 0xbc, 0x48, 0x1e, 0x90, 0xe8, 0x70, 0x6f, 0x94, 0x82, 0x37, 0xc1, 0x6a, 0xc1,
-	0xa9, 0xc0, 0xeb, 0x02, 0xbe, 0x22, 0xde, 0x57, 0xa0, 0x77, 0x40, 0x9d, 0x71, 0xcc, 0x33, 0xa6,
-	0x2f, 0x98, 0x4a, 0x73, 0xd9, 0x59, 0x9b, 0x62, 0xfb, 0x40, 0x80, 0x5c, 0x09, 0xb6, 0x2c, 0xb0,
-	0x3a, 0xda, 0x74, 0x79, 0x90, 0xbf, 0xcd, 0xd3, 0x7a, 0x5a, 0xb9, 0xda, 0xd1, 0xd0, 0xef, 0x02,
-	0x2d, 0xaf, 0x2d, 0xef, 0xf5, 0x5f, 0x6f, 0x41, 0x70, 0x9c, 0x13, 0x15, 0x2c, 0x8e, 0x2a, 0xc2,
-	0x3e, 0x98, 0x17, 0x77, 0x06, 0x37, 0xff, 0x54, 0x64, 0xe2, 0x7b, 0x69, 0x6c, 0xcd, 0x82, 0x15,
-	0x1d, 0xad, 0xeb, 0x27, 0x5f, 0x7e, 0x7c, 0x50, 0xaf, 0xc2, 0x2b, 0x68, 0xda, 0xcf, 0x04, 0xbe,
-	0x01, 0x9a, 0x90, 0xb0, 0xf1, 0xd7, 0x92, 0x65, 0xe3, 0xcd, 0x19, 0x28, 0xd9, 0x77, 0x53, 0xf4,
-	0x5d, 0x87, 0x6b, 0xd3, 0xfa, 0xa2, 0xb7, 0xa1, 0xff, 0x6e, 0x77, 0xef, 0x74, 0x60, 0x28, 0x67,
-	0x03, 0x43, 0xf9, 0x3e, 0x30, 0x94, 0xf7, 0x43, 0xa3, 0x76, 0x36, 0x34, 0x6a, 0x5f, 0x87, 0x46,
-	0xed, 0xe5, 0x76, 0x10, 0xf2, 0x4e, 0xe6, 0xd9, 0x6d, 0xda, 0x43, 0x8f, 0x5e, 0x3c, 0xbf, 0xff,
-	0x84, 0xf0, 0x57, 0x34, 0xed, 0xa2, 0x76, 0x07, 0x87, 0x31, 0x7a, 0x2d, 0x2b, 0xf2, 0x7e, 0x42,
-	0x98, 0x57, 0x17, 0x7f, 0x96, 0xdb, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x88, 0x98, 0x43, 0x81,
-	0x31, 0x05, 0x00, 0x00,
+	// 978 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4b, 0x6f, 0x1b, 0x55,
+	0x14, 0xce, 0xd8, 0xe3, 0x3c, 0x8e, 0x13, 0xdb, 0xb9, 0x94, 0x76, 0x6a, 0xa8, 0xe3, 0x8e, 0x92,
+	0xd4, 0x24, 0xc8, 0xa3, 0x1a, 0xb1, 0x41, 0x2c, 0x90, 0x69, 0x8b, 0xc4, 0xd3, 0x4c, 0x50, 0x25,
+	0xd8, 0x8c, 0xae, 0x3d, 0x37, 0x93, 0x51, 0xc6, 0x73, 0xa7, 0x73, 0xef, 0xa4, 0x38, 0x88, 0x0d,
+	0xbf, 0x00, 0xa9, 0x2c, 0x90, 0x58, 0xf3, 0x2b, 0xf8, 0x03, 0x5d, 0x56, 0x62, 0xc3, 0x0a, 0xa1,
+	0xa4, 0x3f, 0x04, 0xdd, 0xc7, 0xd8, 0xe3, 0x3a, 0xaf, 0x9d, 0xcf, 0x39, 0xdf, 0x77, 0x9e, 0xdf,
+	0x1d, 0x43, 0xeb, 0x78, 0x72, 0x42, 0x9c, 0x67, 0x19, 0x49, 0x27, 0xce, 0xc9, 0xc3, 0x21, 0xe1,
+	0xf8, 0xa1, 0x93, 0x50, 0x1a, 0xb1, 0x6e, 0x92, 0x52, 0x4e, 0x11, 0x12, 0xf1, 0xae, 0x8c, 0x77,
+	0x75, 0xbc, 0xb9, 0x37, 0xa2, 0x6c, 0x4c, 0x99, 0x33, 0xc4, 0x6c, 0x81, 0x8a, 0x83, 0x30, 0xc6,
+	0x3c, 0xa4, 0xb1, 0xe2, 0x37, 0x6f, 0x05, 0x34, 0xa0, 0xf2, 0xa7, 0x23, 0x7e, 0x69, 0xef, 0xbb,
+	0x01, 0xa5, 0x41, 0x44, 0x1c, 0x9c, 0x84, 0x0e, 0x8e, 0x63, 0xca, 0x25, 0x45, 0xd7, 0x6c, 0xda,
+	0xb2, 0xa7, 0x61, 0x16, 0xfb, 0x11, 0x61, 0xd3, 0xd4, 0xda, 0xce, 0x33, 0x48, 0x8c, 0xe8, 0x74,
+	0xae, 0x6d, 0x1d, 0xdd, 0x5a, 0x8c, 0xa6, 0x59, 0xcc, 0xc3, 0x31, 0x51, 0x00, 0xfb, 0x4f, 0x03,
+	0x36, 0xbf, 0x15, 0x9d, 0x0f, 0xc4, 0xac, 0x2e, 0x79, 0x96, 0x11, 0xc6, 0xd1, 0x13, 0x80, 0xd9,
+	0x00, 0x96, 0xd1, 0x36, 0x3a, 0xd5, 0xde, 0x6e, 0x57, 0x4d, 0xdb, 0x15, 0xd3, 0xce, 0x2f, 0xa2,
+	0x3b, 0xc0, 0x01, 0xd1, 0x5c, 0xb7, 0xc0, 0x44, 0xb7, 0x61, 0x99, 0x11, 0x9c, 0x8e, 0x8e, 0xac,
+	0x52, 0xdb, 0xe8, 0xac, 0xb9, 0xda, 0x42, 0x16, 0xac, 0xe8, 0x36, 0xac, 0xb2, 0x0c, 0xe4, 0xa6,
+	0x60, 0x24, 0x38, 0x63, 0xc4, 0xb7, 0xcc, 0xb6, 0xd1, 0x59, 0x75, 0xb5, 0x65, 0xff, 0x61, 0x00,
+	0x2a, 0xf6, 0xc9, 0x12, 0x1a, 0x33, 0x82, 0x3e, 0x86, 0x8a, 0x3c, 0x92, 0x65, 0xb4, 0xcb, 0x9d,
+	0x6a, 0xaf, 0xdd, 0x5d, 0xbc, 0x52, 0x57, 0x30, 0x72, 0x42, 0xdf, 0x7c, 0xf9, 0xef, 0xd6, 0x92,
+	0xab, 0x48, 0xe8, 0xb3, 0xb9, 0x31, 0x4b, 0x72, 0xcc, 0x07, 0xd7, 0x8e, 0xa9, 0x32, 0x15, 0xe7,
+	0xb4, 0x5f, 0x97, 0x61, 0xbd, 0x58, 0x06, 0xd5, 0xa0, 0x14, 0xfa, 0x72, 0x71, 0xa6, 0x5b, 0x0a,
+	0x7d, 0xb4, 0x0f, 0xa6, 0x8f, 0x39, 0xd6, 0x35, 0xee, 0xa8, 0x36, 0xe5, 0x9d, 0xe6, 0xba, 0x94,
+	0x20, 0xf4, 0x15, 0xd4, 0xd5, 0x8d, 0xbd, 0x24, 0xa5, 0x09, 0x65, 0x38, 0x92, 0x5b, 0xaa, 0xf6,
+	0xb6, 0x15, 0x2f, 0x17, 0x40, 0x4e, 0xed, 0x4b, 0x7b, 0xa0, 0xb1, 0x6e, 0x6d, 0x38, 0x67, 0x8b,
+	0x65, 0x33, 0x8e, 0x8f, 0x49, 0xca, 0x2c, 0xb3, 0x5d, 0x16, 0xcb, 0xd6, 0x26, 0xea, 0xc1, 0xdb,
+	0x9c, 0x72, 0x1c, 0x79, 0x8c, 0x44, 0x87, 0x9e, 0x4f, 0x22, 0x12, 0xa8, 0x55, 0x54, 0x64, 0xe3,
+	0x6f, 0xc9, 0xe0, 0x01, 0x89, 0x0e, 0x1f, 0x4d, 0x43, 0xe8, 0x3d, 0x68, 0x28, 0x4e, 0x01, 0xbe,
+	0x2c, 0xe1, 0x75, 0xe9, 0x2f, 0x40, 0x3f, 0x84, 0x65, 0xc6, 0x31, 0xcf, 0x98, 0xb5, 0xd2, 0x36,
+	0x3a, 0xb5, 0xde, 0xbd, 0x4b, 0xc6, 0x3e, 0x90, 0x20, 0x57, 0x83, 0xd1, 0x97, 0xb0, 0x29, 0xb5,
+	0x39, 0xa2, 0x91, 0x37, 0x0c, 0x63, 0x9c, 0x86, 0x84, 0x59, 0xab, 0xf2, 0xbe, 0x77, 0x2f, 0xc8,
+	0xd0, 0x17, 0x90, 0x89, 0x3e, 0x6c, 0x23, 0x67, 0xf6, 0x35, 0x11, 0x7d, 0x0e, 0x8d, 0x2c, 0x09,
+	0x52, 0xec, 0x93, 0x59, 0xb2, 0xb5, 0x9b, 0x25, 0xab, 0x6b, 0x62, 0x9e, 0xcb, 0xb6, 0xa1, 0x31,
+	0xd5, 0x60, 0xfe, 0x54, 0xde, 0xb8, 0xb4, 0xfd, 0x4d, 0xe1, 0x3d, 0x4d, 0xe5, 0xf0, 0x11, 0x98,
+	0xa2, 0x8c, 0x7e, 0x49, 0x37, 0x55, 0xa9, 0xe4, 0xd8, 0x7d, 0xb8, 0x3d, 0x4b, 0x98, 0xc5, 0xcf,
+	0xf1, 0xe4, 0x92, 0xd2, 0xe2, 0xd0, 0x5a, 0x1a, 0x52, 0x67, 0xa6, 0x9b, 0x9b, 0xf6, 0x6f, 0x65,
+	0xb8, 0xb3, 0x90, 0x44, 0xf7, 0xb6, 0x03, 0x35, 0x9a, 0x90, 0x14, 0xf3, 0x30, 0x0e, 0xbc, 0x11,
+	0x65, 0x5c, 0x67, 0xdc, 0x98, 0x7a, 0x3f, 0xa5, 0x8c, 0xa3, 0xfb, 0xb0, 0xce, 0x38, 0x4d, 0x71,
+	0x40, 0x14, 0x48, 0x55, 0xa8, 0x6a, 0x9f, 0x84, 0xec, 0xc1, 0x26, 0x3e, 0x21, 0x12, 0x32, 0x9c,
+	0x70, 0xe2, 0xb1, 0xf0, 0x54, 0xbd, 0x6f, 0xd3, 0xad, 0xeb, 0x40, 0x7f, 0xc2, 0xc9, 0x41, 0x78,
+	0x4a, 0xd0, 0x03, 0xa8, 0x33, 0x3c, 0x4e, 0x22, 0xe2, 0x7b, 0x79, 0xcf, 0xa6, 0x44, 0xd6, 0xb4,
+	0x5b, 0x89, 0x9a, 0xa1, 0x5d, 0xa8, 0x8b, 0x7a, 0x5e, 0x42, 0x52, 0x8d, 0xd4, 0xea, 0xdc, 0x10,
+	0xee, 0x01, 0x49, 0x15, 0x10, 0x6d, 0x41, 0x55, 0xe9, 0xf2, 0x04, 0x47, 0x19, 0xd1, 0x92, 0x04,
+	0xe9, 0x7a, 0x2a, 0x3c, 0x68, 0x1f, 0x36, 0x75, 0x25, 0x2f, 0x25, 0x63, 0x1c, 0xc6, 0x61, 0x1c,
+	0x48, 0x61, 0x9a, 0x6e, 0x43, 0x07, 0xdc, 0xdc, 0x8f, 0x6c, 0xd8, 0x10, 0x9f, 0x23, 0x8f, 0x53,
+	0x8f, 0x8c, 0x13, 0x3e, 0xb1, 0x56, 0xd5, 0xb8, 0xc2, 0xf9, 0x1d, 0x7d, 0x2c, 0x5c, 0xe8, 0x13,
+	0x58, 0x39, 0xcc, 0x62, 0x9f, 0xa4, 0xb9, 0xa0, 0x2e, 0xbc, 0xeb, 0x13, 0x09, 0x51, 0x3b, 0xd7,
+	0x77, 0xcd, 0x69, 0xf6, 0x5f, 0x06, 0xac, 0x17, 0xe3, 0xe2, 0x82, 0xd8, 0xf7, 0x53, 0xc2, 0x98,
+	0x3c, 0xc2, 0x9a, 0x9b, 0x9b, 0xe8, 0x16, 0x54, 0xd4, 0x60, 0x6a, 0xef, 0xca, 0x10, 0x1b, 0x1f,
+	0x1d, 0xe1, 0x34, 0x20, 0xc5, 0xf5, 0xe8, 0x8d, 0xab, 0xc0, 0x6c, 0x41, 0x17, 0xce, 0x6f, 0xde,
+	0x74, 0xfe, 0xca, 0xc2, 0xfc, 0xbd, 0xdf, 0xcb, 0xb0, 0x36, 0x15, 0x15, 0x9a, 0x40, 0x65, 0x20,
+	0x3f, 0xaa, 0x3b, 0x17, 0x6d, 0x61, 0xe1, 0x2f, 0xa6, 0xb9, 0x7b, 0x1d, 0x4c, 0xc9, 0xd3, 0xbe,
+	0xff, 0xcb, 0xdf, 0xaf, 0x5f, 0x94, 0xde, 0x41, 0x77, 0x9d, 0xcb, 0xfe, 0xa0, 0xd1, 0x29, 0x98,
+	0xb2, 0x85, 0xed, 0x2b, 0x53, 0xe6, 0x85, 0x77, 0xae, 0x41, 0xe9, 0xba, 0x3b, 0xb2, 0xee, 0x16,
+	0xba, 0x77, 0x59, 0x5d, 0xe7, 0xa7, 0xd0, 0xff, 0x19, 0xbd, 0x30, 0x00, 0x66, 0x8f, 0x0a, 0xed,
+	0x5d, 0x9d, 0xbc, 0xf8, 0x7c, 0x9b, 0xfb, 0x37, 0xc2, 0xea, 0x76, 0xde, 0x97, 0xed, 0xec, 0xa2,
+	0xed, 0x2b, 0xdb, 0x71, 0x52, 0xa5, 0xb3, 0x47, 0x2f, 0xcf, 0x5a, 0xc6, 0xab, 0xb3, 0x96, 0xf1,
+	0xdf, 0x59, 0xcb, 0xf8, 0xf5, 0xbc, 0xb5, 0xf4, 0xea, 0xbc, 0xb5, 0xf4, 0xcf, 0x79, 0x6b, 0xe9,
+	0x87, 0xbd, 0x20, 0xe4, 0x47, 0xd9, 0xb0, 0x3b, 0xa2, 0x63, 0xe7, 0x8b, 0xef, 0x9f, 0x3e, 0xfe,
+	0x9a, 0xf0, 0xe7, 0x34, 0x3d, 0x76, 0x46, 0x47, 0x38, 0x8c, 0x9d, 0x1f, 0x75, 0x62, 0x3e, 0x49,
+	0x08, 0x1b, 0x2e, 0xcb, 0x8f, 0xe9, 0x07, 0xff, 0x0f, 0x00, 0x3b, 0xdd, 0xe0, 0xd6, 0x1b, 0x09,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -422,6 +730,8 @@ type QueryPoolClient interface {
 	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
 	// Pool queries a pool by its Id.
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// PoolRunway estimates how long the funds of a pool and its funders will last.
+	PoolRunway(ctx context.Context, in *QueryPoolRunwayRequest, opts ...grpc.CallOption) (*QueryPoolRunwayResponse, error)
 }
 
 type queryPoolClient struct {
@@ -450,12 +760,23 @@ func (c *queryPoolClient) Pool(ctx context.Context, in *QueryPoolRequest, opts .
 	return out, nil
 }
 
+func (c *queryPoolClient) PoolRunway(ctx context.Context, in *QueryPoolRunwayRequest, opts ...grpc.CallOption) (*QueryPoolRunwayResponse, error) {
+	out := new(QueryPoolRunwayResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryPool/PoolRunway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryPoolServer is the server API for QueryPool service.
 type QueryPoolServer interface {
 	// Pools queries for all pools.
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
 	// Pool queries a pool by its Id.
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	// PoolRunway estimates how long the funds of a pool and its funders will last.
+	PoolRunway(context.Context, *QueryPoolRunwayRequest) (*QueryPoolRunwayResponse, error)
 }
 
 // UnimplementedQueryPoolServer can be embedded to have forward compatible implementations.
@@ -468,6 +789,9 @@ func (*UnimplementedQueryPoolServer) Pools(ctx context.Context, req *QueryPoolsR
 func (*UnimplementedQueryPoolServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
 }
+func (*UnimplementedQueryPoolServer) PoolRunway(ctx context.Context, req *QueryPoolRunwayRequest) (*QueryPoolRunwayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolRunway not implemented")
+}
 
 func RegisterQueryPoolServer(s grpc1.Server, srv QueryPoolServer) {
 	s.RegisterService(&_QueryPool_serviceDesc, srv)
@@ -509,6 +833,24 @@ func _QueryPool_Pool_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryPool_PoolRunway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRunwayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryPoolServer).PoolRunway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryPool/PoolRunway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryPoolServer).PoolRunway(ctx, req.(*QueryPoolRunwayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryPool_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kyve.query.v1beta1.QueryPool",
 	HandlerType: (*QueryPoolServer)(nil),
@@ -521,6 +863,10 @@ var _QueryPool_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Pool",
 			Handler:    _QueryPool_Pool_Handler,
 		},
+		{
+			MethodName: "PoolRunway",
+			Handler:    _QueryPool_PoolRunway_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kyve/query/v1beta1/pools.proto",
@@ -654,6 +1000,34 @@ func (m *PoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UpgradeBinaries) > 0 {
+		for iNdEx := len(m.UpgradeBinaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpgradeBinaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ProtocolBinaries) > 0 {
+		for iNdEx := len(m.ProtocolBinaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolBinaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Status != 0 {
 		i = encodeVarintPools(dAtA, i, uint64(m.Status))
 		i--
@@ -771,48 +1145,208 @@ func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPools(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPools(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPoolRunwayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryPoolRunwayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolRunwayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovPools(uint64(l))
-	}
-	l = len(m.Search)
-	if l > 0 {
-		n += 1 + l + sovPools(uint64(l))
-	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovPools(uint64(l))
+	if m.Bundles != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.Bundles))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Paused {
-		n += 2
+	if m.Id != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolRunwayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolRunwayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolRunwayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
+	if len(m.Funders) > 0 {
+		for iNdEx := len(m.Funders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TimeToEmpty != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.TimeToEmpty))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BundlesRemaining != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.BundlesRemaining))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalValue != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.TotalValue))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CostPerBundle != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.CostPerBundle))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SampledBundles != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.SampledBundles))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AverageByteSize != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.AverageByteSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StorageCost != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.StorageCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OperatingCost != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.OperatingCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunderRunway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunderRunway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunderRunway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeToEmpty != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.TimeToEmpty))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BundlesRemaining != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.BundlesRemaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChargePerBundle != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.ChargePerBundle))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Value != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPools(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPools(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPools(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovPools(uint64(l))
+	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovPools(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
 		for _, e := range m.Pools {
 			l = e.Size()
 			n += 1 + l + sovPools(uint64(l))
@@ -857,6 +1391,18 @@ func (m *PoolResponse) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovPools(uint64(m.Status))
 	}
+	if len(m.ProtocolBinaries) > 0 {
+		for _, e := range m.ProtocolBinaries {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	if len(m.UpgradeBinaries) > 0 {
+		for _, e := range m.UpgradeBinaries {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -883,6 +1429,85 @@ func (m *QueryPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPoolRunwayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPools(uint64(m.Id))
+	}
+	if m.Bundles != 0 {
+		n += 1 + sovPools(uint64(m.Bundles))
+	}
+	return n
+}
+
+func (m *QueryPoolRunwayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatingCost != 0 {
+		n += 1 + sovPools(uint64(m.OperatingCost))
+	}
+	if m.StorageCost != 0 {
+		n += 1 + sovPools(uint64(m.StorageCost))
+	}
+	if m.AverageByteSize != 0 {
+		n += 1 + sovPools(uint64(m.AverageByteSize))
+	}
+	if m.SampledBundles != 0 {
+		n += 1 + sovPools(uint64(m.SampledBundles))
+	}
+	if m.CostPerBundle != 0 {
+		n += 1 + sovPools(uint64(m.CostPerBundle))
+	}
+	if m.TotalValue != 0 {
+		n += 1 + sovPools(uint64(m.TotalValue))
+	}
+	if m.BundlesRemaining != 0 {
+		n += 1 + sovPools(uint64(m.BundlesRemaining))
+	}
+	if m.TimeToEmpty != 0 {
+		n += 1 + sovPools(uint64(m.TimeToEmpty))
+	}
+	if len(m.Funders) > 0 {
+		for _, e := range m.Funders {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FunderRunway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPools(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovPools(uint64(m.Value))
+	}
+	if m.ChargePerBundle != 0 {
+		n += 1 + sovPools(uint64(m.ChargePerBundle))
+	}
+	if m.BundlesRemaining != 0 {
+		n += 1 + sovPools(uint64(m.BundlesRemaining))
+	}
+	if m.TimeToEmpty != 0 {
+		n += 1 + sovPools(uint64(m.TimeToEmpty))
+	}
+	return n
+}
+
 func sovPools(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1388,6 +2013,74 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolBinaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolBinaries = append(m.ProtocolBinaries, types.Binary{})
+			if err := m.ProtocolBinaries[len(m.ProtocolBinaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeBinaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeBinaries = append(m.UpgradeBinaries, types.Binary{})
+			if err := m.UpgradeBinaries[len(m.UpgradeBinaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPools(dAtA[iNdEx:])
@@ -1561,6 +2254,488 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPoolRunwayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRunwayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRunwayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundles", wireType)
+			}
+			m.Bundles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bundles |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolRunwayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRunwayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRunwayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatingCost", wireType)
+			}
+			m.OperatingCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatingCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageCost", wireType)
+			}
+			m.StorageCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageByteSize", wireType)
+			}
+			m.AverageByteSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageByteSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampledBundles", wireType)
+			}
+			m.SampledBundles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampledBundles |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostPerBundle", wireType)
+			}
+			m.CostPerBundle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CostPerBundle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValue", wireType)
+			}
+			m.TotalValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalValue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundlesRemaining", wireType)
+			}
+			m.BundlesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundlesRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeToEmpty", wireType)
+			}
+			m.TimeToEmpty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeToEmpty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funders = append(m.Funders, FunderRunway{})
+			if err := m.Funders[len(m.Funders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunderRunway) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunderRunway: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunderRunway: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargePerBundle", wireType)
+			}
+			m.ChargePerBundle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargePerBundle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundlesRemaining", wireType)
+			}
+			m.BundlesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundlesRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeToEmpty", wireType)
+			}
+			m.TimeToEmpty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeToEmpty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPools(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,7 +5,10 @@ package types
 
 import (
 	fmt "fmt"
-	types "github.com/KYVENetwork/chain/x/pool/types"
+	types1 "github.com/KYVENetwork/chain/x/pool/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -37,10 +40,10 @@ type BasicPool struct {
 	Logo string `protobuf:"bytes,4,opt,name=logo,proto3" json:"logo,omitempty"`
 	// total_funds of the pool. If the pool runs
 	// out of funds no more bundles will be produced
-	TotalFunds uint64 `protobuf:"varint,5,opt,name=total_funds,json=totalFunds,proto3" json:"total_funds,omitempty"`
+	TotalFunds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_funds,json=totalFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_funds"`
 	// status of the pool if pool is able
 	// to produce bundles, etc.
-	Status types.PoolStatus `protobuf:"varint,6,opt,name=status,proto3,enum=kyve.pool.v1beta1.PoolStatus" json:"status,omitempty"`
+	Status types1.PoolStatus `protobuf:"varint,6,opt,name=status,proto3,enum=kyve.pool.v1beta1.PoolStatus" json:"status,omitempty"`
 }
 
 func (m *BasicPool) Reset()         { *m = BasicPool{} }
@@ -104,18 +107,18 @@ func (m *BasicPool) GetLogo() string {
 	return ""
 }
 
-func (m *BasicPool) GetTotalFunds() uint64 {
+func (m *BasicPool) GetTotalFunds() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.TotalFunds
 	}
-	return 0
+	return nil
 }
 
-func (m *BasicPool) GetStatus() types.PoolStatus {
+func (m *BasicPool) GetStatus() types1.PoolStatus {
 	if m != nil {
 		return m.Status
 	}
-	return types.POOL_STATUS_UNSPECIFIED
+	return types1.POOL_STATUS_UNSPECIFIED
 }
 
 // FullStaker aggregates information from the staker and its delegators
@@ -247,6 +250,17 @@ type StakerMetadata struct {
 	// the commission is applied. Users have time to redelegate
 	// if they not agree with the new commission.
 	PendingCommissionChange *CommissionChangeEntry `protobuf:"bytes,9,opt,name=pending_commission_change,json=pendingCommissionChange,proto3" json:"pending_commission_change,omitempty"`
+	// pending_retirement shows if the staker is retiring.
+	// All delegations are unbonding and the staker gets
+	// removed once the unbonding is over.
+	PendingRetirement *RetireStakerEntry `protobuf:"bytes,10,opt,name=pending_retirement,json=pendingRetirement,proto3" json:"pending_retirement,omitempty"`
+	// max_commission is the highest commission the staker
+	// can ever charge. It can not be changed.
+	MaxCommission string `protobuf:"bytes,11,opt,name=max_commission,json=maxCommission,proto3" json:"max_commission,omitempty"`
+	// max_commission_change_rate is the maximum amount a single
+	// commission change can differ from the current commission.
+	// It can not be changed.
+	MaxCommissionChangeRate string `protobuf:"bytes,12,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3" json:"max_commission_change_rate,omitempty"`
 }
 
 func (m *StakerMetadata) Reset()         { *m = StakerMetadata{} }
@@ -317,6 +331,27 @@ func (m *StakerMetadata) GetPendingCommissionChange() *CommissionChangeEntry {
 	return nil
 }
 
+func (m *StakerMetadata) GetPendingRetirement() *RetireStakerEntry {
+	if m != nil {
+		return m.PendingRetirement
+	}
+	return nil
+}
+
+func (m *StakerMetadata) GetMaxCommission() string {
+	if m != nil {
+		return m.MaxCommission
+	}
+	return ""
+}
+
+func (m *StakerMetadata) GetMaxCommissionChangeRate() string {
+	if m != nil {
+		return m.MaxCommissionChangeRate
+	}
+	return ""
+}
+
 // CommissionChangeEntry shows when the old commission
 // of a staker will change to the new commission
 type CommissionChangeEntry struct {
@@ -375,6 +410,53 @@ func (m *CommissionChangeEntry) GetCreationDate() int64 {
 	return 0
 }
 
+// RetireStakerEntry shows when a staker started to retire
+type RetireStakerEntry struct {
+	// creation_date is the UNIX-timestamp (in seconds)
+	// of when the entry was created.
+	CreationDate int64 `protobuf:"varint,1,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+}
+
+func (m *RetireStakerEntry) Reset()         { *m = RetireStakerEntry{} }
+func (m *RetireStakerEntry) String() string { return proto.CompactTextString(m) }
+func (*RetireStakerEntry) ProtoMessage()    {}
+func (*RetireStakerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b41255feae93a15, []int{4}
+}
+func (m *RetireStakerEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetireStakerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetireStakerEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetireStakerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetireStakerEntry.Merge(m, src)
+}
+func (m *RetireStakerEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetireStakerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetireStakerEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetireStakerEntry proto.InternalMessageInfo
+
+func (m *RetireStakerEntry) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
+	}
+	return 0
+}
+
 // PoolMembership shows in which pool the staker
 // is participating
 type PoolMembership struct {
@@ -393,13 +475,20 @@ type PoolMembership struct {
 	// and submit bundles. If the server gets compromised
 	// the staker can just change the valaddress.
 	Valaddress string `protobuf:"bytes,4,opt,name=valaddress,proto3" json:"valaddress,omitempty"`
+	// jailed_until is the unix time until the staker is jailed
+	// in this pool. A jailed staker can neither upload nor vote
+	// until it gets unjailed. Zero means not jailed.
+	JailedUntil uint64 `protobuf:"varint,5,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	// jail_count is the number of times the staker
+	// got jailed in this pool.
+	JailCount uint64 `protobuf:"varint,6,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
 }
 
 func (m *PoolMembership) Reset()         { *m = PoolMembership{} }
 func (m *PoolMembership) String() string { return proto.CompactTextString(m) }
 func (*PoolMembership) ProtoMessage()    {}
 func (*PoolMembership) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b41255feae93a15, []int{4}
+	return fileDescriptor_6b41255feae93a15, []int{5}
 }
 func (m *PoolMembership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,57 +545,84 @@ func (m *PoolMembership) GetValaddress() string {
 	return ""
 }
 
+func (m *PoolMembership) GetJailedUntil() uint64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+func (m *PoolMembership) GetJailCount() uint64 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BasicPool)(nil), "kyve.query.v1beta1.BasicPool")
 	proto.RegisterType((*FullStaker)(nil), "kyve.query.v1beta1.FullStaker")
 	proto.RegisterType((*StakerMetadata)(nil), "kyve.query.v1beta1.StakerMetadata")
 	proto.RegisterType((*CommissionChangeEntry)(nil), "kyve.query.v1beta1.CommissionChangeEntry")
+	proto.RegisterType((*RetireStakerEntry)(nil), "kyve.query.v1beta1.RetireStakerEntry")
 	proto.RegisterType((*PoolMembership)(nil), "kyve.query.v1beta1.PoolMembership")
 }
 
 func init() { proto.RegisterFile("kyve/query/v1beta1/query.proto", fileDescriptor_6b41255feae93a15) }
 
 var fileDescriptor_6b41255feae93a15 = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xae, 0x53, 0x37, 0x6d, 0xa6, 0x90, 0xa2, 0x95, 0xa0, 0x2e, 0xa2, 0xa6, 0x0a, 0x87, 0xb6,
-	0x1c, 0x1c, 0xb5, 0x08, 0x09, 0x71, 0xe0, 0xd0, 0xbf, 0x0b, 0x14, 0xa1, 0x45, 0x20, 0x81, 0x90,
-	0xac, 0x8d, 0x3d, 0x4d, 0x56, 0xb1, 0x77, 0x83, 0x77, 0x9d, 0x92, 0xb7, 0xe0, 0xcc, 0x7b, 0xf0,
-	0x0e, 0x1c, 0x7b, 0x84, 0x13, 0xa8, 0x79, 0x11, 0xb4, 0xeb, 0x1f, 0x92, 0x52, 0xc4, 0xcd, 0xf3,
-	0xcd, 0x37, 0xb3, 0xf3, 0x7d, 0x3b, 0x6b, 0xf0, 0x87, 0x93, 0x31, 0x76, 0x3f, 0xe6, 0x98, 0x4d,
-	0xba, 0xe3, 0xbd, 0x1e, 0x6a, 0xb6, 0x57, 0x44, 0xc1, 0x28, 0x93, 0x5a, 0x12, 0x62, 0xf2, 0x41,
-	0x81, 0x94, 0xf9, 0xbb, 0xf7, 0x6c, 0xcd, 0x48, 0xca, 0xa4, 0x2e, 0x31, 0x41, 0x51, 0xd1, 0xf9,
-	0xea, 0x40, 0xeb, 0x80, 0x29, 0x1e, 0xbd, 0x92, 0x32, 0x21, 0x6d, 0x68, 0xf0, 0xd8, 0x73, 0xb6,
-	0x9c, 0x1d, 0x97, 0x36, 0x78, 0x4c, 0x08, 0xb8, 0x82, 0xa5, 0xe8, 0x35, 0xb6, 0x9c, 0x9d, 0x16,
-	0xb5, 0xdf, 0xc4, 0x83, 0xe5, 0x2c, 0x17, 0x9a, 0xa7, 0xe8, 0x2d, 0x5a, 0xb8, 0x0a, 0x0d, 0x3b,
-	0x91, 0x7d, 0xe9, 0xb9, 0x05, 0xdb, 0x7c, 0x93, 0xfb, 0xb0, 0xaa, 0xa5, 0x66, 0x49, 0x78, 0x96,
-	0x8b, 0x58, 0x79, 0x4b, 0xb6, 0x35, 0x58, 0xe8, 0xc4, 0x20, 0xe4, 0x31, 0x34, 0x95, 0x66, 0x3a,
-	0x57, 0x5e, 0x73, 0xcb, 0xd9, 0x69, 0xef, 0x6f, 0x06, 0x56, 0x83, 0x1d, 0xb1, 0x9c, 0x37, 0x30,
-	0xb3, 0xbd, 0xb6, 0x24, 0x5a, 0x92, 0x3b, 0x3f, 0x1a, 0x00, 0x27, 0x79, 0x62, 0xe0, 0x21, 0x66,
-	0x66, 0x28, 0x16, 0xc7, 0x19, 0x2a, 0x65, 0xa7, 0x6f, 0xd1, 0x2a, 0x24, 0xcf, 0x60, 0x25, 0x45,
-	0xcd, 0x62, 0xa6, 0x99, 0x95, 0xb1, 0xba, 0xdf, 0x09, 0xfe, 0x76, 0x29, 0x28, 0xfa, 0x9c, 0x96,
-	0x4c, 0x5a, 0xd7, 0x90, 0x6d, 0x58, 0x53, 0x98, 0x9c, 0x85, 0x31, 0x26, 0xd8, 0x67, 0x9a, 0x4b,
-	0x61, 0x65, 0xbb, 0xb4, 0x6d, 0xe0, 0xa3, 0x1a, 0x25, 0x4f, 0x61, 0xe3, 0x0a, 0x31, 0xcc, 0x45,
-	0x4f, 0x8a, 0x98, 0x8b, 0xbe, 0xb5, 0xc4, 0xa5, 0xeb, 0xf3, 0x25, 0x6f, 0xaa, 0x34, 0xd9, 0x85,
-	0x5b, 0x85, 0x4b, 0x33, 0xa7, 0xac, 0xd8, 0x92, 0x35, 0x8b, 0xcf, 0x1c, 0xb3, 0x0d, 0x6b, 0x25,
-	0x49, 0x66, 0x61, 0x24, 0x73, 0xa1, 0x4b, 0x53, 0xdb, 0x35, 0x7c, 0x68, 0x50, 0xf2, 0x04, 0x96,
-	0x8c, 0x89, 0xc6, 0xd7, 0xc5, 0x7f, 0xa9, 0x36, 0xc6, 0x9e, 0x62, 0xda, 0xc3, 0x4c, 0x0d, 0xf8,
-	0x88, 0x16, 0x05, 0x9d, 0x9f, 0x0e, 0xb4, 0xe7, 0xfd, 0x20, 0x3e, 0x40, 0x24, 0xd3, 0x94, 0x2b,
-	0x65, 0x46, 0x2b, 0x2c, 0x9e, 0x41, 0x8c, 0xff, 0xa9, 0x14, 0x7c, 0x88, 0x59, 0xb9, 0x2b, 0x55,
-	0x68, 0x32, 0xe7, 0xd8, 0x53, 0x5c, 0xa3, 0xbd, 0xe0, 0x16, 0xad, 0xc2, 0x7a, 0x5d, 0x96, 0x67,
-	0xd6, 0x05, 0x61, 0x63, 0x84, 0xd6, 0x93, 0xf0, 0x4f, 0xf7, 0x30, 0x1a, 0x30, 0xd1, 0x47, 0xaf,
-	0x65, 0xaf, 0x6f, 0xf7, 0x3a, 0x21, 0x87, 0x35, 0xf9, 0xd0, 0x72, 0x8f, 0x85, 0xce, 0x26, 0x74,
-	0xbd, 0xec, 0x75, 0x35, 0xdb, 0xf9, 0x00, 0xb7, 0xaf, 0xad, 0xf8, 0xaf, 0xce, 0x07, 0x70, 0x33,
-	0xca, 0xb0, 0xb8, 0xdd, 0x98, 0xe9, 0xe2, 0x65, 0x2c, 0xd2, 0x1b, 0x15, 0x78, 0xc4, 0x34, 0x76,
-	0xbe, 0x38, 0xd0, 0x9e, 0x77, 0x96, 0xec, 0x81, 0x6b, 0xbc, 0xb5, 0x1d, 0x57, 0xab, 0x1d, 0x9f,
-	0x97, 0x50, 0xbf, 0x42, 0x6a, 0xa9, 0xe4, 0x0e, 0x34, 0x47, 0x92, 0x0b, 0xad, 0xec, 0x19, 0x2e,
-	0x2d, 0x23, 0xb2, 0x09, 0xc0, 0x55, 0x98, 0x20, 0x1b, 0x9b, 0xc5, 0x32, 0xbb, 0xb8, 0x42, 0x5b,
-	0x5c, 0xbd, 0x28, 0x00, 0xa3, 0x60, 0xcc, 0x92, 0xea, 0x31, 0x14, 0x4f, 0x71, 0x06, 0x39, 0x38,
-	0xfa, 0x76, 0xe9, 0x3b, 0x17, 0x97, 0xbe, 0xf3, 0xeb, 0xd2, 0x77, 0x3e, 0x4f, 0xfd, 0x85, 0x8b,
-	0xa9, 0xbf, 0xf0, 0x7d, 0xea, 0x2f, 0xbc, 0x7f, 0xd8, 0xe7, 0x7a, 0x90, 0xf7, 0x82, 0x48, 0xa6,
-	0xdd, 0xe7, 0xef, 0xde, 0x1e, 0xbf, 0x44, 0x7d, 0x2e, 0xb3, 0x61, 0x37, 0x1a, 0x30, 0x2e, 0xba,
-	0x9f, 0xca, 0xdf, 0x8e, 0x9e, 0x8c, 0x50, 0xf5, 0x9a, 0xf6, 0xef, 0xf1, 0xe8, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x3d, 0x32, 0x90, 0x63, 0x91, 0x04, 0x00, 0x00,
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xd1, 0x6e, 0x23, 0x35,
+	0x14, 0xcd, 0x24, 0x69, 0xb6, 0xb9, 0xe9, 0xa6, 0xac, 0x05, 0x74, 0x5a, 0xd1, 0xd9, 0x10, 0xb4,
+	0xda, 0x2c, 0x12, 0x33, 0x34, 0x08, 0x69, 0x05, 0x12, 0x0f, 0x4d, 0x77, 0x5f, 0xa0, 0x08, 0x19,
+	0x8a, 0x04, 0x42, 0x1a, 0x39, 0x33, 0x6e, 0x62, 0x32, 0x63, 0x87, 0xb1, 0x27, 0x6d, 0x5e, 0xf9,
+	0x02, 0x5e, 0xf9, 0x05, 0xbe, 0xa4, 0x8f, 0x7d, 0x04, 0x09, 0x01, 0x6a, 0x7f, 0x04, 0xd9, 0x9e,
+	0x99, 0x26, 0x6d, 0x10, 0x4f, 0x99, 0x7b, 0x7c, 0xee, 0xbd, 0x3e, 0xc7, 0xf6, 0x0d, 0x78, 0xb3,
+	0xe5, 0x82, 0x06, 0x3f, 0xe5, 0x34, 0x5b, 0x06, 0x8b, 0xa3, 0x31, 0x55, 0xe4, 0xc8, 0x46, 0xfe,
+	0x3c, 0x13, 0x4a, 0x20, 0xa4, 0xd7, 0x7d, 0x8b, 0x14, 0xeb, 0x07, 0x5e, 0x24, 0x64, 0x2a, 0x64,
+	0x30, 0x26, 0x92, 0x56, 0x49, 0x91, 0x60, 0xdc, 0xe6, 0x1c, 0xbc, 0x39, 0x11, 0x13, 0x61, 0x3e,
+	0x03, 0xfd, 0x55, 0xa0, 0xef, 0x98, 0x4e, 0x73, 0x21, 0x92, 0x2a, 0x47, 0x07, 0x76, 0xb5, 0xff,
+	0x73, 0x1d, 0xda, 0xc7, 0x44, 0xb2, 0xe8, 0x2b, 0x21, 0x12, 0xd4, 0x85, 0x3a, 0x8b, 0x5d, 0xa7,
+	0xe7, 0x0c, 0x9a, 0xb8, 0xce, 0x62, 0x84, 0xa0, 0xc9, 0x49, 0x4a, 0xdd, 0x7a, 0xcf, 0x19, 0xb4,
+	0xb1, 0xf9, 0x46, 0x2e, 0x3c, 0xca, 0x72, 0xae, 0x58, 0x4a, 0xdd, 0x86, 0x81, 0xcb, 0x50, 0xb3,
+	0x13, 0x31, 0x11, 0x6e, 0xd3, 0xb2, 0xf5, 0x37, 0x4a, 0xa0, 0xa3, 0x84, 0x22, 0x49, 0x78, 0x9e,
+	0xf3, 0x58, 0xba, 0x5b, 0xbd, 0xc6, 0xa0, 0x33, 0xdc, 0xf7, 0xad, 0x12, 0x5f, 0x2b, 0x29, 0xe5,
+	0xf9, 0x23, 0xc1, 0xf8, 0xf1, 0x87, 0x57, 0x7f, 0x3d, 0xad, 0xfd, 0xf6, 0xf7, 0xd3, 0xc1, 0x84,
+	0xa9, 0x69, 0x3e, 0xf6, 0x23, 0x91, 0x06, 0x85, 0x6c, 0xfb, 0xf3, 0x81, 0x8c, 0x67, 0x81, 0x5a,
+	0xce, 0xa9, 0x34, 0x09, 0x12, 0x83, 0xa9, 0xff, 0x5a, 0x97, 0x47, 0x1f, 0x43, 0x4b, 0x2a, 0xa2,
+	0x72, 0xe9, 0xb6, 0x7a, 0xce, 0xa0, 0x3b, 0x3c, 0xf4, 0x8d, 0x8d, 0x46, 0x6f, 0xd9, 0x46, 0x0b,
+	0xfd, 0xda, 0x90, 0x70, 0x41, 0xee, 0xff, 0x51, 0x07, 0x78, 0x9d, 0x27, 0x1a, 0x9e, 0xd1, 0x4c,
+	0x2b, 0x24, 0x71, 0x9c, 0x51, 0x29, 0x8d, 0x15, 0x6d, 0x5c, 0x86, 0xe8, 0x33, 0xd8, 0x4e, 0xa9,
+	0x22, 0x31, 0x51, 0xc4, 0x78, 0xd2, 0x19, 0xf6, 0xfd, 0x87, 0x07, 0xe5, 0xdb, 0x3a, 0xa7, 0x05,
+	0x13, 0x57, 0x39, 0xe8, 0x39, 0xec, 0x4a, 0x9a, 0x9c, 0x87, 0x31, 0x4d, 0xe8, 0x84, 0x28, 0x26,
+	0xb8, 0xf1, 0xb0, 0x89, 0xbb, 0x1a, 0x3e, 0xa9, 0x50, 0xf4, 0x09, 0xec, 0xdf, 0x23, 0x86, 0x39,
+	0x1f, 0x0b, 0x1e, 0x33, 0x3e, 0x31, 0xfe, 0x36, 0xf1, 0xde, 0x7a, 0xca, 0x59, 0xb9, 0x8c, 0x5e,
+	0xc0, 0x1b, 0xd6, 0xf2, 0x95, 0x2e, 0xdb, 0x26, 0x65, 0xd7, 0xe0, 0x2b, 0x6d, 0x9e, 0xc3, 0x6e,
+	0x41, 0x12, 0x59, 0x18, 0x89, 0x9c, 0x2b, 0x77, 0xcb, 0xee, 0xa7, 0x82, 0x47, 0x1a, 0x45, 0x2f,
+	0x61, 0x4b, 0x9b, 0xa8, 0x7d, 0x6d, 0xfc, 0x97, 0x6a, 0x6d, 0xec, 0x29, 0x4d, 0xc7, 0x34, 0x93,
+	0x53, 0x36, 0xc7, 0x36, 0xa1, 0xff, 0x6b, 0x03, 0xba, 0xeb, 0x7e, 0x20, 0x0f, 0x20, 0x12, 0x69,
+	0xca, 0xa4, 0xd4, 0x5b, 0xb3, 0x16, 0xaf, 0x20, 0xda, 0xff, 0x54, 0x70, 0x36, 0xa3, 0x59, 0x71,
+	0xf1, 0xca, 0x50, 0xaf, 0x5c, 0xd0, 0xb1, 0x64, 0x8a, 0x9a, 0x03, 0x6e, 0xe3, 0x32, 0xac, 0xee,
+	0xde, 0xa3, 0x95, 0xbb, 0x47, 0x61, 0x7f, 0x4e, 0x8d, 0x27, 0xe1, 0x5d, 0xf5, 0x30, 0x9a, 0x12,
+	0x3e, 0xa1, 0x6e, 0xdb, 0x1c, 0xdf, 0x8b, 0x4d, 0x42, 0x46, 0x15, 0x79, 0x64, 0xb8, 0xaf, 0xb8,
+	0xca, 0x96, 0x78, 0xaf, 0xa8, 0x75, 0x7f, 0x15, 0x7d, 0x03, 0xa8, 0x6c, 0x93, 0x51, 0xc5, 0x32,
+	0x9a, 0x52, 0xae, 0x5c, 0x30, 0xf5, 0x9f, 0x6d, 0xaa, 0x8f, 0x0d, 0xcb, 0x9a, 0x62, 0x6b, 0x3f,
+	0x29, 0x0a, 0xe0, 0x2a, 0x1f, 0x3d, 0x83, 0x6e, 0x4a, 0x2e, 0x57, 0x36, 0xee, 0x76, 0x8c, 0xb4,
+	0xc7, 0x29, 0xb9, 0xbc, 0xdb, 0x02, 0xfa, 0x14, 0x0e, 0xd6, 0x69, 0x85, 0xbe, 0x30, 0x23, 0x8a,
+	0xba, 0x3b, 0x26, 0x65, 0x6f, 0x2d, 0xc5, 0xee, 0x1a, 0x13, 0x45, 0xfb, 0x3f, 0xc0, 0x5b, 0x1b,
+	0xb5, 0xfe, 0xef, 0x09, 0xbd, 0x07, 0x8f, 0xa3, 0x8c, 0xda, 0x7b, 0x19, 0xeb, 0x46, 0xfa, 0x9c,
+	0x1a, 0x78, 0xa7, 0x04, 0x4f, 0x74, 0xf5, 0x97, 0xf0, 0xe4, 0x81, 0xd2, 0x87, 0x99, 0xce, 0x86,
+	0xcc, 0x3f, 0x1d, 0xe8, 0xae, 0xdf, 0x26, 0x74, 0x04, 0x4d, 0x7d, 0x9f, 0x0c, 0xbd, 0x33, 0x3c,
+	0xdc, 0x64, 0x6b, 0x35, 0xc6, 0xb0, 0xa1, 0xa2, 0xb7, 0xa1, 0x35, 0x17, 0x8c, 0x2b, 0x69, 0x76,
+	0xd7, 0xc4, 0x45, 0x84, 0x0e, 0x01, 0x98, 0x0c, 0x13, 0x4a, 0x16, 0xfa, 0x31, 0xe9, 0xf7, 0xb7,
+	0x8d, 0xdb, 0x4c, 0x7e, 0x61, 0x01, 0xad, 0x7d, 0x41, 0x92, 0x72, 0x00, 0xd8, 0x59, 0xb6, 0x82,
+	0xa0, 0x77, 0x61, 0xe7, 0x47, 0xc2, 0x12, 0x1a, 0x87, 0x7a, 0xec, 0x25, 0xc5, 0x83, 0xe9, 0x58,
+	0xec, 0x4c, 0x43, 0xba, 0x83, 0x0e, 0x8b, 0x17, 0xd5, 0x32, 0x84, 0xb6, 0x46, 0xcc, 0x63, 0x3a,
+	0x3e, 0xb9, 0xba, 0xf1, 0x9c, 0xeb, 0x1b, 0xcf, 0xf9, 0xe7, 0xc6, 0x73, 0x7e, 0xb9, 0xf5, 0x6a,
+	0xd7, 0xb7, 0x5e, 0xed, 0xf7, 0x5b, 0xaf, 0xf6, 0xfd, 0xfb, 0x2b, 0x53, 0xef, 0xf3, 0xef, 0xbe,
+	0x7d, 0xf5, 0x25, 0x55, 0x17, 0x22, 0x9b, 0x05, 0xd1, 0x94, 0x30, 0x1e, 0x5c, 0x16, 0xff, 0x17,
+	0x66, 0xfa, 0x8d, 0x5b, 0x66, 0x80, 0x7f, 0xf4, 0xef, 0x00, 0xc2, 0xbe, 0xa2, 0x21, 0x4a, 0x06,
+	0x00, 0x00,
 }
 
 func (m *BasicPool) Marshal() (dAtA []byte, err error) {
@@ -534,10 +650,19 @@ func (m *BasicPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.TotalFunds != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalFunds))
-		i--
-		dAtA[i] = 0x28
+	if len(m.TotalFunds) > 0 {
+		for iNdEx := len(m.TotalFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
 	if len(m.Logo) > 0 {
 		i -= len(m.Logo)
@@ -664,6 +789,32 @@ func (m *StakerMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxCommissionChangeRate) > 0 {
+		i -= len(m.MaxCommissionChangeRate)
+		copy(dAtA[i:], m.MaxCommissionChangeRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MaxCommissionChangeRate)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.MaxCommission) > 0 {
+		i -= len(m.MaxCommission)
+		copy(dAtA[i:], m.MaxCommission)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MaxCommission)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PendingRetirement != nil {
+		{
+			size, err := m.PendingRetirement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.PendingCommissionChange != nil {
 		{
 			size, err := m.PendingCommissionChange.MarshalToSizedBuffer(dAtA[:i])
@@ -742,6 +893,34 @@ func (m *CommissionChangeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetireStakerEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetireStakerEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetireStakerEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationDate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreationDate))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolMembership) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -762,6 +941,16 @@ func (m *PoolMembership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JailCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.JailedUntil != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Valaddress) > 0 {
 		i -= len(m.Valaddress)
 		copy(dAtA[i:], m.Valaddress)
@@ -831,8 +1020,11 @@ func (m *BasicPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.TotalFunds != 0 {
-		n += 1 + sovQuery(uint64(m.TotalFunds))
+	if len(m.TotalFunds) > 0 {
+		for _, e := range m.TotalFunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	if m.Status != 0 {
 		n += 1 + sovQuery(uint64(m.Status))
@@ -901,6 +1093,18 @@ func (m *StakerMetadata) Size() (n int) {
 		l = m.PendingCommissionChange.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.PendingRetirement != nil {
+		l = m.PendingRetirement.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MaxCommission)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MaxCommissionChangeRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -920,6 +1124,18 @@ func (m *CommissionChangeEntry) Size() (n int) {
 	return n
 }
 
+func (m *RetireStakerEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationDate != 0 {
+		n += 1 + sovQuery(uint64(m.CreationDate))
+	}
+	return n
+}
+
 func (m *PoolMembership) Size() (n int) {
 	if m == nil {
 		return 0
@@ -940,6 +1156,12 @@ func (m *PoolMembership) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovQuery(uint64(m.JailedUntil))
+	}
+	if m.JailCount != 0 {
+		n += 1 + sovQuery(uint64(m.JailCount))
+	}
 	return n
 }
 
@@ -1094,10 +1316,10 @@ func (m *BasicPool) Unmarshal(dAtA []byte) error {
 			m.Logo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TotalFunds", wireType)
 			}
-			m.TotalFunds = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1107,11 +1329,26 @@ func (m *BasicPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalFunds |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFunds = append(m.TotalFunds, types.Coin{})
+			if err := m.TotalFunds[len(m.TotalFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
@@ -1126,7 +1363,7 @@ func (m *BasicPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= types.PoolStatus(b&0x7F) << shift
+				m.Status |= types1.PoolStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1573,6 +1810,106 @@ func (m *StakerMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRetirement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingRetirement == nil {
+				m.PendingRetirement = &RetireStakerEntry{}
+			}
+			if err := m.PendingRetirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxCommission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxCommissionChangeRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1695,6 +2032,75 @@ func (m *CommissionChangeEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RetireStakerEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetireStakerEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetireStakerEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+			}
+			m.CreationDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolMembership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1831,6 +2237,44 @@ func (m *PoolMembership) Unmarshal(dAtA []byte) error {
 			}
 			m.Valaddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
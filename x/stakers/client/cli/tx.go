@@ -21,8 +21,13 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateStaker())
 	cmd.AddCommand(CmdJoinPool())
 	cmd.AddCommand(CmdLeavePool())
+	cmd.AddCommand(CmdUnjail())
 	cmd.AddCommand(CmdUpdateCommission())
 	cmd.AddCommand(CmdUpdateMetadata())
+	cmd.AddCommand(CmdUpdateValaddress())
+	cmd.AddCommand(CmdRetireStaker())
+	cmd.AddCommand(CmdCancelLeavePool())
+	cmd.AddCommand(CmdCancelCommissionChange())
 
 	return cmd
 }
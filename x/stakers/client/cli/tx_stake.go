@@ -9,6 +9,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagMaxCommission           = "max-commission"
+	FlagMaxCommissionChangeRate = "max-commission-change-rate"
+)
+
 func CmdCreateStaker() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-staker [amount]",
@@ -21,14 +26,26 @@ func CmdCreateStaker() *cobra.Command {
 				return err
 			}
 
+			maxCommission, err := cmd.Flags().GetString(FlagMaxCommission)
+			if err != nil {
+				return err
+			}
+
+			maxCommissionChangeRate, err := cmd.Flags().GetString(FlagMaxCommissionChangeRate)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
 			msg := types.MsgCreateStaker{
-				Creator: clientCtx.GetFromAddress().String(),
-				Amount:  argAmount,
+				Creator:                 clientCtx.GetFromAddress().String(),
+				Amount:                  argAmount,
+				MaxCommission:           maxCommission,
+				MaxCommissionChangeRate: maxCommissionChangeRate,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -39,6 +56,9 @@ func CmdCreateStaker() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagMaxCommission, "", "The highest commission the staker can ever charge, defaults to the global max commission")
+	cmd.Flags().String(FlagMaxCommissionChangeRate, "", "The maximum amount a single commission change can differ from the current commission, unlimited by default")
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdUpdateValaddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-valaddress [pool_id] [old_valaddress] [valaddress] [amount]",
+		Short: "Broadcast message update-valaddress, the old valaddress has to sign as well if the amount is not zero",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+			argPoolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argOldValaddress := args[1]
+			argValaddress := args[2]
+
+			argAmount, err := cast.ToUint64E(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateValaddress{
+				Creator:       clientCtx.GetFromAddress().String(),
+				PoolId:        argPoolId,
+				OldValaddress: argOldValaddress,
+				Valaddress:    argValaddress,
+				Amount:        argAmount,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -29,7 +29,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 
 	k.SetQueueState(ctx, types.QUEUE_IDENTIFIER_COMMISSION, genState.QueueStateCommission)
+	for _, entry := range genState.RetireStakerEntries {
+		k.SetRetireStakerEntry(ctx, entry)
+	}
+
 	k.SetQueueState(ctx, types.QUEUE_IDENTIFIER_LEAVE, genState.QueueStateLeave)
+	k.SetQueueState(ctx, types.QUEUE_IDENTIFIER_RETIRE, genState.QueueStateRetire)
+
+	for _, entry := range genState.JailEntries {
+		k.SetJailEntry(ctx, entry)
+	}
+
+	k.SetQueueState(ctx, types.QUEUE_IDENTIFIER_JAIL, genState.QueueStateJail)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -49,5 +60,13 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.QueueStateLeave = k.GetQueueState(ctx, types.QUEUE_IDENTIFIER_LEAVE)
 
+	genesis.RetireStakerEntries = k.GetAllRetireStakerEntries(ctx)
+
+	genesis.QueueStateRetire = k.GetQueueState(ctx, types.QUEUE_IDENTIFIER_RETIRE)
+
+	genesis.JailEntries = k.GetAllJailEntries(ctx)
+
+	genesis.QueueStateJail = k.GetQueueState(ctx, types.QUEUE_IDENTIFIER_JAIL)
+
 	return genesis
 }
@@ -63,5 +63,9 @@ func (k Keeper) AssertValaccountAuthorized(ctx sdk.Context, poolId uint64, stake
 		return types.ErrValaccountUnauthorized
 	}
 
+	if valaccount.IsJailed() {
+		return types.ErrValaccountJailed
+	}
+
 	return nil
 }
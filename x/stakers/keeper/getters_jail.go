@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetJailEntry ...
+func (k Keeper) SetJailEntry(ctx sdk.Context, jailEntry types.JailEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailEntryKeyPrefix)
+	b := k.cdc.MustMarshal(&jailEntry)
+	store.Set(types.JailEntryKey(jailEntry.Index), b)
+
+	// Insert the same entry with a different key prefix for query lookup
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, jailEntry.Index)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailEntryKeyPrefixIndex2)
+	indexStore.Set(types.JailEntryKeyIndex2(jailEntry.Staker, jailEntry.PoolId), indexBytes)
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailEntryKeyPrefixIndexExpiry)
+	expiryStore.Set(types.JailEntryKeyIndexExpiry(jailEntry.JailedUntil, jailEntry.Index), indexBytes)
+}
+
+// GetJailEntry ...
+func (k Keeper) GetJailEntry(ctx sdk.Context, index uint64) (val types.JailEntry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailEntryKeyPrefix)
+
+	b := store.Get(types.JailEntryKey(index))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetJailEntryByIndex2 ...
+func (k Keeper) GetJailEntryByIndex2(ctx sdk.Context, staker string, poolId uint64) (val types.JailEntry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailEntryKeyPrefixIndex2)
+
+	b := store.Get(types.JailEntryKeyIndex2(staker, poolId))
+	if b == nil {
+		return val, false
+	}
+
+	index := binary.BigEndian.Uint64(b)
+
+	return k.GetJailEntry(ctx, index)
+}
+
+// RemoveJailEntry ...
+func (k Keeper) RemoveJailEntry(ctx sdk.Context, jailEntry *types.JailEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailEntryKeyPrefix)
+	store.Delete(types.JailEntryKey(jailEntry.Index))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailEntryKeyPrefixIndex2)
+	indexStore.Delete(types.JailEntryKeyIndex2(jailEntry.Staker, jailEntry.PoolId))
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailEntryKeyPrefixIndexExpiry)
+	expiryStore.Delete(types.JailEntryKeyIndexExpiry(jailEntry.JailedUntil, jailEntry.Index))
+}
+
+// GetJailEntriesExpiredBefore returns all jail entries which expired
+// before or at the given time, ordered by their expiry.
+func (k Keeper) GetJailEntriesExpiredBefore(ctx sdk.Context, time uint64) (list []types.JailEntry) {
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailEntryKeyPrefixIndexExpiry)
+	iterator := expiryStore.Iterator(nil, types.JailEntryKeyIndexExpiry(time+1, 0))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if val, found := k.GetJailEntry(ctx, binary.BigEndian.Uint64(iterator.Value())); found {
+			list = append(list, val)
+		}
+	}
+
+	return
+}
+
+// GetAllJailEntries ...
+func (k Keeper) GetAllJailEntries(ctx sdk.Context) (list []types.JailEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailEntryKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.JailEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
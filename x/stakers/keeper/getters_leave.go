@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	"github.com/KYVENetwork/chain/x/stakers/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,11 +21,14 @@ func (k Keeper) SetLeavePoolEntry(ctx sdk.Context, leavePoolEntry types.LeavePoo
 	), b)
 
 	// Insert the same entry with a different key prefix for query lookup
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, leavePoolEntry.Index)
+
 	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LeavePoolEntryKeyPrefixIndex2)
 	indexStore.Set(types.LeavePoolEntryKeyIndex2(
 		leavePoolEntry.Staker,
 		leavePoolEntry.PoolId,
-	), []byte{1})
+	), indexBytes)
 }
 
 // GetLeavePoolEntry ...
@@ -48,8 +53,9 @@ func (k Keeper) GetLeavePoolEntryByIndex2(ctx sdk.Context, staker string, poolId
 		return val, false
 	}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+	index := binary.BigEndian.Uint64(b)
+
+	return k.GetLeavePoolEntry(ctx, index)
 }
 
 // DoesLeavePoolEntryExistByIndex2 ...
@@ -15,6 +15,10 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.UnbondingStakingTime(ctx),
 		k.CommissionChangeTime(ctx),
 		k.LeavePoolTime(ctx),
+		k.JailDuration(ctx),
+		k.MaxJailings(ctx),
+		k.MaxCommission(ctx),
+		k.UnjailGracePeriod(ctx),
 	)
 }
 
@@ -59,6 +63,30 @@ func (k Keeper) LeavePoolTime(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// JailDuration returns the JailDuration param
+func (k Keeper) JailDuration(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyJailDuration, &res)
+	return
+}
+
+// MaxJailings returns the MaxJailings param
+func (k Keeper) MaxJailings(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxJailings, &res)
+	return
+}
+
+// MaxCommission returns the MaxCommission param
+func (k Keeper) MaxCommission(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyMaxCommission, &res)
+	return
+}
+
+// UnjailGracePeriod returns the UnjailGracePeriod param
+func (k Keeper) UnjailGracePeriod(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyUnjailGracePeriod, &res)
+	return
+}
+
 // ParamStore returns the entire param store
 func (k Keeper) ParamStore() (paramStore paramtypes.Subspace) {
 	return k.paramstore
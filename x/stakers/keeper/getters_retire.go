@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetRetireStakerEntry ...
+func (k Keeper) SetRetireStakerEntry(ctx sdk.Context, retireStakerEntry types.RetireStakerEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RetireStakerEntryKeyPrefix)
+	b := k.cdc.MustMarshal(&retireStakerEntry)
+	store.Set(types.RetireStakerEntryKey(retireStakerEntry.Index), b)
+
+	// Insert the same entry with a different key prefix for query lookup
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, retireStakerEntry.Index)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RetireStakerEntryKeyPrefixIndex2)
+	indexStore.Set(types.RetireStakerEntryKeyIndex2(retireStakerEntry.Staker), indexBytes)
+}
+
+// GetRetireStakerEntry ...
+func (k Keeper) GetRetireStakerEntry(ctx sdk.Context, index uint64) (val types.RetireStakerEntry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RetireStakerEntryKeyPrefix)
+
+	b := store.Get(types.RetireStakerEntryKey(index))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetRetireStakerEntryByIndex2 ...
+func (k Keeper) GetRetireStakerEntryByIndex2(ctx sdk.Context, staker string) (val types.RetireStakerEntry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RetireStakerEntryKeyPrefixIndex2)
+
+	b := store.Get(types.RetireStakerEntryKeyIndex2(staker))
+	if b == nil {
+		return val, false
+	}
+
+	index := binary.BigEndian.Uint64(b)
+
+	return k.GetRetireStakerEntry(ctx, index)
+}
+
+// IsStakerRetiring returns true if the staker started to retire.
+func (k Keeper) IsStakerRetiring(ctx sdk.Context, staker string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RetireStakerEntryKeyPrefixIndex2)
+	return store.Has(types.RetireStakerEntryKeyIndex2(staker))
+}
+
+// RemoveRetireStakerEntry ...
+func (k Keeper) RemoveRetireStakerEntry(ctx sdk.Context, retireStakerEntry *types.RetireStakerEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RetireStakerEntryKeyPrefix)
+	store.Delete(types.RetireStakerEntryKey(retireStakerEntry.Index))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RetireStakerEntryKeyPrefixIndex2)
+	indexStore.Delete(types.RetireStakerEntryKeyIndex2(retireStakerEntry.Staker))
+}
+
+// GetAllRetireStakerEntries ...
+func (k Keeper) GetAllRetireStakerEntries(ctx sdk.Context) (list []types.RetireStakerEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RetireStakerEntryKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RetireStakerEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -42,6 +42,9 @@ func (k Keeper) AddValaccountToPool(ctx sdk.Context, poolId uint64, stakerAddres
 				Valaddress: valaddress,
 			})
 			k.AddOneToCount(ctx, poolId)
+
+			// A new staker could let the pool reach its min stake
+			k.poolKeeper.ExpireUploadDeadline(ctx, poolId)
 		}
 	}
 }
@@ -57,6 +60,12 @@ func (k Keeper) RemoveValaccountFromPool(ctx sdk.Context, poolId uint64, stakerA
 		// remove valaccount from pool
 		k.removeValaccount(ctx, valaccount)
 		k.subtractOneFromCount(ctx, poolId)
+
+		// a removed valaccount can no longer be unjailed
+		k.removeJailEntry(ctx, stakerAddress, poolId)
+
+		// Without the staker the pool could fall below its min stake
+		k.poolKeeper.ExpireUploadDeadline(ctx, poolId)
 	}
 }
 
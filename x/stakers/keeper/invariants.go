@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all stakers module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "valaccount-references", ValaccountReferencesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the stakers module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ValaccountReferencesInvariant(k)(ctx)
+	}
+}
+
+// ValaccountReferencesInvariant checks that every valaccount
+// belongs to an existing pool and an existing staker.
+func ValaccountReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, valaccount := range k.GetAllValaccounts(ctx) {
+			if err := k.poolKeeper.AssertPoolExists(ctx, valaccount.PoolId); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tvalaccount of staker %s references non-existent pool %d\n", valaccount.Staker, valaccount.PoolId)
+			}
+
+			if !k.DoesStakerExist(ctx, valaccount.Staker) {
+				broken = true
+				msg += fmt.Sprintf("\tvalaccount in pool %d references non-existent staker %s\n", valaccount.PoolId, valaccount.Staker)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "valaccount-references", msg), broken
+	}
+}
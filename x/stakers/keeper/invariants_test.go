@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	"github.com/KYVENetwork/chain/x/stakers/keeper"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - invariants
+
+* Invariants hold for a staker who joined a pool
+* Valaccount references invariant breaks if the pool does not exist
+* Valaccount references invariant breaks if the staker does not exist
+
+*/
+
+var _ = Describe("invariants", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pool
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name: "Moontest",
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		// create staker
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// join pool
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Invariants hold for a staker who joined a pool", func() {
+		// ACT
+		_, broken := keeper.AllInvariants(s.App().StakersKeeper)(s.Ctx())
+
+		// ASSERT
+		Expect(broken).To(BeFalse())
+	})
+
+	It("Valaccount references invariant breaks if the pool does not exist", func() {
+		// ARRANGE
+		// break the state in a cached context, so the validity checks still pass
+		ctx, _ := s.Ctx().CacheContext()
+
+		s.App().StakersKeeper.SetValaccount(ctx, stakerstypes.Valaccount{
+			PoolId:     1,
+			Staker:     i.STAKER_0,
+			Valaddress: i.VALADDRESS_0,
+		})
+
+		// ACT
+		msg, broken := keeper.ValaccountReferencesInvariant(s.App().StakersKeeper)(ctx)
+
+		// ASSERT
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("non-existent pool 1"))
+	})
+
+	It("Valaccount references invariant breaks if the staker does not exist", func() {
+		// ARRANGE
+		// break the state in a cached context, so the validity checks still pass
+		ctx, _ := s.Ctx().CacheContext()
+
+		s.App().StakersKeeper.SetValaccount(ctx, stakerstypes.Valaccount{
+			PoolId:     0,
+			Staker:     i.STAKER_1,
+			Valaddress: i.VALADDRESS_1,
+		})
+
+		// ACT
+		msg, broken := keeper.ValaccountReferencesInvariant(s.App().StakersKeeper)(ctx)
+
+		// ASSERT
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("non-existent staker " + i.STAKER_1))
+	})
+})
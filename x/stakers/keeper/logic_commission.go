@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // orderNewCommissionChange inserts a new change entry into the queue.
@@ -29,9 +30,72 @@ func (k Keeper) orderNewCommissionChange(ctx sdk.Context, staker string, commiss
 	k.SetCommissionChangeEntry(ctx, commissionChangeEntry)
 }
 
+// getMaxCommission returns the highest commission the staker can charge.
+// It is the lower one of the max commission the staker chose on creation
+// and the global MaxCommission param.
+func (k Keeper) getMaxCommission(ctx sdk.Context, staker types.Staker) sdk.Dec {
+	// The param is validated whenever it gets set
+	maxCommission := sdk.MustNewDecFromStr(k.MaxCommission(ctx))
+
+	// Stakers created before the max commission was introduced have none
+	if stakerMaxCommission, err := sdk.NewDecFromStr(staker.MaxCommission); err == nil && stakerMaxCommission.LT(maxCommission) {
+		maxCommission = stakerMaxCommission
+	}
+
+	return maxCommission
+}
+
+// validateCommissionChange checks that the new commission does not exceed
+// the max commission and differs from the current commission by at most
+// the max commission change rate of the staker.
+func (k Keeper) validateCommissionChange(ctx sdk.Context, staker types.Staker, commission sdk.Dec) error {
+	maxCommission := k.getMaxCommission(ctx, staker)
+	if commission.GT(maxCommission) {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrCommissionTooHigh.Error(), commission, maxCommission)
+	}
+
+	// Stakers created before the max commission change rate was introduced
+	// have none, their commission can be changed freely like before.
+	maxCommissionChangeRate, err := sdk.NewDecFromStr(staker.MaxCommissionChangeRate)
+	if err != nil {
+		return nil
+	}
+
+	currentCommission, err := sdk.NewDecFromStr(staker.Commission)
+	if err != nil {
+		return nil
+	}
+
+	if commission.Sub(currentCommission).Abs().GT(maxCommissionChangeRate) {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrCommissionChangeTooHigh.Error(), maxCommissionChangeRate)
+	}
+
+	return nil
+}
+
+// cancelCommissionChange removes the pending commission change
+// of the staker from the queue.
+func (k Keeper) cancelCommissionChange(ctx sdk.Context, staker string) error {
+
+	queueEntry, found := k.GetCommissionChangeEntryByIndex2(ctx, staker)
+	if !found {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrNoCommissionChange.Error())
+	}
+
+	k.RemoveCommissionChangeEntry(ctx, &queueEntry)
+
+	k.releaseQueueSlot(ctx, types.QUEUE_IDENTIFIER_COMMISSION, func(index uint64) bool {
+		_, found := k.GetCommissionChangeEntry(ctx, index)
+		return found
+	})
+
+	return nil
+}
+
 // ProcessCommissionChangeQueue checks the queue for entries which are due
 // and can be executed. If this is the case, the new commission
-// will be applied to the staker
+// will be applied to the staker. If the max commission got lowered in the
+// meantime the commission is capped to it.
 func (k Keeper) ProcessCommissionChangeQueue(ctx sdk.Context) {
 
 	k.processQueue(ctx, types.QUEUE_IDENTIFIER_COMMISSION, func(index uint64) bool {
@@ -46,11 +110,20 @@ func (k Keeper) ProcessCommissionChangeQueue(ctx sdk.Context) {
 
 			k.RemoveCommissionChangeEntry(ctx, &queueEntry)
 
-			k.UpdateStakerCommission(ctx, queueEntry.Staker, queueEntry.Commission)
+			commission := queueEntry.Commission
+
+			if staker, found := k.GetStaker(ctx, queueEntry.Staker); found {
+				maxCommission := k.getMaxCommission(ctx, staker)
+				if newCommission, err := sdk.NewDecFromStr(commission); err == nil && newCommission.GT(maxCommission) {
+					commission = maxCommission.String()
+				}
+			}
+
+			k.UpdateStakerCommission(ctx, queueEntry.Staker, commission)
 
 			ctx.EventManager().EmitTypedEvent(&types.EventUpdateCommission{
 				Address:    queueEntry.Staker,
-				Commission: queueEntry.Commission,
+				Commission: commission,
 			})
 
 			// Continue with next entry
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// JailValaccount jails the valaccount of the given staker after a timeout.
+// The valaccount keeps its slot in the pool but is excluded from uploading
+// and voting until the staker unjails it. If the staker does not unjail it
+// within the `UnjailGracePeriod` after the jail duration, the valaccount is
+// removed from the pool. If the valaccount already got jailed `MaxJailings`
+// times it is removed from the pool right away.
+// It returns true if the valaccount was removed from the pool.
+func (k Keeper) JailValaccount(ctx sdk.Context, poolId uint64, stakerAddress string) (removed bool) {
+	valaccount, found := k.GetValaccount(ctx, poolId, stakerAddress)
+	if !found {
+		return false
+	}
+
+	if valaccount.JailCount >= k.MaxJailings(ctx) {
+		k.RemoveValaccountFromPool(ctx, poolId, stakerAddress)
+
+		if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventLeavePool{
+			PoolId: poolId,
+			Staker: stakerAddress,
+		}); errEmit != nil {
+			util.LogFatalLogicError("Event not parsable", errEmit.Error())
+		}
+
+		return true
+	}
+
+	valaccount.JailCount += 1
+	valaccount.JailedUntil = uint64(ctx.BlockTime().Unix()) + k.JailDuration(ctx)
+	valaccount.Points = 0
+
+	k.SetValaccount(ctx, valaccount)
+
+	k.removeJailEntry(ctx, stakerAddress, poolId)
+	k.SetJailEntry(ctx, types.JailEntry{
+		Index:        k.getNextQueueSlot(ctx, types.QUEUE_IDENTIFIER_JAIL),
+		Staker:       stakerAddress,
+		PoolId:       poolId,
+		CreationDate: ctx.BlockTime().Unix(),
+		JailedUntil:  valaccount.JailedUntil,
+	})
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventJail{
+		PoolId:      poolId,
+		Staker:      stakerAddress,
+		JailedUntil: valaccount.JailedUntil,
+		JailCount:   valaccount.JailCount,
+	}); errEmit != nil {
+		util.LogFatalLogicError("Event not parsable", errEmit.Error())
+	}
+
+	return false
+}
+
+// IsValaccountJailed returns true if the given staker has a
+// valaccount in the given pool which is currently jailed.
+func (k Keeper) IsValaccountJailed(ctx sdk.Context, poolId uint64, stakerAddress string) bool {
+	valaccount, found := k.GetValaccount(ctx, poolId, stakerAddress)
+	return found && valaccount.IsJailed()
+}
+
+// removeJailEntry removes the pending jail entry of the valaccount, e.g.
+// because it got unjailed or left the pool.
+func (k Keeper) removeJailEntry(ctx sdk.Context, stakerAddress string, poolId uint64) {
+	jailEntry, found := k.GetJailEntryByIndex2(ctx, stakerAddress, poolId)
+	if !found {
+		return
+	}
+
+	k.RemoveJailEntry(ctx, &jailEntry)
+
+	k.releaseQueueSlot(ctx, types.QUEUE_IDENTIFIER_JAIL, func(index uint64) bool {
+		_, found := k.GetJailEntry(ctx, index)
+		return found
+	})
+}
+
+// ProcessJailQueue removes all valaccounts from their pool which did not get
+// unjailed within the UnjailGracePeriod after their jail duration was over.
+// The entries are processed in the order of their expiry, so that entries
+// created after the jail duration got lowered are not blocked by older ones.
+func (k Keeper) ProcessJailQueue(ctx sdk.Context) {
+	now := uint64(ctx.BlockTime().Unix())
+	unjailGracePeriod := k.UnjailGracePeriod(ctx)
+
+	if now < unjailGracePeriod {
+		return
+	}
+
+	expiredEntries := k.GetJailEntriesExpiredBefore(ctx, now-unjailGracePeriod)
+	if len(expiredEntries) == 0 {
+		return
+	}
+
+	for _, queueEntry := range expiredEntries {
+		k.RemoveJailEntry(ctx, &queueEntry)
+
+		valaccount, valaccountFound := k.GetValaccount(ctx, queueEntry.PoolId, queueEntry.Staker)
+		if !valaccountFound || !valaccount.IsJailed() {
+			// the entry is outdated
+			continue
+		}
+
+		k.RemoveValaccountFromPool(ctx, queueEntry.PoolId, queueEntry.Staker)
+
+		if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventLeavePool{
+			PoolId: queueEntry.PoolId,
+			Staker: queueEntry.Staker,
+		}); errEmit != nil {
+			util.LogFatalLogicError("Event not parsable", errEmit.Error())
+		}
+	}
+
+	k.releaseQueueSlot(ctx, types.QUEUE_IDENTIFIER_JAIL, func(index uint64) bool {
+		_, found := k.GetJailEntry(ctx, index)
+		return found
+	})
+}
@@ -27,6 +27,24 @@ func (k Keeper) orderLeavePool(ctx sdk.Context, staker string, poolId uint64) er
 	return nil
 }
 
+// cancelLeavePool removes the pending leave entry of the staker from the queue.
+func (k Keeper) cancelLeavePool(ctx sdk.Context, staker string, poolId uint64) error {
+
+	leavePoolEntry, found := k.GetLeavePoolEntryByIndex2(ctx, staker, poolId)
+	if !found {
+		return sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrNoPoolLeaveInProgress.Error())
+	}
+
+	k.RemoveLeavePoolEntry(ctx, &leavePoolEntry)
+
+	k.releaseQueueSlot(ctx, types.QUEUE_IDENTIFIER_LEAVE, func(index uint64) bool {
+		_, found := k.GetLeavePoolEntry(ctx, index)
+		return found
+	})
+
+	return nil
+}
+
 // ProcessLeavePoolQueue ...
 func (k Keeper) ProcessLeavePoolQueue(ctx sdk.Context) {
 
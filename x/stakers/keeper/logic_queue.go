@@ -44,3 +44,24 @@ func (k Keeper) processQueue(ctx sdk.Context, identifier types.QUEUE_IDENTIFIER,
 	}
 	k.SetQueueState(ctx, identifier, queueState)
 }
+
+// releaseQueueSlot must be called after an entry was removed from the queue
+// identified by `identifier` before it was processed. It shrinks the queue
+// state to the remaining entries, so that the low and high index again point
+// to the first and last existing entry. `entryExists` reports whether an entry
+// is still stored at the given index.
+func (k Keeper) releaseQueueSlot(ctx sdk.Context, identifier types.QUEUE_IDENTIFIER, entryExists func(index uint64) bool) {
+	queueState := k.GetQueueState(ctx, identifier)
+
+	// drop removed entries from the top of the queue
+	for queueState.HighIndex > queueState.LowIndex && !entryExists(queueState.HighIndex) {
+		queueState.HighIndex -= 1
+	}
+
+	// drop removed entries from the end of the queue
+	for queueState.LowIndex < queueState.HighIndex && !entryExists(queueState.LowIndex+1) {
+		queueState.LowIndex += 1
+	}
+
+	k.SetQueueState(ctx, identifier, queueState)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// orderRetireStaker inserts a retire entry into the queue and starts the
+// unbonding of all delegations of the staker, including the ones of third
+// party delegators. Only one retirement per staker can be in progress.
+func (k Keeper) orderRetireStaker(ctx sdk.Context, staker string) {
+
+	k.delegationKeeper.StartUnbondingAllDelegators(ctx, staker)
+
+	queueIndex := k.getNextQueueSlot(ctx, types.QUEUE_IDENTIFIER_RETIRE)
+
+	retireStakerEntry := types.RetireStakerEntry{
+		Index:          queueIndex,
+		Staker:         staker,
+		CreationDate:   ctx.BlockTime().Unix(),
+		UnbondingIndex: k.delegationKeeper.GetQueueState(ctx).HighIndex,
+	}
+
+	k.SetRetireStakerEntry(ctx, retireStakerEntry)
+}
+
+// ProcessRetireStakerQueue removes retired stakers once the UnbondingStakingTime
+// is over and the delegation unbonding queue has processed all unbondings
+// which were started with the retirement.
+func (k Keeper) ProcessRetireStakerQueue(ctx sdk.Context) {
+
+	k.processQueue(ctx, types.QUEUE_IDENTIFIER_RETIRE, func(index uint64) bool {
+
+		// Get queue entry in question
+		queueEntry, found := k.GetRetireStakerEntry(ctx, index)
+
+		if !found {
+			// continue with the next entry
+			return true
+		} else if queueEntry.CreationDate+int64(k.UnbondingStakingTime(ctx)) <= ctx.BlockTime().Unix() &&
+			k.delegationKeeper.GetQueueState(ctx).LowIndex >= queueEntry.UnbondingIndex {
+
+			k.RemoveRetireStakerEntry(ctx, &queueEntry)
+			k.deleteStaker(ctx, queueEntry.Staker)
+
+			ctx.EventManager().EmitTypedEvent(&types.EventRemoveStaker{
+				Staker: queueEntry.Staker,
+			})
+
+			// Continue with next entry
+			return true
+		}
+
+		// Stop queue processing
+		return false
+	})
+}
+
+// deleteStaker removes the staker together with its pending commission
+// change and its delegation data.
+func (k Keeper) deleteStaker(ctx sdk.Context, address string) {
+	if commissionChange, found := k.GetCommissionChangeEntryByIndex2(ctx, address); found {
+		k.RemoveCommissionChangeEntry(ctx, &commissionChange)
+	}
+
+	k.delegationKeeper.RemoveStakerDelegationData(ctx, address)
+
+	if staker, found := k.GetStaker(ctx, address); found {
+		k.removeStaker(ctx, staker)
+	}
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CancelCommissionChange removes a pending commission change of the staker
+// from the queue. The current commission of the staker stays unchanged.
+func (k msgServer) CancelCommissionChange(goCtx context.Context, msg *types.MsgCancelCommissionChange) (*types.MsgCancelCommissionChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the sender is a protocol node (aka has staked into this pool).
+	if !k.DoesStakerExist(ctx, msg.Creator) {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrNoStaker.Error())
+	}
+
+	if err := k.cancelCommissionChange(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventCancelCommissionChange{
+		Staker: msg.Creator,
+	}); errEmit != nil {
+		return nil, errEmit
+	}
+
+	return &types.MsgCancelCommissionChangeResponse{}, nil
+}
@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - msg_server_cancel_commission_change.go
+
+* Cancel the commission change at the head of the queue
+* Cancel the commission change in the middle of the queue
+* Cancel the commission change at the tail of the queue
+* Try to cancel a commission change which is not in progress
+* Update the commission again after cancelling the commission change
+
+*/
+
+var _ = Describe("msg_server_cancel_commission_change.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	stakers := []string{i.STAKER_0, i.STAKER_1, i.ALICE}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create stakers which change their commission in order
+		for _, staker := range stakers {
+			s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+				Creator: staker,
+				Amount:  100 * i.KYVE,
+			})
+		}
+
+		for _, staker := range stakers {
+			s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+				Creator:    staker,
+				Commission: "0.5",
+			})
+		}
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	cancelCommissionChange := func(staker string) {
+		res, err := s.RunTxStakers(&stakerstypes.MsgCancelCommissionChange{
+			Creator: staker,
+		})
+
+		Expect(err).To(BeNil())
+
+		var event *stakerstypes.EventCancelCommissionChange
+		for _, e := range res.Events {
+			if parsed, err := sdk.ParseTypedEvent(e); err == nil {
+				if cancelled, ok := parsed.(*stakerstypes.EventCancelCommissionChange); ok {
+					event = cancelled
+				}
+			}
+		}
+
+		Expect(event).To(Equal(&stakerstypes.EventCancelCommissionChange{
+			Staker: staker,
+		}))
+
+		_, found := s.App().StakersKeeper.GetCommissionChangeEntryByIndex2(s.Ctx(), staker)
+		Expect(found).To(BeFalse())
+	}
+
+	waitForCommissionChange := func() {
+		s.CommitAfterSeconds(s.App().StakersKeeper.CommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+	}
+
+	expectCommissions := func(commissions ...string) {
+		for index, staker := range stakers {
+			found, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), staker)
+			Expect(found.Commission).To(Equal(commissions[index]))
+		}
+	}
+
+	It("Cancel the commission change at the head of the queue", func() {
+		// ACT
+		cancelCommissionChange(i.STAKER_0)
+
+		// ASSERT
+		queueState := s.App().StakersKeeper.GetQueueState(s.Ctx(), stakerstypes.QUEUE_IDENTIFIER_COMMISSION)
+		Expect(queueState.LowIndex).To(Equal(uint64(1)))
+		Expect(queueState.HighIndex).To(Equal(uint64(3)))
+
+		Expect(s.App().StakersKeeper.GetAllCommissionChangeEntries(s.Ctx())).To(HaveLen(2))
+
+		waitForCommissionChange()
+
+		expectCommissions(stakerstypes.DefaultCommission, "0.5", "0.5")
+	})
+
+	It("Cancel the commission change in the middle of the queue", func() {
+		// ACT
+		cancelCommissionChange(i.STAKER_1)
+
+		// ASSERT
+		queueState := s.App().StakersKeeper.GetQueueState(s.Ctx(), stakerstypes.QUEUE_IDENTIFIER_COMMISSION)
+		Expect(queueState.LowIndex).To(BeZero())
+		Expect(queueState.HighIndex).To(Equal(uint64(3)))
+
+		Expect(s.App().StakersKeeper.GetAllCommissionChangeEntries(s.Ctx())).To(HaveLen(2))
+
+		waitForCommissionChange()
+
+		expectCommissions("0.5", stakerstypes.DefaultCommission, "0.5")
+
+		queueState = s.App().StakersKeeper.GetQueueState(s.Ctx(), stakerstypes.QUEUE_IDENTIFIER_COMMISSION)
+		Expect(queueState.LowIndex).To(Equal(uint64(3)))
+		Expect(queueState.HighIndex).To(Equal(uint64(3)))
+	})
+
+	It("Cancel the commission change at the tail of the queue", func() {
+		// ACT
+		cancelCommissionChange(i.ALICE)
+
+		// ASSERT
+		queueState := s.App().StakersKeeper.GetQueueState(s.Ctx(), stakerstypes.QUEUE_IDENTIFIER_COMMISSION)
+		Expect(queueState.LowIndex).To(BeZero())
+		Expect(queueState.HighIndex).To(Equal(uint64(2)))
+
+		Expect(s.App().StakersKeeper.GetAllCommissionChangeEntries(s.Ctx())).To(HaveLen(2))
+
+		waitForCommissionChange()
+
+		expectCommissions("0.5", "0.5", stakerstypes.DefaultCommission)
+	})
+
+	It("Try to cancel a commission change which is not in progress", func() {
+		// ARRANGE
+		cancelCommissionChange(i.STAKER_0)
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgCancelCommissionChange{
+			Creator: i.STAKER_0,
+		})
+
+		s.RunTxStakersError(&stakerstypes.MsgCancelCommissionChange{
+			Creator: i.BOB,
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.GetAllCommissionChangeEntries(s.Ctx())).To(HaveLen(2))
+	})
+
+	It("Update the commission again after cancelling the commission change", func() {
+		// ARRANGE
+		cancelCommissionChange(i.ALICE)
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.ALICE,
+			Commission: "0.2",
+		})
+
+		// ASSERT
+		entry, found := s.App().StakersKeeper.GetCommissionChangeEntryByIndex2(s.Ctx(), i.ALICE)
+		Expect(found).To(BeTrue())
+		Expect(entry.Index).To(Equal(uint64(3)))
+
+		waitForCommissionChange()
+
+		expectCommissions("0.5", "0.5", "0.2")
+	})
+})
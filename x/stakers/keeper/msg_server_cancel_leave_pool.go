@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CancelLeavePool removes a pending pool leave of the staker from the queue.
+// The valaccount stays in the pool and is no longer marked as leaving.
+func (k msgServer) CancelLeavePool(goCtx context.Context, msg *types.MsgCancelLeavePool) (*types.MsgCancelLeavePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valaccount, valaccountFound := k.GetValaccount(ctx, msg.PoolId, msg.Creator)
+	if !valaccountFound {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrAlreadyLeftPool.Error())
+	}
+
+	if err := k.cancelLeavePool(ctx, msg.Creator, msg.PoolId); err != nil {
+		return nil, err
+	}
+
+	valaccount.IsLeaving = false
+	k.SetValaccount(ctx, valaccount)
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventCancelLeavePool{
+		PoolId: msg.PoolId,
+		Staker: msg.Creator,
+	}); errEmit != nil {
+		return nil, errEmit
+	}
+
+	return &types.MsgCancelLeavePoolResponse{}, nil
+}
@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - msg_server_cancel_leave_pool.go
+
+* Cancel the pool leave at the head of the queue
+* Cancel the pool leave in the middle of the queue
+* Cancel the pool leave at the tail of the queue
+* Cancel all pool leaves of the queue
+* Try to cancel a pool leave which is not in progress
+* Leave a pool again after cancelling the pool leave
+
+*/
+
+var _ = Describe("msg_server_cancel_leave_pool.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	stakers := []string{i.STAKER_0, i.STAKER_1, i.ALICE}
+	valaddresses := []string{i.VALADDRESS_0, i.VALADDRESS_1, i.VALADDRESS_2}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pool
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name: "Moontest",
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		// create stakers which join and leave the pool in order
+		for index, staker := range stakers {
+			s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+				Creator: staker,
+				Amount:  100 * i.KYVE,
+			})
+
+			s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+				Creator:    staker,
+				PoolId:     0,
+				Valaddress: valaddresses[index],
+			})
+		}
+
+		for _, staker := range stakers {
+			s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+				Creator: staker,
+				PoolId:  0,
+			})
+		}
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	cancelLeavePool := func(staker string) {
+		res, err := s.RunTxStakers(&stakerstypes.MsgCancelLeavePool{
+			Creator: staker,
+			PoolId:  0,
+		})
+
+		Expect(err).To(BeNil())
+
+		var event *stakerstypes.EventCancelLeavePool
+		for _, e := range res.Events {
+			if parsed, err := sdk.ParseTypedEvent(e); err == nil {
+				if cancelled, ok := parsed.(*stakerstypes.EventCancelLeavePool); ok {
+					event = cancelled
+				}
+			}
+		}
+
+		Expect(event).To(Equal(&stakerstypes.EventCancelLeavePool{
+			PoolId: 0,
+			Staker: staker,
+		}))
+
+		Expect(s.App().StakersKeeper.DoesLeavePoolEntryExistByIndex2(s.Ctx(), staker, 0)).To(BeFalse())
+
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, staker)
+		Expect(found).To(BeTrue())
+		Expect(valaccount.IsLeaving).To(BeFalse())
+	}
+
+	waitForLeavePool := func() {
+		s.CommitAfterSeconds(s.App().StakersKeeper.LeavePoolTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+	}
+
+	It("Cancel the pool leave at the head of the queue", func() {
+		// ACT
+		cancelLeavePool(i.STAKER_0)
+
+		// ASSERT
+		queueState := s.App().StakersKeeper.GetQueueState(s.Ctx(), stakerstypes.QUEUE_IDENTIFIER_LEAVE)
+		Expect(queueState.LowIndex).To(Equal(uint64(1)))
+		Expect(queueState.HighIndex).To(Equal(uint64(3)))
+
+		Expect(s.App().StakersKeeper.GetAllLeavePoolEntries(s.Ctx())).To(HaveLen(2))
+
+		waitForLeavePool()
+
+		Expect(s.App().StakersKeeper.GetAllValaccountsOfPool(s.Ctx(), 0)).To(HaveLen(1))
+
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeTrue())
+	})
+
+	It("Cancel the pool leave in the middle of the queue", func() {
+		// ACT
+		cancelLeavePool(i.STAKER_1)
+
+		// ASSERT
+		queueState := s.App().StakersKeeper.GetQueueState(s.Ctx(), stakerstypes.QUEUE_IDENTIFIER_LEAVE)
+		Expect(queueState.LowIndex).To(BeZero())
+		Expect(queueState.HighIndex).To(Equal(uint64(3)))
+
+		Expect(s.App().StakersKeeper.GetAllLeavePoolEntries(s.Ctx())).To(HaveLen(2))
+
+		waitForLeavePool()
+
+		Expect(s.App().StakersKeeper.GetAllValaccountsOfPool(s.Ctx(), 0)).To(HaveLen(1))
+
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
+		Expect(found).To(BeTrue())
+
+		queueState = s.App().StakersKeeper.GetQueueState(s.Ctx(), stakerstypes.QUEUE_IDENTIFIER_LEAVE)
+		Expect(queueState.LowIndex).To(Equal(uint64(3)))
+		Expect(queueState.HighIndex).To(Equal(uint64(3)))
+	})
+
+	It("Cancel the pool leave at the tail of the queue", func() {
+		// ACT
+		cancelLeavePool(i.ALICE)
+
+		// ASSERT
+		queueState := s.App().StakersKeeper.GetQueueState(s.Ctx(), stakerstypes.QUEUE_IDENTIFIER_LEAVE)
+		Expect(queueState.LowIndex).To(BeZero())
+		Expect(queueState.HighIndex).To(Equal(uint64(2)))
+
+		Expect(s.App().StakersKeeper.GetAllLeavePoolEntries(s.Ctx())).To(HaveLen(2))
+
+		waitForLeavePool()
+
+		Expect(s.App().StakersKeeper.GetAllValaccountsOfPool(s.Ctx(), 0)).To(HaveLen(1))
+
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.ALICE)
+		Expect(found).To(BeTrue())
+	})
+
+	It("Cancel all pool leaves of the queue", func() {
+		// ACT
+		cancelLeavePool(i.STAKER_1)
+		cancelLeavePool(i.ALICE)
+		cancelLeavePool(i.STAKER_0)
+
+		// ASSERT
+		queueState := s.App().StakersKeeper.GetQueueState(s.Ctx(), stakerstypes.QUEUE_IDENTIFIER_LEAVE)
+		Expect(queueState.LowIndex).To(Equal(queueState.HighIndex))
+
+		Expect(s.App().StakersKeeper.GetAllLeavePoolEntries(s.Ctx())).To(BeEmpty())
+
+		waitForLeavePool()
+
+		Expect(s.App().StakersKeeper.GetAllValaccountsOfPool(s.Ctx(), 0)).To(HaveLen(3))
+	})
+
+	It("Try to cancel a pool leave which is not in progress", func() {
+		// ARRANGE
+		cancelLeavePool(i.STAKER_0)
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgCancelLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		s.RunTxStakersError(&stakerstypes.MsgCancelLeavePool{
+			Creator: i.BOB,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.GetAllLeavePoolEntries(s.Ctx())).To(HaveLen(2))
+	})
+
+	It("Leave a pool again after cancelling the pool leave", func() {
+		// ARRANGE
+		cancelLeavePool(i.ALICE)
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+			Creator: i.ALICE,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		entry, found := s.App().StakersKeeper.GetLeavePoolEntryByIndex2(s.Ctx(), i.ALICE, 0)
+		Expect(found).To(BeTrue())
+		Expect(entry.Index).To(Equal(uint64(3)))
+
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.ALICE)
+		Expect(valaccount.IsLeaving).To(BeTrue())
+
+		waitForLeavePool()
+
+		Expect(s.App().StakersKeeper.GetAllValaccountsOfPool(s.Ctx(), 0)).To(BeEmpty())
+	})
+})
@@ -7,13 +7,16 @@ import (
 
 	"github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // CreateStaker handles the logic of an SDK message that allows protocol nodes to create
 // a staker with an initial self delegation.
 // Every user can create a staker object with some stake. However,
 // only if self_delegation + delegation is large enough to join a pool the staker
-// is able to participate in the protocol
+// is able to participate in the protocol.
+// The max commission and the max commission change rate are set once here
+// and can not be changed afterwards.
 func (k msgServer) CreateStaker(goCtx context.Context, msg *types.MsgCreateStaker) (*types.MsgCreateStakerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -22,11 +25,39 @@ func (k msgServer) CreateStaker(goCtx context.Context, msg *types.MsgCreateStake
 		return nil, types.ErrStakerAlreadyCreated
 	}
 
+	// The max commission can not exceed the global max commission
+	maxCommission := msg.MaxCommission
+	if maxCommission == "" {
+		maxCommission = k.MaxCommission(ctx)
+	}
+
+	maxCommissionDec, err := sdk.NewDecFromStr(maxCommission)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidCommission.Error(), maxCommission)
+	}
+
+	if maxCommissionDec.GT(sdk.MustNewDecFromStr(k.MaxCommission(ctx))) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrCommissionTooHigh.Error(), maxCommission, k.MaxCommission(ctx))
+	}
+
+	maxCommissionChangeRate := msg.MaxCommissionChangeRate
+	if maxCommissionChangeRate == "" {
+		maxCommissionChangeRate = types.DefaultMaxCommissionChangeRate
+	}
+
+	// Start with the default commission unless it exceeds the max commission
+	commission := types.DefaultCommission
+	if sdk.MustNewDecFromStr(commission).GT(maxCommissionDec) {
+		commission = maxCommission
+	}
+
 	// Create and append new staker to store
 	k.AppendStaker(ctx, types.Staker{
 		Address: msg.Creator,
 		//Amount:     msg.Amount,
-		Commission: types.DefaultCommission,
+		Commission:              commission,
+		MaxCommission:           maxCommission,
+		MaxCommissionChangeRate: maxCommissionChangeRate,
 	})
 
 	// Perform initial self delegation
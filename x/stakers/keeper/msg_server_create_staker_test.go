@@ -18,6 +18,9 @@ TEST CASES - msg_server_create_staker.go
 * Do an additional 50 $KYVE self delegation after staker has already delegated 100 $KYVE
 * Try to create staker with more $KYVE than available in balance
 * Create a second staker by staking 150 $KYVE
+* Create a staker with a max commission and a max commission change rate
+* Create a staker with a max commission below the default commission
+* Try to create a staker with a max commission above the global max commission
 * TODO: create a staker again after the staker unstaked everything
 
 */
@@ -57,6 +60,8 @@ var _ = Describe("msg_server_create_staker.go", Ordered, func() {
 		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_0)).To(Equal(100 * i.KYVE))
 		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(100 * i.KYVE))
 		Expect(staker.Commission).To(Equal(types.DefaultCommission))
+		Expect(staker.MaxCommission).To(Equal(types.DefaultMaxCommission))
+		Expect(staker.MaxCommissionChangeRate).To(Equal(types.DefaultMaxCommissionChangeRate))
 
 		Expect(staker.Moniker).To(BeEmpty())
 		Expect(staker.Logo).To(BeEmpty())
@@ -155,4 +160,69 @@ var _ = Describe("msg_server_create_staker.go", Ordered, func() {
 
 		Expect(valaccounts).To(BeEmpty())
 	})
+
+	It("Create a staker with a max commission and a max commission change rate", func() {
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator:                 i.STAKER_0,
+			Amount:                  100 * i.KYVE,
+			MaxCommission:           "0.95",
+			MaxCommissionChangeRate: "0.1",
+		})
+
+		// ASSERT
+		staker, found := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+
+		Expect(found).To(BeTrue())
+		Expect(staker.Commission).To(Equal(types.DefaultCommission))
+		Expect(staker.MaxCommission).To(Equal("0.95"))
+		Expect(staker.MaxCommissionChangeRate).To(Equal("0.1"))
+	})
+
+	It("Create a staker with a max commission below the default commission", func() {
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator:       i.STAKER_0,
+			Amount:        100 * i.KYVE,
+			MaxCommission: "0.5",
+		})
+
+		// ASSERT
+		staker, found := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+
+		Expect(found).To(BeTrue())
+		Expect(staker.Commission).To(Equal("0.5"))
+		Expect(staker.MaxCommission).To(Equal("0.5"))
+		Expect(staker.MaxCommissionChangeRate).To(Equal(types.DefaultMaxCommissionChangeRate))
+	})
+
+	It("Try to create a staker with a max commission above the global max commission", func() {
+		// ARRANGE
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaxCommission = "0.5"
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgCreateStaker{
+			Creator:       i.STAKER_0,
+			Amount:        100 * i.KYVE,
+			MaxCommission: "0.6",
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.DoesStakerExist(s.Ctx(), i.STAKER_0)).To(BeFalse())
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(initialBalance))
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+
+		Expect(staker.Commission).To(Equal("0.5"))
+		Expect(staker.MaxCommission).To(Equal("0.5"))
+	})
 })
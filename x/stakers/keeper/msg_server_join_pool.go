@@ -24,6 +24,11 @@ func (k msgServer) JoinPool(goCtx context.Context, msg *types.MsgJoinPool) (*typ
 		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrNoStaker.Error())
 	}
 
+	// throw error if staker is retiring
+	if k.IsStakerRetiring(ctx, msg.Creator) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrStakerRetiring.Error())
+	}
+
 	// throw error if staker joins the pool twice
 	_, valaccountFound := k.GetValaccount(ctx, msg.PoolId, msg.Creator)
 	if valaccountFound {
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RetireStaker starts the deregistration of a staker. The staker has to
+// leave all pools first. All delegations, including the self-delegation,
+// are unbonded through the delegation unbonding queue and the staker can
+// not receive new delegations anymore. Once the unbonding is over and the
+// UnbondingStakingTime has passed the staker gets removed.
+func (k msgServer) RetireStaker(goCtx context.Context, msg *types.MsgRetireStaker) (*types.MsgRetireStakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.DoesStakerExist(ctx, msg.Creator) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrNoStaker.Error())
+	}
+
+	if k.IsStakerRetiring(ctx, msg.Creator) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrStakerRetiring.Error())
+	}
+
+	// Valaccounts which are still leaving also count as pool memberships
+	if len(k.GetValaccountsFromStaker(ctx, msg.Creator)) > 0 {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrStakerInPools.Error())
+	}
+
+	k.orderRetireStaker(ctx, msg.Creator)
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventRetireStaker{
+		Staker: msg.Creator,
+	}); errEmit != nil {
+		return nil, errEmit
+	}
+
+	return &types.MsgRetireStakerResponse{}, nil
+}
@@ -0,0 +1,399 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - msg_server_retire_staker.go
+
+* Retire a staker which is in no pool
+* Try to retire a staker which is still in a pool
+* Try to retire a staker twice
+* Try to delegate, undelegate or join a pool while retiring
+* Retire a staker with third party delegators
+* Retire a staker with a longer unbonding staking time
+* Retire a staker with a longer unbonding delegation time
+* Retire a staker with a pending commission change
+* Create a retired staker again with clean delegations and rewards
+
+*/
+
+var _ = Describe("msg_server_retire_staker.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pool
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name: "Moontest",
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		// create staker
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Retire a staker which is in no pool", func() {
+		// ACT
+		res, err := s.RunTxStakers(&stakerstypes.MsgRetireStaker{
+			Creator: i.STAKER_0,
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+
+		var event *stakerstypes.EventRetireStaker
+		for _, e := range res.Events {
+			if parsed, err := sdk.ParseTypedEvent(e); err == nil {
+				if retired, ok := parsed.(*stakerstypes.EventRetireStaker); ok {
+					event = retired
+				}
+			}
+		}
+
+		Expect(event).To(Equal(&stakerstypes.EventRetireStaker{
+			Staker: i.STAKER_0,
+		}))
+
+		Expect(s.App().StakersKeeper.IsStakerRetiring(s.Ctx(), i.STAKER_0)).To(BeTrue())
+
+		entry, found := s.App().StakersKeeper.GetRetireStakerEntryByIndex2(s.Ctx(), i.STAKER_0)
+
+		Expect(found).To(BeTrue())
+		Expect(entry.Staker).To(Equal(i.STAKER_0))
+		Expect(entry.CreationDate).To(Equal(s.Ctx().BlockTime().Unix()))
+
+		unbondingEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.STAKER_0)
+
+		Expect(unbondingEntries).To(HaveLen(1))
+		Expect(unbondingEntries[0].Staker).To(Equal(i.STAKER_0))
+		Expect(unbondingEntries[0].Amount).To(Equal(100 * i.KYVE))
+
+		fullStaker := s.App().QueryKeeper.GetFullStaker(s.Ctx(), i.STAKER_0)
+
+		Expect(fullStaker.Metadata.PendingRetirement.CreationDate).To(Equal(entry.CreationDate))
+
+		// staker still exists during the unbonding
+		s.CommitAfterSeconds(s.App().StakersKeeper.UnbondingStakingTime(s.Ctx()) - 1)
+
+		Expect(s.App().StakersKeeper.DoesStakerExist(s.Ctx(), i.STAKER_0)).To(BeTrue())
+
+		// ACT
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		// the staker gets removed in the block after the delegations got unbonded
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.DoesStakerExist(s.Ctx(), i.STAKER_0)).To(BeFalse())
+		Expect(s.App().StakersKeeper.IsStakerRetiring(s.Ctx(), i.STAKER_0)).To(BeFalse())
+		Expect(s.App().StakersKeeper.GetAllStakers(s.Ctx())).To(BeEmpty())
+
+		_, found = s.App().DelegationKeeper.GetDelegationData(s.Ctx(), i.STAKER_0)
+		Expect(found).To(BeFalse())
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_0)).To(BeZero())
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(1000 * i.KYVE))
+	})
+
+	It("Try to retire a staker which is still in a pool", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgRetireStaker{
+			Creator: i.STAKER_0,
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.IsStakerRetiring(s.Ctx(), i.STAKER_0)).To(BeFalse())
+
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgRetireStaker{
+			Creator: i.STAKER_0,
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.IsStakerRetiring(s.Ctx(), i.STAKER_0)).To(BeFalse())
+
+		// ARRANGE
+		s.CommitAfterSeconds(s.App().StakersKeeper.UnbondingStakingTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgRetireStaker{
+			Creator: i.STAKER_0,
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.IsStakerRetiring(s.Ctx(), i.STAKER_0)).To(BeTrue())
+	})
+
+	It("Try to retire a staker twice", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgRetireStaker{
+			Creator: i.STAKER_0,
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgRetireStaker{
+			Creator: i.STAKER_0,
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.GetAllRetireStakerEntries(s.Ctx())).To(HaveLen(1))
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx())).To(HaveLen(1))
+	})
+
+	It("Try to delegate, undelegate or join a pool while retiring", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgRetireStaker{
+			Creator: i.STAKER_0,
+		})
+
+		// ACT
+		s.RunTxDelegatorError(&delegationtypes.MsgDelegate{
+			Creator: i.BOB,
+			Staker:  i.STAKER_0,
+			Amount:  50 * i.KYVE,
+		})
+
+		s.RunTxDelegatorError(&delegationtypes.MsgUndelegate{
+			Creator: i.STAKER_0,
+			Staker:  i.STAKER_0,
+			Amount:  50 * i.KYVE,
+		})
+
+		s.RunTxStakersError(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_0)).To(Equal(100 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx())).To(HaveLen(1))
+		Expect(s.App().StakersKeeper.GetValaccountsFromStaker(s.Ctx(), i.STAKER_0)).To(BeEmpty())
+		Expect(s.GetBalanceFromAddress(i.BOB)).To(Equal(1000 * i.KYVE))
+	})
+
+	It("Retire a staker with third party delegators", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.BOB,
+			Staker:  i.STAKER_0,
+			Amount:  50 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.CHARLIE,
+			Staker:  i.STAKER_0,
+			Amount:  20 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgRetireStaker{
+			Creator: i.STAKER_0,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx())).To(HaveLen(3))
+
+		bobEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.BOB)
+		Expect(bobEntries).To(HaveLen(1))
+		Expect(bobEntries[0].Amount).To(Equal(50 * i.KYVE))
+
+		charlieEntries := s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntriesOfDelegator(s.Ctx(), i.CHARLIE)
+		Expect(charlieEntries).To(HaveLen(1))
+		Expect(charlieEntries[0].Amount).To(Equal(20 * i.KYVE))
+
+		// ACT
+		s.CommitAfterSeconds(s.App().DelegationKeeper.UnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.DoesStakerExist(s.Ctx(), i.STAKER_0)).To(BeFalse())
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx())).To(BeEmpty())
+
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(1000 * i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.BOB)).To(Equal(1000 * i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.CHARLIE)).To(Equal(1000 * i.KYVE))
+	})
+
+	It("Retire a staker with a longer unbonding staking time", func() {
+		// ARRANGE
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.UnbondingStakingTime = 2 * s.App().DelegationKeeper.UnbondingDelegationTime(s.Ctx())
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgRetireStaker{
+			Creator: i.STAKER_0,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(s.App().DelegationKeeper.UnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllUnbondingDelegationQueueEntries(s.Ctx())).To(BeEmpty())
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_0)).To(BeZero())
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(1000 * i.KYVE))
+
+		Expect(s.App().StakersKeeper.DoesStakerExist(s.Ctx(), i.STAKER_0)).To(BeTrue())
+		Expect(s.App().StakersKeeper.IsStakerRetiring(s.Ctx(), i.STAKER_0)).To(BeTrue())
+
+		// ACT
+		s.CommitAfterSeconds(s.App().DelegationKeeper.UnbondingDelegationTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.DoesStakerExist(s.Ctx(), i.STAKER_0)).To(BeFalse())
+		Expect(s.App().StakersKeeper.IsStakerRetiring(s.Ctx(), i.STAKER_0)).To(BeFalse())
+	})
+
+	It("Retire a staker with a longer unbonding delegation time", func() {
+		// ARRANGE
+		params := s.App().DelegationKeeper.GetParams(s.Ctx())
+		params.UnbondingDelegationTime = 2 * s.App().StakersKeeper.UnbondingStakingTime(s.Ctx())
+		s.App().DelegationKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgRetireStaker{
+			Creator: i.STAKER_0,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(s.App().StakersKeeper.UnbondingStakingTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.DoesStakerExist(s.Ctx(), i.STAKER_0)).To(BeTrue())
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_0)).To(Equal(100 * i.KYVE))
+
+		// ACT
+		s.CommitAfterSeconds(s.App().StakersKeeper.UnbondingStakingTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.DoesStakerExist(s.Ctx(), i.STAKER_0)).To(BeFalse())
+		Expect(s.GetBalanceFromAddress(i.STAKER_0)).To(Equal(1000 * i.KYVE))
+	})
+
+	It("Retire a staker with a pending commission change", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			Commission: "0.5",
+		})
+
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.CommissionChangeTime = 2 * s.App().StakersKeeper.UnbondingStakingTime(s.Ctx())
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgRetireStaker{
+			Creator: i.STAKER_0,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(s.App().StakersKeeper.UnbondingStakingTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.DoesStakerExist(s.Ctx(), i.STAKER_0)).To(BeFalse())
+
+		_, found := s.App().StakersKeeper.GetCommissionChangeEntryByIndex2(s.Ctx(), i.STAKER_0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Create a retired staker again with clean delegations and rewards", func() {
+		// ARRANGE
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.BOB,
+			Staker:  i.STAKER_0,
+			Amount:  50 * i.KYVE,
+		})
+
+		s.App().DelegationKeeper.SlashDelegators(s.Ctx(), 0, i.STAKER_0, stakerstypes.SLASH_TYPE_UPLOAD, 0, false)
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.CHARLIE,
+			Staker:  i.STAKER_0,
+			Amount:  20 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgRetireStaker{
+			Creator: i.STAKER_0,
+		})
+
+		s.CommitAfterSeconds(s.App().DelegationKeeper.UnbondingDelegationTime(s.Ctx()) + 1)
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		Expect(s.App().StakersKeeper.DoesStakerExist(s.Ctx(), i.STAKER_0)).To(BeFalse())
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.BOB,
+			Staker:  i.STAKER_0,
+			Amount:  50 * i.KYVE,
+		})
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.CHARLIE,
+			Staker:  i.STAKER_0,
+			Amount:  20 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.App().DelegationKeeper.GetAllDelegationSlashEntries(s.Ctx())).To(BeEmpty())
+
+		Expect(s.App().DelegationKeeper.GetDelegationAmount(s.Ctx(), i.STAKER_0)).To(Equal(170 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(100 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.BOB)).To(Equal(50 * i.KYVE))
+		Expect(s.App().DelegationKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.CHARLIE)).To(Equal(20 * i.KYVE))
+
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.BOB).IsZero()).To(BeTrue())
+		Expect(s.App().DelegationKeeper.GetOutstandingRewards(s.Ctx(), i.STAKER_0, i.CHARLIE).IsZero()).To(BeTrue())
+	})
+})
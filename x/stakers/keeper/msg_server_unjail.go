@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Unjail allows a staker to unjail its valaccount in the given pool
+// after the jail duration is over. The valaccount can then upload and
+// vote again. The jail count is kept.
+func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valaccount, valaccountFound := k.GetValaccount(ctx, msg.PoolId, msg.Creator)
+	if !valaccountFound {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrAlreadyLeftPool.Error())
+	}
+
+	if !valaccount.IsJailed() {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrValaccountNotJailed.Error())
+	}
+
+	if uint64(ctx.BlockTime().Unix()) < valaccount.JailedUntil {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrJailDurationNotOver.Error(), valaccount.JailedUntil)
+	}
+
+	valaccount.JailedUntil = 0
+	k.SetValaccount(ctx, valaccount)
+
+	k.removeJailEntry(ctx, msg.Creator, msg.PoolId)
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventUnjail{
+		PoolId: msg.PoolId,
+		Staker: msg.Creator,
+	}); errEmit != nil {
+		return nil, errEmit
+	}
+
+	return &types.MsgUnjailResponse{}, nil
+}
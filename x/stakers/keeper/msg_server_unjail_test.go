@@ -0,0 +1,266 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - msg_server_unjail.go
+
+* Jail a valaccount
+* Jailed valaccount is not authorized
+* Try to unjail a valaccount which is not jailed
+* Try to unjail a valaccount before the jail duration is over
+* Unjail a valaccount after the jail duration is over
+* Remove a valaccount from the pool after max jailings
+* Remove a jailed valaccount from the pool after the unjail grace period
+* Keep an unjailed valaccount in the pool after the unjail grace period
+* Remove a jailed valaccount after the jail duration got lowered before an older one
+
+*/
+
+var _ = Describe("msg_server_unjail.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pool
+		s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+			Name: "Moontest",
+			Protocol: &pooltypes.Protocol{
+				Version:     "0.0.0",
+				Binaries:    "{}",
+				LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+			},
+			UpgradePlan: &pooltypes.UpgradePlan{},
+		})
+
+		// create staker
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// join pool
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Jail a valaccount", func() {
+		// ACT
+		removed := s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		// ASSERT
+		Expect(removed).To(BeFalse())
+
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		Expect(found).To(BeTrue())
+		Expect(valaccount.JailCount).To(Equal(uint64(1)))
+		Expect(valaccount.JailedUntil).To(Equal(uint64(s.Ctx().BlockTime().Unix()) + s.App().StakersKeeper.JailDuration(s.Ctx())))
+		Expect(s.App().StakersKeeper.IsValaccountJailed(s.Ctx(), 0, i.STAKER_0)).To(BeTrue())
+
+		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)
+		Expect(poolStakers).To(ConsistOf(i.STAKER_0))
+	})
+
+	It("Jailed valaccount is not authorized", func() {
+		// ARRANGE
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		// ACT
+		err := s.App().StakersKeeper.AssertValaccountAuthorized(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0)
+
+		// ASSERT
+		Expect(err).To(Equal(stakerstypes.ErrValaccountJailed))
+	})
+
+	It("Try to unjail a valaccount which is not jailed", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUnjail{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.JailedUntil).To(BeZero())
+		Expect(valaccount.JailCount).To(BeZero())
+	})
+
+	It("Try to unjail a valaccount before the jail duration is over", func() {
+		// ARRANGE
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.JailDuration(s.Ctx()) - 1)
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUnjail{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.IsValaccountJailed(s.Ctx(), 0, i.STAKER_0)).To(BeTrue())
+	})
+
+	It("Unjail a valaccount after the jail duration is over", func() {
+		// ARRANGE
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.JailDuration(s.Ctx()))
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUnjail{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ASSERT
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		Expect(found).To(BeTrue())
+		Expect(valaccount.JailedUntil).To(BeZero())
+		Expect(valaccount.JailCount).To(Equal(uint64(1)))
+
+		err := s.App().StakersKeeper.AssertValaccountAuthorized(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0)
+		Expect(err).To(BeNil())
+	})
+
+	It("Remove a valaccount from the pool after max jailings", func() {
+		// ARRANGE
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaxJailings = 1
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.JailDuration(s.Ctx()))
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgUnjail{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ACT
+		removed := s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		// ASSERT
+		Expect(removed).To(BeTrue())
+
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeFalse())
+
+		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)
+		Expect(poolStakers).To(BeEmpty())
+
+		_, found = s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(found).To(BeTrue())
+	})
+
+	It("Remove a jailed valaccount from the pool after the unjail grace period", func() {
+		// ARRANGE
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		Expect(s.App().StakersKeeper.GetAllJailEntries(s.Ctx())).To(HaveLen(1))
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.JailDuration(s.Ctx()))
+
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeTrue())
+
+		// ACT
+		s.CommitAfterSeconds(s.App().StakersKeeper.UnjailGracePeriod(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		_, found = s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeFalse())
+
+		poolStakers := s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)
+		Expect(poolStakers).To(BeEmpty())
+
+		Expect(s.App().StakersKeeper.GetAllJailEntries(s.Ctx())).To(BeEmpty())
+
+		_, found = s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(found).To(BeTrue())
+	})
+
+	It("Keep an unjailed valaccount in the pool after the unjail grace period", func() {
+		// ARRANGE
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.JailDuration(s.Ctx()))
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgUnjail{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+
+		Expect(s.App().StakersKeeper.GetAllJailEntries(s.Ctx())).To(BeEmpty())
+
+		// ACT
+		s.CommitAfterSeconds(s.App().StakersKeeper.UnjailGracePeriod(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeTrue())
+		Expect(valaccount.IsJailed()).To(BeFalse())
+
+		queueState := s.App().StakersKeeper.GetQueueState(s.Ctx(), stakerstypes.QUEUE_IDENTIFIER_JAIL)
+		Expect(queueState.LowIndex).To(Equal(queueState.HighIndex))
+	})
+	It("Remove a jailed valaccount after the jail duration got lowered before an older one", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_1,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_1,
+		})
+
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_0)
+
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.JailDuration = 60
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		s.App().StakersKeeper.JailValaccount(s.Ctx(), 0, i.STAKER_1)
+
+		// ACT
+		s.CommitAfterSeconds(60 + s.App().StakersKeeper.UnjailGracePeriod(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_1)
+		Expect(found).To(BeFalse())
+
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeTrue())
+		Expect(valaccount.IsJailed()).To(BeTrue())
+
+		jailEntries := s.App().StakersKeeper.GetAllJailEntries(s.Ctx())
+		Expect(jailEntries).To(HaveLen(1))
+		Expect(jailEntries[0].Staker).To(Equal(i.STAKER_0))
+	})
+})
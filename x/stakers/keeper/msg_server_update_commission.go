@@ -12,11 +12,14 @@ import (
 // After the CommissionChangeTime is over the new commission will be applied.
 // If an update is currently in the queue it will get removed from the queue
 // and the user needs to wait again for the full time to pass.
+// The commission can not exceed the max commission of the staker and
+// can only change by the max commission change rate at once.
 func (k msgServer) UpdateCommission(goCtx context.Context, msg *types.MsgUpdateCommission) (*types.MsgUpdateCommissionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Check if the sender is a protocol node (aka has staked into this pool).
-	if !k.DoesStakerExist(ctx, msg.Creator) {
+	staker, found := k.GetStaker(ctx, msg.Creator)
+	if !found {
 		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrNoStaker.Error())
 	}
 
@@ -30,6 +33,11 @@ func (k msgServer) UpdateCommission(goCtx context.Context, msg *types.MsgUpdateC
 		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidCommission.Error(), msg.Commission)
 	}
 
+	// Check the max commission and the max commission change rate.
+	if err := k.validateCommissionChange(ctx, staker, commission); err != nil {
+		return nil, err
+	}
+
 	// Insert commission change into queue
 	k.orderNewCommissionChange(ctx, msg.Creator, msg.Commission)
 
@@ -5,7 +5,9 @@ import (
 	. "github.com/onsi/gomega"
 
 	i "github.com/KYVENetwork/chain/testutil/integration"
+	delegationtypes "github.com/KYVENetwork/chain/x/delegation/types"
 	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 /*
@@ -21,6 +23,12 @@ TEST CASES - msg_server_update_commission.go
 * Update commission with a too high number from previously default commission
 * Update commission multiple times during the commission change time
 * Update commission multiple times during the commission change time with the same value
+* Try to update commission above the max commission of the staker
+* Try to update commission above the global max commission
+* Update commission in steps of the max commission change rate
+* Update commission of a staker without a max commission change rate
+* Reject an invalid global max commission
+* Cap a pending commission change to a lowered global max commission
 * // TODO: commission should reset if staker unstakes everything and stakes again
 * // TODO: update commission with multiple stakers
 
@@ -210,4 +218,168 @@ var _ = Describe("msg_server_update_commission.go", Ordered, func() {
 		staker, _ = s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
 		Expect(staker.Commission).To(Equal(stakerstypes.DefaultCommission))
 	})
+
+	It("Try to update commission above the max commission of the staker", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator:       i.STAKER_1,
+			Amount:        100 * i.KYVE,
+			MaxCommission: "0.5",
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: "0.6",
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetCommissionChangeEntryByIndex2(s.Ctx(), i.STAKER_1)
+		Expect(found).To(BeFalse())
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: "0.4",
+		})
+
+		// ASSERT
+		s.CommitAfterSeconds(s.App().StakersKeeper.CommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
+		Expect(staker.Commission).To(Equal("0.4"))
+	})
+
+	It("Try to update commission above the global max commission", func() {
+		// ARRANGE
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaxCommission = "0.5"
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			Commission: "0.6",
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetCommissionChangeEntryByIndex2(s.Ctx(), i.STAKER_0)
+		Expect(found).To(BeFalse())
+
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(staker.Commission).To(Equal(stakerstypes.DefaultCommission))
+	})
+
+	It("Update commission in steps of the max commission change rate", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator:                 i.STAKER_1,
+			Amount:                  100 * i.KYVE,
+			MaxCommissionChangeRate: "0.1",
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: "0.7",
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: "0.8",
+		})
+
+		// the change rate is checked against the current commission
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: "0.7",
+		})
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.CommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
+		Expect(staker.Commission).To(Equal("0.8"))
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: "0.7",
+		})
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.CommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		staker, _ = s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
+		Expect(staker.Commission).To(Equal("0.7"))
+	})
+
+	It("Update commission of a staker without a max commission change rate", func() {
+		// ARRANGE
+		// stakers migrated from the registry have no max commission change rate
+		s.App().StakersKeeper.AppendStaker(s.Ctx(), stakerstypes.Staker{
+			Address:    i.STAKER_1,
+			Commission: stakerstypes.DefaultCommission,
+		})
+
+		s.RunTxDelegatorSuccess(&delegationtypes.MsgDelegate{
+			Creator: i.STAKER_1,
+			Staker:  i.STAKER_1,
+			Amount:  100 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_1,
+			Commission: "0",
+		})
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.CommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_1)
+		Expect(staker.MaxCommissionChangeRate).To(BeEmpty())
+		Expect(staker.Commission).To(Equal("0"))
+	})
+
+	It("Reject an invalid global max commission", func() {
+		// ARRANGE
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+
+		// ACT
+		params.MaxCommission = "invalid"
+
+		// ASSERT
+		Expect(params.Validate()).NotTo(BeNil())
+
+		params.MaxCommission = "1.1"
+		Expect(params.Validate()).NotTo(BeNil())
+
+		params.MaxCommission = "0.5"
+		Expect(params.Validate()).To(BeNil())
+	})
+
+	It("Cap a pending commission change to a lowered global max commission", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			Commission: "0.8",
+		})
+
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaxCommission = "0.5"
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		s.CommitAfterSeconds(s.App().StakersKeeper.CommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		staker, _ := s.App().StakersKeeper.GetStaker(s.Ctx(), i.STAKER_0)
+		Expect(staker.Commission).To(Equal(sdk.MustNewDecFromStr("0.5").String()))
+	})
 })
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UpdateValaddress replaces the valaddress of a valaccount at once, so a
+// compromised key can be rotated without leaving the pool. The old key is
+// rejected by all authorization checks right after the update. If an amount
+// is given it is transferred from the old to the new valaddress, in which
+// case the old valaddress has to sign the message as well.
+func (k msgServer) UpdateValaddress(goCtx context.Context, msg *types.MsgUpdateValaddress) (*types.MsgUpdateValaddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valaccount, valaccountFound := k.GetValaccount(ctx, msg.PoolId, msg.Creator)
+	if !valaccountFound {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrAlreadyLeftPool.Error())
+	}
+
+	// throw error if the valaccount was updated in the meantime
+	if valaccount.Valaddress != msg.OldValaddress {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrValaddressMismatch.Error(), msg.OldValaddress)
+	}
+
+	// throw error if staker has joined another pool with the provided valaddress already
+	for _, other := range k.GetValaccountsFromStaker(ctx, msg.Creator) {
+		if other.Valaddress == msg.Valaddress {
+			return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ValaddressAlreadyUsed.Error())
+		}
+	}
+
+	valaccount.Valaddress = msg.Valaddress
+	k.SetValaccount(ctx, valaccount)
+
+	if err := util.TransferFromAddressToAddress(k.bankKeeper, ctx, msg.OldValaddress, msg.Valaddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventUpdateValaddress{
+		PoolId:        msg.PoolId,
+		Staker:        msg.Creator,
+		OldValaddress: msg.OldValaddress,
+		NewValaddress: msg.Valaddress,
+		Amount:        msg.Amount,
+	}); errEmit != nil {
+		return nil, errEmit
+	}
+
+	return &types.MsgUpdateValaddressResponse{}, nil
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+
+TEST CASES - msg_server_update_valaddress.go
+
+* Update the valaddress of a valaccount
+* Update the valaddress of a valaccount and transfer funds from the old valaddress
+* Try to update the valaddress with a wrong old valaddress
+* Try to update the valaddress to one used in another pool
+* Try to update the valaddress of a pool the staker has not joined
+* Old valaddress requires to sign if funds are transferred
+
+*/
+
+var _ = Describe("msg_server_update_valaddress.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pools
+		for n := 0; n < 2; n++ {
+			s.App().PoolKeeper.AppendPool(s.Ctx(), pooltypes.Pool{
+				Name: "Moontest",
+				Protocol: &pooltypes.Protocol{
+					Version:     "0.0.0",
+					Binaries:    "{}",
+					LastUpgrade: uint64(s.Ctx().BlockTime().Unix()),
+				},
+				UpgradePlan: &pooltypes.UpgradePlan{},
+			})
+		}
+
+		// create staker
+		s.RunTxStakersSuccess(&stakerstypes.MsgCreateStaker{
+			Creator: i.STAKER_0,
+			Amount:  100 * i.KYVE,
+		})
+
+		// join pool
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Valaddress: i.VALADDRESS_0,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Update the valaddress of a valaccount", func() {
+		// ARRANGE
+		s.App().StakersKeeper.AddPoint(s.Ctx(), 0, i.STAKER_0)
+
+		// ACT
+		res, err := s.RunTxStakers(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			OldValaddress: i.VALADDRESS_0,
+			Valaddress:    i.VALADDRESS_1,
+		})
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		valaccount, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(found).To(BeTrue())
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_1))
+		Expect(valaccount.Points).To(Equal(uint64(1)))
+
+		Expect(s.App().StakersKeeper.AssertValaccountAuthorized(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_0)).To(Equal(stakerstypes.ErrValaccountUnauthorized))
+		Expect(s.App().StakersKeeper.AssertValaccountAuthorized(s.Ctx(), 0, i.STAKER_0, i.VALADDRESS_1)).To(BeNil())
+
+		Expect(s.App().StakersKeeper.GetAllStakerAddressesOfPool(s.Ctx(), 0)).To(ConsistOf(i.STAKER_0))
+
+		var event *stakerstypes.EventUpdateValaddress
+		for _, e := range res.Events {
+			if parsed, err := sdk.ParseTypedEvent(e); err == nil {
+				if updated, ok := parsed.(*stakerstypes.EventUpdateValaddress); ok {
+					event = updated
+				}
+			}
+		}
+
+		Expect(event).To(Equal(&stakerstypes.EventUpdateValaddress{
+			PoolId:        0,
+			Staker:        i.STAKER_0,
+			OldValaddress: i.VALADDRESS_0,
+			NewValaddress: i.VALADDRESS_1,
+		}))
+	})
+
+	It("Update the valaddress of a valaccount and transfer funds from the old valaddress", func() {
+		// ARRANGE
+		oldBalance := s.GetBalanceFromAddress(i.VALADDRESS_0)
+		newBalance := s.GetBalanceFromAddress(i.VALADDRESS_1)
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			OldValaddress: i.VALADDRESS_0,
+			Valaddress:    i.VALADDRESS_1,
+			Amount:        10 * i.KYVE,
+		})
+
+		// ASSERT
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_0)).To(Equal(oldBalance - 10*i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.VALADDRESS_1)).To(Equal(newBalance + 10*i.KYVE))
+	})
+
+	It("Try to update the valaddress with a wrong old valaddress", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			OldValaddress: i.VALADDRESS_2,
+			Valaddress:    i.VALADDRESS_1,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_0))
+	})
+
+	It("Try to update the valaddress to one used in another pool", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:    i.STAKER_0,
+			PoolId:     1,
+			Valaddress: i.VALADDRESS_1,
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			OldValaddress: i.VALADDRESS_0,
+			Valaddress:    i.VALADDRESS_1,
+		})
+
+		// ASSERT
+		valaccount, _ := s.App().StakersKeeper.GetValaccount(s.Ctx(), 0, i.STAKER_0)
+		Expect(valaccount.Valaddress).To(Equal(i.VALADDRESS_0))
+	})
+
+	It("Try to update the valaddress of a pool the staker has not joined", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        1,
+			OldValaddress: i.VALADDRESS_0,
+			Valaddress:    i.VALADDRESS_1,
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetValaccount(s.Ctx(), 1, i.STAKER_0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Old valaddress requires to sign if funds are transferred", func() {
+		// ACT
+		msg := &stakerstypes.MsgUpdateValaddress{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			OldValaddress: i.VALADDRESS_0,
+			Valaddress:    i.VALADDRESS_1,
+		}
+		withoutFunds := msg.GetSigners()
+
+		msg.Amount = 10 * i.KYVE
+		withFunds := msg.GetSigners()
+
+		// ASSERT
+		Expect(withoutFunds).To(Equal([]sdk.AccAddress{sdk.MustAccAddressFromBech32(i.STAKER_0)}))
+		Expect(withFunds).To(Equal([]sdk.AccAddress{
+			sdk.MustAccAddressFromBech32(i.STAKER_0),
+			sdk.MustAccAddressFromBech32(i.VALADDRESS_0),
+		}))
+
+		msg.Valaddress = i.VALADDRESS_0
+		Expect(msg.ValidateBasic()).NotTo(BeNil())
+	})
+})
@@ -144,7 +144,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 }
 
 // RegisterInvariants registers the capability module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the capability module's genesis initialization It returns
 // no validator updates.
@@ -175,5 +177,7 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	am.keeper.ProcessCommissionChangeQueue(ctx)
 	am.keeper.ProcessLeavePoolQueue(ctx)
+	am.keeper.ProcessRetireStakerQueue(ctx)
+	am.keeper.ProcessJailQueue(ctx)
 	return []abci.ValidatorUpdate{}
 }
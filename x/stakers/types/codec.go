@@ -16,6 +16,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateCommission{}, "registry/UpdateCommission", nil)
 	cdc.RegisterConcrete(&MsgJoinPool{}, "registry/MsgJoinPool", nil)
 	cdc.RegisterConcrete(&MsgLeavePool{}, "registry/MsgLeavePool", nil)
+	cdc.RegisterConcrete(&MsgUnjail{}, "registry/MsgUnjail", nil)
+	cdc.RegisterConcrete(&MsgUpdateValaddress{}, "registry/MsgUpdateValaddress", nil)
+	cdc.RegisterConcrete(&MsgRetireStaker{}, "registry/MsgRetireStaker", nil)
+	cdc.RegisterConcrete(&MsgCancelLeavePool{}, "registry/MsgCancelLeavePool", nil)
+	cdc.RegisterConcrete(&MsgCancelCommissionChange{}, "registry/MsgCancelCommissionChange", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -25,6 +30,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateMetadata{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgJoinPool{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgLeavePool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUnjail{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateValaddress{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRetireStaker{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelLeavePool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelCommissionChange{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
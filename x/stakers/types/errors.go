@@ -18,4 +18,14 @@ var (
 	ErrInvalidCommission          = sdkerrors.Register(ModuleName, 1116, "invalid commission %v")
 	ErrPoolLeaveAlreadyInProgress = sdkerrors.Register(ModuleName, 1117, "Pool leave is already in progress")
 	ErrValaccountUnauthorized     = sdkerrors.Register(ModuleName, 1118, "valaccount unauthorized")
+	ErrValaccountJailed           = sdkerrors.Register(ModuleName, 1119, "valaccount is jailed")
+	ErrValaccountNotJailed        = sdkerrors.Register(ModuleName, 1120, "valaccount is not jailed")
+	ErrJailDurationNotOver        = sdkerrors.Register(ModuleName, 1121, "valaccount is jailed until %v")
+	ErrValaddressMismatch         = sdkerrors.Register(ModuleName, 1122, "%v is not the current valaddress")
+	ErrStakerRetiring             = sdkerrors.Register(ModuleName, 1123, "staker is retiring")
+	ErrStakerInPools              = sdkerrors.Register(ModuleName, 1124, "staker has to leave all pools first")
+	ErrNoPoolLeaveInProgress      = sdkerrors.Register(ModuleName, 1125, "no pool leave in progress")
+	ErrNoCommissionChange         = sdkerrors.Register(ModuleName, 1126, "no commission change in progress")
+	ErrCommissionTooHigh          = sdkerrors.Register(ModuleName, 1127, "commission %v exceeds the max commission of %v")
+	ErrCommissionChangeTooHigh    = sdkerrors.Register(ModuleName, 1128, "commission change exceeds the max change rate of %v")
 )
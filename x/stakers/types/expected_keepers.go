@@ -24,6 +24,7 @@ type BankKeeper interface {
 
 type PoolKeeper interface {
 	AssertPoolExists(ctx sdk.Context, poolId uint64) error
+	ExpireUploadDeadline(ctx sdk.Context, poolId uint64)
 }
 
 type UpgradeKeeper interface {
@@ -16,6 +16,7 @@ func (gs GenesisState) Validate() error {
 
 	// Staker
 	stakerLeaving := make(map[string]bool)
+	stakerJailed := make(map[string]bool)
 
 	// Valaccounts
 	valaccountMap := make(map[string]struct{})
@@ -26,6 +27,7 @@ func (gs GenesisState) Validate() error {
 		}
 		valaccountMap[index] = struct{}{}
 		stakerLeaving[index] = elem.IsLeaving
+		stakerJailed[index] = elem.IsJailed()
 	}
 
 	// Commission Change
@@ -68,6 +70,59 @@ func (gs GenesisState) Validate() error {
 		leavePoolMap[index] = struct{}{}
 	}
 
+	// Retire Staker
+	stakerMap := make(map[string]struct{})
+	for _, elem := range gs.StakerList {
+		stakerMap[elem.Address] = struct{}{}
+	}
+
+	retireStakerMap := make(map[string]struct{})
+	retiringStakers := make(map[string]struct{})
+
+	for _, elem := range gs.RetireStakerEntries {
+		index := string(RetireStakerEntryKey(elem.Index))
+		if _, ok := retireStakerMap[index]; ok {
+			return fmt.Errorf("duplicated index for retire staker entry %v", elem)
+		}
+		if elem.Index > gs.QueueStateRetire.HighIndex {
+			return fmt.Errorf("retire staker entry index too high: %v", elem)
+		}
+		if elem.Index < gs.QueueStateRetire.LowIndex {
+			return fmt.Errorf("retire staker entry index too low: %v", elem)
+		}
+		if _, ok := stakerMap[elem.Staker]; !ok {
+			return fmt.Errorf("retire staker entry of unknown staker: %v", elem)
+		}
+		if _, ok := retiringStakers[elem.Staker]; ok {
+			return fmt.Errorf("duplicated retire staker entry for staker %v", elem.Staker)
+		}
+
+		retireStakerMap[index] = struct{}{}
+		retiringStakers[elem.Staker] = struct{}{}
+	}
+
+	// Jail
+	jailMap := make(map[string]struct{})
+
+	for _, elem := range gs.JailEntries {
+		index := string(JailEntryKey(elem.Index))
+		if _, ok := jailMap[index]; ok {
+			return fmt.Errorf("duplicated index for jail entry %v", elem)
+		}
+		if elem.Index > gs.QueueStateJail.HighIndex {
+			return fmt.Errorf("jail entry index too high: %v", elem)
+		}
+		if elem.Index < gs.QueueStateJail.LowIndex {
+			return fmt.Errorf("jail entry index too low: %v", elem)
+		}
+		if stakerJailed[string(ValaccountKey(elem.PoolId, elem.Staker))] != true {
+			return fmt.Errorf("inconsistent staker jail: %v", elem)
+		}
+		stakerJailed[string(ValaccountKey(elem.PoolId, elem.Staker))] = false
+
+		jailMap[index] = struct{}{}
+	}
+
 	for staker, isLeaving := range stakerLeaving {
 		if isLeaving != false {
 			return fmt.Errorf("inconsistent staker leave: %v", staker)
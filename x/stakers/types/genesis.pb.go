@@ -39,6 +39,14 @@ type GenesisState struct {
 	LeavePoolEntries []LeavePoolEntry `protobuf:"bytes,6,rep,name=leave_pool_entries,json=leavePoolEntries,proto3" json:"leave_pool_entries"`
 	// queue_state_leave ...
 	QueueStateLeave QueueState `protobuf:"bytes,9,opt,name=queue_state_leave,json=queueStateLeave,proto3" json:"queue_state_leave"`
+	// retire_staker_entries ...
+	RetireStakerEntries []RetireStakerEntry `protobuf:"bytes,10,rep,name=retire_staker_entries,json=retireStakerEntries,proto3" json:"retire_staker_entries"`
+	// queue_state_retire ...
+	QueueStateRetire QueueState `protobuf:"bytes,11,opt,name=queue_state_retire,json=queueStateRetire,proto3" json:"queue_state_retire"`
+	// jail_entries ...
+	JailEntries []JailEntry `protobuf:"bytes,12,rep,name=jail_entries,json=jailEntries,proto3" json:"jail_entries"`
+	// queue_state_jail ...
+	QueueStateJail QueueState `protobuf:"bytes,13,opt,name=queue_state_jail,json=queueStateJail,proto3" json:"queue_state_jail"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +131,34 @@ func (m *GenesisState) GetQueueStateLeave() QueueState {
 	return QueueState{}
 }
 
+func (m *GenesisState) GetRetireStakerEntries() []RetireStakerEntry {
+	if m != nil {
+		return m.RetireStakerEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueueStateRetire() QueueState {
+	if m != nil {
+		return m.QueueStateRetire
+	}
+	return QueueState{}
+}
+
+func (m *GenesisState) GetJailEntries() []JailEntry {
+	if m != nil {
+		return m.JailEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueueStateJail() QueueState {
+	if m != nil {
+		return m.QueueStateJail
+	}
+	return QueueState{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.stakers.v1beta1.GenesisState")
 }
@@ -132,34 +168,39 @@ func init() {
 }
 
 var fileDescriptor_0deb2ee89d595051 = []byte{
-	// 417 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x51, 0x6b, 0xda, 0x50,
-	0x14, 0xc7, 0x93, 0x29, 0xb2, 0x5d, 0xc7, 0xdc, 0x82, 0x6c, 0x99, 0x8c, 0xcc, 0xc9, 0x1e, 0x06,
-	0x1b, 0x09, 0x6e, 0x6f, 0x7b, 0x54, 0x6c, 0x1f, 0x2a, 0xad, 0x55, 0x90, 0xb6, 0x14, 0xc2, 0x35,
-	0x1c, 0xe2, 0xc5, 0x24, 0x37, 0xe6, 0xde, 0xa4, 0xf5, 0x5b, 0xf4, 0x63, 0xf9, 0xe8, 0x63, 0xa1,
-	0x50, 0x8a, 0x7e, 0x91, 0x92, 0x9b, 0xdb, 0xc4, 0x87, 0xf8, 0xd0, 0x37, 0xbd, 0xe7, 0x77, 0x7e,
-	0xe7, 0x7f, 0x6e, 0x2e, 0xea, 0x2c, 0x56, 0x09, 0x58, 0x8c, 0xe3, 0x05, 0x44, 0xcc, 0x4a, 0xba,
-	0x33, 0xe0, 0xb8, 0x6b, 0xb9, 0x10, 0x00, 0x23, 0xcc, 0x0c, 0x23, 0xca, 0xa9, 0xd6, 0x4c, 0x19,
-	0x53, 0x32, 0xa6, 0x64, 0x5a, 0x4d, 0x97, 0xba, 0x54, 0x00, 0x56, 0xfa, 0x2b, 0x63, 0x5b, 0x3f,
-	0x4a, 0x7d, 0x21, 0x8e, 0xb0, 0x2f, 0x75, 0xad, 0xf2, 0x91, 0x2f, 0x7a, 0xc1, 0x74, 0x1e, 0xaa,
-	0xe8, 0xfd, 0x71, 0x16, 0x62, 0xc2, 0x31, 0x07, 0xed, 0x3f, 0xaa, 0x65, 0x12, 0x5d, 0x6d, 0xab,
-	0xbf, 0xea, 0x7f, 0xbf, 0x99, 0x65, 0xa1, 0xcc, 0x91, 0x60, 0x7a, 0xd5, 0xf5, 0xe3, 0x77, 0x65,
-	0x2c, 0x3b, 0xb4, 0x3e, 0xaa, 0x67, 0x9c, 0xed, 0x11, 0xc6, 0xf5, 0x37, 0xed, 0xca, 0x61, 0xc1,
-	0x44, 0xfc, 0x97, 0x02, 0x94, 0x55, 0x87, 0x84, 0x71, 0xed, 0x0c, 0x35, 0x12, 0xec, 0x61, 0xc7,
-	0xa1, 0x71, 0xc0, 0x33, 0x51, 0x45, 0x88, 0xda, 0xe5, 0xa2, 0x69, 0x0e, 0x4b, 0xd9, 0x87, 0xa2,
-	0x5d, 0x08, 0x7d, 0xf4, 0xd5, 0xa1, 0xbe, 0x4f, 0x18, 0x23, 0x34, 0xb0, 0x9d, 0x39, 0x0e, 0x5c,
-	0xb0, 0x21, 0xe0, 0x11, 0x01, 0xa6, 0x57, 0x85, 0xfa, 0x77, 0xb9, 0xba, 0x9f, 0xb7, 0xf5, 0x45,
-	0xd7, 0x20, 0xe0, 0xd1, 0x4a, 0x4e, 0xf9, 0xe2, 0x94, 0x14, 0x09, 0x30, 0xed, 0x1a, 0x7d, 0x5e,
-	0xc6, 0x10, 0x83, 0xcd, 0xd2, 0xfb, 0xb4, 0x0b, 0x4c, 0x7f, 0x2b, 0x2e, 0xf4, 0xc0, 0x1a, 0xe7,
-	0x69, 0x8f, 0xf8, 0x04, 0x72, 0x40, 0x73, 0x99, 0x9f, 0x14, 0x39, 0xb4, 0x0b, 0xa4, 0x79, 0x80,
-	0x13, 0xb0, 0x43, 0x4a, 0xbd, 0x7c, 0x8b, 0x9a, 0xd8, 0xe2, 0x67, 0xb9, 0x79, 0x98, 0xf2, 0x23,
-	0x4a, 0xbd, 0xfd, 0xf8, 0x1f, 0xbd, 0xfd, 0xd3, 0x34, 0xf7, 0x18, 0x7d, 0xda, 0xcf, 0x2d, 0xea,
-	0xfa, 0xbb, 0x57, 0x45, 0x6e, 0x14, 0x91, 0xc5, 0xd0, 0xde, 0xd1, 0x7a, 0x6b, 0xa8, 0x9b, 0xad,
-	0xa1, 0x3e, 0x6d, 0x0d, 0xf5, 0x6e, 0x67, 0x28, 0x9b, 0x9d, 0xa1, 0xdc, 0xef, 0x0c, 0xe5, 0xea,
-	0x8f, 0x4b, 0xf8, 0x3c, 0x9e, 0x99, 0x0e, 0xf5, 0xad, 0x93, 0xcb, 0xe9, 0xe0, 0x14, 0xf8, 0x0d,
-	0x8d, 0x16, 0x96, 0x33, 0xc7, 0x24, 0xb0, 0x6e, 0xf3, 0x57, 0xcb, 0x57, 0x21, 0xb0, 0x59, 0x4d,
-	0x3c, 0xd6, 0x7f, 0xcf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x80, 0x0e, 0xb8, 0x45, 0x03, 0x00,
-	0x00,
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0x87, 0x1b, 0x36, 0x15, 0x70, 0xcb, 0x56, 0x4c, 0x81, 0x50, 0xa1, 0xac, 0x4c, 0x48, 0x20,
+	0x81, 0x12, 0x0d, 0xee, 0xb8, 0x5c, 0x35, 0x40, 0x30, 0x41, 0xe9, 0xd0, 0x04, 0x08, 0x29, 0x72,
+	0xa3, 0xa3, 0xd6, 0x6b, 0x12, 0x77, 0xb6, 0x5b, 0xe8, 0x5b, 0xf0, 0x28, 0x3c, 0xc6, 0x2e, 0x77,
+	0xc9, 0x15, 0x42, 0xed, 0x8b, 0x20, 0xff, 0x59, 0x12, 0x90, 0x77, 0xd1, 0xbb, 0xd6, 0xe7, 0x77,
+	0xbe, 0xf3, 0xd9, 0xb1, 0x8c, 0x76, 0x27, 0x8b, 0x39, 0x44, 0x42, 0x92, 0x09, 0x70, 0x11, 0xcd,
+	0xf7, 0x86, 0x20, 0xc9, 0x5e, 0x34, 0x82, 0x1c, 0x04, 0x15, 0xe1, 0x94, 0x33, 0xc9, 0x70, 0x5b,
+	0x65, 0x42, 0x9b, 0x09, 0x6d, 0xa6, 0xd3, 0x1e, 0xb1, 0x11, 0xd3, 0x81, 0x48, 0xfd, 0x32, 0xd9,
+	0xce, 0x03, 0x27, 0x6f, 0x4a, 0x38, 0xc9, 0x2c, 0xae, 0xe3, 0x1e, 0x79, 0x81, 0xd7, 0x99, 0xdd,
+	0x9f, 0x57, 0x51, 0xf3, 0x95, 0x91, 0x38, 0x92, 0x44, 0x02, 0x7e, 0x81, 0xea, 0x06, 0xe2, 0x7b,
+	0x5d, 0xef, 0x71, 0xe3, 0xd9, 0xfd, 0xd0, 0x25, 0x15, 0xf6, 0x75, 0x66, 0x7f, 0xf3, 0xec, 0xf7,
+	0x4e, 0x6d, 0x60, 0x3b, 0x70, 0x0f, 0x35, 0x4c, 0x2e, 0x4e, 0xa9, 0x90, 0xfe, 0x95, 0xee, 0xc6,
+	0xe5, 0x80, 0x23, 0xfd, 0xdf, 0x02, 0x90, 0xa9, 0x1e, 0x52, 0x21, 0xf1, 0x7b, 0xb4, 0x3d, 0x27,
+	0x29, 0x49, 0x12, 0x36, 0xcb, 0xa5, 0x01, 0x6d, 0x68, 0x50, 0xd7, 0x0d, 0x3a, 0x2e, 0xc2, 0x16,
+	0xb6, 0x55, 0xb6, 0x6b, 0x60, 0x86, 0xee, 0x25, 0x2c, 0xcb, 0xa8, 0x10, 0x94, 0xe5, 0x71, 0x32,
+	0x26, 0xf9, 0x08, 0x62, 0xc8, 0x25, 0xa7, 0x20, 0xfc, 0x4d, 0x8d, 0x7e, 0xe2, 0x46, 0xf7, 0x8a,
+	0xb6, 0x9e, 0xee, 0x3a, 0xc8, 0x25, 0x5f, 0xd8, 0x29, 0x77, 0x13, 0x47, 0x91, 0x82, 0xc0, 0x5f,
+	0xd1, 0x9d, 0xd3, 0x19, 0xcc, 0x20, 0x16, 0xea, 0x3c, 0xe3, 0x32, 0xe6, 0x5f, 0xeb, 0x7a, 0x97,
+	0x6f, 0xe3, 0x83, 0xea, 0xd1, 0x9f, 0xc0, 0x0e, 0x68, 0x9f, 0x16, 0x2b, 0xa5, 0x07, 0xfe, 0x84,
+	0x70, 0x0a, 0x64, 0x0e, 0xf1, 0x94, 0xb1, 0xb4, 0xd8, 0x45, 0x5d, 0xef, 0xe2, 0xa1, 0x9b, 0x7c,
+	0xa8, 0xf2, 0x7d, 0xc6, 0xd2, 0xaa, 0x7e, 0x2b, 0xad, 0xae, 0x2a, 0xef, 0x01, 0xba, 0x59, 0xf5,
+	0xd6, 0x75, 0xff, 0xfa, 0x5a, 0xca, 0xdb, 0xa5, 0xb2, 0x1e, 0x8a, 0x09, 0xba, 0xcd, 0x41, 0x52,
+	0x0e, 0xb1, 0xbd, 0x17, 0x17, 0xc2, 0x48, 0x0b, 0x3f, 0x72, 0x73, 0x07, 0xba, 0xc5, 0x5c, 0x90,
+	0xaa, 0xf3, 0x2d, 0xfe, 0x5f, 0x41, 0x69, 0x7f, 0x44, 0xb8, 0xaa, 0x6d, 0x22, 0x7e, 0x63, 0x2d,
+	0xef, 0x56, 0xe9, 0x6d, 0x66, 0xe3, 0xd7, 0xa8, 0x79, 0x42, 0x68, 0x79, 0xc0, 0x4d, 0xed, 0xbb,
+	0xe3, 0xe6, 0xbd, 0x21, 0xf4, 0x9f, 0xb3, 0x6d, 0x9c, 0xd8, 0x05, 0xe5, 0xd7, 0x47, 0xad, 0xaa,
+	0x9f, 0x2a, 0xf9, 0x37, 0xd6, 0xb2, 0xdb, 0x2a, 0xed, 0xd4, 0xa4, 0xfd, 0x97, 0x67, 0xcb, 0xc0,
+	0x3b, 0x5f, 0x06, 0xde, 0x9f, 0x65, 0xe0, 0xfd, 0x58, 0x05, 0xb5, 0xf3, 0x55, 0x50, 0xfb, 0xb5,
+	0x0a, 0x6a, 0x5f, 0x9e, 0x8e, 0xa8, 0x1c, 0xcf, 0x86, 0x61, 0xc2, 0xb2, 0xe8, 0xed, 0xe7, 0xe3,
+	0x83, 0x77, 0x20, 0xbf, 0x31, 0x3e, 0x89, 0x92, 0x31, 0xa1, 0x79, 0xf4, 0xbd, 0x78, 0x0a, 0xe4,
+	0x62, 0x0a, 0x62, 0x58, 0xd7, 0x2f, 0xc0, 0xf3, 0xbf, 0x03, 0x00, 0x7b, 0x82, 0x8d, 0x83, 0x9a,
+	0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -182,6 +223,54 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.QueueStateJail.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.JailEntries) > 0 {
+		for iNdEx := len(m.JailEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size, err := m.QueueStateRetire.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.RetireStakerEntries) > 0 {
+		for iNdEx := len(m.RetireStakerEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetireStakerEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size, err := m.QueueStateLeave.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -318,6 +407,22 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.QueueStateLeave.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RetireStakerEntries) > 0 {
+		for _, e := range m.RetireStakerEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.QueueStateRetire.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.JailEntries) > 0 {
+		for _, e := range m.JailEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.QueueStateJail.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -591,6 +696,140 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetireStakerEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetireStakerEntries = append(m.RetireStakerEntries, RetireStakerEntry{})
+			if err := m.RetireStakerEntries[len(m.RetireStakerEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueStateRetire", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueueStateRetire.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailEntries = append(m.JailEntries, JailEntry{})
+			if err := m.JailEntries[len(m.JailEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueStateJail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueueStateJail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -42,6 +42,18 @@ var (
 	LeavePoolEntryKeyPrefix = []byte{5, 0}
 	// LeavePoolEntryKeyPrefixIndex2 ...
 	LeavePoolEntryKeyPrefixIndex2 = []byte{5, 1}
+
+	// RetireStakerEntryKeyPrefix ...
+	RetireStakerEntryKeyPrefix = []byte{6, 0}
+	// RetireStakerEntryKeyPrefixIndex2 ...
+	RetireStakerEntryKeyPrefixIndex2 = []byte{6, 1}
+
+	// JailEntryKeyPrefix ...
+	JailEntryKeyPrefix = []byte{7, 0}
+	// JailEntryKeyPrefixIndex2 ...
+	JailEntryKeyPrefixIndex2 = []byte{7, 1}
+	// JailEntryKeyPrefixIndexExpiry ...
+	JailEntryKeyPrefixIndexExpiry = []byte{7, 2}
 )
 
 // ENUM aggregated data types
@@ -58,11 +70,17 @@ type QUEUE_IDENTIFIER []byte
 var (
 	QUEUE_IDENTIFIER_COMMISSION QUEUE_IDENTIFIER = []byte{30, 2}
 	QUEUE_IDENTIFIER_LEAVE      QUEUE_IDENTIFIER = []byte{30, 3}
+	QUEUE_IDENTIFIER_RETIRE     QUEUE_IDENTIFIER = []byte{30, 4}
+	QUEUE_IDENTIFIER_JAIL       QUEUE_IDENTIFIER = []byte{30, 5}
 )
 
 const (
 	MaxStakers        = 50
 	DefaultCommission = "0.9"
+	// DefaultMaxCommissionChangeRate allows any commission change. Limiting
+	// the commission change rate is opt-in for stakers, who commit to it
+	// on creation to give their delegators more certainty.
+	DefaultMaxCommissionChangeRate = "1"
 )
 
 // StakerKey returns the store Key to retrieve a Staker from the index fields
@@ -100,3 +118,26 @@ func LeavePoolEntryKey(index uint64) []byte {
 func LeavePoolEntryKeyIndex2(staker string, poolId uint64) []byte {
 	return util.GetByteKey(staker, poolId)
 }
+
+func RetireStakerEntryKey(index uint64) []byte {
+	return util.GetByteKey(index)
+}
+
+// Important: only one queue entry per staker is allowed at a time.
+func RetireStakerEntryKeyIndex2(staker string) []byte {
+	return util.GetByteKey(staker)
+}
+
+func JailEntryKey(index uint64) []byte {
+	return util.GetByteKey(index)
+}
+
+// Important: only one queue entry per staker+poolId is allowed at a time.
+func JailEntryKeyIndex2(staker string, poolId uint64) []byte {
+	return util.GetByteKey(staker, poolId)
+}
+
+// JailEntryKeyIndexExpiry orders the jail entries by the time they expire.
+func JailEntryKeyIndexExpiry(jailedUntil uint64, index uint64) []byte {
+	return util.GetByteKey(jailedUntil, index)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/KYVENetwork/chain/util"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -35,5 +36,18 @@ func (msg *MsgCreateStaker) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+
+	if msg.MaxCommission != "" {
+		if err := util.ValidatePercentage(msg.MaxCommission); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidCommission.Error(), msg.MaxCommission)
+		}
+	}
+
+	if msg.MaxCommissionChangeRate != "" {
+		if err := util.ValidatePercentage(msg.MaxCommissionChangeRate); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidCommission.Error(), msg.MaxCommissionChangeRate)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateValaddress = "update_valaddress"
+
+var _ sdk.Msg = &MsgUpdateValaddress{}
+
+func (msg *MsgUpdateValaddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateValaddress) Type() string {
+	return TypeMsgUpdateValaddress
+}
+
+// GetSigners returns the creator and, if funds are transferred,
+// the old valaddress as well.
+func (msg *MsgUpdateValaddress) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	if msg.Amount == 0 {
+		return []sdk.AccAddress{creator}
+	}
+
+	oldValaddress, err := sdk.AccAddressFromBech32(msg.OldValaddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator, oldValaddress}
+}
+
+func (msg *MsgUpdateValaddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateValaddress) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.OldValaddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid old valaddress (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Valaddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid valaddress (%s)", err)
+	}
+
+	if msg.OldValaddress == msg.Valaddress {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ValaddressAlreadyUsed.Error())
+	}
+
+	return nil
+}
@@ -38,6 +38,26 @@ var (
 	DefaultLeavePoolTime uint64 = 60 * 60 * 24 * 5
 )
 
+var (
+	KeyJailDuration            = []byte("JailDuration")
+	DefaultJailDuration uint64 = 60 * 60 * 24
+)
+
+var (
+	KeyMaxJailings            = []byte("MaxJailings")
+	DefaultMaxJailings uint64 = 3
+)
+
+var (
+	KeyMaxCommission            = []byte("MaxCommission")
+	DefaultMaxCommission string = "1"
+)
+
+var (
+	KeyUnjailGracePeriod            = []byte("UnjailGracePeriod")
+	DefaultUnjailGracePeriod uint64 = 60 * 60 * 24 * 5
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -51,6 +71,10 @@ func NewParams(
 	unbondingStakingTime uint64,
 	commissionChangeTime uint64,
 	leavePoolTime uint64,
+	jailDuration uint64,
+	maxJailings uint64,
+	maxCommission string,
+	unjailGracePeriod uint64,
 ) Params {
 	return Params{
 		VoteSlash:            voteSlash,
@@ -59,6 +83,10 @@ func NewParams(
 		UnbondingStakingTime: unbondingStakingTime,
 		CommissionChangeTime: commissionChangeTime,
 		LeavePoolTime:        leavePoolTime,
+		JailDuration:         jailDuration,
+		MaxJailings:          maxJailings,
+		MaxCommission:        maxCommission,
+		UnjailGracePeriod:    unjailGracePeriod,
 	}
 }
 
@@ -71,6 +99,10 @@ func DefaultParams() Params {
 		DefaultUnbondingStakingTime,
 		DefaultCommissionChangeTime,
 		DefaultLeavePoolTime,
+		DefaultJailDuration,
+		DefaultMaxJailings,
+		DefaultMaxCommission,
+		DefaultUnjailGracePeriod,
 	)
 }
 
@@ -83,6 +115,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyUnbondingStakingTime, &p.UnbondingStakingTime, util.ValidateUint64),
 		paramtypes.NewParamSetPair(KeyCommissionChangeTime, &p.CommissionChangeTime, util.ValidateUint64),
 		paramtypes.NewParamSetPair(KeyLeavePoolTime, &p.LeavePoolTime, util.ValidateUint64),
+		paramtypes.NewParamSetPair(KeyJailDuration, &p.JailDuration, util.ValidateUint64),
+		paramtypes.NewParamSetPair(KeyMaxJailings, &p.MaxJailings, util.ValidateUint64),
+		paramtypes.NewParamSetPair(KeyMaxCommission, &p.MaxCommission, util.ValidatePercentage),
+		paramtypes.NewParamSetPair(KeyUnjailGracePeriod, &p.UnjailGracePeriod, util.ValidateUint64),
 	}
 }
 
@@ -112,6 +148,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := util.ValidateUint64(p.JailDuration); err != nil {
+		return err
+	}
+
+	if err := util.ValidateUint64(p.MaxJailings); err != nil {
+		return err
+	}
+
+	if err := util.ValidatePercentage(p.MaxCommission); err != nil {
+		return err
+	}
+
+	if err := util.ValidateUint64(p.UnjailGracePeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
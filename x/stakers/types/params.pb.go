@@ -37,6 +37,18 @@ type Params struct {
 	CommissionChangeTime uint64 `protobuf:"varint,5,opt,name=commission_change_time,json=commissionChangeTime,proto3" json:"commission_change_time,omitempty"`
 	// commission_change_time ...
 	LeavePoolTime uint64 `protobuf:"varint,6,opt,name=leave_pool_time,json=leavePoolTime,proto3" json:"leave_pool_time,omitempty"`
+	// jail_duration is the time in seconds a jailed valaccount
+	// has to wait until it can be unjailed.
+	JailDuration uint64 `protobuf:"varint,7,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+	// max_jailings is the number of times a valaccount can be jailed.
+	// Any further timeout removes the valaccount from the pool.
+	MaxJailings uint64 `protobuf:"varint,8,opt,name=max_jailings,json=maxJailings,proto3" json:"max_jailings,omitempty"`
+	// max_commission is the highest commission a staker can charge.
+	MaxCommission string `protobuf:"bytes,9,opt,name=max_commission,json=maxCommission,proto3" json:"max_commission,omitempty"`
+	// unjail_grace_period is the time in seconds a staker has after the
+	// jail duration to unjail its valaccount. Afterwards the valaccount
+	// gets removed from the pool.
+	UnjailGracePeriod uint64 `protobuf:"varint,10,opt,name=unjail_grace_period,json=unjailGracePeriod,proto3" json:"unjail_grace_period,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -113,6 +125,34 @@ func (m *Params) GetLeavePoolTime() uint64 {
 	return 0
 }
 
+func (m *Params) GetJailDuration() uint64 {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxJailings() uint64 {
+	if m != nil {
+		return m.MaxJailings
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCommission() string {
+	if m != nil {
+		return m.MaxCommission
+	}
+	return ""
+}
+
+func (m *Params) GetUnjailGracePeriod() uint64 {
+	if m != nil {
+		return m.UnjailGracePeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kyve.stakers.v1beta1.Params")
 }
@@ -120,27 +160,33 @@ func init() {
 func init() { proto.RegisterFile("kyve/stakers/v1beta1/params.proto", fileDescriptor_405cabd7005fc18b) }
 
 var fileDescriptor_405cabd7005fc18b = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0xd1, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0x06, 0xf0, 0x76, 0xce, 0xc1, 0xe2, 0x86, 0x30, 0x86, 0x0c, 0xc1, 0xba, 0x29, 0xc8, 0x0e,
-	0xd2, 0x30, 0xf4, 0xe4, 0x51, 0xd1, 0x8b, 0x20, 0x63, 0x13, 0x41, 0x2f, 0x25, 0xed, 0x42, 0x1b,
-	0xd6, 0xe4, 0x5f, 0x9a, 0xb4, 0xba, 0x47, 0xf0, 0xe6, 0xd1, 0xa3, 0x8f, 0xe3, 0x71, 0x47, 0x8f,
-	0xb2, 0xbe, 0x88, 0x24, 0x29, 0xf3, 0xd4, 0xf2, 0x7d, 0xbf, 0x0f, 0x42, 0x82, 0x46, 0xcb, 0x55,
-	0x49, 0xb1, 0x54, 0x64, 0x49, 0x73, 0x89, 0xcb, 0x49, 0x48, 0x15, 0x99, 0xe0, 0x8c, 0xe4, 0x84,
-	0x4b, 0x3f, 0xcb, 0x41, 0x41, 0xaf, 0xaf, 0x89, 0x5f, 0x13, 0xbf, 0x26, 0x87, 0xfd, 0x18, 0x62,
-	0x30, 0x00, 0xeb, 0x3f, 0x6b, 0x4f, 0xde, 0x1b, 0xa8, 0x35, 0x35, 0xe3, 0xde, 0x11, 0x42, 0x25,
-	0x28, 0x1a, 0xc8, 0x94, 0xc8, 0x64, 0xe0, 0x0e, 0xdd, 0x71, 0x7b, 0xd6, 0xd6, 0xc9, 0x5c, 0x07,
-	0xbd, 0x11, 0xea, 0x14, 0x59, 0x0a, 0x64, 0x51, 0x83, 0x86, 0x01, 0x7b, 0x36, 0xb3, 0xe4, 0x14,
-	0x75, 0x15, 0xe3, 0x14, 0x0a, 0x55, 0x9b, 0x1d, 0x63, 0x3a, 0x75, 0x68, 0xd1, 0x25, 0x3a, 0x28,
-	0x44, 0x08, 0x62, 0xc1, 0x44, 0x1c, 0xe8, 0x43, 0xea, 0xaf, 0x16, 0x83, 0xe6, 0xd0, 0x1d, 0x37,
-	0x67, 0xfd, 0x6d, 0x3b, 0xb7, 0xe5, 0x23, 0xe3, 0x54, 0xaf, 0x22, 0xe0, 0x9c, 0x49, 0xc9, 0x40,
-	0x04, 0x51, 0x42, 0x44, 0x4c, 0xed, 0x6a, 0xd7, 0xae, 0xfe, 0xdb, 0x1b, 0x53, 0x9a, 0xd5, 0x19,
-	0xda, 0x4f, 0x29, 0x29, 0x69, 0x90, 0x01, 0xa4, 0x96, 0xb7, 0x0c, 0xef, 0x9a, 0x78, 0x0a, 0x90,
-	0x6a, 0x77, 0xd5, 0xfc, 0xfc, 0x3a, 0x76, 0xae, 0xef, 0xbe, 0x37, 0x9e, 0xbb, 0xde, 0x78, 0xee,
-	0xef, 0xc6, 0x73, 0x3f, 0x2a, 0xcf, 0x59, 0x57, 0x9e, 0xf3, 0x53, 0x79, 0xce, 0xcb, 0x79, 0xcc,
-	0x54, 0x52, 0x84, 0x7e, 0x04, 0x1c, 0xdf, 0x3f, 0x3f, 0xdd, 0x3e, 0x50, 0xf5, 0x0a, 0xf9, 0x12,
-	0x47, 0x09, 0x61, 0x02, 0xbf, 0x6d, 0x9f, 0x43, 0xad, 0x32, 0x2a, 0xc3, 0x96, 0xb9, 0xda, 0x8b,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xee, 0x95, 0xc5, 0xab, 0x01, 0x00, 0x00,
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x13, 0xb7, 0x56, 0x3b, 0xdb, 0x28, 0xc6, 0x22, 0x41, 0x30, 0xee, 0xba, 0x28, 0x7b,
+	0x90, 0x84, 0x45, 0x4f, 0x1e, 0xad, 0x7f, 0x40, 0x41, 0x4a, 0x2b, 0x82, 0x5e, 0xc2, 0x24, 0x19,
+	0x92, 0xb1, 0x99, 0x79, 0x43, 0x66, 0x12, 0xd3, 0x6f, 0xe1, 0xd1, 0xa3, 0x47, 0x3f, 0x8a, 0xc7,
+	0x1e, 0x3d, 0x4a, 0xfb, 0x45, 0x96, 0x79, 0x27, 0xb4, 0xa7, 0x84, 0xdf, 0xf3, 0x7b, 0x92, 0x97,
+	0x77, 0x86, 0x9c, 0xaf, 0x37, 0x1d, 0x8b, 0x95, 0xa6, 0x6b, 0xd6, 0xa8, 0xb8, 0xbb, 0x4a, 0x99,
+	0xa6, 0x57, 0x71, 0x4d, 0x1b, 0x2a, 0x54, 0x54, 0x37, 0xa0, 0xc1, 0x9f, 0x19, 0x25, 0x1a, 0x94,
+	0x68, 0x50, 0x1e, 0xce, 0x0a, 0x28, 0x00, 0x85, 0xd8, 0xbc, 0x59, 0xf7, 0xc9, 0x9f, 0x13, 0x32,
+	0x5e, 0x60, 0xd9, 0x7f, 0x44, 0x48, 0x07, 0x9a, 0x25, 0xaa, 0xa2, 0xaa, 0x0c, 0xdc, 0x33, 0xf7,
+	0x72, 0xb2, 0x9c, 0x18, 0xb2, 0x32, 0xc0, 0x3f, 0x27, 0xd3, 0xb6, 0xae, 0x80, 0xe6, 0x83, 0x70,
+	0x03, 0x85, 0x53, 0xcb, 0xac, 0x72, 0x41, 0x3c, 0xcd, 0x05, 0x83, 0x56, 0x0f, 0xce, 0x09, 0x3a,
+	0xd3, 0x01, 0x5a, 0xe9, 0x25, 0x79, 0xd0, 0xca, 0x14, 0x64, 0xce, 0x65, 0x91, 0x98, 0x21, 0xcd,
+	0xd3, 0x18, 0xc1, 0xe8, 0xcc, 0xbd, 0x1c, 0x2d, 0x67, 0x87, 0x74, 0x65, 0xc3, 0xcf, 0x5c, 0x30,
+	0xd3, 0xca, 0x40, 0x08, 0xae, 0x14, 0x07, 0x99, 0x64, 0x25, 0x95, 0x05, 0xb3, 0xad, 0x9b, 0xb6,
+	0x75, 0x4c, 0xe7, 0x18, 0x62, 0xeb, 0x19, 0xb9, 0x5b, 0x31, 0xda, 0xb1, 0xa4, 0x06, 0xa8, 0xac,
+	0x3e, 0x46, 0xdd, 0x43, 0xbc, 0x00, 0xa8, 0xd0, 0xbb, 0x20, 0xde, 0x77, 0xca, 0xab, 0x24, 0x6f,
+	0x1b, 0xaa, 0x39, 0xc8, 0xe0, 0x16, 0x5a, 0x53, 0x03, 0xdf, 0x0c, 0xcc, 0x2c, 0x40, 0xd0, 0x3e,
+	0x31, 0x8c, 0xcb, 0x42, 0x05, 0xb7, 0xd1, 0x39, 0x15, 0xb4, 0xff, 0x30, 0x20, 0xff, 0x29, 0xb9,
+	0x63, 0x94, 0xe3, 0x2c, 0xc1, 0x04, 0x37, 0xe0, 0x09, 0xda, 0xcf, 0x0f, 0xd0, 0x8f, 0xc8, 0xfd,
+	0x56, 0xe2, 0x0f, 0x8b, 0x86, 0x66, 0x2c, 0xa9, 0x59, 0xc3, 0x21, 0x0f, 0x08, 0x7e, 0xf0, 0x9e,
+	0x8d, 0xde, 0x9b, 0x64, 0x81, 0xc1, 0xab, 0xd1, 0xaf, 0xdf, 0x8f, 0x9d, 0xd7, 0xef, 0xfe, 0xee,
+	0x42, 0x77, 0xbb, 0x0b, 0xdd, 0xff, 0xbb, 0xd0, 0xfd, 0xb9, 0x0f, 0x9d, 0xed, 0x3e, 0x74, 0xfe,
+	0xed, 0x43, 0xe7, 0xdb, 0xf3, 0x82, 0xeb, 0xb2, 0x4d, 0xa3, 0x0c, 0x44, 0xfc, 0xf1, 0xeb, 0x97,
+	0xb7, 0x9f, 0x98, 0xfe, 0x01, 0xcd, 0x3a, 0xce, 0x4a, 0xca, 0x65, 0xdc, 0x1f, 0x6e, 0x8b, 0xde,
+	0xd4, 0x4c, 0xa5, 0x63, 0x3c, 0xf9, 0x17, 0xd7, 0x03, 0x00, 0xeb, 0x58, 0xa8, 0x5b, 0x4a, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -163,6 +209,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnjailGracePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnjailGracePeriod))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.MaxCommission) > 0 {
+		i -= len(m.MaxCommission)
+		copy(dAtA[i:], m.MaxCommission)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxCommission)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxJailings != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxJailings))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.JailDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JailDuration))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.LeavePoolTime != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.LeavePoolTime))
 		i--
@@ -240,6 +308,19 @@ func (m *Params) Size() (n int) {
 	if m.LeavePoolTime != 0 {
 		n += 1 + sovParams(uint64(m.LeavePoolTime))
 	}
+	if m.JailDuration != 0 {
+		n += 1 + sovParams(uint64(m.JailDuration))
+	}
+	if m.MaxJailings != 0 {
+		n += 1 + sovParams(uint64(m.MaxJailings))
+	}
+	l = len(m.MaxCommission)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.UnjailGracePeriod != 0 {
+		n += 1 + sovParams(uint64(m.UnjailGracePeriod))
+	}
 	return n
 }
 
@@ -431,6 +512,95 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			m.JailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxJailings", wireType)
+			}
+			m.MaxJailings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxJailings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxCommission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailGracePeriod", wireType)
+			}
+			m.UnjailGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -71,6 +71,13 @@ type Staker struct {
 	Website string `protobuf:"bytes,6,opt,name=website,proto3" json:"website,omitempty"`
 	// logo ...
 	Logo string `protobuf:"bytes,7,opt,name=logo,proto3" json:"logo,omitempty"`
+	// max_commission is the highest commission the staker can ever charge.
+	// It is set when the staker gets created and can not be changed.
+	MaxCommission string `protobuf:"bytes,8,opt,name=max_commission,json=maxCommission,proto3" json:"max_commission,omitempty"`
+	// max_commission_change_rate is the maximum amount a single commission
+	// change can differ from the current commission.
+	// It is set when the staker gets created and can not be changed.
+	MaxCommissionChangeRate string `protobuf:"bytes,9,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3" json:"max_commission_change_rate,omitempty"`
 }
 
 func (m *Staker) Reset()         { *m = Staker{} }
@@ -141,6 +148,20 @@ func (m *Staker) GetLogo() string {
 	return ""
 }
 
+func (m *Staker) GetMaxCommission() string {
+	if m != nil {
+		return m.MaxCommission
+	}
+	return ""
+}
+
+func (m *Staker) GetMaxCommissionChangeRate() string {
+	if m != nil {
+		return m.MaxCommissionChangeRate
+	}
+	return ""
+}
+
 // Valaccount ...
 type Valaccount struct {
 	// pool_id ...
@@ -153,6 +174,12 @@ type Valaccount struct {
 	Points uint64 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
 	// isLeaving ...
 	IsLeaving bool `protobuf:"varint,5,opt,name=is_leaving,json=isLeaving,proto3" json:"is_leaving,omitempty"`
+	// jailed_until is the unix time until the valaccount is jailed.
+	// A jailed valaccount keeps its slot in the pool but can neither
+	// upload nor vote until the staker unjails it. Zero means not jailed.
+	JailedUntil uint64 `protobuf:"varint,6,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	// jail_count is the number of times the valaccount got jailed.
+	JailCount uint64 `protobuf:"varint,7,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
 }
 
 func (m *Valaccount) Reset()         { *m = Valaccount{} }
@@ -223,6 +250,20 @@ func (m *Valaccount) GetIsLeaving() bool {
 	return false
 }
 
+func (m *Valaccount) GetJailedUntil() uint64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+func (m *Valaccount) GetJailCount() uint64 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
+}
+
 // CommissionChangeEntry ...
 type CommissionChangeEntry struct {
 	// index ...
@@ -442,6 +483,166 @@ func (m *LeavePoolEntry) GetCreationDate() int64 {
 	return 0
 }
 
+// RetireStakerEntry ...
+type RetireStakerEntry struct {
+	// index ...
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// creation_date ...
+	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	// unbonding_index is the highest index of the delegation unbonding
+	// queue at the time the staker started to retire.
+	UnbondingIndex uint64 `protobuf:"varint,4,opt,name=unbonding_index,json=unbondingIndex,proto3" json:"unbonding_index,omitempty"`
+}
+
+func (m *RetireStakerEntry) Reset()         { *m = RetireStakerEntry{} }
+func (m *RetireStakerEntry) String() string { return proto.CompactTextString(m) }
+func (*RetireStakerEntry) ProtoMessage()    {}
+func (*RetireStakerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d209d1a2a74d375d, []int{5}
+}
+func (m *RetireStakerEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetireStakerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetireStakerEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetireStakerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetireStakerEntry.Merge(m, src)
+}
+func (m *RetireStakerEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetireStakerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetireStakerEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetireStakerEntry proto.InternalMessageInfo
+
+func (m *RetireStakerEntry) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RetireStakerEntry) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *RetireStakerEntry) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
+	}
+	return 0
+}
+
+func (m *RetireStakerEntry) GetUnbondingIndex() uint64 {
+	if m != nil {
+		return m.UnbondingIndex
+	}
+	return 0
+}
+
+// JailEntry is created for every jailed valaccount. The valaccount gets
+// removed from the pool if it is not unjailed within the unjail grace period
+// after the jail duration is over.
+type JailEntry struct {
+	// index ...
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// creation_date ...
+	CreationDate int64 `protobuf:"varint,4,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	// jailed_until is the unix time until which the valaccount is jailed.
+	// The entries are processed in the order of this time, because it
+	// depends on the jail duration at the time the entry was created.
+	JailedUntil uint64 `protobuf:"varint,5,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *JailEntry) Reset()         { *m = JailEntry{} }
+func (m *JailEntry) String() string { return proto.CompactTextString(m) }
+func (*JailEntry) ProtoMessage()    {}
+func (*JailEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d209d1a2a74d375d, []int{6}
+}
+func (m *JailEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailEntry.Merge(m, src)
+}
+func (m *JailEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailEntry proto.InternalMessageInfo
+
+func (m *JailEntry) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *JailEntry) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *JailEntry) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *JailEntry) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
+	}
+	return 0
+}
+
+func (m *JailEntry) GetJailedUntil() uint64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
 // UnbondingState stores the state for the unbonding of stakes and delegations.
 type QueueState struct {
 	// low_index ...
@@ -454,7 +655,7 @@ func (m *QueueState) Reset()         { *m = QueueState{} }
 func (m *QueueState) String() string { return proto.CompactTextString(m) }
 func (*QueueState) ProtoMessage()    {}
 func (*QueueState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d209d1a2a74d375d, []int{5}
+	return fileDescriptor_d209d1a2a74d375d, []int{7}
 }
 func (m *QueueState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -504,6 +705,8 @@ func init() {
 	proto.RegisterType((*CommissionChangeEntry)(nil), "kyve.stakers.v1beta1.CommissionChangeEntry")
 	proto.RegisterType((*UnbondingStakeEntry)(nil), "kyve.stakers.v1beta1.UnbondingStakeEntry")
 	proto.RegisterType((*LeavePoolEntry)(nil), "kyve.stakers.v1beta1.LeavePoolEntry")
+	proto.RegisterType((*RetireStakerEntry)(nil), "kyve.stakers.v1beta1.RetireStakerEntry")
+	proto.RegisterType((*JailEntry)(nil), "kyve.stakers.v1beta1.JailEntry")
 	proto.RegisterType((*QueueState)(nil), "kyve.stakers.v1beta1.QueueState")
 }
 
@@ -512,42 +715,50 @@ func init() {
 }
 
 var fileDescriptor_d209d1a2a74d375d = []byte{
-	// 556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0x9b, 0xd4, 0x6d, 0x46, 0x7c, 0x84, 0x6d, 0x1b, 0xac, 0xa2, 0x5a, 0x28, 0x5c, 0x10,
-	0x42, 0xb5, 0x2a, 0x7e, 0x41, 0x69, 0x5d, 0x35, 0x22, 0x34, 0x21, 0x4e, 0x22, 0x95, 0x8b, 0xb5,
-	0xb1, 0x57, 0xf6, 0x2a, 0xce, 0x6e, 0xf0, 0x6e, 0x92, 0x46, 0xe2, 0xc0, 0x11, 0x71, 0xe2, 0xc0,
-	0x3f, 0xe0, 0xcf, 0x70, 0xec, 0x91, 0x23, 0x4a, 0xfe, 0x08, 0xda, 0xb5, 0x53, 0x8c, 0x90, 0x50,
-	0xd5, 0xdb, 0xbe, 0xf7, 0x66, 0x76, 0xdf, 0xbc, 0xd1, 0x42, 0x63, 0xb4, 0x98, 0x11, 0x47, 0x48,
-	0x3c, 0x22, 0xa9, 0x70, 0x66, 0x47, 0x43, 0x22, 0xf1, 0xd1, 0x1a, 0x1f, 0x4e, 0x52, 0x2e, 0x39,
-	0xda, 0x55, 0x35, 0x87, 0x6b, 0x2e, 0xaf, 0xd9, 0xdf, 0x8d, 0x78, 0xc4, 0x75, 0x81, 0xa3, 0x4e,
-	0x59, 0x6d, 0xe3, 0x8b, 0x01, 0xa6, 0xa7, 0x2b, 0x91, 0x05, 0x5b, 0x38, 0x0c, 0x53, 0x22, 0x84,
-	0x65, 0x3c, 0x35, 0x9e, 0x57, 0xbb, 0x6b, 0x88, 0x6c, 0x80, 0x80, 0x8f, 0xc7, 0x54, 0x08, 0xca,
-	0x99, 0x55, 0xd1, 0x62, 0x81, 0x51, 0x9d, 0x63, 0xce, 0xe8, 0x88, 0xa4, 0xd6, 0x66, 0xd6, 0x99,
-	0x43, 0xa5, 0xcc, 0xc9, 0x50, 0x50, 0x49, 0x2c, 0x33, 0x53, 0x72, 0x88, 0x10, 0x54, 0x12, 0x1e,
-	0x71, 0x6b, 0x4b, 0xd3, 0xfa, 0xdc, 0xf8, 0x66, 0x00, 0x0c, 0x70, 0x82, 0x83, 0x80, 0x4f, 0x99,
-	0x44, 0x8f, 0x61, 0x6b, 0xc2, 0x79, 0xe2, 0xd3, 0x50, 0x1b, 0xaa, 0x74, 0x4d, 0x05, 0x9b, 0x21,
-	0xaa, 0x83, 0x99, 0x4d, 0x67, 0x6d, 0xe8, 0xee, 0x1c, 0x29, 0x9f, 0x33, 0x9c, 0xac, 0x87, 0x28,
-	0x67, 0x3e, 0xff, 0x30, 0xaa, 0x6f, 0xc2, 0x29, 0x93, 0x42, 0xcf, 0xa0, 0xef, 0x53, 0x08, 0x1d,
-	0x00, 0x50, 0xe1, 0x27, 0x04, 0xcf, 0x28, 0x8b, 0xf4, 0x08, 0xdb, 0xdd, 0x2a, 0x15, 0xad, 0x8c,
-	0x50, 0x19, 0xed, 0x9d, 0xdc, 0x4c, 0x7b, 0x12, 0x63, 0x16, 0x11, 0x97, 0xc9, 0x74, 0x81, 0x76,
-	0x61, 0x93, 0xb2, 0x90, 0x5c, 0xe5, 0xfe, 0x32, 0xf0, 0x3f, 0x7b, 0x85, 0x18, 0xcb, 0xff, 0xc4,
-	0xf8, 0x0c, 0xee, 0x07, 0x29, 0xc1, 0x92, 0x72, 0xe6, 0x87, 0x58, 0x12, 0xed, 0xb2, 0xdc, 0xbd,
-	0xb7, 0x26, 0x4f, 0xb1, 0x24, 0x8d, 0x4f, 0x06, 0xec, 0xf4, 0xd9, 0x90, 0xb3, 0x90, 0xb2, 0x48,
-	0x6f, 0xee, 0x2e, 0x56, 0xea, 0x60, 0xe2, 0xb1, 0x0a, 0x59, 0xdb, 0xa8, 0x74, 0x73, 0x74, 0x3b,
-	0x0b, 0x1f, 0xe1, 0x81, 0x8a, 0x86, 0x74, 0x38, 0x4f, 0xee, 0xf2, 0x78, 0x61, 0xaf, 0xe5, 0xbf,
-	0xf6, 0x7a, 0xab, 0xd7, 0xcf, 0x01, 0xde, 0x4d, 0xc9, 0x94, 0x78, 0x12, 0x4b, 0x82, 0x9e, 0x40,
-	0x35, 0xe1, 0x73, 0xbf, 0xf8, 0xfa, 0x76, 0xc2, 0xe7, 0x4d, 0x6d, 0xe0, 0x00, 0x20, 0xa6, 0x51,
-	0x9c, 0xab, 0x1b, 0x5a, 0xad, 0x2a, 0x46, 0xcb, 0x2f, 0x3e, 0x40, 0xd5, 0x4b, 0xb0, 0x88, 0x7b,
-	0x8b, 0x09, 0x41, 0xfb, 0x50, 0xf7, 0x5a, 0xc7, 0xde, 0xb9, 0xdf, 0xbb, 0xec, 0xb8, 0x7e, 0xff,
-	0xc2, 0xeb, 0xb8, 0x27, 0xcd, 0xb3, 0xa6, 0x7b, 0x5a, 0x2b, 0xa1, 0x3a, 0xa0, 0x82, 0xd6, 0x6b,
-	0xbe, 0x75, 0xdb, 0xfd, 0x5e, 0xcd, 0x40, 0x3b, 0xf0, 0xb0, 0xc0, 0x0f, 0xda, 0x3d, 0xb7, 0xb6,
-	0x81, 0xf6, 0xe0, 0x51, 0xf1, 0xa2, 0x4e, 0xab, 0x7d, 0x7c, 0x5a, 0x2b, 0xef, 0x57, 0x3e, 0x7f,
-	0xb7, 0x4b, 0xaf, 0xcf, 0x7e, 0x2c, 0x6d, 0xe3, 0x7a, 0x69, 0x1b, 0xbf, 0x96, 0xb6, 0xf1, 0x75,
-	0x65, 0x97, 0xae, 0x57, 0x76, 0xe9, 0xe7, 0xca, 0x2e, 0xbd, 0x7f, 0x19, 0x51, 0x19, 0x4f, 0x87,
-	0x87, 0x01, 0x1f, 0x3b, 0x6f, 0x2e, 0x07, 0xee, 0x05, 0x91, 0x73, 0x9e, 0x8e, 0x9c, 0x20, 0xc6,
-	0x94, 0x39, 0x57, 0x37, 0x5f, 0x5e, 0x2e, 0x26, 0x44, 0x0c, 0x4d, 0xfd, 0x7b, 0x5f, 0xfd, 0x0e,
-	0x00, 0x00, 0xff, 0xff, 0x78, 0x6e, 0xdc, 0xe5, 0x0f, 0x04, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4b, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8e, 0x9b, 0x34, 0x6d, 0xce, 0xed, 0x23, 0x9d, 0xb6, 0xa9, 0x95, 0xab, 0x6b, 0x5d, 0x82,
+	0x10, 0x08, 0xa1, 0x46, 0x15, 0x4b, 0x56, 0x25, 0x75, 0xd5, 0x40, 0x69, 0x83, 0x93, 0x54, 0x2a,
+	0x1b, 0x6b, 0x62, 0x8f, 0x9c, 0x21, 0xb6, 0x27, 0xd8, 0x93, 0x97, 0xc4, 0x82, 0x25, 0x62, 0x03,
+	0x6b, 0xb6, 0xfc, 0x19, 0x96, 0x5d, 0x21, 0x96, 0xa8, 0xdd, 0xf3, 0x1b, 0xd0, 0xcc, 0xc4, 0xad,
+	0x4b, 0x24, 0x54, 0x75, 0xc1, 0x6e, 0xbe, 0xef, 0xbc, 0xbf, 0x39, 0x33, 0x50, 0xe9, 0x4d, 0x86,
+	0xa4, 0x1a, 0x73, 0xdc, 0x23, 0x51, 0x5c, 0x1d, 0xee, 0x74, 0x08, 0xc7, 0x3b, 0x09, 0xde, 0xee,
+	0x47, 0x8c, 0x33, 0xb4, 0x21, 0x7c, 0xb6, 0x13, 0x6e, 0xea, 0x53, 0xde, 0xf0, 0x98, 0xc7, 0xa4,
+	0x43, 0x55, 0x9c, 0x94, 0x6f, 0xe5, 0xa7, 0x06, 0xf9, 0xa6, 0xf4, 0x44, 0x3a, 0x2c, 0x60, 0xd7,
+	0x8d, 0x48, 0x1c, 0xeb, 0xda, 0xff, 0xda, 0x83, 0x82, 0x95, 0x40, 0x64, 0x00, 0x38, 0x2c, 0x08,
+	0x68, 0x1c, 0x53, 0x16, 0xea, 0x39, 0x69, 0x4c, 0x31, 0x22, 0x32, 0x60, 0x21, 0xed, 0x91, 0x48,
+	0x9f, 0x57, 0x91, 0x53, 0x28, 0x2c, 0x23, 0xd2, 0x89, 0x29, 0x27, 0x7a, 0x5e, 0x59, 0xa6, 0x10,
+	0x21, 0xc8, 0xf9, 0xcc, 0x63, 0xfa, 0x82, 0xa4, 0xe5, 0x19, 0xdd, 0x83, 0x95, 0x00, 0x8f, 0xed,
+	0x54, 0xad, 0x45, 0x69, 0x5d, 0x0e, 0xf0, 0xb8, 0x76, 0x55, 0xee, 0x09, 0x94, 0xaf, 0xbb, 0xd9,
+	0x4e, 0x17, 0x87, 0x1e, 0xb1, 0x23, 0xcc, 0x89, 0x5e, 0x90, 0x21, 0x5b, 0xd7, 0x42, 0x6a, 0xd2,
+	0x6e, 0x61, 0x4e, 0x2a, 0xdf, 0x34, 0x80, 0x13, 0xec, 0x63, 0xc7, 0x61, 0x83, 0x90, 0xa3, 0x2d,
+	0x58, 0xe8, 0x33, 0xe6, 0xdb, 0xd4, 0x95, 0x43, 0xe7, 0xac, 0xbc, 0x80, 0x75, 0x17, 0x95, 0x20,
+	0xaf, 0x14, 0xd4, 0xe7, 0x64, 0xc2, 0x29, 0x12, 0x5a, 0x0c, 0xb1, 0x9f, 0x08, 0x95, 0x55, 0x5a,
+	0x5c, 0x31, 0x22, 0xae, 0xcf, 0x68, 0xc8, 0x63, 0x3d, 0x97, 0xe4, 0x13, 0x08, 0xfd, 0x07, 0x40,
+	0x63, 0xdb, 0x27, 0x78, 0x48, 0x43, 0x4f, 0xca, 0xb4, 0x68, 0x15, 0x68, 0x7c, 0xa8, 0x08, 0x74,
+	0x07, 0x96, 0x5e, 0x63, 0xea, 0x13, 0xd7, 0x1e, 0x84, 0x9c, 0xfa, 0x52, 0xad, 0x9c, 0xf5, 0x8f,
+	0xe2, 0xda, 0x82, 0x12, 0x19, 0x04, 0xb4, 0x65, 0xe3, 0x52, 0xb7, 0x9c, 0x55, 0x10, 0x4c, 0x4d,
+	0x10, 0x95, 0x0f, 0x1a, 0x6c, 0xfe, 0x3e, 0xb1, 0x19, 0xf2, 0x68, 0x82, 0x36, 0x60, 0x9e, 0x86,
+	0x2e, 0x19, 0x4f, 0x27, 0x54, 0xe0, 0x4f, 0x03, 0xa6, 0x2e, 0x20, 0x3b, 0x73, 0xd9, 0x77, 0x61,
+	0xd9, 0x89, 0x08, 0xe6, 0x42, 0x77, 0x57, 0x08, 0x2e, 0xe6, 0xcc, 0x5a, 0x4b, 0x09, 0xb9, 0x27,
+	0x54, 0x7e, 0xa7, 0xc1, 0x7a, 0x3b, 0xec, 0xb0, 0xd0, 0xa5, 0xa1, 0x27, 0xf7, 0xeb, 0x36, 0xad,
+	0x94, 0x20, 0x8f, 0x03, 0x39, 0x6d, 0x56, 0x69, 0xa9, 0xd0, 0xcd, 0x5a, 0x78, 0x0b, 0x2b, 0x42,
+	0x5c, 0xd2, 0x60, 0xcc, 0xbf, 0x4d, 0xf1, 0xd4, 0x66, 0x64, 0xaf, 0x6d, 0xc6, 0x8d, 0xaa, 0x7f,
+	0xd4, 0x60, 0xcd, 0x22, 0x9c, 0x46, 0x44, 0xbd, 0xae, 0xdb, 0x74, 0x30, 0x53, 0x28, 0x3b, 0x5b,
+	0x08, 0xdd, 0x87, 0xd5, 0x41, 0x22, 0xb4, 0xad, 0x92, 0xab, 0xc5, 0x5b, 0xb9, 0xa4, 0xeb, 0x82,
+	0xad, 0x7c, 0xd6, 0xa0, 0xf0, 0x0c, 0xd3, 0xbf, 0xaf, 0xc5, 0xcc, 0x6e, 0xcf, 0xcf, 0xec, 0x76,
+	0xe5, 0x00, 0xe0, 0xe5, 0x80, 0x0c, 0x84, 0x58, 0x9c, 0xa0, 0x7f, 0xa1, 0xe0, 0xb3, 0x91, 0x9d,
+	0x6e, 0x70, 0xd1, 0x67, 0x23, 0x39, 0x87, 0x78, 0x06, 0x5d, 0xea, 0x75, 0xa7, 0xd6, 0x39, 0xf5,
+	0x0c, 0x04, 0x23, 0xcd, 0x0f, 0xdf, 0x40, 0xa1, 0xe9, 0xe3, 0xb8, 0xdb, 0x9a, 0xf4, 0x09, 0x2a,
+	0x43, 0xa9, 0x79, 0xb8, 0xdb, 0x3c, 0xb0, 0x5b, 0xa7, 0x0d, 0xd3, 0x6e, 0x1f, 0x35, 0x1b, 0x66,
+	0xad, 0xbe, 0x5f, 0x37, 0xf7, 0x8a, 0x19, 0x54, 0x02, 0x94, 0xb2, 0xb5, 0xea, 0x2f, 0xcc, 0xe3,
+	0x76, 0xab, 0xa8, 0xa1, 0x75, 0x58, 0x4d, 0xf1, 0x27, 0xc7, 0x2d, 0xb3, 0x38, 0x87, 0x36, 0x61,
+	0x2d, 0x9d, 0xa8, 0x71, 0x78, 0xbc, 0xbb, 0x57, 0xcc, 0x96, 0x73, 0xef, 0xbf, 0x18, 0x99, 0xa7,
+	0xfb, 0x5f, 0xcf, 0x0d, 0xed, 0xec, 0xdc, 0xd0, 0x7e, 0x9c, 0x1b, 0xda, 0xa7, 0x0b, 0x23, 0x73,
+	0x76, 0x61, 0x64, 0xbe, 0x5f, 0x18, 0x99, 0x57, 0x8f, 0x3c, 0xca, 0xbb, 0x83, 0xce, 0xb6, 0xc3,
+	0x82, 0xea, 0xf3, 0xd3, 0x13, 0xf3, 0x88, 0xf0, 0x11, 0x8b, 0x7a, 0x55, 0xa7, 0x8b, 0x69, 0x58,
+	0x1d, 0x5f, 0xfe, 0xe3, 0x7c, 0xd2, 0x27, 0x71, 0x27, 0x2f, 0xbf, 0xe4, 0xc7, 0xbf, 0x06, 0x00,
+	0x5c, 0xea, 0x52, 0xc0, 0xe4, 0x05, 0x00, 0x00,
 }
 
 func (m *Staker) Marshal() (dAtA []byte, err error) {
@@ -570,6 +781,20 @@ func (m *Staker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxCommissionChangeRate) > 0 {
+		i -= len(m.MaxCommissionChangeRate)
+		copy(dAtA[i:], m.MaxCommissionChangeRate)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.MaxCommissionChangeRate)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.MaxCommission) > 0 {
+		i -= len(m.MaxCommission)
+		copy(dAtA[i:], m.MaxCommission)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.MaxCommission)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Logo) > 0 {
 		i -= len(m.Logo)
 		copy(dAtA[i:], m.Logo)
@@ -628,6 +853,16 @@ func (m *Valaccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JailCount != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.JailedUntil != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.IsLeaving {
 		i--
 		if m.IsLeaving {
@@ -802,6 +1037,101 @@ func (m *LeavePoolEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetireStakerEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetireStakerEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetireStakerEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingIndex != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.UnbondingIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreationDate != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.CreationDate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JailEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreationDate != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.CreationDate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueueState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -872,6 +1202,14 @@ func (m *Staker) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStakers(uint64(l))
 	}
+	l = len(m.MaxCommission)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	l = len(m.MaxCommissionChangeRate)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
 	return n
 }
 
@@ -898,6 +1236,12 @@ func (m *Valaccount) Size() (n int) {
 	if m.IsLeaving {
 		n += 2
 	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovStakers(uint64(m.JailedUntil))
+	}
+	if m.JailCount != 0 {
+		n += 1 + sovStakers(uint64(m.JailCount))
+	}
 	return n
 }
 
@@ -968,33 +1312,80 @@ func (m *LeavePoolEntry) Size() (n int) {
 	return n
 }
 
-func (m *QueueState) Size() (n int) {
+func (m *RetireStakerEntry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.LowIndex != 0 {
-		n += 1 + sovStakers(uint64(m.LowIndex))
+	if m.Index != 0 {
+		n += 1 + sovStakers(uint64(m.Index))
 	}
-	if m.HighIndex != 0 {
-		n += 1 + sovStakers(uint64(m.HighIndex))
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if m.CreationDate != 0 {
+		n += 1 + sovStakers(uint64(m.CreationDate))
+	}
+	if m.UnbondingIndex != 0 {
+		n += 1 + sovStakers(uint64(m.UnbondingIndex))
 	}
 	return n
 }
 
-func sovStakers(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStakers(x uint64) (n int) {
-	return sovStakers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Staker) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+func (m *JailEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovStakers(uint64(m.Index))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovStakers(uint64(m.PoolId))
+	}
+	if m.CreationDate != 0 {
+		n += 1 + sovStakers(uint64(m.CreationDate))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovStakers(uint64(m.JailedUntil))
+	}
+	return n
+}
+
+func (m *QueueState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LowIndex != 0 {
+		n += 1 + sovStakers(uint64(m.LowIndex))
+	}
+	if m.HighIndex != 0 {
+		n += 1 + sovStakers(uint64(m.HighIndex))
+	}
+	return n
+}
+
+func sovStakers(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStakers(x uint64) (n int) {
+	return sovStakers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Staker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowStakers
@@ -1178,6 +1569,70 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 			}
 			m.Logo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxCommission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxCommissionChangeRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStakers(dAtA[iNdEx:])
@@ -1350,6 +1805,44 @@ func (m *Valaccount) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsLeaving = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStakers(dAtA[iNdEx:])
@@ -1801,6 +2294,303 @@ func (m *LeavePoolEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RetireStakerEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetireStakerEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetireStakerEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+			}
+			m.CreationDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIndex", wireType)
+			}
+			m.UnbondingIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JailEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JailEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JailEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+			}
+			m.CreationDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueueState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1 +1,6 @@
 package types
+
+// IsJailed returns true if the valaccount got jailed and was not unjailed yet.
+func (v Valaccount) IsJailed() bool {
+	return v.JailedUntil != 0
+}